@@ -0,0 +1,52 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// accessPointOf returns the access point if bucketName is an access point
+// ARN, or the alias of a Multi-Region Access Point on Amazon S3. Requests
+// of access points are sent to the endpoint of the access point, in the
+// region of the ARN and signed for the service of the ARN.
+func (c *Client) accessPointOf(bucketName string) (s3utils.AccessPointARN, bool) {
+	if s3utils.IsMultiRegionAccessPointAlias(bucketName) && s3utils.IsAmazonEndpoint(*c.endpointURL) {
+		return s3utils.AccessPointARN{
+			Partition: "aws",
+			Service:   s3utils.AccessPointServiceS3,
+			Name:      bucketName,
+		}, true
+	}
+	if !strings.HasPrefix(bucketName, "arn:") {
+		return s3utils.AccessPointARN{}, false
+	}
+	accessPoint, err := s3utils.ParseAccessPointARN(bucketName)
+	return accessPoint, err == nil
+}
+
+// copySourcePath returns the path of a copy source, objects of access
+// points are addressed as <arn>/object/<object>.
+func copySourcePath(bucketName, objectName string) string {
+	if strings.HasPrefix(bucketName, "arn:") && s3utils.IsAccessPointARN(bucketName) {
+		return bucketName + "/object/" + objectName
+	}
+	return bucketName + "/" + objectName
+}
@@ -0,0 +1,102 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestAccessPointRequests(t *testing.T) {
+	testCases := []struct {
+		arn, host, scope string
+	}{
+		{
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point",
+			"my-access-point-123456789012.s3-accesspoint.us-west-2.amazonaws.com",
+			"/us-west-2/s3/aws4_request",
+		},
+		{
+			"arn:aws:s3-object-lambda:eu-west-1:123456789012:accesspoint/my-olap",
+			"my-olap-123456789012.s3-object-lambda.eu-west-1.amazonaws.com",
+			"/eu-west-1/s3-object-lambda/aws4_request",
+		},
+		{
+			"arn:aws:s3-outposts:us-east-1:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/my-ap",
+			"my-ap-123456789012.op-01ac5d28a6a232904.s3-outposts.us-east-1.amazonaws.com",
+			"/us-east-1/s3-outposts/aws4_request",
+		},
+	}
+	for i, testCase := range testCases {
+		var requests int
+		rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			if req.URL.Scheme != "https" || req.URL.Host != testCase.host || req.URL.Path != "/dir/object" {
+				t.Errorf("Test %d: unexpected URL %s", i+1, req.URL)
+			}
+			if auth := req.Header.Get("Authorization"); !strings.Contains(auth, testCase.scope) {
+				t.Errorf("Test %d: unexpected authorization %s", i+1, auth)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`<Tagging><TagSet></TagSet></Tagging>`)),
+				Request:    req,
+			}, nil
+		})
+		// The client endpoint and region are not used by access points.
+		clnt, err := New("play.min.io", &Options{
+			Creds:     credentials.NewStaticV4("access", "secret", ""),
+			Region:    "us-east-1",
+			Transport: rt,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := clnt.GetObjectTagging(context.Background(), testCase.arn, "dir/object", GetObjectTaggingOptions{}); err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if requests != 1 {
+			t.Errorf("Test %d: expected one request, got %d", i+1, requests)
+		}
+
+		u, err := clnt.PresignedGetObject(context.Background(), testCase.arn, "dir/object", time.Hour, nil)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if u.Host != testCase.host || !strings.Contains(u.Query().Get("X-Amz-Credential"), testCase.scope) {
+			t.Errorf("Test %d: unexpected presigned URL %s", i+1, u)
+		}
+	}
+}
+
+func TestCopySourcePath(t *testing.T) {
+	const arn = "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point"
+	if p := copySourcePath(arn, "dir/object"); p != arn+"/object/dir/object" {
+		t.Errorf("unexpected copy source %s", p)
+	}
+	if p := copySourcePath("bucket", "dir/object"); p != "bucket/dir/object" {
+		t.Errorf("unexpected copy source %s", p)
+	}
+}
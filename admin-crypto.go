@@ -0,0 +1,96 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// adminSaltSize is the size of the salt of the key of the encrypted
+	// payloads of the MinIO admin API.
+	adminSaltSize = 32
+
+	// adminArgon2idAESGCM is the identifier of payloads encrypted with
+	// AES-GCM and a key derived with Argon2id.
+	adminArgon2idAESGCM = 0x00
+
+	// adminFragmentSize is the size of the fragments of the plaintext
+	// which are encrypted separately.
+	adminFragmentSize = 16 * 1024
+)
+
+// adminKey derives the key of an encrypted payload from the password.
+func adminKey(password string, salt []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, 32)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptAdminData encrypts the payload of a request of the MinIO admin
+// API with the secret key of the client, which is the format of madmin:
+//
+//	salt (32 bytes) | AEAD ID (1 byte) | nonce (8 bytes) | ciphertext
+//
+// The ciphertext is the sio stream of the data, fragments of 16KiB are
+// sealed with the nonce and their sequence number, the final fragment
+// is flagged in the associated data.
+func encryptAdminData(password string, data []byte) ([]byte, error) {
+	salt := make([]byte, adminSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := adminKey(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce[:len(nonce)-4]); err != nil {
+		return nil, err
+	}
+
+	fragments := len(data)/adminFragmentSize + 1
+	ciphertext := make([]byte, 0, adminSaltSize+1+len(nonce)-4+len(data)+fragments*aead.Overhead())
+	ciphertext = append(ciphertext, salt...)
+	ciphertext = append(ciphertext, adminArgon2idAESGCM)
+	ciphertext = append(ciphertext, nonce[:len(nonce)-4]...)
+
+	// The associated data of the fragments is the flag of the final
+	// fragment and the tag of the (empty) associated data of the stream
+	// sealed with sequence number 0.
+	associatedData := aead.Seal([]byte{0x00}, nonce, nil, nil)
+	var seqNum uint32
+	for {
+		seqNum++
+		binary.LittleEndian.PutUint32(nonce[len(nonce)-4:], seqNum)
+		if len(data) <= adminFragmentSize {
+			associatedData[0] = 0x80
+			return aead.Seal(ciphertext, nonce, data, associatedData), nil
+		}
+		ciphertext = aead.Seal(ciphertext, nonce, data[:adminFragmentSize], associatedData)
+		data = data[adminFragmentSize:]
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/minio/minio-go/v7/pkg/s3utils"
@@ -43,8 +44,10 @@ type AppendObjectOptions struct {
 	// where you are interested in the peak() numbers.
 	DisableContentSha256 bool
 
-	customHeaders http.Header
-	checksumType  ChecksumType
+	customHeaders  http.Header
+	checksumType   ChecksumType
+	writeOffset    int64
+	writeOffsetSet bool
 }
 
 // Header returns the custom header for AppendObject API
@@ -61,6 +64,24 @@ func (opts *AppendObjectOptions) setWriteOffset(offset int64) {
 		opts.customHeaders = make(http.Header)
 	}
 	opts.customHeaders["x-amz-write-offset-bytes"] = []string{strconv.FormatInt(offset, 10)}
+	opts.writeOffset = offset
+}
+
+// SetWriteOffset sets the offset at which the data is appended, this
+// must be the current size of the object. When set AppendObject does
+// not look up the current object size, callers appending repeatedly
+// (e.g. log writers) can track the offset from the previous UploadInfo.Size.
+// When set the checksum type must be configured via SetChecksumType if
+// the object was created with a full object checksum.
+func (opts *AppendObjectOptions) SetWriteOffset(offset int64) {
+	opts.setWriteOffset(offset)
+	opts.writeOffsetSet = true
+}
+
+// SetChecksumType sets the full object checksum type of the object
+// being appended to, only required along with SetWriteOffset.
+func (opts *AppendObjectOptions) SetChecksumType(checksumType ChecksumType) {
+	opts.checksumType = checksumType
 }
 
 func (opts *AppendObjectOptions) setChecksumParams(info ObjectInfo) {
@@ -90,7 +111,9 @@ func (opts AppendObjectOptions) validate(c *Client) (err error) {
 		return errInvalidArgument("Append chunkSize cannot be larger than max part size allowed")
 	}
 	switch {
-	case !c.trailingHeaderSupport:
+	case c.Profile() == ProfileRGW:
+		// Ceph RGW appends with a query parameter and without checksums.
+	case !c.trailingChecksums():
 		return errInvalidArgument("AppendObject() requires Client with TrailingHeaders enabled")
 	case c.overrideSignerType.IsV2():
 		return errInvalidArgument("AppendObject() cannot be used with v2 signatures")
@@ -125,6 +148,14 @@ func (c *Client) appendObjectDo(ctx context.Context, bucketName, objectName stri
 		streamSha256:  !opts.DisableContentSha256,
 	}
 
+	if c.Profile() == ProfileRGW {
+		reqMetadata.queryValues = url.Values{
+			"append":   {""},
+			"position": {strconv.FormatInt(opts.writeOffset, 10)},
+		}
+		delete(customHeader, "x-amz-write-offset-bytes")
+	}
+
 	if opts.checksumType.IsSet() {
 		reqMetadata.addCrc = &opts.checksumType
 		reqMetadata.customHeader.Set(amzChecksumAlgo, opts.checksumType.String())
@@ -148,11 +179,20 @@ func (c *Client) appendObjectDo(ctx context.Context, bucketName, objectName stri
 	h := resp.Header
 
 	// When AppendObject() is used, S3 Express will return final object size as x-amz-object-size
+	// and Ceph RGW the offset of the next append.
 	if amzSize := h.Get("x-amz-object-size"); amzSize != "" {
 		size, err = strconv.ParseInt(amzSize, 10, 64)
 		if err != nil {
 			return UploadInfo{}, err
 		}
+	} else if pos := h.Get(rgwHeaderPrefix + rgwNextAppendPosition); pos != "" {
+		size, err = strconv.ParseInt(pos, 10, 64)
+		if err != nil {
+			return UploadInfo{}, err
+		}
+	} else if size >= 0 {
+		// Server did not report the final size, derive it from the offset.
+		size += opts.writeOffset
 	}
 
 	return UploadInfo{
@@ -172,6 +212,9 @@ func (c *Client) appendObjectDo(ctx context.Context, bucketName, objectName stri
 }
 
 // AppendObject - S3 Express Zone https://docs.aws.amazon.com/AmazonS3/latest/userguide/directory-buckets-objects-append.html
+//
+// With ProfileRGW objects of Ceph RGW are appended with its append
+// interface, which creates the object on the first append.
 func (c *Client) AppendObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64,
 	opts AppendObjectOptions,
 ) (info UploadInfo, err error) {
@@ -183,46 +226,62 @@ func (c *Client) AppendObject(ctx context.Context, bucketName, objectName string
 		return UploadInfo{}, err
 	}
 
-	oinfo, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{Checksum: true})
-	if err != nil {
-		return UploadInfo{}, err
-	}
-	if oinfo.ChecksumMode != "" && oinfo.ChecksumMode != ChecksumFullObjectMode.String() {
-		return UploadInfo{}, fmt.Errorf("Append() is not allowed on objects that are not of FULL_OBJECT checksum type: %s", oinfo.ChecksumMode)
+	if !opts.writeOffsetSet && c.Profile() == ProfileRGW {
+		offset, err := c.rgwAppendPosition(ctx, bucketName, objectName)
+		if err != nil {
+			return UploadInfo{}, err
+		}
+		opts.setWriteOffset(offset)
+	} else if !opts.writeOffsetSet {
+		oinfo, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{Checksum: true})
+		if err != nil {
+			return UploadInfo{}, err
+		}
+		if oinfo.ChecksumMode != "" && oinfo.ChecksumMode != ChecksumFullObjectMode.String() {
+			return UploadInfo{}, fmt.Errorf("Append() is not allowed on objects that are not of FULL_OBJECT checksum type: %s", oinfo.ChecksumMode)
+		}
+		opts.setChecksumParams(oinfo)   // set the appropriate checksum params based on the existing object checksum metadata.
+		opts.setWriteOffset(oinfo.Size) // First append must set the current object size as the offset.
 	}
-	opts.setChecksumParams(oinfo)   // set the appropriate checksum params based on the existing object checksum metadata.
-	opts.setWriteOffset(oinfo.Size) // First append must set the current object size as the offset.
 
 	if opts.ChunkSize > 0 {
-		finalObjSize := int64(-1)
-		if objectSize > 0 {
-			finalObjSize = info.Size + objectSize
+		totalPartsCount, partSize, lastPartSize, err := OptimalPartInfo(objectSize, opts.ChunkSize)
+		if err != nil {
+			return UploadInfo{}, err
 		}
-		totalPartsCount, partSize, lastPartSize, err := OptimalPartInfo(finalObjSize, opts.ChunkSize)
+		buf, releaseBuf, err := c.allocBuffer(ctx, partSize)
 		if err != nil {
 			return UploadInfo{}, err
 		}
-		buf := make([]byte, partSize)
-		var partNumber int
-		for partNumber = 1; partNumber <= totalPartsCount; partNumber++ {
+		defer releaseBuf()
+		for partNumber := 1; partNumber <= totalPartsCount; partNumber++ {
 			// Proceed to upload the part.
 			if partNumber == totalPartsCount {
 				partSize = lastPartSize
 			}
-			n, err := readFull(reader, buf)
-			if err != nil {
-				return info, err
+			n, rerr := readFull(reader, buf[:partSize])
+			if rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF {
+				return info, rerr
 			}
-			if n != int(partSize) {
+			if int64(n) != partSize && objectSize >= 0 {
 				return info, io.ErrUnexpectedEOF
 			}
+			if n == 0 && partNumber > 1 {
+				// Unknown size input reached EOF on a chunk boundary.
+				return info, nil
+			}
 			rd := newHook(bytes.NewReader(buf[:n]), opts.Progress)
-			uinfo, err := c.appendObjectDo(ctx, bucketName, objectName, rd, partSize, opts)
+			info, err = c.appendObjectDo(ctx, bucketName, objectName, rd, int64(n), opts)
 			if err != nil {
 				return info, err
 			}
-			opts.setWriteOffset(uinfo.Size)
+			if rerr != nil {
+				// Unknown size input reached EOF.
+				return info, nil
+			}
+			opts.setWriteOffset(opts.writeOffset + int64(n))
 		}
+		return info, nil
 	}
 
 	rd := newHook(reader, opts.Progress)
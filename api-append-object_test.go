@@ -0,0 +1,200 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// appendTestServer stores a single object and appends to it at the
+// offset of x-amz-write-offset-bytes, as S3 Express does.
+type appendTestServer struct {
+	mu   sync.Mutex
+	data []byte
+	// stats is the number of HEAD requests.
+	stats int
+	// appends are the offsets and sizes of the appends.
+	appends [][2]int
+	// header of the last append.
+	header http.Header
+}
+
+func (s *appendTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodHead:
+		s.mu.Lock()
+		s.stats++
+		size := len(s.data)
+		s.mu.Unlock()
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", strconv.Itoa(size))
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			data = decodeAWSChunked(data)
+		}
+		offset, err := strconv.Atoi(r.Header.Get("X-Amz-Write-Offset-Bytes"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if offset != len(s.data) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `<Error><Code>InvalidWriteOffset</Code><Message>The write offset value that you provided does not match the current object size.</Message></Error>`)
+			return
+		}
+		s.data = append(s.data, data...)
+		s.appends = append(s.appends, [2]int{offset, len(data)})
+		s.header = r.Header.Clone()
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("X-Amz-Object-Size", strconv.Itoa(len(s.data)))
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+	}
+}
+
+func newAppendTestClient(t *testing.T, s *appendTestServer) *Client {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:           credentials.NewStaticV4("access", "secret", ""),
+		Region:          "us-east-1",
+		TrailingHeaders: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestAppendObjectUnknownSize(t *testing.T) {
+	const chunkSize = absMinPartSize
+	testCases := []struct {
+		size    int
+		appends [][2]int
+	}{
+		{chunkSize - 1, [][2]int{{3, chunkSize - 1}}},
+		// No empty append at the end of input on a chunk boundary.
+		{2 * chunkSize, [][2]int{{3, chunkSize}, {3 + chunkSize, chunkSize}}},
+		{2*chunkSize + 10, [][2]int{{3, chunkSize}, {3 + chunkSize, chunkSize}, {3 + 2*chunkSize, 10}}},
+	}
+	for i, testCase := range testCases {
+		s := &appendTestServer{data: []byte("abc")}
+		c := newAppendTestClient(t, s)
+
+		data := make([]byte, testCase.size)
+		rand.Read(data)
+		// The size of the reader is not known.
+		info, err := c.AppendObject(context.Background(), "bucket", "object", io.MultiReader(bytes.NewReader(data)), -1,
+			AppendObjectOptions{ChunkSize: chunkSize})
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if !slices.Equal(s.appends, testCase.appends) {
+			t.Errorf("Test %d: expected appends %v, got %v", i+1, testCase.appends, s.appends)
+		}
+		if info.Size != int64(3+testCase.size) {
+			t.Errorf("Test %d: expected size %d, got %d", i+1, 3+testCase.size, info.Size)
+		}
+		if !bytes.Equal(s.data[3:], data) {
+			t.Errorf("Test %d: appended data does not match", i+1)
+		}
+	}
+
+	c := newAppendTestClient(t, &appendTestServer{})
+	if _, err := c.AppendObject(context.Background(), "bucket", "object", strings.NewReader("abc"), -1, AppendObjectOptions{}); err == nil {
+		t.Error("expected unknown size without chunk size to fail")
+	}
+}
+
+func TestAppendObjectWriteOffset(t *testing.T) {
+	s := &appendTestServer{data: []byte("abc")}
+	c := newAppendTestClient(t, s)
+
+	// The offset tracked by the caller is used, the object is not
+	// looked up.
+	var opts AppendObjectOptions
+	opts.SetWriteOffset(3)
+	opts.SetChecksumType(ChecksumFullObjectCRC32C)
+	info, err := c.AppendObject(context.Background(), "bucket", "object", strings.NewReader("defg"), 4, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.stats != 0 {
+		t.Errorf("expected no HEAD request, got %d", s.stats)
+	}
+	if string(s.data) != "abcdefg" || info.Size != 7 {
+		t.Errorf("unexpected object %q of size %d", s.data, info.Size)
+	}
+	if algo, mode := s.header.Get(amzChecksumAlgo), s.header.Get(amzChecksumMode); algo != "CRC32C" || mode != ChecksumFullObjectMode.String() {
+		t.Errorf("expected full object CRC32C checksum, got %s %s", algo, mode)
+	}
+
+	// Repeated appends track the offset from the previous size.
+	opts.SetWriteOffset(info.Size)
+	if info, err = c.AppendObject(context.Background(), "bucket", "object", strings.NewReader("h"), 1, opts); err != nil {
+		t.Fatal(err)
+	}
+	if string(s.data) != "abcdefgh" || info.Size != 8 {
+		t.Errorf("unexpected object %q of size %d", s.data, info.Size)
+	}
+
+	// Without a write offset the size is looked up.
+	if _, err = c.AppendObject(context.Background(), "bucket", "object", strings.NewReader("i"), 1, AppendObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if s.stats != 1 || string(s.data) != "abcdefghi" {
+		t.Errorf("unexpected object %q after %d HEAD requests", s.data, s.stats)
+	}
+}
+
+func TestAppendObjectWriteOffsetMismatch(t *testing.T) {
+	s := &appendTestServer{data: []byte("abc")}
+	c := newAppendTestClient(t, s)
+
+	var opts AppendObjectOptions
+	opts.SetWriteOffset(1)
+	_, err := c.AppendObject(context.Background(), "bucket", "object", strings.NewReader("def"), 3, opts)
+	if code := ToErrorResponse(err).Code; code != "InvalidWriteOffset" {
+		t.Fatalf("expected InvalidWriteOffset error, got %v", err)
+	}
+	if string(s.data) != "abc" || len(s.appends) != 0 {
+		t.Errorf("unexpected object %q", s.data)
+	}
+}
@@ -0,0 +1,355 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// BatchManifestFormat is the format of the manifest of a BatchCopy.
+type BatchManifestFormat int
+
+const (
+	// BatchManifestCSV is a CSV manifest of `bucket,key[,versionId]`
+	// records with URL-encoded keys, the format of the manifests of S3
+	// Batch Operations and of S3 Inventory reports.
+	BatchManifestCSV BatchManifestFormat = iota
+	// BatchManifestJSON is a manifest of newline delimited BatchCopyEntry
+	// objects, e.g. {"bucket":"src","key":"a.txt"}.
+	BatchManifestJSON
+)
+
+// BatchCopyEntry is an object of the manifest of a BatchCopy.
+type BatchCopyEntry struct {
+	Bucket    string `json:"bucket"`
+	Key       string `json:"key"`
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// BatchCopyResult is the result of the copy of an entry of the manifest,
+// which is written to BatchCopyOptions.Results.
+type BatchCopyResult struct {
+	BatchCopyEntry
+	// TargetKey is the key of the copy.
+	TargetKey string `json:"targetKey"`
+	// TargetVersionID is the version of the copy.
+	TargetVersionID string `json:"targetVersionId,omitempty"`
+	Size            int64  `json:"size"`
+	Err             error  `json:"-"`
+}
+
+// BatchCopyOptions represents options specified by user for BatchCopy call
+type BatchCopyOptions struct {
+	// Format is the format of the manifest, defaults to CSV.
+	Format BatchManifestFormat
+
+	// DstBucket is the bucket the objects are copied to.
+	DstBucket string
+
+	// DstPrefix is prepended to the keys of the copies.
+	DstPrefix string
+
+	// Parallel is the number of concurrent copies, defaults to 1.
+	Parallel int
+
+	// QPS limits the number of copies per second across all workers,
+	// unlimited when zero.
+	QPS float64
+
+	// Retries is the number of times a copy failing with a retryable
+	// error is retried, in addition to the retries of its requests.
+	Retries int
+
+	// Results if set receives the result of every entry, in the format
+	// of the manifest. CSV results are `bucket,key,versionId,targetKey,
+	// targetVersionId,size,error` records, JSON results are
+	// BatchCopyResult objects with an additional "error" field.
+	Results io.Writer
+}
+
+// BatchCopyStats contains the statistics of a BatchCopy call.
+type BatchCopyStats struct {
+	// Number of entries of the manifest.
+	Entries int64
+	// Number of objects copied.
+	Copied int64
+	// Number of bytes of the copied objects.
+	CopiedBytes int64
+	// Number of objects that could not be copied.
+	Failed int64
+	// Time spent.
+	Elapsed time.Duration
+}
+
+// BatchCopy copies the objects of a manifest to opts.DstBucket on the
+// server, concurrently. Objects larger than 5GiB are copied in parts,
+// see ComposeObject. The final statistics are returned along with the
+// first error encountered, remaining entries are still attempted when
+// individual objects fail to be copied.
+func (c *Client) BatchCopy(ctx context.Context, manifest io.Reader, opts BatchCopyOptions) (BatchCopyStats, error) {
+	if err := s3utils.CheckValidBucketName(opts.DstBucket); err != nil {
+		return BatchCopyStats{}, err
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = 1
+	}
+	if opts.QPS < 0 {
+		return BatchCopyStats{}, errInvalidArgument("QPS cannot be negative")
+	}
+	var entries func() (BatchCopyEntry, error)
+	switch opts.Format {
+	case BatchManifestCSV:
+		entries = csvBatchEntries(manifest)
+	case BatchManifestJSON:
+		entries = jsonBatchEntries(manifest)
+	default:
+		return BatchCopyStats{}, errInvalidArgument("Unknown manifest format.")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		stats    BatchCopyStats
+		firstErr error
+		start    = time.Now()
+	)
+	setErr := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	var limiter <-chan time.Time
+	if opts.QPS > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / opts.QPS))
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+
+	results := batchResultWriter(opts.Format, opts.Results)
+	record := func(res BatchCopyResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		if res.Err != nil {
+			stats.Failed++
+			setErr(res.Err)
+		} else {
+			stats.Copied++
+			stats.CopiedBytes += res.Size
+		}
+		if results != nil {
+			if err := results(res); err != nil {
+				setErr(err)
+				cancel()
+			}
+		}
+	}
+	// canceled returns the result of an entry not copied since ctx is
+	// canceled.
+	canceled := func(entry BatchCopyEntry) BatchCopyResult {
+		return BatchCopyResult{BatchCopyEntry: entry, TargetKey: opts.DstPrefix + entry.Key, Err: ctx.Err()}
+	}
+
+	entryCh := make(chan BatchCopyEntry)
+	var wg sync.WaitGroup
+	for range opts.Parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range entryCh {
+				if limiter != nil {
+					select {
+					case <-limiter:
+					case <-ctx.Done():
+						record(canceled(entry))
+						continue
+					}
+				}
+				record(c.batchCopyEntry(ctx, entry, opts))
+			}
+		}()
+	}
+
+	var manifestErr error
+	for ctx.Err() == nil {
+		entry, err := entries()
+		if err != nil {
+			if err != io.EOF {
+				manifestErr = err
+			}
+			break
+		}
+		mu.Lock()
+		stats.Entries++
+		mu.Unlock()
+		select {
+		case entryCh <- entry:
+		case <-ctx.Done():
+			record(canceled(entry))
+		}
+	}
+	close(entryCh)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	stats.Elapsed = time.Since(start)
+	if manifestErr != nil {
+		return stats, manifestErr
+	}
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return stats, firstErr
+}
+
+// batchCopyEntry copies an object of the manifest, retrying retryable
+// errors.
+func (c *Client) batchCopyEntry(ctx context.Context, entry BatchCopyEntry, opts BatchCopyOptions) BatchCopyResult {
+	res := BatchCopyResult{BatchCopyEntry: entry, TargetKey: opts.DstPrefix + entry.Key}
+	dst := CopyDestOptions{Bucket: opts.DstBucket, Object: res.TargetKey}
+	src := CopySrcOptions{Bucket: entry.Bucket, Object: entry.Key, VersionID: entry.VersionID}
+	for attempt := range c.newRetryTimer(ctx, opts.Retries+1, DefaultRetryUnit, DefaultRetryCap, MaxJitter) {
+		if res.Err = c.batchCopyObject(ctx, dst, src, &res); res.Err == nil {
+			return res
+		}
+		if attempt == opts.Retries || !batchCopyRetryable(ctx, res.Err) {
+			return res
+		}
+	}
+	if res.Err == nil {
+		res.Err = ctx.Err()
+	}
+	return res
+}
+
+// batchCopyObject copies an object with CopyObject, or in parts if it
+// is larger than 5GiB.
+func (c *Client) batchCopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions, res *BatchCopyResult) error {
+	objInfo, err := c.StatObject(ctx, src.Bucket, src.Object, StatObjectOptions{VersionID: src.VersionID})
+	if err != nil {
+		return err
+	}
+	var info UploadInfo
+	if objInfo.Size <= maxPartSize {
+		src.MatchETag = objInfo.ETag
+		info, err = c.CopyObject(ctx, dst, src)
+	} else {
+		info, err = c.ComposeObject(ctx, dst, src)
+	}
+	if err != nil {
+		return err
+	}
+	res.TargetVersionID, res.Size = info.VersionID, objInfo.Size
+	return nil
+}
+
+// batchCopyRetryable tells whether a failed copy may be retried.
+func batchCopyRetryable(ctx context.Context, err error) bool {
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		return isS3CodeRetryable(errResp.Code) || isHTTPStatusRetryable(errResp.StatusCode)
+	}
+	return isRequestErrorRetryable(ctx, err)
+}
+
+// csvBatchEntries returns the entries of a CSV manifest.
+func csvBatchEntries(manifest io.Reader) func() (BatchCopyEntry, error) {
+	r := csv.NewReader(manifest)
+	r.FieldsPerRecord = -1
+	return func() (BatchCopyEntry, error) {
+		record, err := r.Read()
+		if err != nil {
+			return BatchCopyEntry{}, err
+		}
+		if len(record) < 2 || len(record) > 3 {
+			line, _ := r.FieldPos(0)
+			return BatchCopyEntry{}, errInvalidArgument("Invalid manifest record on line " + strconv.Itoa(line) + ".")
+		}
+		entry := BatchCopyEntry{Bucket: record[0]}
+		if entry.Key, err = url.QueryUnescape(record[1]); err != nil {
+			return BatchCopyEntry{}, err
+		}
+		if len(record) == 3 {
+			entry.VersionID = record[2]
+		}
+		return entry, nil
+	}
+}
+
+// jsonBatchEntries returns the entries of a newline delimited JSON
+// manifest.
+func jsonBatchEntries(manifest io.Reader) func() (BatchCopyEntry, error) {
+	d := json.NewDecoder(manifest)
+	return func() (BatchCopyEntry, error) {
+		var entry BatchCopyEntry
+		if err := d.Decode(&entry); err != nil {
+			return BatchCopyEntry{}, err
+		}
+		if entry.Bucket == "" || entry.Key == "" {
+			return BatchCopyEntry{}, errInvalidArgument("Manifest entry has no bucket or key.")
+		}
+		return entry, nil
+	}
+}
+
+// batchResultWriter returns a function writing results to w in the
+// format, nil if w is nil.
+func batchResultWriter(format BatchManifestFormat, w io.Writer) func(BatchCopyResult) error {
+	if w == nil {
+		return nil
+	}
+	if format == BatchManifestJSON {
+		e := json.NewEncoder(w)
+		return func(res BatchCopyResult) error {
+			var errMsg string
+			if res.Err != nil {
+				errMsg = res.Err.Error()
+			}
+			return e.Encode(struct {
+				BatchCopyResult
+				Error string `json:"error,omitempty"`
+			}{res, errMsg})
+		}
+	}
+	cw := csv.NewWriter(w)
+	return func(res BatchCopyResult) error {
+		var errMsg string
+		if res.Err != nil {
+			errMsg = res.Err.Error()
+		}
+		cw.Write([]string{
+			res.Bucket, url.QueryEscape(res.Key), res.VersionID,
+			url.QueryEscape(res.TargetKey), res.TargetVersionID, strconv.FormatInt(res.Size, 10), errMsg,
+		})
+		cw.Flush()
+		return cw.Error()
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestBatchCopy(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		copies []string
+		flaky  = 1
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.URL.Path == "/src/missing.txt":
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method != http.MethodHead {
+				w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+			}
+		case r.Method == http.MethodHead:
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("Content-Length", "5")
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		case r.Method == http.MethodPut && r.URL.Path == "/dst/copy/flaky.txt" && flaky > 0:
+			flaky--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<Error><Code>SlowDown</Code></Error>"))
+		case r.Method == http.MethodPut:
+			copies = append(copies, r.Header.Get("X-Amz-Copy-Source")+" "+r.URL.Path)
+			w.Header().Set("X-Amz-Version-Id", "v2")
+			w.Write([]byte(`<CopyObjectResult><ETag>"etag"</ETag><LastModified>2006-01-02T15:04:05Z</LastModified></CopyObjectResult>`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		format   BatchManifestFormat
+		manifest string
+		results  []string
+	}{
+		{
+			format:   BatchManifestCSV,
+			manifest: "src,a%2Bb.txt\nsrc,flaky.txt,v1\nsrc,missing.txt\n",
+			results: []string{
+				"src,a%2Bb.txt,,copy%2Fa%2Bb.txt,v2,5,",
+				"src,flaky.txt,v1,copy%2Fflaky.txt,v2,5,",
+				"src,missing.txt,,copy%2Fmissing.txt,,0,The specified key does not exist.",
+			},
+		},
+		{
+			format:   BatchManifestJSON,
+			manifest: `{"bucket":"src","key":"a+b.txt"}` + "\n" + `{"bucket":"src","key":"flaky.txt","versionId":"v1"}` + "\n" + `{"bucket":"src","key":"missing.txt"}`,
+			results: []string{
+				`{"bucket":"src","key":"a+b.txt","targetKey":"copy/a+b.txt","targetVersionId":"v2","size":5}`,
+				`{"bucket":"src","key":"flaky.txt","versionId":"v1","targetKey":"copy/flaky.txt","targetVersionId":"v2","size":5}`,
+				`{"bucket":"src","key":"missing.txt","targetKey":"copy/missing.txt","size":0,"error":"The specified key does not exist."}`,
+			},
+		},
+	}
+	for i, testCase := range testCases {
+		mu.Lock()
+		copies, flaky = nil, 1
+		mu.Unlock()
+
+		var results bytes.Buffer
+		stats, err := clnt.BatchCopy(context.Background(), strings.NewReader(testCase.manifest), BatchCopyOptions{
+			Format:    testCase.format,
+			DstBucket: "dst",
+			DstPrefix: "copy/",
+			Parallel:  2,
+			Retries:   1,
+			Results:   &results,
+		})
+		if ToErrorResponse(err).Code != NoSuchKey {
+			t.Errorf("test %d: expected NoSuchKey, got %v", i+1, err)
+		}
+		if stats.Entries != 3 || stats.Copied != 2 || stats.CopiedBytes != 10 || stats.Failed != 1 {
+			t.Errorf("test %d: unexpected stats %+v", i+1, stats)
+		}
+
+		slices.Sort(copies)
+		expected := []string{"src/a%2Bb.txt /dst/copy/a+b.txt", "src/flaky.txt?versionId=v1 /dst/copy/flaky.txt"}
+		if !slices.Equal(copies, expected) {
+			t.Errorf("test %d: expected copies %v, got %v", i+1, expected, copies)
+		}
+		lines := strings.Split(strings.TrimSpace(results.String()), "\n")
+		slices.Sort(lines)
+		if !slices.Equal(lines, testCase.results) {
+			t.Errorf("test %d: expected results\n%s\ngot\n%s", i+1, strings.Join(testCase.results, "\n"), strings.Join(lines, "\n"))
+		}
+	}
+
+	if _, err = clnt.BatchCopy(context.Background(), strings.NewReader("src\n"), BatchCopyOptions{DstBucket: "dst"}); err == nil {
+		t.Error("expected invalid manifest to fail")
+	}
+}
+
+// Tests that the entries waiting for the rate limiter when ctx is
+// canceled are reported as failed.
+func TestBatchCopyCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var results bytes.Buffer
+	stats, err := clnt.BatchCopy(ctx, strings.NewReader("src,a.txt\nsrc,b.txt\nsrc,c.txt\n"), BatchCopyOptions{
+		DstBucket: "dst",
+		QPS:       0.1,
+		Results:   &results,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+	if stats.Entries == 0 || stats.Failed != stats.Entries || stats.Copied != 0 {
+		t.Errorf("expected all entries to fail, got %+v", stats)
+	}
+	lines := strings.Split(strings.TrimSpace(results.String()), "\n")
+	if int64(len(lines)) != stats.Entries {
+		t.Fatalf("expected %d results, got %q", stats.Entries, lines)
+	}
+	for _, line := range lines {
+		if !strings.HasSuffix(line, context.DeadlineExceeded.Error()) {
+			t.Errorf("expected a failed result, got %q", line)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package minio
 import (
 	"bytes"
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 
@@ -53,7 +54,9 @@ func (c *Client) putBucketCors(ctx context.Context, bucketName string, corsConfi
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(corsStr),
 		contentLength:    int64(len(corsStr)),
-		contentMD5Base64: sumMD5Base64([]byte(corsStr)),
+		contentMD5Base64: c.contentMD5Base64([]byte(corsStr)),
+		md5Required:      true,
+		contentSHA256Hex: sum256Hex([]byte(corsStr)),
 	}
 
 	resp, err := c.executeMethod(ctx, http.MethodPut, reqMetadata)
@@ -97,8 +100,7 @@ func (c *Client) GetBucketCors(ctx context.Context, bucketName string) (*cors.Co
 	}
 	bucketCors, err := c.getBucketCors(ctx, bucketName)
 	if err != nil {
-		errResponse := ToErrorResponse(err)
-		if errResponse.Code == NoSuchCORSConfiguration {
+		if errors.Is(err, ErrNoSuchCORSConfiguration) {
 			return nil, nil
 		}
 		return nil, err
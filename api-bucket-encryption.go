@@ -54,7 +54,9 @@ func (c *Client) SetBucketEncryption(ctx context.Context, bucketName string, con
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(buf),
 		contentLength:    int64(len(buf)),
-		contentMD5Base64: sumMD5Base64(buf),
+		contentMD5Base64: c.contentMD5Base64(buf),
+		md5Required:      true,
+		contentSHA256Hex: sum256Hex(buf),
 	}
 
 	// Execute PUT to upload a new bucket default encryption configuration.
@@ -64,7 +64,9 @@ func (c *Client) putBucketLifecycle(ctx context.Context, bucketName string, buf
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(buf),
 		contentLength:    int64(len(buf)),
-		contentMD5Base64: sumMD5Base64(buf),
+		contentMD5Base64: c.contentMD5Base64(buf),
+		md5Required:      true,
+		contentSHA256Hex: sum256Hex(buf),
 	}
 
 	// Execute PUT to upload a new bucket lifecycle.
@@ -0,0 +1,205 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/notification"
+)
+
+// backfillClockSkew is the tolerated difference between the clocks of
+// the client and the server when telling apart the objects listed during
+// a backfill which may also have live events.
+const backfillClockSkew = time.Minute
+
+// ListenBucketNotificationWithBackfill listen for the bucket events
+// selected by filter like ListenBucketNotificationWithFilter, after
+// sending a notification.ObjectExisting event for every existing object
+// selected by filter which was modified at or after since.
+//
+// The objects are listed once the listen request succeeded, the events
+// received while listing are sent after the existing objects, without
+// the events of objects which were already sent as existing objects.
+// Hence there are no gaps between the existing objects and the live
+// events. The events received while listing are buffered in memory.
+//
+// Existing objects are only sent if filter selects the creation of
+// objects, i.e. notification.ObjectCreatedPut.
+func (c *Client) ListenBucketNotificationWithBackfill(ctx context.Context, bucketName string, since time.Time, filter notification.ListenFilter) <-chan notification.Info {
+	ctx, cancel := context.WithCancel(ctx)
+	connectedCh := make(chan struct{})
+	var connectOnce sync.Once
+	liveCh := c.listenBucketNotification(ctx, bucketName, filter, func() {
+		connectOnce.Do(func() { close(connectedCh) })
+	})
+	start := time.Now().Add(-backfillClockSkew)
+
+	notificationInfoCh := make(chan notification.Info, 1)
+	go func() {
+		defer close(notificationInfoCh)
+		defer cancel()
+
+		send := func(info notification.Info) bool {
+			select {
+			case notificationInfoCh <- info:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// The live events received while connecting and listing.
+		var buffered []notification.Info
+
+		// Objects created before the listen request succeeded have no
+		// events, wait for it before listing.
+		for connectedCh != nil {
+			select {
+			case <-connectedCh:
+				connectedCh = nil
+			case info, ok := <-liveCh:
+				if !ok {
+					return
+				}
+				// Errors of the listen requests are sent at once, events
+				// received once connected after the existing objects.
+				if info.Err == nil {
+					buffered = append(buffered, info)
+				} else if !send(info) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		var listCh <-chan ObjectInfo
+		if len(filter.Events) == 0 || backfillSelectsCreation(filter) {
+			listCh = c.ListObjects(ctx, bucketName, ListObjectsOptions{Prefix: filter.Prefix, Recursive: true})
+		}
+
+		// The listed objects which may also have live events.
+		listed := make(map[string]string)
+		for listCh != nil {
+			select {
+			case object, ok := <-listCh:
+				if !ok {
+					listCh = nil
+					continue
+				}
+				if object.Err != nil {
+					send(notification.Info{Err: object.Err})
+					return
+				}
+				if object.LastModified.Before(since) || !strings.HasSuffix(object.Key, filter.Suffix) {
+					continue
+				}
+				if !send(notification.Info{Records: []notification.Event{existingObjectEvent(bucketName, object)}}) {
+					return
+				}
+				if !object.LastModified.Before(start) {
+					listed[object.Key] = trimEtag(object.ETag)
+				}
+			case info, ok := <-liveCh:
+				if !ok {
+					return
+				}
+				buffered = append(buffered, info)
+			}
+		}
+
+		for _, info := range buffered {
+			if info = withoutListedObjects(info, listed); info.Err != nil || len(info.Records) > 0 {
+				if !send(info) {
+					return
+				}
+			}
+		}
+		// Objects created while listing may be listed before their
+		// events are received.
+		for info := range liveCh {
+			if info = withoutListedObjects(info, listed); info.Err != nil || len(info.Records) > 0 {
+				if !send(info) {
+					return
+				}
+			}
+		}
+	}()
+	return notificationInfoCh
+}
+
+// backfillSelectsCreation tells whether filter selects the creation of
+// objects.
+func backfillSelectsCreation(filter notification.ListenFilter) bool {
+	for _, t := range filter.Events {
+		if t.Matches(notification.ObjectCreatedPut) {
+			return true
+		}
+	}
+	return false
+}
+
+// existingObjectEvent returns the synthetic event of an existing object.
+func existingObjectEvent(bucketName string, object ObjectInfo) notification.Event {
+	return notification.Event{
+		EventVersion: "2.0",
+		EventSource:  "minio:s3",
+		EventTime:    object.LastModified.UTC().Format(time.RFC3339Nano),
+		EventName:    string(notification.ObjectExisting),
+		S3: notification.EventMeta{
+			SchemaVersion: "1.0",
+			Bucket: notification.BucketMeta{
+				Name: bucketName,
+				ARN:  "arn:aws:s3:::" + bucketName,
+			},
+			Object: notification.ObjectMeta{
+				Key:          url.QueryEscape(object.Key),
+				Size:         object.Size,
+				ETag:         trimEtag(object.ETag),
+				ContentType:  object.ContentType,
+				UserMetadata: object.UserMetadata,
+				VersionID:    object.VersionID,
+			},
+		},
+	}
+}
+
+// withoutListedObjects returns info without the events of the creation
+// of listed objects.
+func withoutListedObjects(info notification.Info, listed map[string]string) notification.Info {
+	if len(listed) == 0 {
+		return info
+	}
+	records := info.Records[:0:0]
+	for _, event := range info.Records {
+		name, err := event.S3.Object.ObjectName()
+		if err == nil && notification.ObjectCreatedAll.Matches(event.Type()) {
+			if etag, ok := listed[name]; ok && etag == trimEtag(event.S3.Object.ETag) {
+				continue
+			}
+		}
+		records = append(records, event)
+	}
+	info.Records = records
+	return info
+}
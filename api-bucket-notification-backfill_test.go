@@ -0,0 +1,159 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/notification"
+)
+
+func TestListenBucketNotificationWithBackfill(t *testing.T) {
+	now := time.Now().UTC()
+	since := now.Add(-time.Hour)
+	listening := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bucket/" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Has("events") {
+			fmt.Fprintln(w, `{"Records":[`+
+				`{"eventName":"s3:ObjectCreated:Put","s3":{"object":{"key":"a.txt","eTag":"abc"}}},`+
+				`{"eventName":"s3:ObjectCreated:Put","s3":{"object":{"key":"c.txt","eTag":"def"}}}]}`)
+			w.(http.Flusher).Flush()
+			close(listening)
+			<-r.Context().Done()
+			return
+		}
+		// Receive the live events while listing.
+		<-listening
+		fmt.Fprintf(w, `<ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`+
+			`<Contents><Key>a.txt</Key><ETag>"abc"</ETag><Size>3</Size><LastModified>%s</LastModified></Contents>`+
+			`<Contents><Key>b.txt</Key><ETag>"123"</ETag><Size>5</Size><LastModified>%s</LastModified></Contents>`+
+			`<Contents><Key>old.txt</Key><ETag>"456"</ETag><Size>7</Size><LastModified>%s</LastModified></Contents>`+
+			`</ListBucketResult>`,
+			now.Format(time.RFC3339), now.Add(-time.Minute).Format(time.RFC3339), since.Add(-time.Hour).Format(time.RFC3339))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ch := clnt.ListenBucketNotificationWithBackfill(ctx, "bucket", since, notification.NewListenFilter(notification.ObjectCreatedAll))
+	expected := []struct {
+		name notification.EventType
+		key  string
+	}{
+		{notification.ObjectExisting, "a.txt"},
+		{notification.ObjectExisting, "b.txt"},
+		{notification.ObjectCreatedPut, "c.txt"},
+	}
+	var events []notification.Event
+	for len(events) < len(expected) {
+		info := <-ch
+		if info.Err != nil {
+			t.Fatal(info.Err)
+		}
+		events = append(events, info.Records...)
+	}
+	for i, e := range expected {
+		if events[i].Type() != e.name || events[i].S3.Object.Key != e.key {
+			t.Errorf("event %d: expected %s of %s, got %s of %s", i, e.name, e.key, events[i].EventName, events[i].S3.Object.Key)
+		}
+	}
+	if events[0].S3.Object.ETag != "abc" || events[0].S3.Object.Size != 3 || events[0].S3.Bucket.Name != "bucket" {
+		t.Errorf("unexpected existing object event %+v", events[0])
+	}
+	cancel()
+	for range ch {
+	}
+}
+
+// Tests that objects created until the listen request succeeds are
+// listed.
+func TestListenBucketNotificationWithBackfillConnect(t *testing.T) {
+	now := time.Now().UTC()
+	var created atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("events") {
+			// b.txt is created before the listen request succeeds, it
+			// has no event.
+			time.Sleep(100 * time.Millisecond)
+			created.Store(true)
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		contents := fmt.Sprintf(`<Contents><Key>a.txt</Key><ETag>"abc"</ETag><Size>3</Size><LastModified>%s</LastModified></Contents>`, now.Format(time.RFC3339))
+		if created.Load() {
+			contents += fmt.Sprintf(`<Contents><Key>b.txt</Key><ETag>"123"</ETag><Size>5</Size><LastModified>%s</LastModified></Contents>`, now.Format(time.RFC3339))
+		}
+		fmt.Fprintf(w, `<ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated>%s</ListBucketResult>`, contents)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ch := clnt.ListenBucketNotificationWithBackfill(ctx, "bucket", now.Add(-time.Hour), notification.NewListenFilter(notification.ObjectCreatedAll))
+	var keys []string
+	for len(keys) < 2 {
+		select {
+		case info := <-ch:
+			if info.Err != nil {
+				t.Fatal(info.Err)
+			}
+			for _, event := range info.Records {
+				keys = append(keys, event.S3.Object.Key)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("expected the existing objects a.txt and b.txt, got %v", keys)
+		}
+	}
+	if keys[0] != "a.txt" || keys[1] != "b.txt" {
+		t.Errorf("expected the existing objects a.txt and b.txt, got %v", keys)
+	}
+	cancel()
+	for range ch {
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"errors"
 	"net/http"
 	"net/url"
 	"time"
@@ -54,7 +55,8 @@ func (c *Client) SetBucketNotification(ctx context.Context, bucketName string, c
 		queryValues:      urlValues,
 		contentBody:      notifBuffer,
 		contentLength:    int64(len(notifBytes)),
-		contentMD5Base64: sumMD5Base64(notifBytes),
+		contentMD5Base64: c.contentMD5Base64(notifBytes),
+		md5Required:      true,
 		contentSHA256Hex: sum256Hex(notifBytes),
 	}
 
@@ -77,6 +79,29 @@ func (c *Client) RemoveAllBucketNotification(ctx context.Context, bucketName str
 	return c.SetBucketNotification(ctx, bucketName, notification.Configuration{})
 }
 
+// EnsureNotification makes the notification configuration of the bucket
+// match config. The configuration is only set if it differs from the
+// current configuration, configs without an ID match the configs with
+// the same ARN, events and filters regardless of the ID assigned by the
+// server. It returns whether the configuration was changed.
+func (c *Client) EnsureNotification(ctx context.Context, bucketName string, config notification.Configuration) (bool, error) {
+	if err := config.Validate(); err != nil {
+		return false, errInvalidArgument(err.Error())
+	}
+	current, err := c.GetBucketNotification(ctx, bucketName)
+	if err != nil {
+		return false, err
+	}
+	added, removed := current.Diff(config)
+	if added.IsEmpty() && removed.IsEmpty() {
+		return false, nil
+	}
+	if err = c.SetBucketNotification(ctx, bucketName, config); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // GetBucketNotification returns current bucket notification configuration
 func (c *Client) GetBucketNotification(ctx context.Context, bucketName string) (bucketNotification notification.Configuration, err error) {
 	// Input validation.
@@ -124,129 +149,171 @@ func (c *Client) ListenNotification(ctx context.Context, prefix, suffix string,
 	return c.ListenBucketNotification(ctx, "", prefix, suffix, events)
 }
 
+// ListenNotificationWithFilter listen for the events of all buckets
+// selected by filter, this is a MinIO specific API. The events are those
+// of ListenBucketNotificationWithFilter, including the creation and
+// removal of buckets, and listening reconnects the same way.
+func (c *Client) ListenNotificationWithFilter(ctx context.Context, filter notification.ListenFilter) <-chan notification.Info {
+	return c.ListenBucketNotificationWithFilter(ctx, "", filter)
+}
+
 // ListenBucketNotification listen for bucket events, this is a MinIO specific API
 func (c *Client) ListenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info {
+	filter := notification.ListenFilter{Prefix: prefix, Suffix: suffix}
+	for _, event := range events {
+		filter.Events = append(filter.Events, notification.EventType(event))
+	}
+	return c.ListenBucketNotificationWithFilter(ctx, bucketName, filter)
+}
+
+// Backoff of reconnecting listen requests.
+var (
+	listenBaseSleep = time.Second
+	listenMaxSleep  = 30 * time.Second
+)
+
+// ListenBucketNotificationWithFilter listen for the bucket events selected
+// by filter, this is a MinIO specific API. Listening continues until ctx
+// is canceled, the listen request is sent again with backoff when the
+// connection drops or fails.
+//
+// Errors are sent as notification.Info with Err set, listening ends after
+// errors which are not retryable, e.g. AccessDenied.
+func (c *Client) ListenBucketNotificationWithFilter(ctx context.Context, bucketName string, filter notification.ListenFilter) <-chan notification.Info {
+	return c.listenBucketNotification(ctx, bucketName, filter, nil)
+}
+
+// listenBucketNotification is ListenBucketNotificationWithFilter, calling
+// onConnect, if not nil, whenever a listen request succeeds.
+func (c *Client) listenBucketNotification(ctx context.Context, bucketName string, filter notification.ListenFilter, onConnect func()) <-chan notification.Info {
 	notificationInfoCh := make(chan notification.Info, 1)
-	const notificationCapacity = 4 * 1024 * 1024
-	notificationEventBuffer := make([]byte, notificationCapacity)
 	// Only success, start a routine to start reading line by line.
 	go func(notificationInfoCh chan<- notification.Info) {
 		defer close(notificationInfoCh)
 
+		send := func(info notification.Info) bool {
+			select {
+			case notificationInfoCh <- info:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
 		// Validate the bucket name.
 		if bucketName != "" {
 			if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-				select {
-				case notificationInfoCh <- notification.Info{
-					Err: err,
-				}:
-				case <-ctx.Done():
-				}
+				send(notification.Info{Err: err})
 				return
 			}
 		}
 
 		// Check ARN partition to verify if listening bucket is supported
 		if s3utils.IsAmazonEndpoint(*c.endpointURL) || s3utils.IsGoogleEndpoint(*c.endpointURL) {
-			select {
-			case notificationInfoCh <- notification.Info{
+			send(notification.Info{
 				Err: errAPINotSupported("Listening for bucket notification is specific only to `minio` server endpoints"),
-			}:
-			case <-ctx.Done():
-			}
+			})
 			return
 		}
 
 		// Prepare urlValues to pass into the request on every loop
-		urlValues := make(url.Values)
+		urlValues := filter.Query()
 		urlValues.Set("ping", "10")
-		urlValues.Set("prefix", prefix)
-		urlValues.Set("suffix", suffix)
-		urlValues["events"] = events
-
-		// Wait on the jitter retry loop.
-		for range c.newRetryTimerContinous(time.Second, time.Second*30, MaxJitter) {
-			// Execute GET on bucket to list objects.
-			resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{
-				bucketName:       bucketName,
-				queryValues:      urlValues,
-				contentSHA256Hex: emptySHA256Hex,
-			})
+
+		var attempt int
+		for {
+			connected, err := c.listen(ctx, bucketName, urlValues, send, onConnect)
+			if ctx.Err() != nil {
+				return
+			}
 			if err != nil {
-				select {
-				case notificationInfoCh <- notification.Info{
-					Err: err,
-				}:
-				case <-ctx.Done():
+				if !send(notification.Info{Err: err}) || !isListenErrorRetryable(err) {
+					return
 				}
-				return
 			}
+			// Reset the backoff after a successful connection.
+			if connected {
+				attempt = 0
+			}
+			attempt++
 
-			// Validate http response, upon error return quickly.
-			if resp.StatusCode != http.StatusOK {
-				errResponse := httpRespToErrorResponse(resp, bucketName, "")
-				select {
-				case notificationInfoCh <- notification.Info{
-					Err: errResponse,
-				}:
-				case <-ctx.Done():
-				}
+			sleep := min(listenBaseSleep<<min(attempt, 30), listenMaxSleep)
+			sleep -= time.Duration(c.random.Float64() * float64(sleep) * MaxJitter)
+			timer := time.NewTimer(sleep)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
 				return
 			}
+		}
+	}(notificationInfoCh)
 
-			// Initialize a new bufio scanner, to read line by line.
-			bio := bufio.NewScanner(resp.Body)
-
-			// Use a higher buffer to support unexpected
-			// caching done by proxies
-			bio.Buffer(notificationEventBuffer, notificationCapacity)
-
-			// Unmarshal each line, returns marshaled values.
-			for bio.Scan() {
-				var notificationInfo notification.Info
-				if err = json.Unmarshal(bio.Bytes(), &notificationInfo); err != nil {
-					// Unexpected error during json unmarshal, send
-					// the error to caller for actionable as needed.
-					select {
-					case notificationInfoCh <- notification.Info{
-						Err: err,
-					}:
-					case <-ctx.Done():
-						return
-					}
-					closeResponse(resp)
-					continue
-				}
+	// Returns the notification info channel, for caller to start reading from.
+	return notificationInfoCh
+}
 
-				// Empty events pinged from the server
-				if len(notificationInfo.Records) == 0 && notificationInfo.Err == nil {
-					continue
-				}
+// listen sends a listen request and sends the events of its response
+// until the connection drops. It returns whether the request succeeded,
+// onConnect, if not nil, is called once it succeeded.
+func (c *Client) listen(ctx context.Context, bucketName string, urlValues url.Values, send func(notification.Info) bool, onConnect func()) (connected bool, err error) {
+	// Execute GET on bucket to listen for notifications.
+	resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{
+		bucketName:       bucketName,
+		queryValues:      urlValues,
+		contentSHA256Hex: emptySHA256Hex,
+	})
+	if err != nil {
+		return false, err
+	}
+	defer closeResponse(resp)
 
-				// Send notificationInfo
-				select {
-				case notificationInfoCh <- notificationInfo:
-				case <-ctx.Done():
-					closeResponse(resp)
-					return
-				}
-			}
+	// Validate http response, upon error return quickly.
+	if resp.StatusCode != http.StatusOK {
+		return false, httpRespToErrorResponse(resp, bucketName, "")
+	}
+	if onConnect != nil {
+		onConnect()
+	}
 
-			if err = bio.Err(); err != nil {
-				select {
-				case notificationInfoCh <- notification.Info{
-					Err: err,
-				}:
-				case <-ctx.Done():
-					return
-				}
+	// Initialize a new bufio scanner, to read line by line.
+	bio := bufio.NewScanner(resp.Body)
+
+	// Use a higher buffer to support unexpected
+	// caching done by proxies
+	const notificationCapacity = 4 * 1024 * 1024
+	bio.Buffer(make([]byte, notificationCapacity), notificationCapacity)
+
+	// Unmarshal each line, returns marshaled values.
+	for bio.Scan() {
+		var notificationInfo notification.Info
+		if err = json.Unmarshal(bio.Bytes(), &notificationInfo); err != nil {
+			// Unexpected error during json unmarshal, send
+			// the error to caller for actionable as needed.
+			if !send(notification.Info{Err: err}) {
+				return true, nil
 			}
+			continue
+		}
 
-			// Close current connection before looping further.
-			closeResponse(resp)
+		// Empty events pinged from the server
+		if len(notificationInfo.Records) == 0 && notificationInfo.Err == nil {
+			continue
 		}
-	}(notificationInfoCh)
 
-	// Returns the notification info channel, for caller to start reading from.
-	return notificationInfoCh
+		// Send notificationInfo
+		if !send(notificationInfo) {
+			return true, nil
+		}
+	}
+	return true, bio.Err()
+}
+
+// isListenErrorRetryable - is the error of a listen request retryable.
+func isListenErrorRetryable(err error) bool {
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		return isHTTPStatusRetryable(errResp.StatusCode) || isS3CodeRetryable(errResp.Code)
+	}
+	return true
 }
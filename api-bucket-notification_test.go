@@ -0,0 +1,193 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/notification"
+)
+
+func TestListenBucketNotificationReconnect(t *testing.T) {
+	defer func(base, maxSleep time.Duration) {
+		listenBaseSleep, listenMaxSleep = base, maxSleep
+	}(listenBaseSleep, listenMaxSleep)
+	listenBaseSleep, listenMaxSleep = time.Millisecond, 10*time.Millisecond
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		query := r.URL.Query()
+		if query.Get("prefix") != "photos/" || query.Get("events") != string(notification.ObjectCreatedAll) {
+			t.Errorf("unexpected query: %v", query)
+		}
+		if n == 3 {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`)
+			return
+		}
+		// Send a ping and one event, then drop the connection.
+		fmt.Fprintln(w, `{}`)
+		fmt.Fprintf(w, `{"Records":[{"eventName":"s3:ObjectCreated:Put","eventTime":"2025-01-02T03:04:05.678Z","s3":{"object":{"key":"photos%%2F%d.jpg"}}}]}`+"\n", n)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := notification.NewListenFilter(notification.ObjectCreatedAll).WithPrefix("photos/")
+	var infos []notification.Info
+	for info := range clnt.ListenBucketNotificationWithFilter(ctx, "bucket", filter) {
+		infos = append(infos, info)
+	}
+	if len(infos) != 3 {
+		t.Fatalf("expected 2 events and an error, got %+v", infos)
+	}
+	for i, info := range infos[:2] {
+		if info.Err != nil || len(info.Records) != 1 {
+			t.Fatalf("unexpected info: %+v", info)
+		}
+		event := info.Records[0]
+		if name, _ := event.S3.Object.ObjectName(); name != fmt.Sprintf("photos/%d.jpg", i+1) {
+			t.Errorf("unexpected object name %s", name)
+		}
+		if !filter.Matches(event) {
+			t.Errorf("expected the filter to match %+v", event)
+		}
+		if tm, err := event.Time(); err != nil || !tm.Equal(time.Date(2025, 1, 2, 3, 4, 5, 678e6, time.UTC)) {
+			t.Errorf("unexpected event time %v: %v", tm, err)
+		}
+	}
+	if ToErrorResponse(infos[2].Err).Code != AccessDenied {
+		t.Fatalf("expected AccessDenied, got %v", infos[2].Err)
+	}
+}
+
+func TestEnsureNotification(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		current = []byte(`<NotificationConfiguration><QueueConfiguration><Id>server-id</Id><Queue>arn:minio:sqs::1:webhook</Queue><Event>s3:ObjectCreated:*</Event></QueueConfiguration></NotificationConfiguration>`)
+		puts    int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			w.Write(current)
+		case http.MethodPut:
+			puts++
+			current, _ = io.ReadAll(r.Body)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	config := notification.NewConfig(notification.NewMinIOArn("", "1", notification.TargetWebhook))
+	config.AddEvents(notification.ObjectCreatedAll)
+	var want notification.Configuration
+	want.AddQueue(config)
+	if changed, err := clnt.EnsureNotification(ctx, "bucket", want); err != nil || changed || puts != 0 {
+		t.Fatalf("expected no change, got %v %v with %d puts", changed, err, puts)
+	}
+
+	config.AddFilterSuffix(".jpg")
+	want.QueueConfigs[0] = notification.QueueConfig{Config: config, Queue: config.Arn.String()}
+	if changed, err := clnt.EnsureNotification(ctx, "bucket", want); err != nil || !changed || puts != 1 {
+		t.Fatalf("expected a change, got %v %v with %d puts", changed, err, puts)
+	}
+	if changed, err := clnt.EnsureNotification(ctx, "bucket", want); err != nil || changed || puts != 1 {
+		t.Fatalf("expected no change after the update, got %v %v with %d puts", changed, err, puts)
+	}
+
+	want.QueueConfigs[0].Events = nil
+	if _, err = clnt.EnsureNotification(ctx, "bucket", want); ToErrorResponse(err).Code != InvalidArgument {
+		t.Fatalf("expected an InvalidArgument error, got %v", err)
+	}
+}
+
+func TestListenNotification(t *testing.T) {
+	defer func(base, maxSleep time.Duration) {
+		listenBaseSleep, listenMaxSleep = base, maxSleep
+	}(listenBaseSleep, listenMaxSleep)
+	listenBaseSleep, listenMaxSleep = time.Millisecond, 10*time.Millisecond
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, `{"Records":[{"eventName":"s3:BucketCreated:*","s3":{"bucket":{"name":"bucket"}}}]}`)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ch := clnt.ListenNotificationWithFilter(ctx, notification.NewListenFilter(notification.BucketCreatedAll))
+	if info := <-ch; ToErrorResponse(info.Err).StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected the error of the first request, got %+v", info)
+	}
+	info := <-ch
+	if info.Err != nil || len(info.Records) != 1 || info.Records[0].Type() != notification.BucketCreatedAll ||
+		info.Records[0].S3.Bucket.Name != "bucket" {
+		t.Fatalf("unexpected info %+v", info)
+	}
+	cancel()
+	for range ch {
+	}
+}
@@ -0,0 +1,171 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/minio/minio-go/v7/pkg/policy"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// Grantee URIs of the groups of the public.
+const (
+	allUsersURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// BucketPolicyStatus is the policy status of a bucket.
+type BucketPolicyStatus struct {
+	XMLName xml.Name `xml:"PolicyStatus"`
+	// IsPublic is set when the policy of the bucket grants public
+	// access.
+	IsPublic bool `xml:"IsPublic"`
+}
+
+// PublicAccessBlockConfiguration is the public access block of a bucket.
+type PublicAccessBlockConfiguration struct {
+	XMLName               xml.Name `xml:"PublicAccessBlockConfiguration"`
+	BlockPublicAcls       bool     `xml:"BlockPublicAcls"`
+	IgnorePublicAcls      bool     `xml:"IgnorePublicAcls"`
+	BlockPublicPolicy     bool     `xml:"BlockPublicPolicy"`
+	RestrictPublicBuckets bool     `xml:"RestrictPublicBuckets"`
+}
+
+// BucketPublicStatus is the result of IsBucketPublic.
+type BucketPublicStatus struct {
+	// IsPublic is set when the bucket is accessible by the public,
+	// through its policy or its ACL, despite its public access block.
+	IsPublic bool
+	// PolicyPublic is set when the policy of the bucket grants public
+	// access.
+	PolicyPublic bool
+	// ACLPublic is set when the ACL of the bucket grants access to all
+	// users or to all authenticated users.
+	ACLPublic bool
+	// PublicAccessBlock of the bucket, nil when the bucket has none or
+	// the endpoint does not support it.
+	PublicAccessBlock *PublicAccessBlockConfiguration
+}
+
+// GetBucketPolicyStatus returns the policy status of a bucket, i.e.
+// whether its policy grants public access. Buckets without policy are
+// not public.
+func (c *Client) GetBucketPolicyStatus(ctx context.Context, bucketName string) (BucketPolicyStatus, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return BucketPolicyStatus{}, err
+	}
+
+	var status BucketPolicyStatus
+	err := c.getBucketSubresource(ctx, bucketName, "policyStatus", &status)
+	if errors.Is(err, ErrNoSuchBucketPolicy) {
+		return BucketPolicyStatus{}, nil
+	}
+	return status, err
+}
+
+// IsBucketPublic tells whether a bucket is accessible by the public,
+// according to its policy status, its ACL and its public access block.
+// The policy is evaluated locally, see policy.BucketAccessPolicy.IsPublic,
+// by endpoints which do not support policy status. The public access
+// block of the account is not consulted.
+func (c *Client) IsBucketPublic(ctx context.Context, bucketName string) (BucketPublicStatus, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return BucketPublicStatus{}, err
+	}
+	var status BucketPublicStatus
+
+	block := &PublicAccessBlockConfiguration{}
+	err := c.getBucketSubresource(ctx, bucketName, "publicAccessBlock", block)
+	switch {
+	case err == nil:
+		status.PublicAccessBlock = block
+	case !isUnsupportedSubresource(err) && ToErrorResponse(err).Code != "NoSuchPublicAccessBlockConfiguration":
+		return status, err
+	}
+
+	policyStatus, err := c.GetBucketPolicyStatus(ctx, bucketName)
+	switch {
+	case err == nil:
+		status.PolicyPublic = policyStatus.IsPublic
+	case isUnsupportedSubresource(err):
+		bucketPolicy, err := c.GetBucketPolicy(ctx, bucketName)
+		if err != nil {
+			return status, err
+		}
+		if bucketPolicy != "" {
+			p, err := policy.ParseBucketAccessPolicy(bucketPolicy)
+			if err != nil {
+				return status, err
+			}
+			status.PolicyPublic = p.IsPublic()
+		}
+	default:
+		return status, err
+	}
+
+	var acl accessControlPolicy
+	err = c.getBucketSubresource(ctx, bucketName, "acl", &acl)
+	switch {
+	case err == nil:
+		for _, grant := range acl.AccessControlList.Grant {
+			if grant.Grantee.URI == allUsersURI || grant.Grantee.URI == authenticatedUsersURI {
+				status.ACLPublic = true
+			}
+		}
+	case !isUnsupportedSubresource(err):
+		return status, err
+	}
+
+	block = status.PublicAccessBlock
+	status.IsPublic = (status.PolicyPublic && (block == nil || !block.RestrictPublicBuckets)) ||
+		(status.ACLPublic && (block == nil || !block.IgnorePublicAcls))
+	return status, nil
+}
+
+// getBucketSubresource decodes a XML subresource of a bucket into v.
+func (c *Client) getBucketSubresource(ctx context.Context, bucketName, subresource string, v any) error {
+	urlValues := make(url.Values)
+	urlValues.Set(subresource, "")
+
+	resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{
+		bucketName:       bucketName,
+		queryValues:      urlValues,
+		contentSHA256Hex: emptySHA256Hex,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp, bucketName, "")
+	}
+	return xmlDecoder(resp.Body, v)
+}
+
+// isUnsupportedSubresource tells whether an endpoint does not support a
+// subresource.
+func isUnsupportedSubresource(err error) bool {
+	return errors.Is(err, ErrNotImplemented) || errors.Is(err, ErrMethodNotAllowed)
+}
@@ -0,0 +1,152 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestIsBucketPublic(t *testing.T) {
+	const (
+		publicACL = `<AccessControlPolicy><Owner><ID>owner</ID></Owner><AccessControlList>` +
+			`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee><Permission>READ</Permission></Grant>` +
+			`</AccessControlList></AccessControlPolicy>`
+		privateACL = `<AccessControlPolicy><Owner><ID>owner</ID></Owner><AccessControlList>` +
+			`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>` +
+			`</AccessControlList></AccessControlPolicy>`
+		publicPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`
+	)
+	errorBody := func(code string) string {
+		return fmt.Sprintf("<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+	}
+
+	testCases := []struct {
+		policyStatus string
+		policy       string
+		acl          string
+		block        string
+		expected     BucketPublicStatus
+	}{
+		{
+			policyStatus: "<PolicyStatus><IsPublic>false</IsPublic></PolicyStatus>",
+			acl:          privateACL,
+			expected:     BucketPublicStatus{},
+		},
+		{
+			policyStatus: "<PolicyStatus><IsPublic>true</IsPublic></PolicyStatus>",
+			acl:          privateACL,
+			expected:     BucketPublicStatus{IsPublic: true, PolicyPublic: true},
+		},
+		{
+			policyStatus: "<PolicyStatus><IsPublic>true</IsPublic></PolicyStatus>",
+			acl:          publicACL,
+			block:        "<PublicAccessBlockConfiguration><RestrictPublicBuckets>true</RestrictPublicBuckets></PublicAccessBlockConfiguration>",
+			expected: BucketPublicStatus{
+				IsPublic: true, PolicyPublic: true, ACLPublic: true,
+				PublicAccessBlock: &PublicAccessBlockConfiguration{RestrictPublicBuckets: true},
+			},
+		},
+		{
+			policyStatus: "<PolicyStatus><IsPublic>true</IsPublic></PolicyStatus>",
+			acl:          publicACL,
+			block:        "<PublicAccessBlockConfiguration><IgnorePublicAcls>true</IgnorePublicAcls><RestrictPublicBuckets>true</RestrictPublicBuckets></PublicAccessBlockConfiguration>",
+			expected: BucketPublicStatus{
+				PolicyPublic: true, ACLPublic: true,
+				PublicAccessBlock: &PublicAccessBlockConfiguration{IgnorePublicAcls: true, RestrictPublicBuckets: true},
+			},
+		},
+		{
+			// Policy status not supported, the policy is evaluated.
+			policy:   publicPolicy,
+			acl:      privateACL,
+			expected: BucketPublicStatus{IsPublic: true, PolicyPublic: true},
+		},
+		{
+			// Bucket without policy.
+			policyStatus: errorBody("NoSuchBucketPolicy"),
+			acl:          publicACL,
+			expected:     BucketPublicStatus{IsPublic: true, ACLPublic: true},
+		},
+	}
+
+	for i, testCase := range testCases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			q := r.URL.Query()
+			switch {
+			case q.Has("publicAccessBlock"):
+				if testCase.block == "" {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(errorBody("NoSuchPublicAccessBlockConfiguration")))
+					return
+				}
+				w.Write([]byte(testCase.block))
+			case q.Has("policyStatus"):
+				switch {
+				case testCase.policyStatus == "":
+					w.WriteHeader(http.StatusNotImplemented)
+					w.Write([]byte(errorBody("NotImplemented")))
+				case strings.HasPrefix(testCase.policyStatus, "<Error>"):
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(testCase.policyStatus))
+				default:
+					w.Write([]byte(testCase.policyStatus))
+				}
+			case q.Has("policy"):
+				w.Write([]byte(testCase.policy))
+			case q.Has("acl"):
+				w.Write([]byte(testCase.acl))
+			default:
+				w.WriteHeader(http.StatusNotImplemented)
+			}
+		}))
+
+		c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:  credentials.NewStaticV4("access", "secret", ""),
+			Region: "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		status, err := c.IsBucketPublic(context.Background(), "bucket")
+		srv.Close()
+		if err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+			continue
+		}
+		if status.IsPublic != testCase.expected.IsPublic || status.PolicyPublic != testCase.expected.PolicyPublic ||
+			status.ACLPublic != testCase.expected.ACLPublic {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.expected, status)
+		}
+		if (status.PublicAccessBlock == nil) != (testCase.expected.PublicAccessBlock == nil) ||
+			(status.PublicAccessBlock != nil && (status.PublicAccessBlock.IgnorePublicAcls != testCase.expected.PublicAccessBlock.IgnorePublicAcls ||
+				status.PublicAccessBlock.RestrictPublicBuckets != testCase.expected.PublicAccessBlock.RestrictPublicBuckets)) {
+			t.Errorf("Test %d: expected public access block %+v, got %+v", i+1, testCase.expected.PublicAccessBlock, status.PublicAccessBlock)
+		}
+	}
+}
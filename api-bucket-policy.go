@@ -18,6 +18,7 @@ package minio
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -103,8 +104,7 @@ func (c *Client) GetBucketPolicy(ctx context.Context, bucketName string) (string
 	}
 	bucketPolicy, err := c.getBucketPolicy(ctx, bucketName)
 	if err != nil {
-		errResponse := ToErrorResponse(err)
-		if errResponse.Code == NoSuchBucketPolicy {
+		if errors.Is(err, ErrNoSuchBucketPolicy) {
 			return "", nil
 		}
 		return "", err
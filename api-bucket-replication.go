@@ -68,7 +68,9 @@ func (c *Client) putBucketReplication(ctx context.Context, bucketName string, cf
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(replication),
 		contentLength:    int64(len(replication)),
-		contentMD5Base64: sumMD5Base64(replication),
+		contentMD5Base64: c.contentMD5Base64(replication),
+		md5Required:      true,
+		contentSHA256Hex: sum256Hex(replication),
 	}
 
 	// Execute PUT to upload a new bucket replication config.
@@ -48,7 +48,8 @@ func (c *Client) SetBucketVersioning(ctx context.Context, bucketName string, con
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(buf),
 		contentLength:    int64(len(buf)),
-		contentMD5Base64: sumMD5Base64(buf),
+		contentMD5Base64: c.contentMD5Base64(buf),
+		md5Required:      true,
 		contentSHA256Hex: sum256Hex(buf),
 	}
 
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -80,6 +81,16 @@ type CopyDestOptions struct {
 	Size int64 // Needs to be specified if progress bar is specified.
 	// Progress of the entire copy operation will be sent here.
 	Progress io.Reader
+
+	// Checksum is the checksum computed by the server for the parts
+	// copied by ComposeObject, defaults to Options.DefaultChecksum.
+	Checksum ChecksumType
+
+	// Grants are the permissions granted on the destination object,
+	// the ACL of the source is not copied.
+	Grants Grants
+
+	requestExtensions
 }
 
 // Process custom-metadata to remove a `x-amz-meta-` prefix if
@@ -120,8 +131,9 @@ func (opts CopyDestOptions) Marshal(header http.Header) {
 	}
 
 	if opts.Encryption != nil {
-		opts.Encryption.Marshal(header)
+		encrypt.SSE(opts.Encryption).Marshal(header)
 	}
+	opts.Grants.Marshal(header)
 	if opts.ContentType != "" {
 		header.Set("Content-Type", opts.ContentType)
 	}
@@ -165,7 +177,7 @@ func (opts CopyDestOptions) validate() (err error) {
 	if opts.Progress != nil && opts.Size < 0 {
 		return errInvalidArgument("For progress bar effective size needs to be specified")
 	}
-	return nil
+	return opts.Grants.validate()
 }
 
 // CopySrcOptions represents a source object to be copied, using
@@ -186,9 +198,9 @@ type CopySrcOptions struct {
 // equivalent HTTP header representation
 func (opts CopySrcOptions) Marshal(header http.Header) {
 	// Set the source header
-	header.Set("x-amz-copy-source", s3utils.EncodePath(opts.Bucket+"/"+opts.Object))
+	header.Set("x-amz-copy-source", s3utils.EncodePath(copySourcePath(opts.Bucket, opts.Object)))
 	if opts.VersionID != "" {
-		header.Set("x-amz-copy-source", s3utils.EncodePath(opts.Bucket+"/"+opts.Object)+"?versionId="+opts.VersionID)
+		header.Set("x-amz-copy-source", s3utils.EncodePath(copySourcePath(opts.Bucket, opts.Object))+"?versionId="+opts.VersionID)
 	}
 
 	if opts.MatchETag != "" {
@@ -205,9 +217,7 @@ func (opts CopySrcOptions) Marshal(header http.Header) {
 		header.Set("x-amz-copy-source-if-unmodified-since", opts.MatchUnmodifiedSince.Format(http.TimeFormat))
 	}
 
-	if opts.Encryption != nil {
-		encrypt.SSECopy(opts.Encryption).Marshal(header)
-	}
+	encrypt.MarshalCopySource(opts.Encryption, header)
 }
 
 func (opts CopySrcOptions) validate() (err error) {
@@ -271,6 +281,12 @@ func (c *Client) copyObjectDo(ctx context.Context, srcBucket, srcObject, destBuc
 		objectName:   destObject,
 		customHeader: headers,
 	}
+	// SSE-C keys of the source and the destination.
+	encrypt.MarshalCopySource(srcOpts.Encryption, headers)
+	if dstOpts.ServerSideEncryption != nil {
+		encrypt.SSE(dstOpts.ServerSideEncryption).Marshal(headers)
+	}
+
 	if dstOpts.Internal.SourceVersionID != "" {
 		if dstOpts.Internal.SourceVersionID != nullVersionID {
 			if _, err := uuid.Parse(dstOpts.Internal.SourceVersionID); err != nil {
@@ -283,9 +299,9 @@ func (c *Client) copyObjectDo(ctx context.Context, srcBucket, srcObject, destBuc
 	}
 
 	// Set the source header
-	headers.Set("x-amz-copy-source", s3utils.EncodePath(srcBucket+"/"+srcObject))
+	headers.Set("x-amz-copy-source", s3utils.EncodePath(copySourcePath(srcBucket, srcObject)))
 	if srcOpts.VersionID != "" {
-		headers.Set("x-amz-copy-source", s3utils.EncodePath(srcBucket+"/"+srcObject)+"?versionId="+srcOpts.VersionID)
+		headers.Set("x-amz-copy-source", s3utils.EncodePath(copySourcePath(srcBucket, srcObject))+"?versionId="+srcOpts.VersionID)
 	}
 	// Send upload-part-copy request
 	resp, err := c.executeMethod(ctx, http.MethodPut, reqMetadata)
@@ -319,7 +335,7 @@ func (c *Client) copyObjectPartDo(ctx context.Context, srcBucket, srcObject, des
 	headers := make(http.Header)
 
 	// Set source
-	headers.Set("x-amz-copy-source", s3utils.EncodePath(srcBucket+"/"+srcObject))
+	headers.Set("x-amz-copy-source", s3utils.EncodePath(copySourcePath(srcBucket, srcObject)))
 
 	if startOffset < 0 {
 		return p, errInvalidArgument("startOffset must be non-negative")
@@ -333,34 +349,7 @@ func (c *Client) copyObjectPartDo(ctx context.Context, srcBucket, srcObject, des
 		headers.Set(k, v)
 	}
 
-	queryValues := make(url.Values)
-	queryValues.Set("partNumber", strconv.Itoa(partID))
-	queryValues.Set("uploadId", uploadID)
-
-	resp, err := c.executeMethod(ctx, http.MethodPut, requestMetadata{
-		bucketName:   destBucket,
-		objectName:   destObject,
-		customHeader: headers,
-		queryValues:  queryValues,
-	})
-	defer closeResponse(resp)
-	if err != nil {
-		return
-	}
-
-	// Check if we got an error response.
-	if resp.StatusCode != http.StatusOK {
-		return p, httpRespToErrorResponse(resp, destBucket, destObject)
-	}
-
-	// Decode copy-part response on success.
-	cpObjRes := copyObjectResult{}
-	err = xmlDecoder(resp.Body, &cpObjRes)
-	if err != nil {
-		return p, err
-	}
-	p.PartNumber, p.ETag = partID, cpObjRes.ETag
-	return p, nil
+	return c.uploadPartCopy(ctx, destBucket, destObject, uploadID, partID, headers)
 }
 
 // uploadPartCopy - helper function to create a part in a multipart
@@ -398,6 +387,9 @@ func (c *Client) uploadPartCopy(ctx context.Context, bucket, object, uploadID st
 		return p, err
 	}
 	p.PartNumber, p.ETag = partNumber, cpObjRes.ETag
+	p.ChecksumCRC32, p.ChecksumCRC32C = cpObjRes.ChecksumCRC32, cpObjRes.ChecksumCRC32C
+	p.ChecksumSHA1, p.ChecksumSHA256 = cpObjRes.ChecksumSHA1, cpObjRes.ChecksumSHA256
+	p.ChecksumCRC64NVME = cpObjRes.ChecksumCRC64NVME
 	return p, nil
 }
 
@@ -407,6 +399,8 @@ func (c *Client) uploadPartCopy(ctx context.Context, bucket, object, uploadID st
 // operations. Optionally takes progress reader hook for applications to
 // look at current progress.
 func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (UploadInfo, error) {
+	ctx = withRequestExtensions(ctx, dst.requestExtensions)
+
 	if len(srcs) < 1 || len(srcs) > maxPartsCount {
 		return UploadInfo{}, errInvalidArgument("There must be as least one and up to 10000 source objects.")
 	}
@@ -420,6 +414,14 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 	if err := dst.validate(); err != nil {
 		return UploadInfo{}, err
 	}
+	if err := c.validateCopyDestMetadata(dst); err != nil {
+		return UploadInfo{}, err
+	}
+	dst.Encryption = encrypt.SSE(dst.Encryption)
+
+	if c.useGCSInterop() {
+		return c.composeObjectGCS(ctx, dst, srcs)
+	}
 
 	srcObjectInfos := make([]ObjectInfo, len(srcs))
 	srcObjectSizes := make([]int64, len(srcs))
@@ -505,14 +507,33 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 		userTags = srcObjectInfos[0].UserTags
 	}
 
-	uploadID, err := c.newUploadID(ctx, dst.Bucket, dst.Object, PutObjectOptions{
-		ServerSideEncryption: dst.Encryption,
-		UserMetadata:         userMeta,
-		UserTags:             userTags,
-		Mode:                 dst.Mode,
-		RetainUntilDate:      dst.RetainUntilDate,
-		LegalHold:            dst.LegalHold,
-	})
+	// The server computes the checksums of the copied parts, the default
+	// checksum of the client is downgraded if the endpoint rejects it.
+	checksum, usesDefault := dst.Checksum, !dst.Checksum.IsSet()
+	if usesDefault {
+		checksum = c.defaultChecksumType()
+	}
+	var uploadID string
+	for {
+		putOpts := PutObjectOptions{
+			ServerSideEncryption: dst.Encryption,
+			UserMetadata:         maps.Clone(userMeta),
+			UserTags:             userTags,
+			Mode:                 dst.Mode,
+			RetainUntilDate:      dst.RetainUntilDate,
+			LegalHold:            dst.LegalHold,
+			AutoChecksum:         checksum,
+			Grants:               dst.Grants,
+		}
+		if checksum.IsSet() {
+			addAutoChecksumHeaders(&putOpts)
+		}
+		uploadID, err = c.newUploadID(ctx, dst.Bucket, dst.Object, putOpts)
+		if err == nil || !usesDefault || !checksum.IsSet() || !isChecksumRejected(err) || !c.downgradeChecksum(checksum) {
+			break
+		}
+		checksum = c.defaultChecksumType()
+	}
 	if err != nil {
 		return UploadInfo{}, err
 	}
@@ -21,6 +21,8 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 )
 
 const (
@@ -183,3 +185,38 @@ func TestDestOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestCopyOptionsSSEC(t *testing.T) {
+	sse, err := encrypt.NewSSEC(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Either form of the SSE-C key must result in the right headers.
+	for _, key := range []encrypt.ServerSide{sse, encrypt.SSECopy(sse)} {
+		h := make(http.Header)
+		CopySrcOptions{Bucket: "bucket", Object: "src", Encryption: key}.Marshal(h)
+		CopyDestOptions{Bucket: "bucket", Object: "dst", Encryption: key}.Marshal(h)
+		for _, k := range []string{
+			encrypt.SseCopyCustomerAlgorithm, encrypt.SseCopyCustomerKey, encrypt.SseCopyCustomerKeyMD5,
+			encrypt.SseCustomerAlgorithm, encrypt.SseCustomerKey, encrypt.SseCustomerKeyMD5,
+		} {
+			if h.Get(k) == "" {
+				t.Errorf("Expected header %s to be set", k)
+			}
+		}
+
+		for k := range (GetObjectOptions{ServerSideEncryption: key}).Header() {
+			if strings.HasPrefix(k, "X-Amz-Copy-Source") {
+				t.Errorf("Unexpected copy source header %s", k)
+			}
+		}
+	}
+
+	// Source encryption other than SSE-C must not be sent.
+	h := make(http.Header)
+	CopySrcOptions{Bucket: "bucket", Object: "src", Encryption: encrypt.NewSSE()}.Marshal(h)
+	if v := h.Get(encrypt.SseGenericHeader); v != "" {
+		t.Errorf("Unexpected header %s: %s", encrypt.SseGenericHeader, v)
+	}
+}
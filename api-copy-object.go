@@ -25,6 +25,8 @@ import (
 
 // CopyObject - copy a source object into a new object
 func (c *Client) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error) {
+	ctx = withRequestExtensions(ctx, dst.requestExtensions)
+
 	if err := src.validate(); err != nil {
 		return UploadInfo{}, err
 	}
@@ -32,6 +34,9 @@ func (c *Client) CopyObject(ctx context.Context, dst CopyDestOptions, src CopySr
 	if err := dst.validate(); err != nil {
 		return UploadInfo{}, err
 	}
+	if err := c.validateCopyDestMetadata(dst); err != nil {
+		return UploadInfo{}, err
+	}
 
 	header := make(http.Header)
 	dst.Marshal(header)
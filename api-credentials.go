@@ -0,0 +1,54 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type credentialsContextKey struct{}
+
+// WithCredentials returns a copy of ctx carrying creds, requests made with
+// the returned context are signed with creds instead of the credentials of
+// the Client. This allows a single Client, along with its transport and
+// bucket location cache, to act on behalf of many tenants.
+//
+// Already resolved credentials can be passed with
+// credentials.New(&credentials.Static{Value: value}).
+func WithCredentials(ctx context.Context, creds *credentials.Credentials) context.Context {
+	if creds == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, credentialsContextKey{}, creds)
+}
+
+// requestCredentials returns the per-request credentials of ctx, if any.
+func requestCredentials(ctx context.Context) *credentials.Credentials {
+	creds, _ := ctx.Value(credentialsContextKey{}).(*credentials.Credentials)
+	return creds
+}
+
+// getCredentials returns the credentials to sign a request made with ctx.
+func (c *Client) getCredentials(ctx context.Context) (credentials.Value, error) {
+	if creds := requestCredentials(ctx); creds != nil {
+		return creds.GetWithContext(c.CredContext())
+	}
+	return c.credsProvider.GetWithContext(c.CredContext())
+}
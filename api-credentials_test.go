@@ -0,0 +1,81 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestRequestCredentials(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=")
+		accessKey, _, _ := strings.Cut(auth, "/")
+		mu.Lock()
+		keys = append(keys, accessKey)
+		mu.Unlock()
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", "0")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("client", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err = c.StatObject(ctx, "bucket", "object", StatObjectOptions{
+		Credentials: credentials.NewStaticV4("tenant-a", "secret", ""),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.RemoveObject(ctx, "bucket", "object", RemoveObjectOptions{
+		Credentials: credentials.NewStaticV4("tenant-b", "secret", ""),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.StatObject(WithCredentials(ctx, credentials.NewStaticV4("tenant-c", "secret", "")), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"tenant-a", "tenant-b", "tenant-c", "client"}
+	if strings.Join(keys, ",") != strings.Join(expected, ",") {
+		t.Fatalf("Expected requests signed by %v, got %v", expected, keys)
+	}
+}
@@ -220,6 +220,23 @@ type ObjectInfo struct {
 
 	Restore *RestoreInfo
 
+	// Server-side encryption of the object: the algorithm, i.e.
+	// "AES256" or "aws:kms", the KMS key, whether it is encrypted with
+	// an S3 bucket key and the algorithm of SSE-C keys.
+	ServerSideEncryption string
+	SSEKMSKeyID          string
+	SSEBucketKeyEnabled  bool
+	SSECustomerAlgorithm string
+
+	// Object lock retention and legal hold of the object.
+	ObjectLockMode            RetentionMode
+	ObjectLockRetainUntilDate time.Time
+	ObjectLockLegalHold       LegalHoldStatus
+
+	// PartsCount is the number of parts of multipart objects, from the
+	// x-amz-mp-parts-count header or the ETag, zero if unknown.
+	PartsCount int
+
 	// Checksum values
 	ChecksumCRC32     string
 	ChecksumCRC32C    string
@@ -228,11 +245,24 @@ type ObjectInfo struct {
 	ChecksumCRC64NVME string
 	ChecksumMode      string
 
+	// ChecksumVerified is the checksum verified while reading the
+	// object, see GetObjectOptions.VerifyChecksum.
+	ChecksumVerified ChecksumType `json:"-" xml:"-"`
+
 	Internal *struct {
 		K int // Data blocks
 		M int // Parity blocks
 	} `xml:"Internal"`
 
+	// RawHeaders are the response headers the info is parsed from, nil
+	// if it is not parsed from headers, e.g. when listing objects.
+	RawHeaders http.Header `json:"-" xml:"-"`
+
+	// x-rgw-* headers stripped "x-rgw-" prefix in lower case, e.g.
+	// "object-type" and "next-append-position" of appendable objects.
+	// Only returned by Ceph RGW servers.
+	RGWAttributes StringMap `json:"rgwAttributes,omitempty" xml:"-"`
+
 	// Error
 	Err error `json:"-"`
 }
@@ -0,0 +1,125 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// SignedRequest is a request signed and sent by Client.Do.
+type SignedRequest struct {
+	// Method of the request, defaults to GET.
+	Method string
+	// Path is the bucket name followed by the object name, e.g.
+	// "bucket/prefix/object". It is empty for requests to the service.
+	Path string
+	// Query parameters of the request, e.g. "uploads" with an empty
+	// value for ?uploads.
+	Query url.Values
+	// Headers of the request, only their first value is sent.
+	Headers http.Header
+	// Body of the request. Bodies implementing io.ReaderAt and
+	// io.Seeker, e.g. *os.File and *bytes.Reader, are sent from their
+	// current offset, other bodies are read in memory to be retried.
+	Body io.Reader
+}
+
+type readAtSeeker interface {
+	io.ReaderAt
+	io.Seeker
+}
+
+// Do signs and sends a request to the endpoint like the typed APIs, i.e.
+// with the bucket location, retries and the options of the client, and
+// returns the raw response, e.g. to call vendor APIs the client does not
+// support yet. Responses with error statuses are returned without error
+// after retries, their body holds the error of the endpoint. The body of
+// the response must be closed.
+func (c *Client) Do(ctx context.Context, req SignedRequest) (*http.Response, error) {
+	bucketName, objectName, _ := strings.Cut(strings.TrimPrefix(req.Path, "/"), "/")
+	if bucketName != "" {
+		if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+			return nil, err
+		}
+	} else if objectName != "" {
+		return nil, errInvalidArgument("Path of objects must start with the bucket name.")
+	}
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	metadata := requestMetadata{
+		bucketName:   bucketName,
+		objectName:   objectName,
+		queryValues:  req.Query,
+		customHeader: req.Headers,
+	}
+	if req.Body != nil {
+		body, ok := req.Body.(readAtSeeker)
+		if !ok {
+			data, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			body = bytes.NewReader(data)
+		}
+		start, err := body.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		end, err := body.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = body.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+		// The section is retried from the offset of the body and leaves
+		// it open.
+		section := io.NewSectionReader(body, start, end-start)
+		// Signed requests over plain HTTP sign the payload.
+		if !c.secure {
+			h := sha256.New()
+			if _, err = io.Copy(h, section); err != nil {
+				return nil, err
+			}
+			if _, err = section.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			metadata.contentSHA256Hex = hex.EncodeToString(h.Sum(nil))
+		}
+		metadata.contentBody = section
+		metadata.contentLength = section.Size()
+	}
+
+	resp, err := c.executeMethod(ctx, method, metadata)
+	if resp == nil {
+		return nil, err
+	}
+	return resp, nil
+}
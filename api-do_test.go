@@ -0,0 +1,105 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestDo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		contentSha256 := unsignedPayload
+		if len(body) > 0 {
+			contentSha256 = sum256Hex(body)
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256") || r.Header.Get("X-Amz-Content-Sha256") != contentSha256 {
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, "<Error><Code>AccessDenied</Code><Message>Unsigned request.</Message></Error>")
+			return
+		}
+		w.Header().Set("X-Echo", r.Method+" "+r.URL.RequestURI()+" "+r.Header.Get("X-Vendor")+" "+string(body))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seeker := strings.NewReader("skipped body")
+	seeker.Seek(8, io.SeekStart)
+	testCases := []struct {
+		req    SignedRequest
+		status int
+		echo   string
+	}{
+		{req: SignedRequest{}, status: http.StatusAccepted, echo: "GET /"},
+		{
+			req: SignedRequest{
+				Method:  http.MethodPost,
+				Path:    "/bucket/prefix/object name",
+				Query:   url.Values{"vendor-api": {""}},
+				Headers: http.Header{"X-Vendor": {"value"}},
+				Body:    io.MultiReader(strings.NewReader("a "), strings.NewReader("body")),
+			},
+			status: http.StatusAccepted,
+			echo:   "POST /bucket/prefix/object%20name?vendor-api= value a body",
+		},
+		{req: SignedRequest{Method: http.MethodPut, Path: "bucket", Body: seeker}, status: http.StatusAccepted, echo: "PUT /bucket/  body"},
+	}
+	for i, testCase := range testCases {
+		resp, err := c.Do(context.Background(), testCase.req)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != testCase.status {
+			t.Errorf("Test %d: expected status %d, got %d", i+1, testCase.status, resp.StatusCode)
+		}
+		if echo := resp.Header.Get("X-Echo"); echo != testCase.echo {
+			t.Errorf("Test %d: expected %q, got %q", i+1, testCase.echo, echo)
+		}
+	}
+
+	// Error responses are returned.
+	resp, err := c.Public().Do(context.Background(), SignedRequest{Path: "bucket"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if b, _ := io.ReadAll(resp.Body); resp.StatusCode != http.StatusForbidden || !strings.Contains(string(b), "AccessDenied") {
+		t.Errorf("Expected the error response, got %d %s", resp.StatusCode, b)
+	}
+
+	if _, err = c.Do(context.Background(), SignedRequest{Path: "/b/object"}); err == nil {
+		t.Error("Expected an invalid bucket name to fail")
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/url"
+)
+
+// EndpointResolver resolves the endpoint requests of a bucket are sent
+// to. The bucket location is empty when it is not known yet, i.e. while
+// resolving it. Virtual host or path style requests and Amazon S3
+// regional endpoints are derived from the returned endpoint as for the
+// client endpoint.
+type EndpointResolver interface {
+	ResolveEndpoint(ctx context.Context, bucketName, bucketLocation string) (*url.URL, error)
+}
+
+// EndpointResolverFunc is an adapter to use a function as EndpointResolver.
+type EndpointResolverFunc func(ctx context.Context, bucketName, bucketLocation string) (*url.URL, error)
+
+// ResolveEndpoint calls f(ctx, bucketName, bucketLocation).
+func (f EndpointResolverFunc) ResolveEndpoint(ctx context.Context, bucketName, bucketLocation string) (*url.URL, error) {
+	return f(ctx, bucketName, bucketLocation)
+}
+
+// DefaultEndpointResolver returns an EndpointResolver resolving all
+// buckets to endpointURL, the behavior without an EndpointResolver.
+func DefaultEndpointResolver(endpointURL *url.URL) EndpointResolver {
+	return EndpointResolverFunc(func(context.Context, string, string) (*url.URL, error) {
+		return endpointURL, nil
+	})
+}
+
+// resolveEndpoint returns the endpoint of bucketName.
+func (c *Client) resolveEndpoint(ctx context.Context, bucketName, bucketLocation string) (*url.URL, error) {
+	if c.circuitBreaker != nil && c.circuitBreaker.failover != nil && isFailover(ctx) {
+		return c.circuitBreaker.failover, nil
+	}
+	if c.endpointResolver == nil || bucketName == "" {
+		return c.endpointURL, nil
+	}
+	u, err := c.endpointResolver.ResolveEndpoint(ctx, bucketName, bucketLocation)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, errInvalidArgument("Endpoint resolved for bucket " + bucketName + " is invalid.")
+	}
+	return u, nil
+}
@@ -0,0 +1,84 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestEndpointResolver(t *testing.T) {
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("ETag", `"`+name+`"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.WriteHeader(http.StatusOK)
+		}))
+	}
+	primary, tenant := newServer("primary"), newServer("tenant")
+	defer primary.Close()
+	defer tenant.Close()
+
+	primaryURL, _ := url.Parse(primary.URL)
+	tenantURL, _ := url.Parse(tenant.URL)
+	clnt, err := New(primaryURL.Host, &Options{
+		Creds:  credentials.NewStaticV4("foo", "foo12345", ""),
+		Region: "us-east-1",
+		EndpointResolver: EndpointResolverFunc(func(_ context.Context, bucketName, _ string) (*url.URL, error) {
+			if strings.HasPrefix(bucketName, "tenant-") {
+				return tenantURL, nil
+			}
+			return primaryURL, nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for bucket, etag := range map[string]string{"tenant-a": "tenant", "shared": "primary"} {
+		info, err := clnt.StatObject(context.Background(), bucket, "object", StatObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.ETag != etag {
+			t.Errorf("Bucket %s: expected endpoint %s, got %s", bucket, etag, info.ETag)
+		}
+	}
+
+	u, err := clnt.PresignedGetObject(context.Background(), "tenant-a", "object", time.Hour, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Host != tenantURL.Host {
+		t.Errorf("Expected presigned host %s, got %s", tenantURL.Host, u.Host)
+	}
+
+	clnt.endpointResolver = EndpointResolverFunc(func(context.Context, string, string) (*url.URL, error) {
+		return &url.URL{Host: "example.com"}, nil
+	})
+	if _, err = clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err == nil {
+		t.Error("Expected error for an endpoint without scheme")
+	}
+}
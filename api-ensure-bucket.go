@@ -0,0 +1,356 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// BucketSpec is the desired state of a bucket, see EnsureBucket. The
+// settings left nil are not reconciled.
+type BucketSpec struct {
+	Name string
+
+	// Region and ObjectLocking are used to create a missing bucket,
+	// object locking is also enabled on existing buckets.
+	Region        string
+	ObjectLocking bool
+
+	// Versioning configuration, an empty status matches unversioned
+	// buckets.
+	Versioning *BucketVersioningConfiguration
+
+	// Default encryption, a configuration without rules removes it.
+	Encryption *sse.Configuration
+
+	// Lifecycle configuration, an empty configuration removes it.
+	Lifecycle *lifecycle.Configuration
+
+	// Tags of the bucket, an empty map removes them.
+	Tags map[string]string
+
+	// Policy of the bucket, an empty policy removes it. Policies are
+	// compared as JSON documents.
+	Policy *string
+
+	// DefaultRetention of the objects of the bucket, a zero retention
+	// removes it. It requires ObjectLocking.
+	DefaultRetention *Retention
+}
+
+// BucketChange is a setting of a bucket changed by EnsureBucket.
+type BucketChange struct {
+	// Setting is one of bucket, versioning, encryption, lifecycle,
+	// tags, policy and object-lock.
+	Setting string
+	// From and To are the previous and the new value of the setting,
+	// empty when it was not set.
+	From, To string
+}
+
+// EnsureBucketResult is the result of EnsureBucket.
+type EnsureBucketResult struct {
+	// Created is set when the bucket was missing.
+	Created bool
+	// Changes are the settings changed, in the order they were applied.
+	Changes []BucketChange
+}
+
+// EnsureBucket creates the bucket of spec if it is missing and makes its
+// settings match spec. Settings are only set when they differ from the
+// current settings, so calling it again with the same spec changes
+// nothing. The changes applied before an error are returned along with
+// the error.
+func (c *Client) EnsureBucket(ctx context.Context, spec BucketSpec) (EnsureBucketResult, error) {
+	var result EnsureBucketResult
+	if err := s3utils.CheckValidBucketName(spec.Name); err != nil {
+		return result, err
+	}
+	var (
+		bucketTags   *tags.Tags
+		lockMode     *RetentionMode
+		lockValidity *uint
+		lockUnit     *ValidityUnit
+		err          error
+	)
+	if len(spec.Tags) > 0 {
+		if bucketTags, err = tags.NewTags(spec.Tags, false); err != nil {
+			return result, err
+		}
+	}
+	if spec.DefaultRetention != nil {
+		if !spec.ObjectLocking {
+			return result, errInvalidArgument("Default retention requires object locking")
+		}
+		if *spec.DefaultRetention != (Retention{}) {
+			if lockMode, lockValidity, lockUnit, err = defaultRetentionConfig(*spec.DefaultRetention); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	exists, err := c.BucketExists(ctx, spec.Name)
+	if err != nil {
+		return result, err
+	}
+	if !exists {
+		if err = c.MakeBucket(ctx, spec.Name, MakeBucketOptions{Region: spec.Region, ObjectLocking: spec.ObjectLocking}); err != nil {
+			return result, err
+		}
+		result.Created = true
+		result.Changes = append(result.Changes, BucketChange{Setting: "bucket", To: spec.Name})
+	}
+
+	// Versioning first, object locking and noncurrent version rules
+	// depend on it.
+	if spec.Versioning != nil {
+		current, err := c.GetBucketVersioning(ctx, spec.Name)
+		if err != nil {
+			return result, err
+		}
+		desired := *spec.Versioning
+		current.XMLName, desired.XMLName = xml.Name{}, xml.Name{}
+		if current.Status == "" && desired.Suspended() {
+			current.Status = Suspended
+		}
+		if !reflect.DeepEqual(current, desired) {
+			if err = c.SetBucketVersioning(ctx, spec.Name, desired); err != nil {
+				return result, err
+			}
+			result.Changes = append(result.Changes, BucketChange{Setting: "versioning", From: current.Status, To: desired.Status})
+		}
+	}
+
+	if spec.Encryption != nil {
+		current, err := c.GetBucketEncryption(ctx, spec.Name)
+		if err != nil && ToErrorResponse(err).Code != "ServerSideEncryptionConfigurationNotFoundError" {
+			return result, err
+		}
+		from, to := xmlString(current), xmlString(spec.Encryption)
+		if current == nil || len(current.Rules) == 0 {
+			from = ""
+		}
+		if len(spec.Encryption.Rules) == 0 {
+			to = ""
+		}
+		if normalizedEncryption(current) != normalizedEncryption(spec.Encryption) {
+			if to == "" {
+				err = c.RemoveBucketEncryption(ctx, spec.Name)
+			} else {
+				err = c.SetBucketEncryption(ctx, spec.Name, spec.Encryption)
+			}
+			if err != nil {
+				return result, err
+			}
+			result.Changes = append(result.Changes, BucketChange{Setting: "encryption", From: from, To: to})
+		}
+	}
+
+	if spec.Lifecycle != nil {
+		current, err := c.GetBucketLifecycle(ctx, spec.Name)
+		if err != nil && ToErrorResponse(err).Code != "NoSuchLifecycleConfiguration" {
+			return result, err
+		}
+		from, to := "", ""
+		if current != nil && !current.Empty() {
+			from = xmlString(current)
+		}
+		if !spec.Lifecycle.Empty() {
+			to = xmlString(spec.Lifecycle)
+		}
+		// Servers generate the IDs of rules without one.
+		ignoreIDs := slices.ContainsFunc(spec.Lifecycle.Rules, func(r lifecycle.Rule) bool { return r.ID == "" })
+		if normalizedLifecycle(current, ignoreIDs) != normalizedLifecycle(spec.Lifecycle, ignoreIDs) {
+			if err = c.SetBucketLifecycle(ctx, spec.Name, spec.Lifecycle); err != nil {
+				return result, err
+			}
+			result.Changes = append(result.Changes, BucketChange{Setting: "lifecycle", From: from, To: to})
+		}
+	}
+
+	if spec.Tags != nil {
+		current, err := c.GetBucketTagging(ctx, spec.Name)
+		if err != nil && !errors.Is(err, ErrNoSuchTagSet) {
+			return result, err
+		}
+		from, to := "", ""
+		if current != nil {
+			from = current.String()
+		}
+		if bucketTags != nil {
+			to = bucketTags.String()
+		}
+		if from != to {
+			if bucketTags == nil {
+				err = c.RemoveBucketTagging(ctx, spec.Name)
+			} else {
+				err = c.SetBucketTagging(ctx, spec.Name, bucketTags)
+			}
+			if err != nil {
+				return result, err
+			}
+			result.Changes = append(result.Changes, BucketChange{Setting: "tags", From: from, To: to})
+		}
+	}
+
+	if spec.Policy != nil {
+		current, err := c.GetBucketPolicy(ctx, spec.Name)
+		if err != nil {
+			return result, err
+		}
+		if !equalPolicies(current, *spec.Policy) {
+			if err = c.SetBucketPolicy(ctx, spec.Name, *spec.Policy); err != nil {
+				return result, err
+			}
+			result.Changes = append(result.Changes, BucketChange{Setting: "policy", From: current, To: *spec.Policy})
+		}
+	}
+
+	if spec.ObjectLocking {
+		enabled, mode, validity, unit, err := c.GetObjectLockConfig(ctx, spec.Name)
+		if err != nil && ToErrorResponse(err).Code != "ObjectLockConfigurationNotFoundError" {
+			return result, err
+		}
+		from := retentionString(enabled == "Enabled", mode, validity, unit)
+		// The current retention is kept unless reconciled.
+		if spec.DefaultRetention != nil {
+			mode, validity, unit = lockMode, lockValidity, lockUnit
+		}
+		if to := retentionString(true, mode, validity, unit); from != to {
+			if err = c.SetObjectLockConfig(ctx, spec.Name, mode, validity, unit); err != nil {
+				return result, err
+			}
+			result.Changes = append(result.Changes, BucketChange{Setting: "object-lock", From: from, To: to})
+		}
+	}
+	return result, nil
+}
+
+// xmlString returns the XML encoding of v, or an empty string if it is
+// nil.
+func xmlString(v any) string {
+	if v == nil || reflect.ValueOf(v).IsNil() {
+		return ""
+	}
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// normalizedEncryption returns the XML encoding of an encryption
+// configuration with sorted rules, or an empty string if it has none.
+func normalizedEncryption(config *sse.Configuration) string {
+	if config == nil || len(config.Rules) == 0 {
+		return ""
+	}
+	rules := make([]string, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		rules = append(rules, xmlString(&rule))
+	}
+	slices.Sort(rules)
+	return strings.Join(rules, "")
+}
+
+// normalizedLifecycle returns the XML encoding of a lifecycle
+// configuration with sorted rules, without namespaces and without rule
+// IDs if ignoreIDs is set, or an empty string if it has no rules. The
+// prefixes of rules without filter are moved to their filters.
+func normalizedLifecycle(config *lifecycle.Configuration, ignoreIDs bool) string {
+	if config.Empty() {
+		return ""
+	}
+	// Decode a copy, the server may have set namespaces.
+	var normalized lifecycle.Configuration
+	if err := xml.Unmarshal([]byte(xmlString(config)), &normalized); err != nil {
+		return xmlString(config)
+	}
+	clearXMLNames(reflect.ValueOf(&normalized).Elem())
+	rules := make([]string, 0, len(normalized.Rules))
+	for _, rule := range normalized.Rules {
+		if ignoreIDs {
+			rule.ID = ""
+		}
+		if rule.Prefix != "" && rule.RuleFilter.IsNull() {
+			rule.RuleFilter.Prefix, rule.Prefix = rule.Prefix, ""
+		}
+		rules = append(rules, xmlString(&rule))
+	}
+	slices.Sort(rules)
+	return strings.Join(rules, "")
+}
+
+// clearXMLNames zeroes the xml.Name fields of v and of its fields.
+func clearXMLNames(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			clearXMLNames(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			clearXMLNames(v.Index(i))
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(xml.Name{}) {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				clearXMLNames(v.Field(i))
+			}
+		}
+	}
+}
+
+// equalPolicies tells whether two policies are the same JSON document.
+func equalPolicies(a, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// retentionString describes an object lock configuration.
+func retentionString(enabled bool, mode *RetentionMode, validity *uint, unit *ValidityUnit) string {
+	switch {
+	case !enabled:
+		return ""
+	case mode == nil || validity == nil || unit == nil:
+		return "Enabled"
+	}
+	return fmt.Sprintf("Enabled %s %d %s", *mode, *validity, *unit)
+}
@@ -0,0 +1,160 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/sse"
+)
+
+func TestEnsureBucket(t *testing.T) {
+	notFound := map[string]string{
+		"encryption":  "ServerSideEncryptionConfigurationNotFoundError",
+		"lifecycle":   "NoSuchLifecycleConfiguration",
+		"tagging":     NoSuchTagSet,
+		"policy":      NoSuchBucketPolicy,
+		"object-lock": "ObjectLockConfigurationNotFoundError",
+	}
+	var (
+		mu      sync.Mutex
+		created bool
+		config  = make(map[string]string)
+		writes  int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		subresource := ""
+		for _, name := range []string{"versioning", "encryption", "lifecycle", "tagging", "policy", "object-lock"} {
+			if r.URL.Query().Has(name) {
+				subresource = name
+			}
+		}
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case subresource == "" && r.Method == http.MethodHead:
+			if !created {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case subresource == "" && r.Method == http.MethodPut:
+			created = true
+			writes++
+		case r.Method == http.MethodPut:
+			// Like AWS S3, echo the configurations with a namespace
+			// and generated rule IDs.
+			stored := strings.Replace(string(body), "Configuration>", `Configuration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`, 1)
+			config[subresource] = strings.ReplaceAll(stored, "<ID></ID>", "<ID>generated-id</ID>")
+			writes++
+		case r.Method == http.MethodDelete:
+			delete(config, subresource)
+			writes++
+			w.WriteHeader(http.StatusNoContent)
+		case config[subresource] != "":
+			w.Write([]byte(config[subresource]))
+		case subresource == "versioning":
+			w.Write([]byte(`<VersioningConfiguration></VersioningConfiguration>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `<Error><Code>%s</Code></Error>`, notFound[subresource])
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lc := lifecycle.NewConfiguration()
+	lc.Rules = []lifecycle.Rule{{
+		Status:     "Enabled",
+		RuleFilter: lifecycle.Filter{Prefix: "tmp/"},
+		Expiration: lifecycle.Expiration{Days: 7},
+	}}
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`
+	spec := BucketSpec{
+		Name:             "bucket",
+		ObjectLocking:    true,
+		Versioning:       &BucketVersioningConfiguration{Status: Enabled},
+		Encryption:       sse.NewConfigurationSSES3(),
+		Lifecycle:        lc,
+		Tags:             map[string]string{"team": "storage"},
+		Policy:           &policy,
+		DefaultRetention: &Retention{Mode: Governance, Validity: 365 * 24 * time.Hour},
+	}
+
+	ctx := context.Background()
+	result, err := clnt.EnsureBucket(ctx, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var settings []string
+	for _, change := range result.Changes {
+		settings = append(settings, change.Setting)
+	}
+	if !result.Created || strings.Join(settings, ",") != "bucket,versioning,encryption,lifecycle,tags,policy,object-lock" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if lock := result.Changes[len(result.Changes)-1]; lock.From != "" || lock.To != "Enabled GOVERNANCE 1 YEARS" {
+		t.Errorf("unexpected object lock change %+v", lock)
+	}
+
+	if !strings.Contains(config["lifecycle"], "generated-id") || !strings.Contains(config["encryption"], "xmlns") {
+		t.Fatalf("expected the server to add rule IDs and namespaces, got %v", config)
+	}
+
+	// Reconciling again changes nothing, policies are compared as JSON
+	// and rule IDs generated by the server are ignored.
+	writes = 0
+	indented := strings.ReplaceAll(policy, ",", ", ")
+	spec.Policy = &indented
+	if result, err = clnt.EnsureBucket(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+	if result.Created || len(result.Changes) != 0 || writes != 0 {
+		t.Fatalf("expected no changes, got %+v and %d writes", result, writes)
+	}
+
+	// Empty settings are removed.
+	empty := ""
+	result, err = clnt.EnsureBucket(ctx, BucketSpec{
+		Name:       "bucket",
+		Encryption: &sse.Configuration{},
+		Tags:       map[string]string{},
+		Policy:     &empty,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Changes) != 3 || config["encryption"] != "" || config["tagging"] != "" || config["policy"] != "" {
+		t.Fatalf("expected the settings to be removed, got %+v", result)
+	}
+}
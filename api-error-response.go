@@ -20,6 +20,7 @@ package minio
 import (
 	"bytes"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -60,6 +61,9 @@ type ErrorResponse struct {
 
 	// Underlying HTTP status code for the returned error
 	StatusCode int `xml:"-" json:"-"`
+
+	// history of the failed attempts of retried requests, see AttemptLog.
+	history *attemptHistory
 }
 
 // ToErrorResponse - Returns parsed ErrorResponse struct from body and
@@ -76,12 +80,75 @@ type ErrorResponse struct {
 //	}
 //	...
 func ToErrorResponse(err error) ErrorResponse {
-	switch err := err.(type) {
-	case ErrorResponse:
-		return err
-	default:
-		return ErrorResponse{}
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp
+	}
+	return ErrorResponse{}
+}
+
+// Sentinel errors matching the ErrorResponse of a S3 error code with
+// errors.Is, also when the ErrorResponse is wrapped.
+//
+// For example:
+//
+//	_, err := c.StatObject(ctx, bucket, object, minio.StatObjectOptions{})
+//	if errors.Is(err, minio.ErrNoSuchKey) {
+//	   ...
+//	}
+var (
+	ErrNoSuchBucket            = ErrorResponse{Code: NoSuchBucket, StatusCode: http.StatusNotFound}
+	ErrNoSuchKey               = ErrorResponse{Code: NoSuchKey, StatusCode: http.StatusNotFound}
+	ErrNoSuchUpload            = ErrorResponse{Code: NoSuchUpload, StatusCode: http.StatusNotFound}
+	ErrNoSuchVersion           = ErrorResponse{Code: NoSuchVersion, StatusCode: http.StatusNotFound}
+	ErrNoSuchBucketPolicy      = ErrorResponse{Code: NoSuchBucketPolicy, StatusCode: http.StatusNotFound}
+	ErrNoSuchTagSet            = ErrorResponse{Code: NoSuchTagSet, StatusCode: http.StatusNotFound}
+	ErrNoSuchCORSConfiguration = ErrorResponse{Code: NoSuchCORSConfiguration, StatusCode: http.StatusNotFound}
+	ErrAccessDenied            = ErrorResponse{Code: AccessDenied, StatusCode: http.StatusForbidden}
+	ErrPreconditionFailed      = ErrorResponse{Code: PreconditionFailed, StatusCode: http.StatusPreconditionFailed}
+	ErrInvalidRange            = ErrorResponse{Code: InvalidRange, StatusCode: http.StatusRequestedRangeNotSatisfiable}
+	ErrInvalidObjectState      = ErrorResponse{Code: InvalidObjectState, StatusCode: http.StatusForbidden}
+	ErrBucketAlreadyExists     = ErrorResponse{Code: BucketAlreadyExists, StatusCode: http.StatusConflict}
+	ErrBucketAlreadyOwnedByYou = ErrorResponse{Code: BucketAlreadyOwnedByYou, StatusCode: http.StatusConflict}
+	ErrBucketNotEmpty          = ErrorResponse{Code: BucketNotEmpty, StatusCode: http.StatusConflict}
+	ErrEntityTooLarge          = ErrorResponse{Code: EntityTooLarge, StatusCode: http.StatusBadRequest}
+	ErrEntityTooSmall          = ErrorResponse{Code: EntityTooSmall, StatusCode: http.StatusBadRequest}
+	ErrInvalidArgument         = ErrorResponse{Code: InvalidArgument, StatusCode: http.StatusBadRequest}
+	ErrRequestTimeTooSkewed    = ErrorResponse{Code: RequestTimeTooSkewed, StatusCode: http.StatusForbidden}
+	ErrSignatureDoesNotMatch   = ErrorResponse{Code: SignatureDoesNotMatch, StatusCode: http.StatusForbidden}
+	ErrInvalidAccessKeyID      = ErrorResponse{Code: InvalidAccessKeyID, StatusCode: http.StatusForbidden}
+	ErrMethodNotAllowed        = ErrorResponse{Code: MethodNotAllowed, StatusCode: http.StatusMethodNotAllowed}
+	ErrNotImplemented          = ErrorResponse{Code: NotImplemented, StatusCode: http.StatusNotImplemented}
+	ErrInternalError           = ErrorResponse{Code: InternalError, StatusCode: http.StatusInternalServerError}
+
+	// ErrSlowDown also matches the SlowDownRead and SlowDownWrite
+	// codes of MinIO.
+	ErrSlowDown = ErrorResponse{Code: SlowDown, StatusCode: http.StatusServiceUnavailable}
+)
+
+// errorCodeAliases are the codes matched by sentinel errors in addition
+// to their own code.
+var errorCodeAliases = map[string][]string{
+	SlowDown: {"SlowDownRead", "SlowDownWrite"},
+}
+
+// Is returns true if target is a sentinel error, or any ErrorResponse,
+// with the code of e. It makes errors.Is(err, ErrNoSuchKey) true for
+// all NoSuchKey errors regardless of their message and request ID.
+func (e ErrorResponse) Is(target error) bool {
+	t, ok := target.(ErrorResponse)
+	if !ok || t.Code == "" {
+		return false
+	}
+	if t.Code == e.Code {
+		return true
+	}
+	for _, code := range errorCodeAliases[t.Code] {
+		if code == e.Code {
+			return true
+		}
 	}
+	return false
 }
 
 // Error - Returns S3 error string.
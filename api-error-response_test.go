@@ -20,6 +20,7 @@ package minio
 import (
 	"bytes"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -286,3 +287,31 @@ func TestErrorResponseComparable(t *testing.T) {
 		t.Fatalf("ErrorResponse should be comparable")
 	}
 }
+
+// Tests sentinel errors matching with errors.Is.
+func TestErrorResponseIs(t *testing.T) {
+	noSuchKey := ErrorResponse{Code: NoSuchKey, Message: "gone", RequestID: "1", StatusCode: http.StatusNotFound}
+	testCases := []struct {
+		err    error
+		target error
+		is     bool
+	}{
+		{noSuchKey, ErrNoSuchKey, true},
+		{fmt.Errorf("stat: %w", noSuchKey), ErrNoSuchKey, true},
+		{noSuchKey, ErrNoSuchBucket, false},
+		{ErrorResponse{Code: "SlowDownWrite"}, ErrSlowDown, true},
+		{ErrorResponse{Code: SlowDown}, ErrSlowDown, true},
+		{ErrorResponse{}, ErrorResponse{}, true},
+		{ErrorResponse{Code: NoSuchKey}, ErrorResponse{}, false},
+		{errors.New(NoSuchKey), ErrNoSuchKey, false},
+	}
+	for i, testCase := range testCases {
+		if is := errors.Is(testCase.err, testCase.target); is != testCase.is {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.is, is)
+		}
+	}
+
+	if errResp := ToErrorResponse(fmt.Errorf("wrapped: %w", noSuchKey)); errResp != noSuchKey {
+		t.Errorf("Expected the wrapped ErrorResponse, got %#v", errResp)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// Number of concurrent GetObjectTagging calls while scanning
+// for tags on servers not returning tags in listings.
+const findObjectsByTagsWorkers = 16
+
+// FindObjectsByTags lists all objects under prefix and sends the objects
+// whose tags contain all the key/value pairs of selector on the returned
+// channel. An empty selector matches all objects.
+//
+// MinIO returns object tags as part of metadata listings, which are used
+// when the listing is answered by MinIO. For other servers the tags of each
+// object are fetched concurrently, in that case objects are not sent in
+// lexical order.
+//
+// Errors are sent as ObjectInfo with Err set, the caller must drain the
+// channel until it is closed.
+func (c *Client) FindObjectsByTags(ctx context.Context, bucketName, prefix string, selector map[string]string) <-chan ObjectInfo {
+	resultCh := make(chan ObjectInfo, 1)
+
+	matches := func(userTags map[string]string) bool {
+		for k, v := range selector {
+			if tv, ok := userTags[k]; !ok || tv != v {
+				return false
+			}
+		}
+		return true
+	}
+	send := func(info ObjectInfo) bool {
+		select {
+		case resultCh <- info:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	// Validate bucket name.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		defer close(resultCh)
+		resultCh <- ObjectInfo{Err: err}
+		return resultCh
+	}
+
+	go func() {
+		defer close(resultCh)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		scanCh := make(chan ObjectInfo)
+		var wg sync.WaitGroup
+		for range findObjectsByTagsWorkers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for object := range scanCh {
+					t, err := c.GetObjectTagging(ctx, bucketName, object.Key, GetObjectTaggingOptions{VersionID: object.VersionID})
+					if err != nil {
+						if !send(ObjectInfo{Key: object.Key, Err: err}) {
+							return
+						}
+						continue
+					}
+					object.UserTags = t.ToMap()
+					object.UserTagCount = t.Count()
+					if matches(object.UserTags) && !send(object) {
+						return
+					}
+				}
+			}()
+		}
+		defer wg.Wait()
+		defer close(scanCh)
+
+		// Only MinIO is known to return tags in listings.
+		withMetadata := !s3utils.IsAmazonEndpoint(*c.endpointURL) && !s3utils.IsGoogleEndpoint(*c.endpointURL)
+		var continuationToken string
+		for {
+			result, h, err := c.listObjectsV2QueryHeader(ctx, bucketName, prefix, continuationToken, false, withMetadata, "", "", 0, nil)
+			if err != nil {
+				send(ObjectInfo{Err: err})
+				return
+			}
+			tagsListed := withMetadata && strings.HasPrefix(h.Get("Server"), "MinIO")
+			for _, object := range result.Contents {
+				object.ETag = trimEtag(object.ETag)
+				switch {
+				case tagsListed:
+					if matches(object.UserTags) && !send(object) {
+						return
+					}
+				case len(selector) == 0:
+					if !send(object) {
+						return
+					}
+				default:
+					select {
+					case scanCh <- object:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+			if !result.IsTruncated {
+				return
+			}
+			continuationToken = result.NextContinuationToken
+		}
+	}()
+
+	return resultCh
+}
@@ -0,0 +1,152 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// findByTagsTestServer lists the objects of tags in pages of two keys.
+// Metadata listings return the tags of the objects if server is MinIO.
+type findByTagsTestServer struct {
+	server string
+	tags   map[string]map[string]string
+	// taggingRequests is the number of GetObjectTagging requests.
+	taggingRequests atomic.Int32
+}
+
+func (s *findByTagsTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.server != "" {
+		w.Header().Set("Server", s.server)
+	}
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet && query.Has("tagging"):
+		s.taggingRequests.Add(1)
+		key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+		fmt.Fprint(w, `<Tagging><TagSet>`)
+		for k, v := range s.tags[key] {
+			fmt.Fprintf(w, `<Tag><Key>%s</Key><Value>%s</Value></Tag>`, k, v)
+		}
+		fmt.Fprint(w, `</TagSet></Tagging>`)
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		var keys []string
+		for key := range s.tags {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		start, _ := strconv.Atoi(query.Get("continuation-token"))
+		end := min(start+2, len(keys))
+		fmt.Fprintf(w, `<ListBucketResult><IsTruncated>%t</IsTruncated><NextContinuationToken>%d</NextContinuationToken>`, end < len(keys), end)
+		for _, key := range keys[start:end] {
+			fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>1</Size>`, key)
+			// Metadata is also listed by other servers, without tags.
+			if query.Get("metadata") == "true" {
+				fmt.Fprint(w, `<UserMetadata><content-type>text/plain</content-type></UserMetadata>`)
+				if s.server == "MinIO" {
+					tags := make(url.Values)
+					for k, v := range s.tags[key] {
+						tags.Set(k, v)
+					}
+					fmt.Fprintf(w, `<UserTags>%s</UserTags>`, strings.ReplaceAll(tags.Encode(), "&", "&amp;"))
+				}
+			}
+			fmt.Fprint(w, `</Contents>`)
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+	}
+}
+
+func TestFindObjectsByTags(t *testing.T) {
+	tags := map[string]map[string]string{
+		"a": {"env": "prod", "team": "storage"},
+		"b": {"env": "dev"},
+		"c": {},
+		"d": {"env": "prod"},
+		"e": {"team": "storage"},
+	}
+	testCases := []struct {
+		server          string
+		selector        map[string]string
+		keys            []string
+		taggingRequests int32
+	}{
+		// Tags are part of the metadata listings of MinIO.
+		{"MinIO", map[string]string{"env": "prod"}, []string{"a", "d"}, 0},
+		{"MinIO", map[string]string{"env": "prod", "team": "storage"}, []string{"a"}, 0},
+		// Other servers fall back to GetObjectTagging.
+		{"", map[string]string{"env": "prod"}, []string{"a", "d"}, 5},
+		{"", map[string]string{"team": "storage"}, []string{"a", "e"}, 5},
+		{"", nil, []string{"a", "b", "c", "d", "e"}, 0},
+	}
+	for i, testCase := range testCases {
+		s := &findByTagsTestServer{server: testCase.server, tags: tags}
+		srv := httptest.NewServer(s)
+		c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:  credentials.NewStaticV4("access", "secret", ""),
+			Region: "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var keys []string
+		for object := range c.FindObjectsByTags(context.Background(), "bucket", "", testCase.selector) {
+			if object.Err != nil {
+				t.Fatalf("Test %d: %v", i+1, object.Err)
+			}
+			keys = append(keys, object.Key)
+		}
+		srv.Close()
+
+		slices.Sort(keys)
+		if !slices.Equal(keys, testCase.keys) {
+			t.Errorf("Test %d: expected objects %v, got %v", i+1, testCase.keys, keys)
+		}
+		if n := s.taggingRequests.Load(); n != testCase.taggingRequests {
+			t.Errorf("Test %d: expected %d GetObjectTagging requests, got %d", i+1, testCase.taggingRequests, n)
+		}
+	}
+}
+
+func TestFindObjectsByTagsInvalidBucket(t *testing.T) {
+	c, err := New("localhost:9000", &Options{Creds: credentials.NewStaticV4("access", "secret", "")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []ObjectInfo
+	for object := range c.FindObjectsByTags(context.Background(), "invalid_bucket", "", nil) {
+		results = append(results, object)
+	}
+	if len(results) != 1 || results[0].Err == nil {
+		t.Fatalf("expected a single error, got %v", results)
+	}
+}
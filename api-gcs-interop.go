@@ -0,0 +1,315 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// The size of the chunks of GCS resumable uploads must be a multiple
+	// of 256KiB, except for the last chunk.
+	gcsResumableChunkAlign = 256 * 1024
+
+	// The largest number of objects GCS composes into an object.
+	gcsMaxComposeComponents = 32
+
+	// The status of the responses of GCS to the chunks of resumable
+	// uploads which are not the last chunk.
+	gcsStatusResumeIncomplete = 308
+)
+
+// useGCSInterop returns true if uploads and compose use the GCS specific
+// APIs, see Options.GCSInterop.
+func (c *Client) useGCSInterop() bool {
+	return c.gcsInterop && c.Profile() == ProfileGCS
+}
+
+// putObjectResumable uploads an object with a GCS resumable upload, the
+// data is sent in chunks of opts.PartSize. Chunks which fail to upload
+// are resumed at the offset persisted by GCS.
+func (c *Client) putObjectResumable(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, opts PutObjectOptions) (info UploadInfo, err error) {
+	chunkSize := int64(opts.PartSize)
+	if chunkSize == 0 {
+		chunkSize = minPartSize
+	}
+	chunkSize = max(chunkSize/gcsResumableChunkAlign, 1) * gcsResumableChunkAlign
+
+	sessionURL, err := c.startResumableUpload(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer func() {
+		if err != nil {
+			c.cancelResumableUpload(sessionURL)
+		}
+	}()
+
+	reader = newHook(opts.progress.part(reader, 0), opts.Progress)
+	buf, releaseBuf, err := c.allocBuffer(ctx, chunkSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer releaseBuf()
+	var offset int64
+	for {
+		n, rerr := io.ReadFull(reader, buf)
+		if rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF {
+			return UploadInfo{}, rerr
+		}
+		last := rerr != nil || (size >= 0 && offset+int64(n) >= size)
+		total := int64(-1)
+		if last {
+			total = offset + int64(n)
+			if size >= 0 && total != size {
+				return UploadInfo{}, errUnexpectedEOF(total, size, bucketName, objectName)
+			}
+		}
+
+		resp, err := c.sendResumableChunk(ctx, sessionURL, buf[:n], offset, total, bucketName, objectName)
+		if err != nil {
+			return UploadInfo{}, err
+		}
+		offset += int64(n)
+		if resp == nil {
+			continue
+		}
+		closeResponse(resp)
+		return UploadInfo{
+			Bucket:    bucketName,
+			Key:       objectName,
+			ETag:      trimEtag(resp.Header.Get("ETag")),
+			VersionID: resp.Header.Get(amzVersionID),
+			Size:      offset,
+		}, nil
+	}
+}
+
+// startResumableUpload starts a GCS resumable upload and returns the URL
+// of its session.
+func (c *Client) startResumableUpload(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (string, error) {
+	header := opts.Header()
+	header.Set("x-goog-resumable", "start")
+	resp, err := c.executeMethod(ctx, http.MethodPost, requestMetadata{
+		bucketName:       bucketName,
+		objectName:       objectName,
+		customHeader:     header,
+		contentSHA256Hex: emptySHA256Hex,
+		expectStatus:     http.StatusCreated,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", httpRespToErrorResponse(resp, bucketName, objectName)
+	}
+	location, err := resp.Request.URL.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.Header.Get("Location") == "" {
+		return "", errInvalidArgument("GCS did not return the session of the resumable upload")
+	}
+	return location.String(), nil
+}
+
+// sendResumableChunk sends the chunk data at offset of a resumable upload,
+// total is the size of the object for the last chunk and -1 otherwise. It
+// returns the response of the completed upload after the last chunk.
+func (c *Client) sendResumableChunk(ctx context.Context, sessionURL string, data []byte, offset, total int64, bucketName, objectName string) (*http.Response, error) {
+	var lastErr error
+	for attempt := 0; attempt < c.maxRetries; {
+		resp, err := c.resumableRequest(ctx, sessionURL, data, offset, total)
+		if err == nil && resp.StatusCode >= http.StatusInternalServerError {
+			err = httpRespToErrorResponse(resp, bucketName, objectName)
+			closeResponse(resp)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			lastErr = err
+			attempt++
+			// Ask GCS for the offset it persisted.
+			if resp, err = c.resumableRequest(ctx, sessionURL, nil, offset, total); err != nil {
+				continue
+			}
+		}
+		switch resp.StatusCode {
+		case http.StatusOK, http.StatusCreated:
+			return resp, nil
+		case gcsStatusResumeIncomplete:
+			persisted := resumablePersistedOffset(resp.Header.Get("Range"))
+			closeResponse(resp)
+			end := offset + int64(len(data))
+			if persisted >= end && total < 0 {
+				return nil, nil
+			}
+			if persisted < offset || persisted > end {
+				return nil, fmt.Errorf("GCS persisted %d bytes of the resumable upload, expected %d to %d", persisted, offset, end)
+			}
+			if persisted == offset && err == nil {
+				attempt++
+			}
+			data, offset = data[persisted-offset:], persisted
+		default:
+			defer closeResponse(resp)
+			return nil, httpRespToErrorResponse(resp, bucketName, objectName)
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("GCS did not persist the chunk at offset %d of the resumable upload", offset)
+	}
+	return nil, lastErr
+}
+
+// resumableRequest sends data at offset to the session of a resumable
+// upload, without data it asks for the offset persisted by GCS.
+func (c *Client) resumableRequest(ctx context.Context, sessionURL string, data []byte, offset, total int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, sessionURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(data))
+	size := "*"
+	if total >= 0 {
+		size = strconv.FormatInt(total, 10)
+	}
+	if len(data) == 0 {
+		req.Header.Set("Content-Range", "bytes */"+size)
+	} else {
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%s", offset, offset+int64(len(data))-1, size))
+	}
+	c.setUserAgent(req)
+	return c.do(req)
+}
+
+// cancelResumableUpload deletes the session of a failed resumable upload.
+func (c *Client) cancelResumableUpload(sessionURL string) {
+	req, err := http.NewRequest(http.MethodDelete, sessionURL, nil)
+	if err != nil {
+		return
+	}
+	c.setUserAgent(req)
+	resp, err := c.do(req)
+	if err == nil {
+		closeResponse(resp)
+	}
+}
+
+// resumablePersistedOffset returns the number of bytes persisted by GCS
+// from the Range header of a response, e.g. "bytes=0-1023".
+func resumablePersistedOffset(header string) int64 {
+	_, end, ok := strings.Cut(strings.TrimPrefix(header, "bytes="), "-")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(end, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n + 1
+}
+
+// gcsComposeRequest is the body of a GCS compose request.
+type gcsComposeRequest struct {
+	XMLName    xml.Name              `xml:"ComposeRequest"`
+	Components []gcsComposeComponent `xml:"Component"`
+}
+
+type gcsComposeComponent struct {
+	Name       string `xml:"Name"`
+	Generation string `xml:"Generation,omitempty"`
+}
+
+// composeObjectGCS creates dst by composing the sources with the GCS
+// compose API. The sources must be whole unencrypted objects of the
+// bucket of dst, without conditions.
+func (c *Client) composeObjectGCS(ctx context.Context, dst CopyDestOptions, srcs []CopySrcOptions) (UploadInfo, error) {
+	if len(srcs) > gcsMaxComposeComponents {
+		return UploadInfo{}, errInvalidArgument(fmt.Sprintf("GCS composes up to %d source objects", gcsMaxComposeComponents))
+	}
+	if dst.Encryption != nil {
+		return UploadInfo{}, errInvalidArgument("GCS compose does not support server-side encryption")
+	}
+	compose := gcsComposeRequest{Components: make([]gcsComposeComponent, 0, len(srcs))}
+	for i, src := range srcs {
+		switch {
+		case src.Bucket != dst.Bucket:
+			return UploadInfo{}, errInvalidArgument(fmt.Sprintf("CopySrcOptions %d: GCS composes objects of the destination bucket only", i))
+		case src.MatchRange:
+			return UploadInfo{}, errInvalidArgument(fmt.Sprintf("CopySrcOptions %d: GCS composes whole objects only", i))
+		case src.Encryption != nil:
+			return UploadInfo{}, errInvalidArgument(fmt.Sprintf("CopySrcOptions %d: GCS compose does not support server-side encryption", i))
+		case src.MatchETag != "" || src.NoMatchETag != "" || !src.MatchModifiedSince.IsZero() || !src.MatchUnmodifiedSince.IsZero():
+			return UploadInfo{}, errInvalidArgument(fmt.Sprintf("CopySrcOptions %d: GCS compose does not support conditions", i))
+		}
+		compose.Components = append(compose.Components, gcsComposeComponent{Name: src.Object, Generation: src.VersionID})
+	}
+	buf, err := xml.Marshal(compose)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	header := make(http.Header)
+	dst.Marshal(header)
+	// Compose always sets the metadata and has no tags.
+	header.Del("x-amz-metadata-directive")
+	header.Del(amzTaggingHeaderDirective)
+	header.Del(amzTaggingHeader)
+
+	urlValues := make(url.Values)
+	urlValues.Set("compose", "")
+	resp, err := c.executeMethod(ctx, http.MethodPut, requestMetadata{
+		bucketName:       dst.Bucket,
+		objectName:       dst.Object,
+		queryValues:      urlValues,
+		customHeader:     header,
+		contentBody:      bytes.NewReader(buf),
+		contentLength:    int64(len(buf)),
+		contentMD5Base64: c.contentMD5Base64(buf),
+		md5Required:      true,
+		contentSHA256Hex: sum256Hex(buf),
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return UploadInfo{}, httpRespToErrorResponse(resp, dst.Bucket, dst.Object)
+	}
+	info := UploadInfo{
+		Bucket:    dst.Bucket,
+		Key:       dst.Object,
+		ETag:      trimEtag(resp.Header.Get("ETag")),
+		VersionID: resp.Header.Get(amzVersionID),
+	}
+	if size, err := strconv.ParseInt(resp.Header.Get("X-Goog-Stored-Content-Length"), 10, 64); err == nil {
+		info.Size = size
+	}
+	if dst.Progress != nil {
+		io.Copy(io.Discard, io.LimitReader(dst.Progress, dst.Size))
+	}
+	return info, nil
+}
@@ -0,0 +1,198 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func newGCSTestClient(t *testing.T, rt roundTripperFunc) *Client {
+	t.Helper()
+	clnt, err := New("storage.googleapis.com", &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		Transport:  rt,
+		GCSInterop: true,
+		MaxRetries: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clnt
+}
+
+func TestPutObjectResumable(t *testing.T) {
+	const sessionURL = "https://storage.googleapis.com/upload/session"
+	var (
+		mu       sync.Mutex
+		stored   []byte
+		ranges   []string
+		partial  bool
+		canceled bool
+	)
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		respond := func(status int, header http.Header) *http.Response {
+			if header == nil {
+				header = make(http.Header)
+			}
+			return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader("")), Request: req}
+		}
+		switch {
+		case req.Method == http.MethodPost:
+			if req.Header.Get("X-Goog-Resumable") != "start" || req.Header.Get("Content-Type") != "text/plain" {
+				t.Errorf("unexpected headers of the session request: %v", req.Header)
+			}
+			return respond(http.StatusCreated, http.Header{"Location": {sessionURL}}), nil
+		case req.Method == http.MethodDelete:
+			canceled = true
+			return respond(499, nil), nil
+		case req.URL.String() != sessionURL:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL)
+			return respond(http.StatusBadRequest, nil), nil
+		}
+		if req.Header.Get("Authorization") != "" {
+			t.Error("unexpected authorization of a chunk")
+		}
+		contentRange := req.Header.Get("Content-Range")
+		ranges = append(ranges, contentRange)
+		data, _ := io.ReadAll(req.Body)
+		var start, end int64
+		var total string
+		if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%s", &start, &end, &total); err != nil {
+			t.Errorf("unexpected content range %q", contentRange)
+		}
+		if start != int64(len(stored)) {
+			t.Errorf("chunk starts at %d, expected %d", start, len(stored))
+		}
+		// Persist half of the second chunk only.
+		if start > 0 && !partial {
+			partial = true
+			data = data[:len(data)/2]
+		}
+		stored = append(stored, data...)
+		if total != "*" && int64(len(stored)) == end+1 {
+			return respond(http.StatusOK, http.Header{"Etag": {`"etag"`}}), nil
+		}
+		return respond(gcsStatusResumeIncomplete, http.Header{"Range": {fmt.Sprintf("bytes=0-%d", len(stored)-1)}}), nil
+	})
+	clnt := newGCSTestClient(t, rt)
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), (11<<20)/16)
+	info, err := clnt.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), -1, PutObjectOptions{
+		PartSize:    5 << 20,
+		ContentType: "text/plain",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ETag != "etag" || info.Size != int64(len(data)) {
+		t.Fatalf("unexpected upload info %+v", info)
+	}
+	if !bytes.Equal(stored, data) {
+		t.Fatal("stored data differs from the uploaded data")
+	}
+	if canceled {
+		t.Fatal("unexpected cancellation of the upload")
+	}
+	expected := []string{
+		"bytes 0-5242879/*",
+		"bytes 5242880-10485759/*",
+		"bytes 7864320-10485759/*",
+		"bytes 10485760-11534335/11534336",
+	}
+	if strings.Join(ranges, ",") != strings.Join(expected, ",") {
+		t.Fatalf("unexpected ranges %v", ranges)
+	}
+}
+
+func TestComposeObjectGCS(t *testing.T) {
+	var body string
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPut || !req.URL.Query().Has("compose") {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL)
+		}
+		b, _ := io.ReadAll(req.Body)
+		body = string(b)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": {`"etag"`}, "X-Goog-Stored-Content-Length": {"42"}},
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	})
+	clnt := newGCSTestClient(t, rt)
+
+	ctx := context.Background()
+	info, err := clnt.ComposeObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "dst"},
+		CopySrcOptions{Bucket: "bucket", Object: "a"},
+		CopySrcOptions{Bucket: "bucket", Object: "b", VersionID: "17"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ETag != "etag" || info.Size != 42 {
+		t.Fatalf("unexpected upload info %+v", info)
+	}
+	expected := `<ComposeRequest><Component><Name>a</Name></Component><Component><Name>b</Name><Generation>17</Generation></Component></ComposeRequest>`
+	if body != expected {
+		t.Fatalf("unexpected compose request %s", body)
+	}
+
+	_, err = clnt.ComposeObject(ctx, CopyDestOptions{Bucket: "bucket", Object: "dst"},
+		CopySrcOptions{Bucket: "other", Object: "a"})
+	if err == nil {
+		t.Fatal("expected sources of other buckets to be rejected")
+	}
+}
+
+func TestResumablePersistedOffset(t *testing.T) {
+	testCases := []struct {
+		header string
+		offset int64
+	}{
+		{"", 0},
+		{"bytes=0-0", 1},
+		{"bytes=0-262143", 262144},
+		{"invalid", 0},
+	}
+	for _, testCase := range testCases {
+		if offset := resumablePersistedOffset(testCase.header); offset != testCase.offset {
+			t.Errorf("%q: expected %d, got %d", testCase.header, testCase.offset, offset)
+		}
+	}
+}
+
+func TestCreatedStatusOnlyForResumableUploads(t *testing.T) {
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusCreated, Header: make(http.Header), Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	})
+	clnt := newGCSTestClient(t, rt)
+	if _, err := clnt.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err == nil {
+		t.Fatal("expected 201 Created to fail a stat")
+	}
+}
@@ -26,10 +26,11 @@ import (
 
 // Grantee represents the person being granted permissions.
 type Grantee struct {
-	XMLName     xml.Name `xml:"Grantee"`
-	ID          string   `xml:"ID"`
-	DisplayName string   `xml:"DisplayName"`
-	URI         string   `xml:"URI"`
+	XMLName      xml.Name `xml:"Grantee"`
+	ID           string   `xml:"ID"`
+	DisplayName  string   `xml:"DisplayName"`
+	URI          string   `xml:"URI"`
+	EmailAddress string   `xml:"EmailAddress,omitempty"`
 }
 
 // Grant holds grant information
@@ -137,15 +138,15 @@ func getAmzGrantACL(aCPolicy *accessControlPolicy) map[string][]string {
 	for _, g := range grants {
 		switch g.Permission {
 		case "READ":
-			res["X-Amz-Grant-Read"] = append(res["X-Amz-Grant-Read"], "id="+g.Grantee.ID)
+			res["X-Amz-Grant-Read"] = append(res["X-Amz-Grant-Read"], g.Grantee.headerValue())
 		case "WRITE":
-			res["X-Amz-Grant-Write"] = append(res["X-Amz-Grant-Write"], "id="+g.Grantee.ID)
+			res["X-Amz-Grant-Write"] = append(res["X-Amz-Grant-Write"], g.Grantee.headerValue())
 		case "READ_ACP":
-			res["X-Amz-Grant-Read-Acp"] = append(res["X-Amz-Grant-Read-Acp"], "id="+g.Grantee.ID)
+			res["X-Amz-Grant-Read-Acp"] = append(res["X-Amz-Grant-Read-Acp"], g.Grantee.headerValue())
 		case "WRITE_ACP":
-			res["X-Amz-Grant-Write-Acp"] = append(res["X-Amz-Grant-Write-Acp"], "id="+g.Grantee.ID)
+			res["X-Amz-Grant-Write-Acp"] = append(res["X-Amz-Grant-Write-Acp"], g.Grantee.headerValue())
 		case "FULL_CONTROL":
-			res["X-Amz-Grant-Full-Control"] = append(res["X-Amz-Grant-Full-Control"], "id="+g.Grantee.ID)
+			res["X-Amz-Grant-Full-Control"] = append(res["X-Amz-Grant-Full-Control"], g.Grantee.headerValue())
 		}
 	}
 	return res
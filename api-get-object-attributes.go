@@ -47,6 +47,8 @@ type ObjectAttributesOptions struct {
 	VersionID            string
 	PartNumberMarker     int
 	ServerSideEncryption encrypt.ServerSide
+
+	requestExtensions
 }
 
 // ObjectAttributes is the response object returned by the GetObjectAttributes API
@@ -133,6 +135,8 @@ func (o *ObjectAttributes) parseResponse(resp *http.Response) (err error) {
 // GetObjectAttributes API combines HeadObject and ListParts.
 // More details on usage can be found in the documentation for ObjectAttributesOptions{}
 func (c *Client) GetObjectAttributes(ctx context.Context, bucketName, objectName string, opts ObjectAttributesOptions) (*ObjectAttributes, error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return nil, err
 	}
@@ -161,7 +165,7 @@ func (c *Client) GetObjectAttributes(ctx context.Context, bucketName, objectName
 	}
 
 	if opts.ServerSideEncryption != nil {
-		opts.ServerSideEncryption.Marshal(headers)
+		encrypt.SSE(opts.ServerSideEncryption).Marshal(headers)
 	}
 
 	resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{
@@ -30,6 +30,9 @@ import (
 
 // GetObject wrapper function that accepts a request context
 func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*Object, error) {
+	ctx = WithCredentials(ctx, opts.Credentials)
+	ctx = WithRetryPolicy(ctx, opts.RetryPolicy)
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return nil, ErrorResponse{
@@ -50,7 +53,7 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 
 	// Detect if snowball is server location we are talking to.
 	var snowball bool
-	if location, ok := c.bucketLocCache.Get(bucketName); ok {
+	if location, ok := c.bucketLocCache.get(c.bucketLocationEndpoint(ctx, bucketName), bucketName); ok {
 		snowball = location == "snowball"
 	}
 
@@ -106,6 +109,7 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 					// reached our EOF.
 					size, err := readFull(httpReader, req.Buffer)
 					totalRead += size
+					objectInfo.ChecksumVerified = checksumVerified(httpReader)
 					if size > 0 && err == io.ErrUnexpectedEOF {
 						if int64(size) < objectInfo.Size {
 							// In situations when returned size
@@ -215,6 +219,7 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 				// reached our EOF.
 				size, err := readFull(httpReader, req.Buffer)
 				totalRead += size
+				objectInfo.ChecksumVerified = checksumVerified(httpReader)
 				if size > 0 && err == io.ErrUnexpectedEOF {
 					if int64(totalRead) < objectInfo.Size {
 						// In situations when returned size
@@ -247,7 +252,9 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 	}()
 
 	// Create a newObject through the information sent back by reqCh.
-	return newObject(gctx, cancel, reqCh, resCh), nil
+	obj := newObject(gctx, cancel, reqCh, resCh)
+	obj.progress = newTransferProgress(ctx, -1, opts.ProgressFunc)
+	return obj, nil
 }
 
 // get request message container to communicate with internal
@@ -302,6 +309,9 @@ type Object struct {
 
 	// Keeps track of if objectInfo has been set yet.
 	objectInfoSet bool
+
+	// Progress of reading the object, nil if not requested.
+	progress *transferProgress
 }
 
 // doGetRequest - sends and blocks on the firstReqCh and reqCh of an object.
@@ -332,6 +342,13 @@ func (o *Object) doGetRequest(request getRequest) (getResponse, error) {
 	if !o.objectInfoSet && !request.isReadAt {
 		o.objectInfo = response.objectInfo
 		o.objectInfoSet = true
+		if o.progress != nil {
+			o.progress.setTotal(o.objectInfo.Size)
+		}
+	}
+	// The checksum is verified once the whole object was read.
+	if response.objectInfo.ChecksumVerified.IsSet() && !request.isReadAt {
+		o.objectInfo.ChecksumVerified = response.objectInfo.ChecksumVerified
 	}
 	// Set beenRead only if it has not been set before.
 	if !o.beenRead {
@@ -398,6 +415,9 @@ func (o *Object) Read(b []byte) (n int, err error) {
 
 	// Bytes read.
 	bytesRead := int64(response.Size)
+	if o.progress != nil && bytesRead > 0 {
+		o.progress.report(0, bytesRead, false)
+	}
 
 	// Set the new offset.
 	oerr := o.setOffset(bytesRead)
@@ -494,6 +514,9 @@ func (o *Object) ReadAt(b []byte, offset int64) (n int, err error) {
 	}
 	// Bytes read.
 	bytesRead := int64(response.Size)
+	if o.progress != nil && bytesRead > 0 {
+		o.progress.report(0, bytesRead, false)
+	}
 	// There is no valid objectInfo yet
 	// 	to compare against for EOF.
 	if !o.objectInfoSet {
@@ -647,6 +670,31 @@ func newObject(ctx context.Context, cancel context.CancelFunc, reqCh chan<- getR
 	}
 }
 
+// NewObject returns an Object reading the content of an object described
+// by objectInfo from r, e.g. to return objects from fakes of the API.
+func NewObject(objectInfo ObjectInfo, r io.ReaderAt) *Object {
+	ctx, cancel := context.WithCancel(context.Background())
+	reqCh := make(chan getRequest)
+	resCh := make(chan getResponse)
+	go func() {
+		defer close(resCh)
+		for req := range reqCh {
+			if !req.isReadOp {
+				resCh <- getResponse{objectInfo: objectInfo}
+				continue
+			}
+			size, err := r.ReadAt(req.Buffer, req.Offset)
+			resCh <- getResponse{
+				Size:       size,
+				Error:      err,
+				didRead:    true,
+				objectInfo: objectInfo,
+			}
+		}
+	}()
+	return newObject(ctx, cancel, reqCh, resCh)
+}
+
 // getObject - retrieve object from Object Storage.
 //
 // Additionally this function also takes range arguments to download the specified
@@ -671,6 +719,10 @@ func (c *Client) getObject(ctx context.Context, bucketName, objectName string, o
 		}
 	}
 
+	if c.verifyChecksum {
+		opts.VerifyChecksum = true
+	}
+
 	// Execute GET on objectName.
 	resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{
 		bucketName:       bucketName,
@@ -694,6 +746,11 @@ func (c *Client) getObject(ctx context.Context, bucketName, objectName string, o
 		return nil, ObjectInfo{}, nil, err
 	}
 
+	body := resp.Body
+	if _, ranged := opts.headers["Range"]; opts.VerifyChecksum && !ranged && opts.PartNumber == 0 {
+		body = c.newChecksumVerifier(ctx, bucketName, objectName, objectStat, opts, body)
+	}
+
 	// do not close body here, caller will close
-	return resp.Body, objectStat, resp.Header, nil
+	return body, objectStat, resp.Header, nil
 }
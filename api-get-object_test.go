@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -146,3 +147,25 @@ func TestGetObjectReturnErrorIfServerSendsMore(t *testing.T) {
 		t.Fatalf("Expected %v, got %v", io.ErrUnexpectedEOF, err)
 	}
 }
+
+func TestNewObject(t *testing.T) {
+	content := "0123456789"
+	obj := NewObject(ObjectInfo{Key: "object", Size: int64(len(content))}, strings.NewReader(content))
+	defer obj.Close()
+
+	info, err := obj.Stat()
+	if err != nil || info.Key != "object" {
+		t.Fatalf("unexpected Stat: %+v, %v", info, err)
+	}
+	buf := make([]byte, 3)
+	if n, err := obj.ReadAt(buf, 7); n != 3 || string(buf) != "789" {
+		t.Fatalf("unexpected ReadAt: %d, %q, %v", n, buf, err)
+	}
+	if _, err := obj.Seek(2, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	if err != nil || string(data) != content[2:] {
+		t.Fatalf("unexpected content: %q, %v", data, err)
+	}
+}
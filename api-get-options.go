@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 )
 
@@ -48,8 +49,29 @@ type GetObjectOptions struct {
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html
 	Checksum bool
 
+	// VerifyChecksum verifies the full object or composite checksum of
+	// the object, if it has one, while it is read. Reading the whole
+	// object fails with an error wrapping ErrChecksumMismatch instead of
+	// returning its last bytes if the checksum does not match, the
+	// verified checksum is set in ObjectInfo.ChecksumVerified then.
+	// Ranges and parts of objects are not verified. Implies Checksum.
+	VerifyChecksum bool
+
 	// To be not used by external applications
 	Internal AdvancedGetOptions
+
+	// Credentials of the request, overrides the credentials
+	// of the Client, see WithCredentials.
+	Credentials *credentials.Credentials
+
+	// RetryPolicy of the request, overrides the retry policy
+	// of the Client, see WithRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// ProgressFunc receives the progress of reading the object.
+	ProgressFunc ProgressFunc
+
+	requestExtensions
 }
 
 // StatObjectOptions are used to specify additional headers or options
@@ -63,14 +85,14 @@ func (o GetObjectOptions) Header() http.Header {
 		headers.Set(k, v)
 	}
 	if o.ServerSideEncryption != nil && o.ServerSideEncryption.Type() == encrypt.SSEC {
-		o.ServerSideEncryption.Marshal(headers)
+		encrypt.SSE(o.ServerSideEncryption).Marshal(headers)
 	}
 	// this header is set for active-active replication scenario where GET/HEAD
 	// to site A is proxy'd to site B if object/version missing on site A.
 	if o.Internal.ReplicationProxyRequest != "" {
 		headers.Set(minIOBucketReplicationProxyRequest, o.Internal.ReplicationProxyRequest)
 	}
-	if o.Checksum {
+	if o.Checksum || o.VerifyChecksum {
 		headers.Set("x-amz-checksum-mode", "ENABLED")
 	}
 	return headers
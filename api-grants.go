@@ -0,0 +1,194 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Grant headers, one per permission.
+const (
+	amzGrantRead        = "X-Amz-Grant-Read"
+	amzGrantWrite       = "X-Amz-Grant-Write"
+	amzGrantReadACP     = "X-Amz-Grant-Read-Acp"
+	amzGrantWriteACP    = "X-Amz-Grant-Write-Acp"
+	amzGrantFullControl = "X-Amz-Grant-Full-Control"
+)
+
+// Grants are the permissions granted on an object or a bucket when it is
+// created, sent as x-amz-grant-* headers. They allow other accounts access
+// without bucket policies, e.g.
+//
+//	opts := PutObjectOptions{Grants: Grants{
+//		Read:        []Grantee{CanonicalUserGrantee("79a59df900b949e5...")},
+//		FullControl: []Grantee{EmailGrantee("admin@example.com")},
+//	}}
+type Grants struct {
+	Read        []Grantee
+	Write       []Grantee
+	ReadACP     []Grantee
+	WriteACP    []Grantee
+	FullControl []Grantee
+}
+
+// CanonicalUserGrantee returns the grantee of the account with the
+// canonical user ID.
+func CanonicalUserGrantee(id string) Grantee {
+	return Grantee{ID: id}
+}
+
+// EmailGrantee returns the grantee of the account with the email
+// address, only supported by some AWS regions.
+func EmailGrantee(email string) Grantee {
+	return Grantee{EmailAddress: email}
+}
+
+// GroupGrantee returns the grantee of a predefined group, e.g.
+// http://acs.amazonaws.com/groups/s3/LogDelivery.
+func GroupGrantee(uri string) Grantee {
+	return Grantee{URI: uri}
+}
+
+// IsEmpty tells whether no permission is granted.
+func (g Grants) IsEmpty() bool {
+	return len(g.Read) == 0 && len(g.Write) == 0 && len(g.ReadACP) == 0 &&
+		len(g.WriteACP) == 0 && len(g.FullControl) == 0
+}
+
+// Marshal sets the grant headers of the permissions granted.
+func (g Grants) Marshal(header http.Header) {
+	for _, p := range g.permissions() {
+		if len(p.grantees) == 0 {
+			continue
+		}
+		values := make([]string, 0, len(p.grantees))
+		for _, grantee := range p.grantees {
+			values = append(values, grantee.headerValue())
+		}
+		header.Set(p.header, strings.Join(values, ", "))
+	}
+}
+
+// ParseGrants returns the grants of the grant headers of header, e.g.
+// the metadata of the object returned by GetObjectACL.
+func ParseGrants(header http.Header) (Grants, error) {
+	var g Grants
+	for _, p := range g.permissions() {
+		for _, value := range header.Values(p.header) {
+			grantees, err := parseGrantees(value)
+			if err != nil {
+				return Grants{}, errInvalidArgument(p.header + ": " + err.Error())
+			}
+			*p.list = append(*p.list, grantees...)
+		}
+	}
+	return g, nil
+}
+
+// validate checks that each grantee is identified by exactly one of a
+// canonical user ID, an email address or a group URI.
+func (g Grants) validate() error {
+	for _, p := range g.permissions() {
+		for _, grantee := range p.grantees {
+			n := 0
+			for _, v := range []string{grantee.ID, grantee.EmailAddress, grantee.URI} {
+				if v != "" {
+					n++
+				}
+			}
+			if n != 1 {
+				return errInvalidArgument(p.header + ": grantee must have one of an ID, an email address or a URI")
+			}
+		}
+	}
+	return nil
+}
+
+type grantPermission struct {
+	header   string
+	grantees []Grantee
+	list     *[]Grantee
+}
+
+// permissions returns the grantees of each permission, in header order.
+func (g *Grants) permissions() []grantPermission {
+	return []grantPermission{
+		{amzGrantRead, g.Read, &g.Read},
+		{amzGrantWrite, g.Write, &g.Write},
+		{amzGrantReadACP, g.ReadACP, &g.ReadACP},
+		{amzGrantWriteACP, g.WriteACP, &g.WriteACP},
+		{amzGrantFullControl, g.FullControl, &g.FullControl},
+	}
+}
+
+// headerValue returns the grantee as a grant header value, e.g.
+// id="79a59df900b949e5...".
+func (g Grantee) headerValue() string {
+	switch {
+	case g.ID != "":
+		return "id=" + strconv.Quote(g.ID)
+	case g.EmailAddress != "":
+		return "emailAddress=" + strconv.Quote(g.EmailAddress)
+	}
+	return "uri=" + strconv.Quote(g.URI)
+}
+
+// parseGrantees parses a grant header value, a comma separated list of
+// type=value pairs whose values may be quoted.
+func parseGrantees(value string) ([]Grantee, error) {
+	var grantees []Grantee
+	for value = strings.TrimSpace(value); value != ""; {
+		typ, rest, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, errInvalidArgument("missing grantee type in " + strconv.Quote(value))
+		}
+		rest = strings.TrimSpace(rest)
+		var v string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return nil, errInvalidArgument("unterminated grantee " + strconv.Quote(rest))
+			}
+			v, rest = rest[1:end+1], rest[end+2:]
+		} else {
+			v, rest, _ = strings.Cut(rest, ",")
+			v, rest = strings.TrimSpace(v), ","+rest
+		}
+		if v == "" {
+			return nil, errInvalidArgument("empty grantee in " + strconv.Quote(value))
+		}
+		switch strings.ToLower(strings.TrimSpace(typ)) {
+		case "id":
+			grantees = append(grantees, CanonicalUserGrantee(v))
+		case "emailaddress":
+			grantees = append(grantees, EmailGrantee(v))
+		case "uri":
+			grantees = append(grantees, GroupGrantee(v))
+		default:
+			return nil, errInvalidArgument("unknown grantee type " + strconv.Quote(typ))
+		}
+		rest = strings.TrimSpace(rest)
+		if rest != "" && !strings.HasPrefix(rest, ",") {
+			return nil, errInvalidArgument("missing comma before " + strconv.Quote(rest))
+		}
+		value = strings.TrimSpace(strings.TrimPrefix(rest, ","))
+	}
+	return grantees, nil
+}
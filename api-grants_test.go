@@ -0,0 +1,169 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestGrantsMarshal(t *testing.T) {
+	g := Grants{
+		Read:        []Grantee{CanonicalUserGrantee("id1"), EmailGrantee("user@example.com")},
+		FullControl: []Grantee{GroupGrantee("http://acs.amazonaws.com/groups/s3/LogDelivery")},
+	}
+	header := make(http.Header)
+	g.Marshal(header)
+	expected := http.Header{
+		"X-Amz-Grant-Read":         {`id="id1", emailAddress="user@example.com"`},
+		"X-Amz-Grant-Full-Control": {`uri="http://acs.amazonaws.com/groups/s3/LogDelivery"`},
+	}
+	if !reflect.DeepEqual(header, expected) {
+		t.Errorf("expected %v, got %v", expected, header)
+	}
+
+	parsed, err := ParseGrants(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, g) {
+		t.Errorf("expected %+v, got %+v", g, parsed)
+	}
+}
+
+func TestParseGrants(t *testing.T) {
+	testCases := []struct {
+		header   http.Header
+		expected Grants
+		success  bool
+	}{
+		{http.Header{}, Grants{}, true},
+		{http.Header{"X-Amz-Grant-Read": {`id="a",id="b"`}}, Grants{Read: []Grantee{{ID: "a"}, {ID: "b"}}}, true},
+		// As returned by GetObjectACL.
+		{http.Header{"X-Amz-Grant-Write-Acp": {"id=a", "id=b"}}, Grants{WriteACP: []Grantee{{ID: "a"}, {ID: "b"}}}, true},
+		{http.Header{"X-Amz-Grant-Read-Acp": {` EmailAddress = "a@b.com" , uri=http://acs.amazonaws.com/groups/global/AllUsers `}},
+			Grants{ReadACP: []Grantee{{EmailAddress: "a@b.com"}, {URI: "http://acs.amazonaws.com/groups/global/AllUsers"}}}, true},
+		{http.Header{"X-Amz-Grant-Read": {`id`}}, Grants{}, false},
+		{http.Header{"X-Amz-Grant-Read": {`id="a`}}, Grants{}, false},
+		{http.Header{"X-Amz-Grant-Read": {`id=""`}}, Grants{}, false},
+		{http.Header{"X-Amz-Grant-Read": {`user="a"`}}, Grants{}, false},
+		{http.Header{"X-Amz-Grant-Read": {`id="a" id="b"`}}, Grants{}, false},
+	}
+	for i, testCase := range testCases {
+		g, err := ParseGrants(testCase.header)
+		if (err == nil) != testCase.success {
+			t.Errorf("Test %d: expected success %v, got %v", i+1, testCase.success, err)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(g, testCase.expected) {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.expected, g)
+		}
+	}
+}
+
+func TestGetObjectACLParseGrants(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !r.URL.Query().Has("acl") {
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			return
+		}
+		io.WriteString(w, `<AccessControlPolicy><Owner><ID>owner</ID></Owner><AccessControlList>`+
+			`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>`+
+			`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/s3/LogDelivery</URI></Grantee><Permission>WRITE</Permission></Grant>`+
+			`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="AmazonCustomerByEmail"><EmailAddress>user@example.com</EmailAddress></Grantee><Permission>READ_ACP</Permission></Grant>`+
+			`</AccessControlList></AccessControlPolicy>`)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := c.GetObjectACL(context.Background(), "bucket", "object")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := ParseGrants(info.Metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Grants{
+		Write:       []Grantee{{URI: "http://acs.amazonaws.com/groups/s3/LogDelivery"}},
+		ReadACP:     []Grantee{{EmailAddress: "user@example.com"}},
+		FullControl: []Grantee{{ID: "owner"}},
+	}
+	if !reflect.DeepEqual(g, want) {
+		t.Errorf("expected %+v, got %+v", want, g)
+	}
+}
+
+func TestGrantsValidate(t *testing.T) {
+	testCases := []struct {
+		grants  Grants
+		success bool
+	}{
+		{Grants{}, true},
+		{Grants{Write: []Grantee{CanonicalUserGrantee("a")}}, true},
+		{Grants{Write: []Grantee{{}}}, false},
+		{Grants{Write: []Grantee{{ID: "a", EmailAddress: "a@b.com"}}}, false},
+	}
+	for i, testCase := range testCases {
+		if err := testCase.grants.validate(); (err == nil) != testCase.success {
+			t.Errorf("Test %d: expected success %v, got %v", i+1, testCase.success, err)
+		}
+	}
+}
+
+func TestMakeBucketGrants(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := MakeBucketOptions{Grants: Grants{Read: []Grantee{CanonicalUserGrantee("id1")}}}
+	if err = c.MakeBucket(context.Background(), "bucket", opts); err != nil {
+		t.Fatal(err)
+	}
+	if v := got.Get("X-Amz-Grant-Read"); v != `id="id1"` {
+		t.Errorf("expected grant header %q, got %q", `id="id1"`, v)
+	}
+
+	opts.Grants.Read = []Grantee{{}}
+	if err = c.MakeBucket(context.Background(), "bucket", opts); err == nil {
+		t.Error("expected an error for an empty grantee")
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// API is the interface of the S3 operations of Client, it allows to
+// replace the Client with a fake in unit tests, see the miniotest
+// package. Methods configuring the Client are not part of it.
+type API interface {
+	AppendObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts AppendObjectOptions) (info UploadInfo, err error)
+	BatchCopy(ctx context.Context, manifest io.Reader, opts BatchCopyOptions) (BatchCopyStats, error)
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+	CancelBucketReplicationResync(ctx context.Context, bucketName string, tgtArn string) (id string, err error)
+	Capabilities(ctx context.Context, bucketName string) (Capabilities, error)
+	CheckBucketPublic(ctx context.Context, bucketName string) (bool, error)
+	CheckBucketReplication(ctx context.Context, bucketName string) (err error)
+	ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ...CopySrcOptions) (UploadInfo, error)
+	CopyObject(ctx context.Context, dst CopyDestOptions, src CopySrcOptions) (UploadInfo, error)
+	CreateSession(ctx context.Context, bucketName string, sessionMode SessionMode) (cred credentials.Value, err error)
+	EnableVersioning(ctx context.Context, bucketName string) error
+	EnsureBucket(ctx context.Context, spec BucketSpec) (EnsureBucketResult, error)
+	EnsureNotification(ctx context.Context, bucketName string, config notification.Configuration) (bool, error)
+	FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts GetObjectOptions) error
+	FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts PutObjectOptions) (info UploadInfo, err error)
+	FailoverMultiRegionAccessPoint(ctx context.Context, mrapARN string, regions ...string) error
+	FindObjectsByTags(ctx context.Context, bucketName, prefix string, selector map[string]string) <-chan ObjectInfo
+	GetBucketCors(ctx context.Context, bucketName string) (*cors.Config, error)
+	GetBucketEncryption(ctx context.Context, bucketName string) (*sse.Configuration, error)
+	GetBucketLifecycle(ctx context.Context, bucketName string) (*lifecycle.Configuration, error)
+	GetBucketLifecycleWithInfo(ctx context.Context, bucketName string) (*lifecycle.Configuration, time.Time, error)
+	GetBucketLocation(ctx context.Context, bucketName string) (string, error)
+	GetBucketNotification(ctx context.Context, bucketName string) (bucketNotification notification.Configuration, err error)
+	GetBucketObjectLockConfig(ctx context.Context, bucketName string) (mode *RetentionMode, validity *uint, unit *ValidityUnit, err error)
+	GetBucketPolicy(ctx context.Context, bucketName string) (string, error)
+	GetBucketPolicyStatus(ctx context.Context, bucketName string) (BucketPolicyStatus, error)
+	GetBucketReplication(ctx context.Context, bucketName string) (cfg replication.Config, err error)
+	GetBucketReplicationMetrics(ctx context.Context, bucketName string) (s replication.Metrics, err error)
+	GetBucketReplicationMetricsV2(ctx context.Context, bucketName string) (s replication.MetricsV2, err error)
+	GetBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (rinfo replication.ResyncTargetsInfo, err error)
+	GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error)
+	GetBucketVersioning(ctx context.Context, bucketName string) (BucketVersioningConfiguration, error)
+	GetMultiRegionAccessPointRoutes(ctx context.Context, mrapARN string) ([]MultiRegionAccessPointRoute, error)
+	GetObject(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (*Object, error)
+	GetObjectACL(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error)
+	GetObjectAttributes(ctx context.Context, bucketName, objectName string, opts ObjectAttributesOptions) (*ObjectAttributes, error)
+	GetObjectLegalHold(ctx context.Context, bucketName, objectName string, opts GetObjectLegalHoldOptions) (status *LegalHoldStatus, err error)
+	GetObjectLockConfig(ctx context.Context, bucketName string) (objectLock string, mode *RetentionMode, validity *uint, unit *ValidityUnit, err error)
+	GetObjectRetention(ctx context.Context, bucketName, objectName, versionID string) (mode *RetentionMode, retainUntilDate *time.Time, err error)
+	GetObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, error)
+	GetObjectVersionBefore(ctx context.Context, bucketName, objectName string, t time.Time, opts GetObjectOptions) (*Object, error)
+	GetRGWBucketStats(ctx context.Context, bucketName string) (RGWBucketStats, error)
+	IsBucketPublic(ctx context.Context, bucketName string) (BucketPublicStatus, error)
+	ListBuckets(ctx context.Context) ([]BucketInfo, error)
+	ListDirectoryBuckets(ctx context.Context) (iter.Seq2[BucketInfo, error], error)
+	ListIncompleteUploads(ctx context.Context, bucketName, objectPrefix string, recursive bool) <-chan ObjectMultipartInfo
+	ListObjectVersionsOf(ctx context.Context, bucketName, objectName string) ([]ObjectInfo, error)
+	ListObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo
+	ListObjectsIter(ctx context.Context, bucketName string, opts ListObjectsOptions) iter.Seq[ObjectInfo]
+	ListRemoteTargets(ctx context.Context, bucketName string, arnType replication.ServiceType) ([]replication.BucketTarget, error)
+	ListenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info
+	ListenBucketNotificationWithBackfill(ctx context.Context, bucketName string, since time.Time, filter notification.ListenFilter) <-chan notification.Info
+	ListenBucketNotificationWithFilter(ctx context.Context, bucketName string, filter notification.ListenFilter) <-chan notification.Info
+	ListenNotification(ctx context.Context, prefix, suffix string, events []string) <-chan notification.Info
+	ListenNotificationWithFilter(ctx context.Context, filter notification.ListenFilter) <-chan notification.Info
+	MakeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) (err error)
+	MoveObject(ctx context.Context, src CopySrcOptions, dst CopyDestOptions, opts MoveObjectOptions) (UploadInfo, error)
+	ObjectLockReport(ctx context.Context, bucketName, prefix string, w io.Writer, opts ObjectLockReportOptions) (ObjectLockReportSummary, error)
+	Presign(ctx context.Context, method, bucketName, objectName string, expires time.Duration, reqParams url.Values) (u *url.URL, err error)
+	PresignBatch(ctx context.Context, method, bucketName string, keys []string, expires time.Duration, reqParams url.Values) ([]*url.URL, error)
+	PresignHeader(ctx context.Context, method, bucketName, objectName string, expires time.Duration, reqParams url.Values, extraHeaders http.Header) (u *url.URL, err error)
+	PresignWithOptions(ctx context.Context, method, bucketName, objectName string, opts PresignOptions) (*url.URL, error)
+	PresignedAbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, expires time.Duration) (*url.URL, error)
+	PresignedCompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, expires time.Duration) (*url.URL, error)
+	PresignedCreateMultipartUpload(ctx context.Context, bucketName, objectName string, expires time.Duration, opts PutObjectOptions) (*url.URL, error)
+	PresignedGetObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (u *url.URL, err error)
+	PresignedHeadObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (u *url.URL, err error)
+	PresignedPostForm(ctx context.Context, p *PostPolicy) (*PresignedPostForm, error)
+	PresignedPostPolicy(ctx context.Context, p *PostPolicy) (u *url.URL, formData map[string]string, err error)
+	PresignedPutObject(ctx context.Context, bucketName, objectName string, expires time.Duration) (u *url.URL, err error)
+	PresignedUploadPart(ctx context.Context, bucketName, objectName, uploadID string, partNumber int, expires time.Duration) (*url.URL, error)
+	PresignedUploadParts(ctx context.Context, bucketName, objectName, uploadID string, partCount int, expires time.Duration) ([]*url.URL, error)
+	PromptObject(ctx context.Context, bucketName, objectName, prompt string, opts PromptObjectOptions) (io.ReadCloser, error)
+	PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, opts PutObjectOptions) (info UploadInfo, err error)
+	PutObjectFanOut(ctx context.Context, bucket string, fanOutData io.Reader, fanOutReq PutObjectFanOutRequest) ([]PutObjectFanOutResponse, error)
+	PutObjectLegalHold(ctx context.Context, bucketName, objectName string, opts PutObjectLegalHoldOptions) error
+	PutObjectRetention(ctx context.Context, bucketName, objectName string, opts PutObjectRetentionOptions) error
+	PutObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts PutObjectTaggingOptions) error
+	PutObjectsSnowball(ctx context.Context, bucketName string, opts SnowballOptions, objs <-chan SnowballObject) (err error)
+	RemoveAllBucketNotification(ctx context.Context, bucketName string) error
+	RemoveBucket(ctx context.Context, bucketName string) error
+	RemoveBucketEncryption(ctx context.Context, bucketName string) error
+	RemoveBucketReplication(ctx context.Context, bucketName string) error
+	RemoveBucketTagging(ctx context.Context, bucketName string) error
+	RemoveBucketWithOptions(ctx context.Context, bucketName string, opts RemoveBucketOptions) error
+	RemoveIncompleteUpload(ctx context.Context, bucketName, objectName string) error
+	RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) error
+	RemoveObjectTagging(ctx context.Context, bucketName, objectName string, opts RemoveObjectTaggingOptions) error
+	RemoveObjectVersions(ctx context.Context, bucketName string, objects iter.Seq[ObjectVersion], opts RemoveObjectsOptions) (iter.Seq[RemoveObjectResult], error)
+	RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectError
+	RemoveObjectsForce(ctx context.Context, bucketName string, objects iter.Seq[ObjectVersion], opts RemoveObjectsForceOptions) (iter.Seq[RemoveObjectForceResult], error)
+	RemoveObjectsWithIter(ctx context.Context, bucketName string, objectsIter iter.Seq[ObjectInfo], opts RemoveObjectsOptions) (iter.Seq[RemoveObjectResult], error)
+	RemoveObjectsWithResult(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectResult
+	RemovePrefix(ctx context.Context, bucketName, prefix string, opts RemovePrefixOptions) (RemovePrefixStats, error)
+	RemoveRemoteTarget(ctx context.Context, bucketName, arn string) error
+	ResetBucketReplication(ctx context.Context, bucketName string, olderThan time.Duration) (rID string, err error)
+	ResetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, tgtArn string) (replication.ResyncTargetsInfo, error)
+	RestoreObject(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) error
+	RestoreObjectWithResult(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) (RestoreObjectResult, error)
+	RestorePrefixToTime(ctx context.Context, bucketName, prefix string, t time.Time, opts RestorePrefixOptions) (RestorePrefixReport, error)
+	SelectObjectContent(ctx context.Context, bucketName, objectName string, opts SelectObjectOptions) (*SelectResults, error)
+	SetBucketCors(ctx context.Context, bucketName string, corsConfig *cors.Config) error
+	SetBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error
+	SetBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	SetBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error
+	SetBucketObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error
+	SetBucketPolicy(ctx context.Context, bucketName, policy string) error
+	SetBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error
+	SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) error
+	SetBucketVersioning(ctx context.Context, bucketName string, config BucketVersioningConfiguration) error
+	SetObjectLockConfig(ctx context.Context, bucketName string, mode *RetentionMode, validity *uint, unit *ValidityUnit) error
+	SetRemoteTarget(ctx context.Context, bucketName string, target *replication.BucketTarget) (string, error)
+	StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (ObjectInfo, error)
+	StatObjectVersionBefore(ctx context.Context, bucketName, objectName string, t time.Time) (ObjectInfo, error)
+	StatObjects(ctx context.Context, bucketName string, keys []string, opts StatObjectsOptions) ([]ObjectInfo, error)
+	SubmitMultiRegionAccessPointRoutes(ctx context.Context, mrapARN string, routes []MultiRegionAccessPointRoute) error
+	SuspendVersioning(ctx context.Context, bucketName string) error
+	UndeleteObject(ctx context.Context, bucketName, objectName string) error
+	UndeleteObjects(ctx context.Context, bucketName, prefix string) (iter.Seq[RemoveObjectResult], error)
+	UpdateObjectMetadata(ctx context.Context, bucketName, objectName string, patch MetadataPatch) (UploadInfo, error)
+	UpdateRemoteTarget(ctx context.Context, target *replication.BucketTarget, ops ...replication.TargetUpdateType) (string, error)
+	WaitForRestore(ctx context.Context, bucketName, objectName, versionID string, pollInterval time.Duration) (ObjectInfo, error)
+}
+
+var _ API = (*Client)(nil)
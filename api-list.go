@@ -26,6 +26,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
@@ -210,13 +211,20 @@ func (c *Client) listObjectsV2(ctx context.Context, bucketName string, opts List
 // ?start-after - Sets a marker to start listing lexically at this key onwards.
 // ?max-keys - Sets the maximum number of keys returned in the response body.
 func (c *Client) listObjectsV2Query(ctx context.Context, bucketName, objectPrefix, continuationToken string, fetchOwner, metadata bool, delimiter, startAfter string, maxkeys int, headers http.Header) (ListBucketV2Result, error) {
+	result, _, err := c.listObjectsV2QueryHeader(ctx, bucketName, objectPrefix, continuationToken, fetchOwner, metadata, delimiter, startAfter, maxkeys, headers)
+	return result, err
+}
+
+// listObjectsV2QueryHeader is listObjectsV2Query also returning the
+// header of the response.
+func (c *Client) listObjectsV2QueryHeader(ctx context.Context, bucketName, objectPrefix, continuationToken string, fetchOwner, metadata bool, delimiter, startAfter string, maxkeys int, headers http.Header) (ListBucketV2Result, http.Header, error) {
 	// Validate bucket name.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-		return ListBucketV2Result{}, err
+		return ListBucketV2Result{}, nil, err
 	}
 	// Validate object prefix.
 	if err := s3utils.CheckValidObjectNamePrefix(objectPrefix); err != nil {
-		return ListBucketV2Result{}, err
+		return ListBucketV2Result{}, nil, err
 	}
 	// Get resources properly escaped and lined up before
 	// using them in http request.
@@ -267,24 +275,24 @@ func (c *Client) listObjectsV2Query(ctx context.Context, bucketName, objectPrefi
 	})
 	defer closeResponse(resp)
 	if err != nil {
-		return ListBucketV2Result{}, err
+		return ListBucketV2Result{}, nil, err
 	}
 	if resp != nil {
 		if resp.StatusCode != http.StatusOK {
-			return ListBucketV2Result{}, httpRespToErrorResponse(resp, bucketName, "")
+			return ListBucketV2Result{}, nil, httpRespToErrorResponse(resp, bucketName, "")
 		}
 	}
 
 	// Decode listBuckets XML.
 	listBucketResult := ListBucketV2Result{}
 	if err = xmlDecoder(resp.Body, &listBucketResult); err != nil {
-		return listBucketResult, err
+		return listBucketResult, nil, err
 	}
 
 	// This is an additional verification check to make
 	// sure proper responses are received.
 	if listBucketResult.IsTruncated && listBucketResult.NextContinuationToken == "" {
-		return listBucketResult, ErrorResponse{
+		return listBucketResult, nil, ErrorResponse{
 			Code:    NotImplemented,
 			Message: "Truncated response should have continuation token set",
 		}
@@ -293,7 +301,7 @@ func (c *Client) listObjectsV2Query(ctx context.Context, bucketName, objectPrefi
 	for i, obj := range listBucketResult.Contents {
 		listBucketResult.Contents[i].Key, err = decodeS3Name(obj.Key, listBucketResult.EncodingType)
 		if err != nil {
-			return listBucketResult, err
+			return listBucketResult, nil, err
 		}
 		listBucketResult.Contents[i].LastModified = listBucketResult.Contents[i].LastModified.Truncate(time.Millisecond)
 	}
@@ -301,12 +309,18 @@ func (c *Client) listObjectsV2Query(ctx context.Context, bucketName, objectPrefi
 	for i, obj := range listBucketResult.CommonPrefixes {
 		listBucketResult.CommonPrefixes[i].Prefix, err = decodeS3Name(obj.Prefix, listBucketResult.EncodingType)
 		if err != nil {
-			return listBucketResult, err
+			return listBucketResult, nil, err
 		}
 	}
 
+	err = decodeS3Names(listBucketResult.EncodingType, &listBucketResult.Prefix,
+		&listBucketResult.Delimiter, &listBucketResult.StartAfter)
+	if err != nil {
+		return listBucketResult, nil, err
+	}
+
 	// Success.
-	return listBucketResult, nil
+	return listBucketResult, resp.Header, nil
 }
 
 func (c *Client) listObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) iter.Seq[ObjectInfo] {
@@ -609,11 +623,11 @@ func (c *Client) listObjectVersionsQuery(ctx context.Context, bucketName string,
 		}
 	}
 
-	if listObjectVersionsOutput.NextKeyMarker != "" {
-		listObjectVersionsOutput.NextKeyMarker, err = decodeS3Name(listObjectVersionsOutput.NextKeyMarker, listObjectVersionsOutput.EncodingType)
-		if err != nil {
-			return listObjectVersionsOutput, err
-		}
+	err = decodeS3Names(listObjectVersionsOutput.EncodingType, &listObjectVersionsOutput.Prefix,
+		&listObjectVersionsOutput.Delimiter, &listObjectVersionsOutput.KeyMarker,
+		&listObjectVersionsOutput.NextKeyMarker)
+	if err != nil {
+		return listObjectVersionsOutput, err
 	}
 
 	return listObjectVersionsOutput, nil
@@ -698,11 +712,10 @@ func (c *Client) listObjectsQuery(ctx context.Context, bucketName, objectPrefix,
 		}
 	}
 
-	if listBucketResult.NextMarker != "" {
-		listBucketResult.NextMarker, err = decodeS3Name(listBucketResult.NextMarker, listBucketResult.EncodingType)
-		if err != nil {
-			return listBucketResult, err
-		}
+	err = decodeS3Names(listBucketResult.EncodingType, &listBucketResult.Prefix,
+		&listBucketResult.Delimiter, &listBucketResult.Marker, &listBucketResult.NextMarker)
+	if err != nil {
+		return listBucketResult, err
 	}
 
 	return listBucketResult, nil
@@ -732,7 +745,22 @@ type ListObjectsOptions struct {
 	// Use the deprecated list objects V1 API
 	UseV1 bool
 
+	// AllowUnordered lists the objects of Ceph RGW out of order, which
+	// is faster for buckets with sharded indexes. Ignored by other
+	// servers, which always list in order.
+	AllowUnordered bool
+
+	// Credentials of the request, overrides the credentials
+	// of the Client, see WithCredentials.
+	Credentials *credentials.Credentials
+
+	// RetryPolicy of the request, overrides the retry policy
+	// of the Client, see WithRetryPolicy.
+	RetryPolicy RetryPolicy
+
 	headers http.Header
+
+	requestExtensions
 }
 
 // Set adds a key value pair to the options. The
@@ -756,6 +784,10 @@ func (o *ListObjectsOptions) Set(key, value string) {
 // caller must drain the channel entirely and wait until channel is closed before proceeding, without
 // waiting on the channel to be closed completely you might leak goroutines.
 func (c *Client) ListObjects(ctx context.Context, bucketName string, opts ListObjectsOptions) <-chan ObjectInfo {
+	opts = c.providerListOptions(opts)
+	ctx = WithCredentials(ctx, opts.Credentials)
+	ctx = WithRetryPolicy(ctx, opts.RetryPolicy)
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
 	objectStatCh := make(chan ObjectInfo, 1)
 	go func() {
 		defer close(objectStatCh)
@@ -771,7 +803,7 @@ func (c *Client) ListObjects(ctx context.Context, bucketName string, opts ListOb
 		case opts.UseV1:
 			objIter = c.listObjects(ctx, bucketName, opts)
 		default:
-			location, _ := c.bucketLocCache.Get(bucketName)
+			location, _ := c.bucketLocCache.get(c.bucketLocationEndpoint(ctx, bucketName), bucketName)
 			if location == "snowball" {
 				objIter = c.listObjects(ctx, bucketName, opts)
 			} else {
@@ -806,6 +838,9 @@ func (c *Client) ListObjects(ctx context.Context, bucketName string, opts ListOb
 // Canceling the context the iterator will stop, if you wish to discard the yielding make sure
 // to cancel the passed context without that you might leak coroutines
 func (c *Client) ListObjectsIter(ctx context.Context, bucketName string, opts ListObjectsOptions) iter.Seq[ObjectInfo] {
+	opts = c.providerListOptions(opts)
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	if opts.WithVersions {
 		return c.listObjectVersions(ctx, bucketName, opts)
 	}
@@ -816,7 +851,7 @@ func (c *Client) ListObjectsIter(ctx context.Context, bucketName string, opts Li
 	}
 
 	// Check whether this is snowball region, if yes ListObjectsV2 doesn't work, fallback to listObjectsV1.
-	if location, ok := c.bucketLocCache.Get(bucketName); ok {
+	if location, ok := c.bucketLocCache.get(c.bucketLocationEndpoint(ctx, bucketName), bucketName); ok {
 		if location == "snowball" {
 			return c.listObjects(ctx, bucketName, opts)
 		}
@@ -1000,7 +1035,9 @@ func (c *Client) listMultipartUploadsQuery(ctx context.Context, bucketName, keyM
 		return listMultipartUploadsResult, err
 	}
 
-	listMultipartUploadsResult.NextKeyMarker, err = decodeS3Name(listMultipartUploadsResult.NextKeyMarker, listMultipartUploadsResult.EncodingType)
+	err = decodeS3Names(listMultipartUploadsResult.EncodingType, &listMultipartUploadsResult.Prefix,
+		&listMultipartUploadsResult.Delimiter, &listMultipartUploadsResult.KeyMarker,
+		&listMultipartUploadsResult.NextKeyMarker)
 	if err != nil {
 		return listMultipartUploadsResult, err
 	}
@@ -1093,6 +1130,8 @@ func (c *Client) listObjectPartsQuery(ctx context.Context, bucketName, objectNam
 	urlValues.Set("part-number-marker", fmt.Sprintf("%d", partNumberMarker))
 	// Set upload id.
 	urlValues.Set("uploadId", uploadID)
+	// Always set encoding-type
+	urlValues.Set("encoding-type", "url")
 
 	// maxParts should be 1000 or less.
 	if maxParts > 0 {
@@ -1122,6 +1161,10 @@ func (c *Client) listObjectPartsQuery(ctx context.Context, bucketName, objectNam
 	if err != nil {
 		return listObjectPartsResult, err
 	}
+	listObjectPartsResult.Key, err = decodeS3Name(listObjectPartsResult.Key, listObjectPartsResult.EncodingType)
+	if err != nil {
+		return listObjectPartsResult, err
+	}
 	return listObjectPartsResult, nil
 }
 
@@ -1134,3 +1177,13 @@ func decodeS3Name(name, encodingType string) (string, error) {
 		return name, nil
 	}
 }
+
+// Decode S3 names in place according to the encoding type
+func decodeS3Names(encodingType string, names ...*string) (err error) {
+	for _, name := range names {
+		if *name, err = decodeS3Name(*name, encodingType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// MoveStage indicates the step of a MoveObject call that failed.
+type MoveStage string
+
+const (
+	// MoveStageStat - the source object could not be looked up,
+	// nothing was modified.
+	MoveStageStat MoveStage = "stat"
+	// MoveStageCopy - the copy to the destination failed, the source
+	// is untouched.
+	MoveStageCopy MoveStage = "copy"
+	// MoveStageVerify - the destination was written but does not match
+	// the source, the source is untouched.
+	MoveStageVerify MoveStage = "verify"
+	// MoveStageDelete - the destination was written and verified, but
+	// the source could not be removed. The object now exists twice.
+	MoveStageDelete MoveStage = "delete"
+)
+
+// ErrMoveVerification is the error of a MoveObjectError of the
+// MoveStageVerify stage when the destination does not match the source,
+// i.e. its size, ETag or checksums differ.
+var ErrMoveVerification = errors.New("moved object does not match its source")
+
+// MoveObjectError is returned by MoveObject, it describes which stage
+// failed and therefore which of source and destination exist.
+type MoveObjectError struct {
+	Stage MoveStage
+
+	// DestinationWritten is set if the destination object was
+	// created before the failure.
+	DestinationWritten bool
+	// Destination holds the information of the written destination
+	// object, if DestinationWritten is set.
+	Destination UploadInfo
+
+	Err error
+}
+
+func (e *MoveObjectError) Error() string {
+	return fmt.Sprintf("move object failed at %s stage: %v", e.Stage, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *MoveObjectError) Unwrap() error {
+	return e.Err
+}
+
+// MoveObjectOptions represents options specified by user for MoveObject call
+type MoveObjectOptions struct {
+	// DisableVerify skips comparing the destination to the
+	// source before removing the source.
+	DisableVerify bool
+
+	// RemoveDestinationOnVerifyFailure removes the destination
+	// object if it does not match the source.
+	RemoveDestinationOnVerifyFailure bool
+
+	// GovernanceBypass is used while removing the source object.
+	GovernanceBypass bool
+}
+
+// MoveObject moves the source object to the destination using server side
+// copies, objects larger than 5GiB are copied with multipart copies. The
+// destination is then verified against the source (size, ETag and checksums
+// when comparable) and the source object is removed.
+//
+// Any failure is returned as a *MoveObjectError describing the failed stage.
+// The source and the destination must be different objects, even for
+// different versions of the same object, and the whole source is moved,
+// ranges are rejected.
+func (c *Client) MoveObject(ctx context.Context, src CopySrcOptions, dst CopyDestOptions, opts MoveObjectOptions) (UploadInfo, error) {
+	if err := src.validate(); err != nil {
+		return UploadInfo{}, err
+	}
+	if err := dst.validate(); err != nil {
+		return UploadInfo{}, err
+	}
+	// The copy would replace the source, which is then removed.
+	if src.Bucket == dst.Bucket && src.Object == dst.Object {
+		return UploadInfo{}, errInvalidArgument("Source and destination of a move cannot be the same object")
+	}
+	// The source is removed, a ranged copy would lose the rest of it.
+	if src.MatchRange {
+		return UploadInfo{}, errInvalidArgument("Source of a move cannot be a range of an object")
+	}
+
+	srcInfo, err := c.StatObject(ctx, src.Bucket, src.Object, StatObjectOptions{
+		ServerSideEncryption: encrypt.SSE(src.Encryption),
+		VersionID:            src.VersionID,
+		Checksum:             true,
+	})
+	if err != nil {
+		return UploadInfo{}, &MoveObjectError{Stage: MoveStageStat, Err: err}
+	}
+
+	// Make sure the object did not change between stat and copy.
+	if src.MatchETag == "" {
+		src.MatchETag = srcInfo.ETag
+	}
+
+	var info UploadInfo
+	composed := srcInfo.Size > maxPartSize
+	if composed {
+		info, err = c.ComposeObject(ctx, dst, src)
+	} else {
+		info, err = c.CopyObject(ctx, dst, src)
+	}
+	if err != nil {
+		return UploadInfo{}, &MoveObjectError{Stage: MoveStageCopy, Err: err}
+	}
+
+	if !opts.DisableVerify {
+		dstInfo, err := c.StatObject(ctx, dst.Bucket, dst.Object, StatObjectOptions{
+			ServerSideEncryption: encrypt.SSE(dst.Encryption),
+			VersionID:            info.VersionID,
+			Checksum:             true,
+		})
+		if err == nil {
+			err = verifyMovedObject(srcInfo, dstInfo, composed, dst.Encryption != nil || src.Encryption != nil)
+		}
+		if err != nil {
+			if opts.RemoveDestinationOnVerifyFailure {
+				if rerr := c.RemoveObject(ctx, dst.Bucket, dst.Object, RemoveObjectOptions{VersionID: info.VersionID}); rerr == nil {
+					return UploadInfo{}, &MoveObjectError{Stage: MoveStageVerify, Err: err}
+				}
+			}
+			return UploadInfo{}, &MoveObjectError{
+				Stage:              MoveStageVerify,
+				DestinationWritten: true,
+				Destination:        info,
+				Err:                err,
+			}
+		}
+	}
+
+	err = c.RemoveObject(ctx, src.Bucket, src.Object, RemoveObjectOptions{
+		VersionID:        src.VersionID,
+		GovernanceBypass: opts.GovernanceBypass,
+	})
+	if err != nil {
+		return info, &MoveObjectError{
+			Stage:              MoveStageDelete,
+			DestinationWritten: true,
+			Destination:        info,
+			Err:                err,
+		}
+	}
+	return info, nil
+}
+
+// verifyMovedObject compares the copied destination with its source. ETags
+// are only compared when the copy preserves them, i.e. for single copies of
+// unencrypted objects, composite checksums only for single copies.
+func verifyMovedObject(src, dst ObjectInfo, composed, encrypted bool) error {
+	if src.Size != dst.Size {
+		return fmt.Errorf("%w: destination size %d does not match source size %d", ErrMoveVerification, dst.Size, src.Size)
+	}
+	if !composed && !encrypted && !strings.Contains(src.ETag, "-") && src.ETag != dst.ETag {
+		return fmt.Errorf("%w: destination ETag %s does not match source ETag %s", ErrMoveVerification, dst.ETag, src.ETag)
+	}
+	if src.ChecksumMode == dst.ChecksumMode && (!composed || src.ChecksumMode == ChecksumFullObjectMode.String()) {
+		for _, pair := range [][2]string{
+			{src.ChecksumCRC32, dst.ChecksumCRC32},
+			{src.ChecksumCRC32C, dst.ChecksumCRC32C},
+			{src.ChecksumSHA1, dst.ChecksumSHA1},
+			{src.ChecksumSHA256, dst.ChecksumSHA256},
+			{src.ChecksumCRC64NVME, dst.ChecksumCRC64NVME},
+		} {
+			if pair[0] != "" && pair[1] != "" && pair[0] != pair[1] {
+				return fmt.Errorf("%w: destination checksum %s does not match source checksum %s", ErrMoveVerification, pair[1], pair[0])
+			}
+		}
+	}
+	return nil
+}
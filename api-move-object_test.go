@@ -0,0 +1,207 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestMoveObject(t *testing.T) {
+	testCases := []struct {
+		// failing request of the server, e.g. "HEAD /bucket/src".
+		fail string
+		// dstSize is the size of the copy, 3 bytes like its source
+		// unless set.
+		dstSize            int
+		opts               MoveObjectOptions
+		stage              MoveStage
+		destinationWritten bool
+		// mismatch is set if the destination does not match the source.
+		mismatch bool
+		objects  []string
+	}{
+		{objects: []string{"dst"}},
+		{fail: "HEAD /bucket/src", stage: MoveStageStat, objects: []string{"src"}},
+		{fail: "PUT /bucket/dst", stage: MoveStageCopy, objects: []string{"src"}},
+		{fail: "HEAD /bucket/dst", stage: MoveStageVerify, destinationWritten: true, objects: []string{"dst", "src"}},
+		{dstSize: 2, stage: MoveStageVerify, destinationWritten: true, mismatch: true, objects: []string{"dst", "src"}},
+		{
+			dstSize: 2, opts: MoveObjectOptions{RemoveDestinationOnVerifyFailure: true},
+			stage: MoveStageVerify, mismatch: true, objects: []string{"src"},
+		},
+		{dstSize: 2, opts: MoveObjectOptions{DisableVerify: true}, objects: []string{"dst"}},
+		{fail: "DELETE /bucket/src", stage: MoveStageDelete, destinationWritten: true, objects: []string{"dst", "src"}},
+	}
+
+	for i, testCase := range testCases {
+		var (
+			mu      sync.Mutex
+			objects = map[string]int{"src": 3}
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+			if testCase.fail == r.Method+" "+r.URL.Path {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`)
+				return
+			}
+			switch r.Method {
+			case http.MethodHead:
+				size, ok := objects[key]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+				w.Header().Set("ETag", `"etag"`)
+				w.Header().Set("Content-Length", fmt.Sprint(size))
+			case http.MethodPut:
+				if strings.TrimPrefix(r.Header.Get("X-Amz-Copy-Source"), "/") != "bucket/src" ||
+					strings.Trim(r.Header.Get("X-Amz-Copy-Source-If-Match"), `"`) != "etag" {
+					http.Error(w, "unexpected copy", http.StatusBadRequest)
+					return
+				}
+				objects[key] = objects["src"]
+				if testCase.dstSize > 0 {
+					objects[key] = testCase.dstSize
+				}
+				fmt.Fprint(w, `<CopyObjectResult><ETag>"etag"</ETag><LastModified>2025-01-01T00:00:00Z</LastModified></CopyObjectResult>`)
+			case http.MethodDelete:
+				delete(objects, key)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				http.Error(w, "unexpected request", http.StatusBadRequest)
+			}
+		}))
+
+		c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:  credentials.NewStaticV4("access", "secret", ""),
+			Region: "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.MoveObject(context.Background(),
+			CopySrcOptions{Bucket: "bucket", Object: "src"},
+			CopyDestOptions{Bucket: "bucket", Object: "dst"}, testCase.opts)
+		srv.Close()
+
+		var moveErr *MoveObjectError
+		switch {
+		case testCase.stage == "" && err != nil:
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		case testCase.stage != "" && !errors.As(err, &moveErr):
+			t.Errorf("Test %d: expected a MoveObjectError, got %v", i+1, err)
+		case testCase.stage != "" && (moveErr.Stage != testCase.stage || moveErr.DestinationWritten != testCase.destinationWritten):
+			t.Errorf("Test %d: expected stage %s and destination written %v, got %s and %v",
+				i+1, testCase.stage, testCase.destinationWritten, moveErr.Stage, moveErr.DestinationWritten)
+		case errors.Is(err, ErrMoveVerification) != testCase.mismatch:
+			t.Errorf("Test %d: expected mismatch %v, got %v", i+1, testCase.mismatch, err)
+		}
+		var got []string
+		for k := range objects {
+			got = append(got, k)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, testCase.objects) {
+			t.Errorf("Test %d: expected objects %v, got %v", i+1, testCase.objects, got)
+		}
+	}
+}
+
+func TestVerifyMovedObject(t *testing.T) {
+	src := ObjectInfo{Size: 3, ETag: "etag", ChecksumCRC32C: "crc"}
+	testCases := []struct {
+		dst       ObjectInfo
+		composed  bool
+		encrypted bool
+		mismatch  bool
+	}{
+		{dst: src},
+		{dst: ObjectInfo{Size: 2, ETag: "etag", ChecksumCRC32C: "crc"}, mismatch: true},
+		{dst: ObjectInfo{Size: 3, ETag: "other", ChecksumCRC32C: "crc"}, mismatch: true},
+		// ETags of encrypted and composed copies differ.
+		{dst: ObjectInfo{Size: 3, ETag: "other", ChecksumCRC32C: "crc"}, encrypted: true},
+		{dst: ObjectInfo{Size: 3, ETag: "other"}, composed: true},
+		{dst: ObjectInfo{Size: 3, ETag: "etag", ChecksumCRC32C: "other"}, mismatch: true},
+	}
+	for i, testCase := range testCases {
+		err := verifyMovedObject(src, testCase.dst, testCase.composed, testCase.encrypted)
+		if (err != nil) != testCase.mismatch || (err != nil && !errors.Is(err, ErrMoveVerification)) {
+			t.Errorf("Test %d: expected mismatch %v, got %v", i+1, testCase.mismatch, err)
+		}
+	}
+}
+
+func TestMoveObjectSameObject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, versionID := range []string{"", "v1"} {
+		_, err = c.MoveObject(context.Background(),
+			CopySrcOptions{Bucket: "bucket", Object: "object", VersionID: versionID},
+			CopyDestOptions{Bucket: "bucket", Object: "object"}, MoveObjectOptions{})
+		if ToErrorResponse(err).Code != InvalidArgument {
+			t.Errorf("expected InvalidArgument moving version %q onto itself, got %v", versionID, err)
+		}
+	}
+}
+
+func TestMoveObjectRange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The source is removed after the copy, a range would lose data.
+	_, err = c.MoveObject(context.Background(),
+		CopySrcOptions{Bucket: "bucket", Object: "src", MatchRange: true, End: 9},
+		CopyDestOptions{Bucket: "bucket", Object: "dst"}, MoveObjectOptions{})
+	if ToErrorResponse(err).Code != InvalidArgument {
+		t.Fatalf("expected InvalidArgument error, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"slices"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// MultiRegionAccessPointRoute is the route of a Multi-Region Access Point
+// to one of its buckets. Requests are routed to the buckets with a
+// TrafficDialPercentage of 100, setting it to 0 fails over to the other
+// buckets.
+type MultiRegionAccessPointRoute struct {
+	Bucket                string `xml:"Bucket,omitempty"`
+	Region                string `xml:"Region,omitempty"`
+	TrafficDialPercentage int    `xml:"TrafficDialPercentage"`
+}
+
+type multiRegionAccessPointRoutes struct {
+	Routes []MultiRegionAccessPointRoute `xml:"Routes>Route"`
+}
+
+type submitMultiRegionAccessPointRoutesRequest struct {
+	XMLName      xml.Name                      `xml:"http://awss3control.amazonaws.com/doc/2018-08-20/ SubmitMultiRegionAccessPointRoutesRequest"`
+	RouteUpdates []MultiRegionAccessPointRoute `xml:"RouteUpdates>Route"`
+}
+
+// mrapControlRegions are the regions serving the routes of Multi-Region
+// Access Points.
+var mrapControlRegions = []string{"us-east-1", "us-west-2", "ap-southeast-2", "ap-northeast-1", "eu-west-1"}
+
+// GetMultiRegionAccessPointRoutes returns the routes of the Multi-Region
+// Access Point with the ARN mrapARN, e.g.
+// arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap.
+func (c *Client) GetMultiRegionAccessPointRoutes(ctx context.Context, mrapARN string) ([]MultiRegionAccessPointRoute, error) {
+	resp, err := c.executeMultiRegionAccessPointRoutes(ctx, http.MethodGet, mrapARN, nil)
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	var result multiRegionAccessPointRoutes
+	if err := xmlDecoder(resp.Body, &result); err != nil {
+		return nil, err
+	}
+	return result.Routes, nil
+}
+
+// SubmitMultiRegionAccessPointRoutes updates the traffic dial percentages
+// of the routes of the Multi-Region Access Point with the ARN mrapARN,
+// the routes of other buckets are unchanged.
+func (c *Client) SubmitMultiRegionAccessPointRoutes(ctx context.Context, mrapARN string, routes []MultiRegionAccessPointRoute) error {
+	if len(routes) == 0 {
+		return errInvalidArgument("Routes cannot be empty.")
+	}
+	for _, route := range routes {
+		if route.TrafficDialPercentage < 0 || route.TrafficDialPercentage > 100 {
+			return errInvalidArgument("Traffic dial percentage must be between 0 and 100.")
+		}
+	}
+	body, err := xml.Marshal(submitMultiRegionAccessPointRoutesRequest{RouteUpdates: routes})
+	if err != nil {
+		return err
+	}
+	resp, err := c.executeMultiRegionAccessPointRoutes(ctx, http.MethodPatch, mrapARN, body)
+	closeResponse(resp)
+	return err
+}
+
+// FailoverMultiRegionAccessPoint routes all requests of the Multi-Region
+// Access Point with the ARN mrapARN to its buckets in the given regions,
+// requests are no longer routed to its buckets in other regions.
+func (c *Client) FailoverMultiRegionAccessPoint(ctx context.Context, mrapARN string, regions ...string) error {
+	if len(regions) == 0 {
+		return errInvalidArgument("Regions cannot be empty.")
+	}
+	routes, err := c.GetMultiRegionAccessPointRoutes(ctx, mrapARN)
+	if err != nil {
+		return err
+	}
+	active := false
+	for i := range routes {
+		routes[i].TrafficDialPercentage = 0
+		if slices.Contains(regions, routes[i].Region) {
+			routes[i].TrafficDialPercentage = 100
+			active = true
+		}
+	}
+	if !active {
+		return errInvalidArgument("Multi-Region Access Point has no bucket in the regions.")
+	}
+	return c.SubmitMultiRegionAccessPointRoutes(ctx, mrapARN, routes)
+}
+
+// executeMultiRegionAccessPointRoutes sends a routes request of a
+// Multi-Region Access Point to the S3 Control endpoint of its account.
+func (c *Client) executeMultiRegionAccessPointRoutes(ctx context.Context, method, mrapARN string, body []byte) (*http.Response, error) {
+	accessPoint, err := s3utils.ParseAccessPointARN(mrapARN)
+	if err != nil {
+		return nil, errInvalidArgument(err.Error())
+	}
+	if !accessPoint.IsMultiRegion() {
+		return nil, errInvalidArgument("ARN " + mrapARN + " is not of a Multi-Region Access Point.")
+	}
+
+	region := "us-west-2"
+	if slices.Contains(mrapControlRegions, c.region) {
+		region = c.region
+	}
+	return c.executeControl(ctx, controlRequest{
+		method:      method,
+		host:        accessPoint.AccountID + ".s3-control." + region + ".amazonaws.com",
+		path:        "/v20180820/mrap/instances/" + mrapARN + "/routes",
+		region:      region,
+		serviceType: signer.ServiceTypeS3,
+		header:      http.Header{"X-Amz-Account-Id": []string{accessPoint.AccountID}},
+		body:        body,
+	})
+}
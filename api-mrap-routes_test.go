@@ -0,0 +1,142 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const testMRAPARN = "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"
+
+func TestMultiRegionAccessPointRequests(t *testing.T) {
+	for _, bucket := range []string{testMRAPARN, "mfzwi23gnjvgw.mrap"} {
+		rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Host != "mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com" || req.URL.Path != "/object" {
+				t.Errorf("%s: unexpected URL %s", bucket, req.URL)
+			}
+			if auth := req.Header.Get("Authorization"); !strings.HasPrefix(auth, "AWS4-ECDSA-P256-SHA256 ") {
+				t.Errorf("%s: unexpected authorization %s", bucket, auth)
+			}
+			if regions := req.Header.Get("X-Amz-Region-Set"); regions != "*" {
+				t.Errorf("%s: unexpected region set %s", bucket, regions)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`<Tagging><TagSet></TagSet></Tagging>`)),
+				Request:    req,
+			}, nil
+		})
+		clnt, err := New("s3.amazonaws.com", &Options{
+			Creds:     credentials.NewStaticV4("access", "secret", ""),
+			Secure:    true,
+			Transport: rt,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := clnt.GetObjectTagging(context.Background(), bucket, "object", GetObjectTaggingOptions{}); err != nil {
+			t.Fatalf("%s: %v", bucket, err)
+		}
+	}
+
+	// Aliases are plain bucket names on other servers.
+	clnt, err := New("play.min.io", &Options{Creds: credentials.NewStaticV4("access", "secret", "")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := clnt.accessPointOf("mfzwi23gnjvgw.mrap"); ok {
+		t.Error("unexpected access point of an alias on a MinIO server")
+	}
+}
+
+func TestMultiRegionAccessPointRoutes(t *testing.T) {
+	var submitted []MultiRegionAccessPointRoute
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host != "123456789012.s3-control.us-west-2.amazonaws.com" ||
+			req.URL.Path != "/v20180820/mrap/instances/"+testMRAPARN+"/routes" {
+			t.Errorf("unexpected URL %s", req.URL)
+		}
+		if id := req.Header.Get("X-Amz-Account-Id"); id != "123456789012" {
+			t.Errorf("unexpected account id %s", id)
+		}
+		if auth := req.Header.Get("Authorization"); !strings.Contains(auth, "/us-west-2/s3/aws4_request") {
+			t.Errorf("unexpected authorization %s", auth)
+		}
+		body := `<GetMultiRegionAccessPointRoutesResult><Mrap>` + testMRAPARN + `</Mrap><Routes>` +
+			`<Route><Bucket>bucket-east</Bucket><Region>us-east-1</Region><TrafficDialPercentage>100</TrafficDialPercentage></Route>` +
+			`<Route><Bucket>bucket-west</Bucket><Region>us-west-2</Region><TrafficDialPercentage>100</TrafficDialPercentage></Route>` +
+			`</Routes></GetMultiRegionAccessPointRoutesResult>`
+		if req.Method == http.MethodPatch {
+			var request submitMultiRegionAccessPointRoutesRequest
+			if err := xml.NewDecoder(req.Body).Decode(&request); err != nil {
+				t.Error(err)
+			}
+			submitted = request.RouteUpdates
+			body = `<SubmitMultiRegionAccessPointRoutesResult/>`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+	clnt, err := New("s3.amazonaws.com", &Options{
+		Creds:     credentials.NewStaticV4("access", "secret", ""),
+		Secure:    true,
+		Transport: rt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes, err := clnt.GetMultiRegionAccessPointRoutes(context.Background(), testMRAPARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes) != 2 || routes[0].Bucket != "bucket-east" || routes[1].TrafficDialPercentage != 100 {
+		t.Fatalf("unexpected routes %+v", routes)
+	}
+
+	if err := clnt.FailoverMultiRegionAccessPoint(context.Background(), testMRAPARN, "us-west-2"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []MultiRegionAccessPointRoute{
+		{Bucket: "bucket-east", Region: "us-east-1", TrafficDialPercentage: 0},
+		{Bucket: "bucket-west", Region: "us-west-2", TrafficDialPercentage: 100},
+	}
+	if !reflect.DeepEqual(submitted, expected) {
+		t.Fatalf("expected routes %+v, got %+v", expected, submitted)
+	}
+
+	if err := clnt.FailoverMultiRegionAccessPoint(context.Background(), testMRAPARN, "eu-west-1"); err == nil {
+		t.Error("expected an error without buckets in the region")
+	}
+	if _, err := clnt.GetMultiRegionAccessPointRoutes(context.Background(), "arn:aws:s3:us-west-2:123456789012:accesspoint/ap"); err == nil {
+		t.Error("expected an error for a regional access point")
+	}
+}
@@ -40,11 +40,15 @@ type objectLegalHold struct {
 type PutObjectLegalHoldOptions struct {
 	VersionID string
 	Status    *LegalHoldStatus
+
+	requestExtensions
 }
 
 // GetObjectLegalHoldOptions represents options specified by user for GetObjectLegalHold call
 type GetObjectLegalHoldOptions struct {
 	VersionID string
+
+	requestExtensions
 }
 
 // LegalHoldStatus - object legal hold status.
@@ -82,6 +86,8 @@ func newObjectLegalHold(status *LegalHoldStatus) (*objectLegalHold, error) {
 
 // PutObjectLegalHold : sets object legal hold for a given object and versionID.
 func (c *Client) PutObjectLegalHold(ctx context.Context, bucketName, objectName string, opts PutObjectLegalHoldOptions) error {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
@@ -116,7 +122,8 @@ func (c *Client) PutObjectLegalHold(ctx context.Context, bucketName, objectName
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(lhData),
 		contentLength:    int64(len(lhData)),
-		contentMD5Base64: sumMD5Base64(lhData),
+		contentMD5Base64: c.contentMD5Base64(lhData),
+		md5Required:      true,
 		contentSHA256Hex: sum256Hex(lhData),
 	}
 
@@ -136,6 +143,8 @@ func (c *Client) PutObjectLegalHold(ctx context.Context, bucketName, objectName
 
 // GetObjectLegalHold gets legal-hold status of given object.
 func (c *Client) GetObjectLegalHold(ctx context.Context, bucketName, objectName string, opts GetObjectLegalHoldOptions) (status *LegalHoldStatus, err error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return nil, err
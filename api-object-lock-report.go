@@ -0,0 +1,253 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// ObjectLockFinding is a finding of an ObjectLockReport about an object.
+type ObjectLockFinding string
+
+const (
+	// LockFindingMissingRetention means the object has no retention, or
+	// its retention has expired.
+	LockFindingMissingRetention ObjectLockFinding = "missing-retention"
+	// LockFindingShortRetention means the object is retained for less
+	// than the minimum retention since it was last modified.
+	LockFindingShortRetention ObjectLockFinding = "short-retention"
+	// LockFindingLegalHold means the object is under legal hold.
+	LockFindingLegalHold ObjectLockFinding = "legal-hold"
+)
+
+// ObjectLockReportOptions represents options specified by user for
+// ObjectLockReport call
+type ObjectLockReportOptions struct {
+	// MinRetention is the retention objects must have since they were
+	// last modified, defaults to the default retention of the bucket.
+	MinRetention time.Duration
+
+	// WithVersions reports all versions of the objects, otherwise only
+	// the latest versions.
+	WithVersions bool
+
+	// All reports every object, otherwise only the objects with
+	// findings.
+	All bool
+
+	// Parallel is the number of objects inspected concurrently,
+	// defaults to 1. Entries are written in the order of the listing
+	// only when it is 1.
+	Parallel int
+}
+
+// ObjectLockReportEntry is an entry of an ObjectLockReport, the report
+// is written as newline delimited JSON entries.
+type ObjectLockReportEntry struct {
+	Key             string              `json:"key"`
+	VersionID       string              `json:"versionId,omitempty"`
+	LastModified    time.Time           `json:"lastModified"`
+	Mode            RetentionMode       `json:"mode,omitempty"`
+	RetainUntilDate *time.Time          `json:"retainUntilDate,omitempty"`
+	LegalHold       bool                `json:"legalHold,omitempty"`
+	Findings        []ObjectLockFinding `json:"findings,omitempty"`
+	// Error is the error of the inspection of the object.
+	Error string `json:"error,omitempty"`
+}
+
+// ObjectLockReportSummary contains the summary of an ObjectLockReport.
+type ObjectLockReportSummary struct {
+	// Object lock configuration of the bucket.
+	ObjectLockEnabled bool
+	DefaultRetention  Retention
+
+	// MinRetention is the retention the objects were checked against.
+	MinRetention time.Duration
+
+	// Number of objects (or versions) inspected.
+	Objects int64
+	// Number of objects without findings other than legal holds.
+	Compliant int64
+	// Number of objects by finding.
+	MissingRetention int64
+	ShortRetention   int64
+	LegalHolds       int64
+	// Number of objects that could not be inspected.
+	Failed int64
+}
+
+// ObjectLockReport writes a compliance report of the object lock of the
+// objects under prefix to w, i.e. the objects without retention, with a
+// retention shorter than the minimum retention and under legal hold. The
+// summary is returned along with the first error encountered, remaining
+// objects are still inspected when individual objects fail.
+func (c *Client) ObjectLockReport(ctx context.Context, bucketName, prefix string, w io.Writer, opts ObjectLockReportOptions) (ObjectLockReportSummary, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return ObjectLockReportSummary{}, err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(prefix); err != nil {
+		return ObjectLockReportSummary{}, err
+	}
+	if opts.MinRetention < 0 {
+		return ObjectLockReportSummary{}, errInvalidArgument("MinRetention cannot be negative")
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = 1
+	}
+
+	var summary ObjectLockReportSummary
+	enabled, mode, validity, unit, err := c.GetObjectLockConfig(ctx, bucketName)
+	if err != nil {
+		// Buckets without object lock have no configuration.
+		if ToErrorResponse(err).Code != "ObjectLockConfigurationNotFoundError" {
+			return summary, err
+		}
+	}
+	summary.ObjectLockEnabled = enabled == "Enabled"
+	if mode != nil && validity != nil && unit != nil {
+		days := time.Duration(*validity) * 24 * time.Hour
+		if *unit == Years {
+			days *= 365
+		}
+		summary.DefaultRetention = Retention{Mode: *mode, Validity: days}
+	}
+	summary.MinRetention = opts.MinRetention
+	if summary.MinRetention == 0 {
+		summary.MinRetention = summary.DefaultRetention.Validity
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		enc      = json.NewEncoder(w)
+		now      = time.Now()
+	)
+	setErr := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	objectCh := make(chan ObjectInfo)
+	var wg sync.WaitGroup
+	for range opts.Parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for object := range objectCh {
+				entry := ObjectLockReportEntry{Key: object.Key, VersionID: object.VersionID, LastModified: object.LastModified}
+				info, err := c.StatObject(ctx, bucketName, object.Key, StatObjectOptions{VersionID: object.VersionID})
+				if err == nil {
+					entry.LastModified = info.LastModified
+					entry.Findings = objectLockFindings(info, summary.MinRetention, now)
+					entry.Mode, entry.LegalHold = info.ObjectLockMode, info.ObjectLockLegalHold == LegalHoldEnabled
+					if !info.ObjectLockRetainUntilDate.IsZero() {
+						entry.RetainUntilDate = &info.ObjectLockRetainUntilDate
+					}
+				} else {
+					entry.Error = err.Error()
+				}
+
+				mu.Lock()
+				summary.Objects++
+				if err != nil {
+					summary.Failed++
+					setErr(err)
+				} else {
+					compliant := true
+					for _, finding := range entry.Findings {
+						switch finding {
+						case LockFindingMissingRetention:
+							summary.MissingRetention++
+							compliant = false
+						case LockFindingShortRetention:
+							summary.ShortRetention++
+							compliant = false
+						case LockFindingLegalHold:
+							summary.LegalHolds++
+						}
+					}
+					if compliant {
+						summary.Compliant++
+					}
+				}
+				if opts.All || err != nil || len(entry.Findings) > 0 {
+					if err = enc.Encode(entry); err != nil {
+						setErr(err)
+						cancel()
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var listErr error
+	for object := range c.ListObjectsIter(ctx, bucketName, ListObjectsOptions{
+		Prefix:       prefix,
+		Recursive:    true,
+		WithVersions: opts.WithVersions,
+	}) {
+		if object.Err != nil {
+			listErr = object.Err
+			break
+		}
+		if object.IsDeleteMarker {
+			continue
+		}
+		select {
+		case objectCh <- object:
+		case <-ctx.Done():
+		}
+	}
+	close(objectCh)
+	wg.Wait()
+
+	if firstErr != nil {
+		return summary, firstErr
+	}
+	if listErr != nil {
+		return summary, listErr
+	}
+	return summary, ctx.Err()
+}
+
+// objectLockFindings returns the findings about the object lock of an
+// object at now.
+func objectLockFindings(info ObjectInfo, minRetention time.Duration, now time.Time) []ObjectLockFinding {
+	var findings []ObjectLockFinding
+	switch {
+	case !info.ObjectLockMode.IsValid() || !info.ObjectLockRetainUntilDate.After(now):
+		findings = append(findings, LockFindingMissingRetention)
+	case info.ObjectLockRetainUntilDate.Sub(info.LastModified) < minRetention:
+		findings = append(findings, LockFindingShortRetention)
+	}
+	if info.ObjectLockLegalHold == LegalHoldEnabled {
+		findings = append(findings, LockFindingLegalHold)
+	}
+	return findings
+}
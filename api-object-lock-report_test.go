@@ -0,0 +1,155 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestObjectLockReport(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	lastModified := now.Add(-24 * time.Hour)
+	type objectLock struct {
+		mode      RetentionMode
+		until     time.Time
+		legalHold bool
+	}
+	objects := map[string]objectLock{
+		"compliant": {mode: Governance, until: now.Add(40 * 24 * time.Hour)},
+		"expired":   {mode: Governance, until: now.Add(-time.Hour)},
+		"held":      {mode: Compliance, until: now.Add(40 * 24 * time.Hour), legalHold: true},
+		"none":      {},
+		"short":     {mode: Compliance, until: now.Add(24 * time.Hour)},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Has("object-lock"):
+			io.WriteString(w, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>`+
+				`<Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+		case r.Method == http.MethodHead:
+			lock := objects[strings.TrimPrefix(r.URL.Path, "/bucket/")]
+			if lock.mode != "" {
+				w.Header().Set(amzLockMode, string(lock.mode))
+				w.Header().Set(amzLockRetainUntil, lock.until.Format(time.RFC3339))
+			}
+			if lock.legalHold {
+				w.Header().Set(amzLegalHoldHeader, "ON")
+			}
+			w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		default:
+			io.WriteString(w, `<ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`)
+			for _, key := range []string{"compliant", "expired", "held", "none", "short"} {
+				fmt.Fprintf(w, `<Contents><Key>%s</Key><LastModified>%s</LastModified></Contents>`, key, lastModified.Format(time.RFC3339))
+			}
+			io.WriteString(w, `</ListBucketResult>`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		opts     ObjectLockReportOptions
+		summary  ObjectLockReportSummary
+		findings map[string]string
+	}{
+		{
+			summary: ObjectLockReportSummary{
+				ObjectLockEnabled: true,
+				DefaultRetention:  Retention{Mode: Governance, Validity: 30 * 24 * time.Hour},
+				MinRetention:      30 * 24 * time.Hour,
+				Objects:           5,
+				Compliant:         2,
+				MissingRetention:  2,
+				ShortRetention:    1,
+				LegalHolds:        1,
+			},
+			findings: map[string]string{
+				"expired": "missing-retention",
+				"held":    "legal-hold",
+				"none":    "missing-retention",
+				"short":   "short-retention",
+			},
+		},
+		{
+			opts: ObjectLockReportOptions{MinRetention: time.Hour, All: true, Parallel: 3},
+			summary: ObjectLockReportSummary{
+				ObjectLockEnabled: true,
+				DefaultRetention:  Retention{Mode: Governance, Validity: 30 * 24 * time.Hour},
+				MinRetention:      time.Hour,
+				Objects:           5,
+				Compliant:         3,
+				MissingRetention:  2,
+				LegalHolds:        1,
+			},
+			findings: map[string]string{
+				"compliant": "",
+				"expired":   "missing-retention",
+				"held":      "legal-hold",
+				"none":      "missing-retention",
+				"short":     "",
+			},
+		},
+	}
+	for i, testCase := range testCases {
+		var buf bytes.Buffer
+		summary, err := c.ObjectLockReport(context.Background(), "bucket", "", &buf, testCase.opts)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if summary != testCase.summary {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.summary, summary)
+		}
+		findings := make(map[string]string)
+		dec := json.NewDecoder(&buf)
+		for dec.More() {
+			var entry ObjectLockReportEntry
+			if err = dec.Decode(&entry); err != nil {
+				t.Fatalf("Test %d: %v", i+1, err)
+			}
+			var s []string
+			for _, finding := range entry.Findings {
+				s = append(s, string(finding))
+			}
+			findings[entry.Key] = strings.Join(s, ",")
+			if entry.Key == "held" && (!entry.LegalHold || entry.Mode != Compliance || entry.RetainUntilDate == nil) {
+				t.Errorf("Test %d: unexpected entry %+v", i+1, entry)
+			}
+		}
+		if fmt.Sprint(findings) != fmt.Sprint(testCase.findings) {
+			t.Errorf("Test %d: expected findings %v, got %v", i+1, testCase.findings, findings)
+		}
+	}
+}
@@ -165,7 +165,8 @@ func (c *Client) SetBucketObjectLockConfig(ctx context.Context, bucketName strin
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(configData),
 		contentLength:    int64(len(configData)),
-		contentMD5Base64: sumMD5Base64(configData),
+		contentMD5Base64: c.contentMD5Base64(configData),
+		md5Required:      true,
 		contentSHA256Hex: sum256Hex(configData),
 	}
 
@@ -60,10 +60,14 @@ type PutObjectRetentionOptions struct {
 	Mode             *RetentionMode
 	RetainUntilDate  *time.Time
 	VersionID        string
+
+	requestExtensions
 }
 
 // PutObjectRetention sets object retention for a given object and versionID.
 func (c *Client) PutObjectRetention(ctx context.Context, bucketName, objectName string, opts PutObjectRetentionOptions) error {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
@@ -106,7 +110,8 @@ func (c *Client) PutObjectRetention(ctx context.Context, bucketName, objectName
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(retentionData),
 		contentLength:    int64(len(retentionData)),
-		contentMD5Base64: sumMD5Base64(retentionData),
+		contentMD5Base64: c.contentMD5Base64(retentionData),
+		md5Required:      true,
 		contentSHA256Hex: sum256Hex(retentionData),
 		customHeader:     headers,
 	}
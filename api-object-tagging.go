@@ -33,6 +33,8 @@ import (
 type PutObjectTaggingOptions struct {
 	VersionID string
 	Internal  AdvancedObjectTaggingOptions
+
+	requestExtensions
 }
 
 // AdvancedObjectTaggingOptions for internal use by MinIO server - not intended for client use.
@@ -43,10 +45,17 @@ type AdvancedObjectTaggingOptions struct {
 // PutObjectTagging replaces or creates object tag(s) and can target
 // a specific object version in a versioned bucket.
 func (c *Client) PutObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts PutObjectTaggingOptions) error {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
 	}
+	if otags != nil {
+		if err := c.validateMetadata(nil, otags.ToMap()); err != nil {
+			return err
+		}
+	}
 
 	// Get resources properly escaped and lined up before
 	// using them in http request.
@@ -71,7 +80,9 @@ func (c *Client) PutObjectTagging(ctx context.Context, bucketName, objectName st
 		queryValues:      urlValues,
 		contentBody:      bytes.NewReader(reqBytes),
 		contentLength:    int64(len(reqBytes)),
-		contentMD5Base64: sumMD5Base64(reqBytes),
+		contentMD5Base64: c.contentMD5Base64(reqBytes),
+		md5Required:      true,
+		contentSHA256Hex: sum256Hex(reqBytes),
 		customHeader:     headers,
 	}
 
@@ -94,11 +105,15 @@ func (c *Client) PutObjectTagging(ctx context.Context, bucketName, objectName st
 type GetObjectTaggingOptions struct {
 	VersionID string
 	Internal  AdvancedObjectTaggingOptions
+
+	requestExtensions
 }
 
 // GetObjectTagging fetches object tag(s) with options to target
 // a specific object version in a versioned bucket.
 func (c *Client) GetObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Get resources properly escaped and lined up before
 	// using them in http request.
 	urlValues := make(url.Values)
@@ -137,11 +152,15 @@ func (c *Client) GetObjectTagging(ctx context.Context, bucketName, objectName st
 type RemoveObjectTaggingOptions struct {
 	VersionID string
 	Internal  AdvancedObjectTaggingOptions
+
+	requestExtensions
 }
 
 // RemoveObjectTagging removes object tag(s) with options to control a specific object
 // version in a versioned bucket
 func (c *Client) RemoveObjectTagging(ctx context.Context, bucketName, objectName string, opts RemoveObjectTaggingOptions) error {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Get resources properly escaped and lined up before
 	// using them in http request.
 	urlValues := make(url.Values)
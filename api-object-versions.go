@@ -0,0 +1,197 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// ListObjectVersionsOf returns the versions and delete markers of an
+// object, newest first, e.g. versions[n] is the nth previous version.
+func (c *Client) ListObjectVersionsOf(ctx context.Context, bucketName, objectName string) ([]ObjectInfo, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+
+	// Stop listing the objects after the object.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var versions []ObjectInfo
+	for version := range c.ListObjectsIter(ctx, bucketName, ListObjectsOptions{
+		Prefix:       objectName,
+		WithVersions: true,
+		Recursive:    true,
+	}) {
+		if version.Err != nil {
+			return nil, version.Err
+		}
+		if version.Key != objectName {
+			// Versions are listed by key, the object is the first one.
+			break
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// StatObjectVersionBefore returns the version of an object which was the
+// latest at t, i.e. the newest version last modified at or before t.
+// It returns ErrNoSuchKey if the object did not exist or was deleted at t.
+func (c *Client) StatObjectVersionBefore(ctx context.Context, bucketName, objectName string, t time.Time) (ObjectInfo, error) {
+	versions, err := c.ListObjectVersionsOf(ctx, bucketName, objectName)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	for _, version := range versions {
+		if version.LastModified.After(t) {
+			continue
+		}
+		if version.IsDeleteMarker {
+			break
+		}
+		return version, nil
+	}
+	return ObjectInfo{}, ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Code:       NoSuchKey,
+		Message:    "The object has no version at " + t.UTC().Format(time.RFC3339) + ".",
+		BucketName: bucketName,
+		Key:        objectName,
+	}
+}
+
+// GetObjectVersionBefore returns the version of an object which was the
+// latest at t, e.g. to restore an object to its content of yesterday,
+// see StatObjectVersionBefore.
+func (c *Client) GetObjectVersionBefore(ctx context.Context, bucketName, objectName string, t time.Time, opts GetObjectOptions) (*Object, error) {
+	version, err := c.StatObjectVersionBefore(ctx, bucketName, objectName, t)
+	if err != nil {
+		return nil, err
+	}
+	opts.VersionID = version.VersionID
+	return c.GetObject(ctx, bucketName, objectName, opts)
+}
+
+// UndeleteObject restores an object deleted in a versioned bucket by
+// removing the delete markers newer than its latest version. It does
+// nothing if the object is not deleted and returns ErrNoSuchKey if the
+// object has no version to restore.
+func (c *Client) UndeleteObject(ctx context.Context, bucketName, objectName string) error {
+	versions, err := c.ListObjectVersionsOf(ctx, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	var markers []ObjectInfo
+	for _, version := range versions {
+		if !version.IsDeleteMarker {
+			for _, marker := range markers {
+				if err = c.RemoveObject(ctx, bucketName, objectName, RemoveObjectOptions{VersionID: marker.VersionID}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		markers = append(markers, version)
+	}
+	return ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Code:       NoSuchKey,
+		Message:    "The object has no version to restore.",
+		BucketName: bucketName,
+		Key:        objectName,
+	}
+}
+
+// UndeleteObjects restores the deleted objects under prefix like
+// UndeleteObject, one result is produced for each removed delete marker.
+// Objects without a version to restore are skipped.
+func (c *Client) UndeleteObjects(ctx context.Context, bucketName, prefix string) (iter.Seq[RemoveObjectResult], error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	return func(yield func(RemoveObjectResult) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var listErr error
+		markers := func(yield func(ObjectVersion) bool) {
+			var (
+				key     string
+				skip    bool
+				pending []ObjectVersion
+			)
+			for version := range c.ListObjectsIter(ctx, bucketName, ListObjectsOptions{
+				Prefix:       prefix,
+				WithVersions: true,
+				Recursive:    true,
+			}) {
+				if version.Err != nil {
+					listErr = version.Err
+					return
+				}
+				if version.Key != key {
+					// Skip the objects which are not deleted.
+					key, pending = version.Key, pending[:0]
+					skip = !version.IsLatest || !version.IsDeleteMarker
+				}
+				if skip {
+					continue
+				}
+				if version.IsDeleteMarker {
+					pending = append(pending, ObjectVersion{Key: version.Key, VersionID: version.VersionID})
+					continue
+				}
+				// The latest version of the object, remove the markers
+				// above it and skip the older versions.
+				for _, marker := range pending {
+					if !yield(marker) {
+						return
+					}
+				}
+				skip = true
+			}
+		}
+
+		results, err := c.RemoveObjectVersions(ctx, bucketName, markers, RemoveObjectsOptions{})
+		if err != nil {
+			yield(RemoveObjectResult{Err: err})
+			return
+		}
+		for result := range results {
+			if !yield(result) {
+				return
+			}
+		}
+		if listErr != nil {
+			yield(RemoveObjectResult{Err: listErr})
+		}
+	}, nil
+}
@@ -0,0 +1,218 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestObjectVersionBefore(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Has("versions") {
+			io.WriteString(w, `<ListVersionsResult><Name>bucket</Name><Prefix>doc</Prefix><IsTruncated>false</IsTruncated>`+
+				`<DeleteMarker><Key>doc</Key><VersionId>v4</VersionId><IsLatest>true</IsLatest><LastModified>2025-01-04T00:00:00.000Z</LastModified></DeleteMarker>`+
+				`<Version><Key>doc</Key><VersionId>v3</VersionId><LastModified>2025-01-03T00:00:00.000Z</LastModified><Size>3</Size></Version>`+
+				`<DeleteMarker><Key>doc</Key><VersionId>v2</VersionId><LastModified>2025-01-02T00:00:00.000Z</LastModified></DeleteMarker>`+
+				`<Version><Key>doc</Key><VersionId>v1</VersionId><LastModified>2025-01-01T00:00:00.000Z</LastModified><Size>1</Size></Version>`+
+				`<Version><Key>doc2</Key><VersionId>v5</VersionId><LastModified>2025-01-05T00:00:00.000Z</LastModified></Version>`+
+				`</ListVersionsResult>`)
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("X-Amz-Version-Id", q.Get("versionId"))
+		io.WriteString(w, q.Get("versionId"))
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	versions, err := c.ListObjectVersionsOf(ctx, "bucket", "doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, version := range versions {
+		ids = append(ids, version.VersionID)
+	}
+	if got := strings.Join(ids, ","); got != "v4,v3,v2,v1" {
+		t.Errorf("Expected the versions v4,v3,v2,v1, got %s", got)
+	}
+
+	day := func(d int) time.Time {
+		return time.Date(2025, time.January, d, 12, 0, 0, 0, time.UTC)
+	}
+	testCases := []struct {
+		t         time.Time
+		versionID string
+	}{
+		{t: day(1), versionID: "v1"},
+		{t: day(2)},
+		{t: day(3), versionID: "v3"},
+		{t: day(4)},
+		{t: time.Date(2025, time.January, 3, 0, 0, 0, 0, time.UTC), versionID: "v3"},
+		{t: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, testCase := range testCases {
+		info, err := c.StatObjectVersionBefore(ctx, "bucket", "doc", testCase.t)
+		if testCase.versionID == "" {
+			if !errors.Is(err, ErrNoSuchKey) {
+				t.Errorf("Test %d: expected NoSuchKey, got %v", i+1, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if info.VersionID != testCase.versionID {
+			t.Errorf("Test %d: expected version %s, got %s", i+1, testCase.versionID, info.VersionID)
+		}
+	}
+
+	obj, err := c.GetObjectVersionBefore(ctx, "bucket", "doc", day(3), GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if b, err := io.ReadAll(obj); err != nil || string(b) != "v3" {
+		t.Errorf("Expected the content of v3, got %s, %v", b, err)
+	}
+}
+
+func TestUndeleteObject(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		removed []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case q.Has("versions"):
+			// Versions of the objects by key, newest first.
+			versions := []string{
+				// Deleted twice.
+				`<DeleteMarker><Key>a</Key><VersionId>a4</VersionId><IsLatest>true</IsLatest></DeleteMarker>`,
+				`<DeleteMarker><Key>a</Key><VersionId>a3</VersionId></DeleteMarker>`,
+				`<Version><Key>a</Key><VersionId>a2</VersionId></Version>`,
+				`<DeleteMarker><Key>a</Key><VersionId>a1</VersionId></DeleteMarker>`,
+				// Not deleted.
+				`<Version><Key>b</Key><VersionId>b2</VersionId><IsLatest>true</IsLatest></Version>`,
+				`<DeleteMarker><Key>b</Key><VersionId>b1</VersionId></DeleteMarker>`,
+				// Only delete markers.
+				`<DeleteMarker><Key>c</Key><VersionId>c1</VersionId><IsLatest>true</IsLatest></DeleteMarker>`,
+				// Deleted once.
+				`<DeleteMarker><Key>d</Key><VersionId>d2</VersionId><IsLatest>true</IsLatest></DeleteMarker>`,
+				`<Version><Key>d</Key><VersionId>d1</VersionId></Version>`,
+			}
+			io.WriteString(w, `<ListVersionsResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`)
+			for _, version := range versions {
+				if strings.Contains(version, "<Key>"+q.Get("prefix")) {
+					io.WriteString(w, version)
+				}
+			}
+			io.WriteString(w, `</ListVersionsResult>`)
+		case q.Has("delete"):
+			var req deleteMultiObjects
+			if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			io.WriteString(w, `<DeleteResult>`)
+			for _, object := range req.Objects {
+				mu.Lock()
+				removed = append(removed, object.VersionID)
+				mu.Unlock()
+				io.WriteString(w, `<Deleted><Key>`+object.Key+`</Key><VersionId>`+object.VersionID+`</VersionId></Deleted>`)
+			}
+			io.WriteString(w, `</DeleteResult>`)
+		case r.Method == http.MethodDelete:
+			mu.Lock()
+			removed = append(removed, q.Get("versionId"))
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	testCases := []struct {
+		object  string
+		removed string
+		err     error
+	}{
+		{object: "a", removed: "a4,a3"},
+		{object: "b"},
+		{object: "c", err: ErrNoSuchKey},
+		{object: "e", err: ErrNoSuchKey},
+	}
+	for i, testCase := range testCases {
+		removed = nil
+		err := c.UndeleteObject(ctx, "bucket", testCase.object)
+		if !errors.Is(err, testCase.err) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.err, err)
+		}
+		if got := strings.Join(removed, ","); got != testCase.removed {
+			t.Errorf("Test %d: expected to remove %q, got %q", i+1, testCase.removed, got)
+		}
+	}
+
+	removed = nil
+	results, err := c.UndeleteObjects(ctx, "bucket", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored []string
+	for result := range results {
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+		restored = append(restored, result.ObjectName+"@"+result.ObjectVersionID)
+	}
+	if got := strings.Join(restored, ","); got != "a@a4,a@a3,d@d2" {
+		t.Errorf("Expected to remove a@a4,a@a3,d@d2, got %s", got)
+	}
+	if got := strings.Join(removed, ","); got != "a4,a3,d2" {
+		t.Errorf("Expected the server to remove a4,a3,d2, got %s", got)
+	}
+}
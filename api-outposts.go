@@ -0,0 +1,102 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// outpostsBucketOf returns the Outposts bucket if bucketName is an
+// Outposts bucket ARN. Outposts buckets are managed by the S3 on Outposts
+// control endpoint, their objects are accessed through access points.
+func outpostsBucketOf(bucketName string) (s3utils.OutpostsBucketARN, bool) {
+	if !strings.HasPrefix(bucketName, "arn:") {
+		return s3utils.OutpostsBucketARN{}, false
+	}
+	bucket, err := s3utils.ParseOutpostsBucketARN(bucketName)
+	return bucket, err == nil
+}
+
+// outpostsBucketHeader returns the headers of the requests of an
+// Outposts bucket.
+func outpostsBucketHeader(accountID, outpostID string) http.Header {
+	header := make(http.Header)
+	if accountID != "" {
+		header.Set("x-amz-account-id", accountID)
+	}
+	header.Set("x-amz-outpost-id", outpostID)
+	return header
+}
+
+// makeOutpostsBucket creates the bucket on the outpost opts.OutpostID.
+func (c *Client) makeOutpostsBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) error {
+	if err := s3utils.CheckValidBucketNameStrict(bucketName); err != nil {
+		return err
+	}
+	region := opts.Region
+	if region == "" {
+		region = c.region
+	}
+	if region == "" {
+		return errInvalidArgument("Region of the outpost " + opts.OutpostID + " is required.")
+	}
+	resp, err := c.executeControl(ctx, controlRequest{
+		method:      http.MethodPut,
+		host:        s3utils.OutpostsControlEndpoint("aws", region),
+		path:        "/v20180820/bucket/" + bucketName,
+		region:      region,
+		serviceType: signer.ServiceTypeS3Outposts,
+		header:      outpostsBucketHeader("", opts.OutpostID),
+	})
+	closeResponse(resp)
+	return err
+}
+
+// removeOutpostsBucket removes an Outposts bucket.
+func (c *Client) removeOutpostsBucket(ctx context.Context, bucket s3utils.OutpostsBucketARN) error {
+	resp, err := c.executeControl(ctx, c.outpostsBucketRequest(http.MethodDelete, bucket))
+	closeResponse(resp)
+	return err
+}
+
+// outpostsBucketExists tells whether an Outposts bucket exists.
+func (c *Client) outpostsBucketExists(ctx context.Context, bucket s3utils.OutpostsBucketARN) (bool, error) {
+	resp, err := c.executeControl(ctx, c.outpostsBucketRequest(http.MethodGet, bucket))
+	closeResponse(resp)
+	if errors.Is(err, ErrNoSuchBucket) || ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *Client) outpostsBucketRequest(method string, bucket s3utils.OutpostsBucketARN) controlRequest {
+	return controlRequest{
+		method:      method,
+		host:        bucket.Endpoint(),
+		path:        "/v20180820/bucket/" + bucket.Bucket,
+		region:      bucket.Region,
+		serviceType: signer.ServiceTypeS3Outposts,
+		header:      outpostsBucketHeader(bucket.AccountID, bucket.OutpostID),
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestOutpostsBuckets(t *testing.T) {
+	const (
+		outpostID = "op-01ac5d28a6a232904"
+		bucketARN = "arn:aws:s3-outposts:us-west-2:123456789012:outpost/" + outpostID + "/bucket/my-bucket"
+	)
+	var (
+		methods []string
+		exists  = true
+	)
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		methods = append(methods, req.Method)
+		if req.URL.Host != "s3-outposts.us-west-2.amazonaws.com" || req.URL.Path != "/v20180820/bucket/my-bucket" {
+			t.Errorf("unexpected URL %s", req.URL)
+		}
+		if id := req.Header.Get("X-Amz-Outpost-Id"); id != outpostID {
+			t.Errorf("unexpected outpost id %q", id)
+		}
+		if id := req.Header.Get("X-Amz-Account-Id"); req.Method != http.MethodPut && id != "123456789012" {
+			t.Errorf("unexpected account id %q", id)
+		}
+		if auth := req.Header.Get("Authorization"); !strings.Contains(auth, "/us-west-2/s3-outposts/aws4_request") {
+			t.Errorf("unexpected authorization %s", auth)
+		}
+		status, body := http.StatusOK, ""
+		switch {
+		case req.Method == http.MethodDelete:
+			status = http.StatusNoContent
+		case req.Method == http.MethodGet && !exists:
+			status, body = http.StatusNotFound, `<Error><Code>NoSuchOutpostsBucket</Code></Error>`
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+	clnt, err := New("s3.amazonaws.com", &Options{
+		Creds:     credentials.NewStaticV4("access", "secret", ""),
+		Region:    "us-west-2",
+		Secure:    true,
+		Transport: rt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if err := clnt.MakeBucket(ctx, "my-bucket", MakeBucketOptions{OutpostID: outpostID}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := clnt.BucketExists(ctx, bucketARN); err != nil || !ok {
+		t.Fatalf("expected the bucket to exist, got %v, %v", ok, err)
+	}
+	if err := clnt.RemoveBucket(ctx, bucketARN); err != nil {
+		t.Fatal(err)
+	}
+	exists = false
+	if ok, err := clnt.BucketExists(ctx, bucketARN); err != nil || ok {
+		t.Fatalf("expected the bucket not to exist, got %v, %v", ok, err)
+	}
+	if got := strings.Join(methods, " "); got != "PUT GET DELETE GET" {
+		t.Fatalf("unexpected requests %s", got)
+	}
+
+	if _, err := clnt.GetObjectTagging(ctx, bucketARN, "object", GetObjectTaggingOptions{}); err == nil {
+		t.Fatal("expected an error accessing objects by the bucket ARN")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// Presigned multipart uploads let a backend hand a set of URLs to browsers
+// or mobile clients which upload a large object directly:
+//
+//  1. POST the PresignedCreateMultipartUpload URL, the UploadId is in the
+//     XML response.
+//  2. PUT each part to its PresignedUploadPart URL, keeping the ETag
+//     response header of each part.
+//  3. POST the CompleteMultipartUpload XML document listing the part
+//     numbers and ETags to the PresignedCompleteMultipartUpload URL.
+
+// PresignedCreateMultipartUpload - Returns a presigned URL to initiate a
+// multipart upload. The headers of opts, e.g. Content-Type and user
+// metadata, are signed and must be sent as is with the request, the
+// Content-Type defaults to application/octet-stream.
+func (c *Client) PresignedCreateMultipartUpload(ctx context.Context, bucketName, objectName string, expires time.Duration, opts PutObjectOptions) (*url.URL, error) {
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	urlValues := make(url.Values)
+	urlValues.Set("uploads", "")
+
+	var extraHeaders http.Header
+	if h := opts.Header(); len(h) > 0 {
+		extraHeaders = h
+	}
+	return c.presignURL(ctx, http.MethodPost, bucketName, objectName, expires, urlValues, extraHeaders)
+}
+
+// PresignedUploadPart - Returns a presigned URL to upload the part
+// partNumber, between 1 and 10000, of a multipart upload.
+func (c *Client) PresignedUploadPart(ctx context.Context, bucketName, objectName, uploadID string, partNumber int, expires time.Duration) (*url.URL, error) {
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if uploadID == "" {
+		return nil, errInvalidArgument("UploadID cannot be empty.")
+	}
+	if err := c.Limits().CheckPartNumber(partNumber); err != nil {
+		return nil, err
+	}
+	urlValues := make(url.Values)
+	urlValues.Set("partNumber", strconv.Itoa(partNumber))
+	urlValues.Set("uploadId", uploadID)
+	return c.presignURL(ctx, http.MethodPut, bucketName, objectName, expires, urlValues, nil)
+}
+
+// PresignedUploadParts - Returns the presigned URLs of the parts 1 to
+// partCount of a multipart upload, in part number order.
+func (c *Client) PresignedUploadParts(ctx context.Context, bucketName, objectName, uploadID string, partCount int, expires time.Duration) ([]*url.URL, error) {
+	if err := c.Limits().CheckPartNumber(partCount); err != nil {
+		return nil, err
+	}
+	urls := make([]*url.URL, 0, partCount)
+	for partNumber := 1; partNumber <= partCount; partNumber++ {
+		u, err := c.PresignedUploadPart(ctx, bucketName, objectName, uploadID, partNumber, expires)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, u)
+	}
+	return urls, nil
+}
+
+// PresignedCompleteMultipartUpload - Returns a presigned URL to complete a
+// multipart upload.
+func (c *Client) PresignedCompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, expires time.Duration) (*url.URL, error) {
+	return c.presignUploadID(ctx, http.MethodPost, bucketName, objectName, uploadID, expires)
+}
+
+// PresignedAbortMultipartUpload - Returns a presigned URL to abort a
+// multipart upload.
+func (c *Client) PresignedAbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, expires time.Duration) (*url.URL, error) {
+	return c.presignUploadID(ctx, http.MethodDelete, bucketName, objectName, uploadID, expires)
+}
+
+func (c *Client) presignUploadID(ctx context.Context, method, bucketName, objectName, uploadID string, expires time.Duration) (*url.URL, error) {
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if uploadID == "" {
+		return nil, errInvalidArgument("UploadID cannot be empty.")
+	}
+	urlValues := make(url.Values)
+	urlValues.Set("uploadId", uploadID)
+	return c.presignURL(ctx, method, bucketName, objectName, expires, urlValues, nil)
+}
@@ -0,0 +1,75 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestPresignedMultipartUpload(t *testing.T) {
+	c, err := New("localhost:9000", &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	u, err := c.PresignedCreateMultipartUpload(ctx, "bucket", "object", time.Hour, PutObjectOptions{ContentType: "video/mp4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if _, ok := q["uploads"]; !ok || !strings.Contains(q.Get("X-Amz-SignedHeaders"), "content-type") {
+		t.Fatalf("Unexpected create multipart upload URL %s", u)
+	}
+
+	urls, err := c.PresignedUploadParts(ctx, "bucket", "object", "upload-id", 3, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(urls) != 3 {
+		t.Fatalf("Expected 3 URLs, got %d", len(urls))
+	}
+	for i, u := range urls {
+		q := u.Query()
+		if q.Get("partNumber") != string(rune('1'+i)) || q.Get("uploadId") != "upload-id" || q.Get("X-Amz-Signature") == "" {
+			t.Fatalf("Unexpected upload part URL %s", u)
+		}
+	}
+	if _, err = c.PresignedUploadPart(ctx, "bucket", "object", "upload-id", 10001, time.Hour); err == nil {
+		t.Fatal("Expected invalid part number to fail")
+	}
+
+	u, err = c.PresignedCompleteMultipartUpload(ctx, "bucket", "object", "upload-id", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Query().Get("uploadId") != "upload-id" {
+		t.Fatalf("Unexpected complete multipart upload URL %s", u)
+	}
+	if _, err = c.PresignedCompleteMultipartUpload(ctx, "bucket", "object", "", time.Hour); err == nil {
+		t.Fatal("Expected empty upload ID to fail")
+	}
+}
@@ -22,8 +22,12 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"runtime"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/signer"
 )
@@ -31,6 +35,10 @@ import (
 // presignURL - Returns a presigned URL for an input 'method'.
 // Expires maximum is 7days - ie. 604800 and minimum is 1.
 func (c *Client) presignURL(ctx context.Context, method, bucketName, objectName string, expires time.Duration, reqParams url.Values, extraHeaders http.Header) (u *url.URL, err error) {
+	return c.presignURLWithTime(ctx, method, bucketName, objectName, expires, reqParams, extraHeaders, time.Time{})
+}
+
+func (c *Client) presignURLWithTime(ctx context.Context, method, bucketName, objectName string, expires time.Duration, reqParams url.Values, extraHeaders http.Header, signingTime time.Time) (u *url.URL, err error) {
 	// Input validation.
 	if method == "" {
 		return nil, errInvalidArgument("method cannot be empty.")
@@ -51,6 +59,7 @@ func (c *Client) presignURL(ctx context.Context, method, bucketName, objectName
 		expires:            expireSeconds,
 		queryValues:        reqParams,
 		extraPresignHeader: extraHeaders,
+		signingTime:        signingTime,
 	}
 
 	// Instantiate a new request.
@@ -132,15 +141,19 @@ func (c *Client) PresignedPostPolicy(ctx context.Context, p *PostPolicy) (u *url
 		return nil, nil, err
 	}
 
-	isVirtualHost := c.isVirtualHostStyleRequest(*c.endpointURL, bucketName)
+	endpointURL, err := c.resolveEndpoint(ctx, bucketName, location)
+	if err != nil {
+		return nil, nil, err
+	}
+	isVirtualHost := c.isVirtualHostStyleRequest(*endpointURL, bucketName)
 
-	u, err = c.makeTargetURL(bucketName, "", location, isVirtualHost, nil)
+	u, err = c.makeTargetURLFor(endpointURL, bucketName, "", location, isVirtualHost, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Get credentials from the configured credentials provider.
-	credValues, err := c.credsProvider.GetWithContext(c.CredContext())
+	credValues, err := c.getCredentials(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -157,7 +170,7 @@ func (c *Client) PresignedPostPolicy(ctx context.Context, p *PostPolicy) (u *url
 	}
 
 	// Keep time.
-	t := time.Now().UTC()
+	t := c.now().UTC()
 	// For signature version '2' handle here.
 	if signerType.IsV2() {
 		policyBase64 := p.base64()
@@ -226,3 +239,148 @@ func (c *Client) PresignedPostPolicy(ctx context.Context, p *PostPolicy) (u *url
 	p.formData["x-amz-signature"] = signer.PostPresignSignatureV4(policyBase64, t, secretAccessKey, location)
 	return u, p.formData, nil
 }
+
+// PresignOptions are the options of PresignWithOptions.
+type PresignOptions struct {
+	// Expires is the validity of the URL, from 1 second to 7 days.
+	Expires time.Duration
+
+	// SigningTime of the URL, defaults to the current time. The URL is
+	// valid from SigningTime for Expires, URLs presigned with the same
+	// time, parameters and credentials are identical, except for the
+	// randomized signatures V4A of Multi-Region Access Points.
+	SigningTime time.Time
+
+	// ExtraQuery are additional query parameters, e.g. response header
+	// overrides or vendor parameters, which are part of the signature.
+	ExtraQuery url.Values
+
+	// ExtraHeaders are headers part of the signature, requests using
+	// the URL must send them as is. Not supported with signature V2.
+	ExtraHeaders http.Header
+}
+
+// PresignWithOptions - returns a presigned URL for any http method of your
+// choice, see PresignOptions.
+func (c *Client) PresignWithOptions(ctx context.Context, method, bucketName, objectName string, opts PresignOptions) (*url.URL, error) {
+	var reqParams url.Values
+	if len(opts.ExtraQuery) > 0 {
+		reqParams = make(url.Values, len(opts.ExtraQuery))
+		for k, v := range opts.ExtraQuery {
+			if signatureQueryParams[strings.ToLower(k)] {
+				return nil, errInvalidArgument("Query parameter " + k + " is reserved for the signature.")
+			}
+			reqParams[k] = append([]string(nil), v...)
+		}
+	}
+	return c.presignURLWithTime(ctx, method, bucketName, objectName, opts.Expires, reqParams, opts.ExtraHeaders, opts.SigningTime)
+}
+
+// signatureQueryParams are the query parameters set by the signature.
+var signatureQueryParams = map[string]bool{
+	"x-amz-algorithm":      true,
+	"x-amz-credential":     true,
+	"x-amz-date":           true,
+	"x-amz-expires":        true,
+	"x-amz-signedheaders":  true,
+	"x-amz-signature":      true,
+	"x-amz-security-token": true,
+}
+
+// PresignBatchKeyPlaceholder is replaced by the object name in the
+// parameter values of PresignBatch, e.g.
+// response-content-disposition=attachment; filename="{key}".
+const PresignBatchKeyPlaceholder = "{key}"
+
+// PresignBatch - returns presigned URLs for all keys of bucketName in the
+// order of keys. All URLs share the bucket location, credentials and
+// signing time and are signed concurrently. Occurrences of
+// PresignBatchKeyPlaceholder in the reqParams values are substituted
+// with the object name of each URL.
+func (c *Client) PresignBatch(ctx context.Context, method, bucketName string, keys []string, expires time.Duration, reqParams url.Values) ([]*url.URL, error) {
+	if method == "" {
+		return nil, errInvalidArgument("method cannot be empty.")
+	}
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := isValidExpiry(expires); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if err := s3utils.CheckValidObjectName(key); err != nil {
+			return nil, err
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	// Resolve the location once, every URL is signed with the same scope.
+	if _, err := c.getBucketLocation(ctx, bucketName); err != nil {
+		return nil, err
+	}
+	signingTime := c.now().UTC()
+
+	templated := false
+	for _, v := range reqParams {
+		for _, s := range v {
+			templated = templated || strings.Contains(s, PresignBatchKeyPlaceholder)
+		}
+	}
+
+	urls := make([]*url.URL, len(keys))
+	errs := make([]error, len(keys))
+	workers := min(runtime.GOMAXPROCS(0), (len(keys)+63)/64)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := w; i < len(keys); i += workers {
+				params := reqParams
+				if templated {
+					params = make(url.Values, len(reqParams))
+					for k, v := range reqParams {
+						params[k] = make([]string, len(v))
+						for j, s := range v {
+							params[k][j] = strings.ReplaceAll(s, PresignBatchKeyPlaceholder, keys[i])
+						}
+					}
+				}
+				urls[i], errs[i] = c.presignURLWithTime(ctx, method, bucketName, keys[i], expires, params, nil, signingTime)
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return urls, nil
+}
+
+// VerifyPresignedURL verifies that u is a GET URL presigned with signature
+// V4 by creds, and valid now give or take clockSkew. It lets services
+// receiving presigned URLs validate them.
+func VerifyPresignedURL(u *url.URL, creds credentials.Value, clockSkew time.Duration) error {
+	return VerifyPresignedRequest(&http.Request{Method: http.MethodGet, URL: u, Host: u.Host}, creds, clockSkew)
+}
+
+// VerifyPresignedRequest is like VerifyPresignedURL for a request of any
+// method, e.g. an incoming server request. The headers signed by the URL
+// must be present in r.
+func VerifyPresignedRequest(r *http.Request, creds credentials.Value, clockSkew time.Duration) error {
+	if r == nil || r.URL == nil {
+		return errInvalidArgument("Request cannot be empty.")
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return errInvalidArgument("Credentials cannot be empty.")
+	}
+	req := *r
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	return signer.VerifyPreSignV4(req, creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken, clockSkew, time.Now())
+}
@@ -0,0 +1,174 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+func TestPresignWithOptions(t *testing.T) {
+	c, err := New("localhost:9000", &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := PresignOptions{
+		Expires:     time.Hour,
+		SigningTime: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		ExtraQuery:  url.Values{"x-vendor-tenant": {"acme"}},
+	}
+	u1, err := c.PresignWithOptions(context.Background(), http.MethodGet, "bucket", "object", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u2, err := c.PresignWithOptions(context.Background(), http.MethodGet, "bucket", "object", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u1.String() != u2.String() {
+		t.Fatalf("Expected deterministic URLs, got %s and %s", u1, u2)
+	}
+	q := u1.Query()
+	if q.Get("X-Amz-Date") != "20250102T030405Z" || q.Get("x-vendor-tenant") != "acme" {
+		t.Fatalf("Unexpected presigned URL %s", u1)
+	}
+
+	opts.ExtraQuery = url.Values{"X-Amz-Signature": {"forged"}}
+	if _, err = c.PresignWithOptions(context.Background(), http.MethodGet, "bucket", "object", opts); err == nil {
+		t.Fatal("Expected reserved query parameter to fail")
+	}
+}
+
+func TestPresignWithOptionsSigningTime(t *testing.T) {
+	custom, err := New("localhost:9000", &Options{
+		Creds:        credentials.NewStaticV4("access", "secret", ""),
+		Region:       "us-east-1",
+		CustomSigner: &hsmSigner{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	aws, err := New("s3.amazonaws.com", &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Secure: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		c         *Client
+		bucket    string
+		algorithm string
+	}{
+		{custom, "bucket", "AWS4-HMAC-SHA256"},
+		// Multi-Region Access Points are presigned with signature V4A.
+		{aws, testMRAPARN, "AWS4-ECDSA-P256-SHA256"},
+	}
+	opts := PresignOptions{Expires: time.Hour, SigningTime: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)}
+	for i, testCase := range testCases {
+		u, err := testCase.c.PresignWithOptions(context.Background(), http.MethodGet, testCase.bucket, "object", opts)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		q := u.Query()
+		if q.Get("X-Amz-Algorithm") != testCase.algorithm || q.Get("X-Amz-Date") != "20250102T030405Z" {
+			t.Errorf("Test %d: unexpected presigned URL %s", i+1, u)
+		}
+		if !strings.Contains(q.Get("X-Amz-Credential"), "/20250102/") {
+			t.Errorf("Test %d: unexpected credential scope %s", i+1, q.Get("X-Amz-Credential"))
+		}
+	}
+	if calls := custom.customSigner.(*hsmSigner).calls.Load(); calls != 1 {
+		t.Errorf("Expected the custom signer to presign, got %d calls", calls)
+	}
+}
+
+func TestVerifyPresignedURL(t *testing.T) {
+	creds := credentials.Value{AccessKeyID: "access", SecretAccessKey: "secret"}
+	c, err := New("localhost:9000", &Options{
+		Creds:  credentials.NewStaticV4(creds.AccessKeyID, creds.SecretAccessKey, ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := c.PresignedGetObject(context.Background(), "bucket", "object", time.Hour, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = VerifyPresignedURL(u, creds, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	creds.SecretAccessKey = "other"
+	if err = VerifyPresignedURL(u, creds, time.Minute); !errors.Is(err, signer.ErrPreSignSignatureMismatch) {
+		t.Fatalf("Expected signature mismatch, got %v", err)
+	}
+}
+
+func TestPresignBatch(t *testing.T) {
+	c, err := New("localhost:9000", &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 200)
+	for i := range keys {
+		keys[i] = "photos/" + strconv.Itoa(i) + ".jpg"
+	}
+	params := url.Values{"response-content-disposition": {`attachment; filename="` + PresignBatchKeyPlaceholder + `"`}}
+	urls, err := c.PresignBatch(context.Background(), http.MethodGet, "bucket", keys, time.Hour, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(urls) != len(keys) {
+		t.Fatalf("Expected %d URLs, got %d", len(keys), len(urls))
+	}
+	date := urls[0].Query().Get("X-Amz-Date")
+	for i, u := range urls {
+		if u.Path != "/bucket/"+keys[i] {
+			t.Fatalf("URL %d: expected path /bucket/%s, got %s", i, keys[i], u.Path)
+		}
+		q := u.Query()
+		if q.Get("X-Amz-Date") != date {
+			t.Errorf("URL %d: expected shared date %s, got %s", i, date, q.Get("X-Amz-Date"))
+		}
+		if want := `attachment; filename="` + keys[i] + `"`; q.Get("response-content-disposition") != want {
+			t.Errorf("URL %d: expected %q, got %q", i, want, q.Get("response-content-disposition"))
+		}
+	}
+	if params.Get("response-content-disposition") != `attachment; filename="{key}"` {
+		t.Error("Expected params to be left unmodified")
+	}
+	if _, err = c.PresignBatch(context.Background(), http.MethodGet, "bucket", []string{"a", ""}, time.Hour, nil); err == nil {
+		t.Error("Expected error for an empty key")
+	}
+}
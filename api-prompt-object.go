@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"maps"
 	"net/http"
 
 	"github.com/minio/minio-go/v7/internal/json"
@@ -30,6 +31,9 @@ import (
 // PromptObject performs language model inference with the prompt and referenced object as context.
 // Inference is performed using a Lambda handler that can process the prompt and object.
 // Currently, this functionality is limited to certain MinIO servers.
+// The returned reader streams the response of the Lambda handler and
+// must be closed. Model parameters are set with the options, e.g. with
+// PromptObjectOptions.SetModel.
 func (c *Client) PromptObject(ctx context.Context, bucketName, objectName, prompt string, opts PromptObjectOptions) (io.ReadCloser, error) {
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
@@ -47,6 +51,14 @@ func (c *Client) PromptObject(ctx context.Context, bucketName, objectName, promp
 		}
 	}
 
+	if opts.LambdaArn == "" {
+		return nil, errInvalidArgument("Lambda ARN cannot be empty")
+	}
+
+	// The options may be reused, leave the maps of the caller untouched.
+	opts.PromptArgs = maps.Clone(opts.PromptArgs)
+	opts.headers = maps.Clone(opts.headers)
+	opts.reqParams = maps.Clone(opts.reqParams)
 	opts.AddLambdaArnToReqParams(opts.LambdaArn)
 	opts.SetHeader("Content-Type", "application/json")
 	opts.AddPromptArg("prompt", prompt)
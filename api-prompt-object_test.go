@@ -0,0 +1,97 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestPromptObject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/bucket/object" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if arns := r.URL.Query()["lambdaArn"]; !reflect.DeepEqual(arns, []string{"arn:minio:s3-object-lambda::prompt:webhook"}) {
+			t.Errorf("unexpected lambda ARNs %v", arns)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("unexpected content type %s", ct)
+		}
+		var args map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+			t.Error(err)
+		}
+		expected := map[string]any{
+			"prompt":      "summarize",
+			"model":       "llama",
+			"temperature": 0.5,
+			"max_tokens":  float64(100),
+			"top_p":       0.9,
+		}
+		if !reflect.DeepEqual(args, expected) {
+			t.Errorf("expected prompt arguments %v, got %v", expected, args)
+		}
+		io.WriteString(w, "summary")
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := PromptObjectOptions{LambdaArn: "arn:minio:s3-object-lambda::prompt:webhook"}
+	opts.SetModel("llama")
+	opts.SetTemperature(0.5)
+	opts.SetMaxTokens(100)
+	opts.SetTopP(0.9)
+	// The options may be reused.
+	for i := 0; i < 2; i++ {
+		r, err := clnt.PromptObject(context.Background(), "bucket", "object", "summarize", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "summary" {
+			t.Fatalf("unexpected response %q", b)
+		}
+	}
+	if _, ok := opts.PromptArgs["prompt"]; ok {
+		t.Error("the prompt arguments of the options were modified")
+	}
+
+	if _, err := clnt.PromptObject(context.Background(), "bucket", "object", "summarize", PromptObjectOptions{}); err == nil {
+		t.Error("expected an error without a Lambda ARN")
+	}
+}
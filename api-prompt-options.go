@@ -59,6 +59,35 @@ func (o *PromptObjectOptions) AddLambdaArnToReqParams(lambdaArn string) {
 	o.reqParams.Add("lambdaArn", lambdaArn)
 }
 
+// Conventional names of the model parameters in the prompt arguments,
+// the Prompt Lambda decides which parameters it supports.
+const (
+	PromptArgModel       = "model"
+	PromptArgTemperature = "temperature"
+	PromptArgMaxTokens   = "max_tokens"
+	PromptArgTopP        = "top_p"
+)
+
+// SetModel sets the model to use for the inference.
+func (o *PromptObjectOptions) SetModel(model string) {
+	o.AddPromptArg(PromptArgModel, model)
+}
+
+// SetTemperature sets the sampling temperature of the model.
+func (o *PromptObjectOptions) SetTemperature(temperature float64) {
+	o.AddPromptArg(PromptArgTemperature, temperature)
+}
+
+// SetMaxTokens sets the maximum number of tokens to generate.
+func (o *PromptObjectOptions) SetMaxTokens(maxTokens int) {
+	o.AddPromptArg(PromptArgMaxTokens, maxTokens)
+}
+
+// SetTopP sets the nucleus sampling probability of the model.
+func (o *PromptObjectOptions) SetTopP(topP float64) {
+	o.AddPromptArg(PromptArgTopP, topP)
+}
+
 // SetHeader adds a key value pair to the options. The
 // key-value pair will be part of the HTTP POST request
 // headers.
@@ -0,0 +1,84 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// publicStripHeaders are only meaningful for authenticated
+// requests and are never sent by a public client.
+var publicStripHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-S3session-Token",
+}
+
+// Public returns a client sharing the transport of c which never signs its
+// requests nor sends authentication headers, regardless of the credentials
+// of c or of the request. Some providers reply 403 to signed requests on
+// public datasets made with credentials of another account.
+func (c *Client) Public() *Client {
+	clnt := c.clone()
+	clnt.credsProvider = credentials.New(&credentials.Static{
+		Value: credentials.Value{SignerType: credentials.SignatureAnonymous},
+	})
+	clnt.overrideSignerType = credentials.SignatureAnonymous
+	clnt.customSigner = nil
+	// Anonymous location lookups fallback to a default region when
+	// denied, which must not be cached for the signed client.
+	clnt.bucketLocCache = &BucketLocationCache{}
+	return clnt
+}
+
+// CheckBucketPublic reports whether the objects of a bucket can be listed
+// anonymously. It returns false if anonymous listing is denied and an
+// error if the bucket does not exist.
+func (c *Client) CheckBucketPublic(ctx context.Context, bucketName string) (bool, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return false, err
+	}
+
+	urlValues := make(url.Values)
+	urlValues.Set("list-type", "2")
+	urlValues.Set("max-keys", "1")
+
+	resp, err := c.Public().executeMethod(ctx, http.MethodGet, requestMetadata{
+		bucketName:       bucketName,
+		queryValues:      urlValues,
+		contentSHA256Hex: emptySHA256Hex,
+	})
+	defer closeResponse(resp)
+	if err == nil && resp.StatusCode != http.StatusOK {
+		err = httpRespToErrorResponse(resp, bucketName, "")
+	}
+	if err != nil {
+		switch ToErrorResponse(err).StatusCode {
+		case http.StatusForbidden, http.StatusUnauthorized:
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,80 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestPublicClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, h := range publicStripHeaders {
+			if r.Header.Get(h) != "" {
+				t.Errorf("Unexpected %s header in public request", h)
+			}
+		}
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/private"):
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+		case strings.HasPrefix(r.URL.Path, "/missing"):
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`))
+		case r.Method == http.MethodHead:
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		default:
+			w.Write([]byte(`<ListBucketResult><Name>public</Name></ListBucketResult>`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", "token"),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for bucket, public := range map[string]bool{"public": true, "private": false} {
+		ok, err := c.CheckBucketPublic(ctx, bucket)
+		if err != nil {
+			t.Fatalf("%s: %v", bucket, err)
+		}
+		if ok != public {
+			t.Errorf("%s: expected public %v, got %v", bucket, public, ok)
+		}
+	}
+	if _, err = c.CheckBucketPublic(ctx, "missing"); ToErrorResponse(err).Code != NoSuchBucket {
+		t.Fatalf("Expected NoSuchBucket, got %v", err)
+	}
+
+	opts := StatObjectOptions{}
+	opts.Set("Authorization", "Bearer secret")
+	if _, err = c.Public().StatObject(ctx, "public", "object", opts); err != nil {
+		t.Fatal(err)
+	}
+}
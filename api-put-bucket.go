@@ -21,33 +21,96 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
 // Bucket operations
 func (c *Client) makeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) (err error) {
+	if opts.OutpostID != "" {
+		return c.makeOutpostsBucket(ctx, bucketName, opts)
+	}
+
 	// Validate the input arguments.
-	if err := s3utils.CheckValidBucketNameStrict(bucketName); err != nil {
+	if err := s3utils.ValidateBucketName(bucketName, c.namingProfile); err != nil {
+		return err
+	}
+	var (
+		bucketTags *tags.Tags
+		mode       *RetentionMode
+		validity   *uint
+		unit       *ValidityUnit
+	)
+	if len(opts.Tags) > 0 {
+		if bucketTags, err = tags.NewTags(opts.Tags, false); err != nil {
+			return err
+		}
+	}
+	if err = opts.Grants.validate(); err != nil {
 		return err
 	}
+	if opts.DefaultRetention != nil {
+		if mode, validity, unit, err = defaultRetentionConfig(*opts.DefaultRetention); err != nil {
+			return err
+		}
+		if !opts.ObjectLocking {
+			return errInvalidArgument("Default retention requires object locking")
+		}
+	}
 
 	err = c.doMakeBucket(ctx, bucketName, opts)
 	if err != nil && (opts.Region == "" || opts.Region == "us-east-1") {
-		if resp, ok := err.(ErrorResponse); ok && resp.Code == AuthorizationHeaderMalformed && resp.Region != "" {
+		if resp := ToErrorResponse(err); resp.Code == AuthorizationHeaderMalformed && resp.Region != "" {
 			opts.Region = resp.Region
 			err = c.doMakeBucket(ctx, bucketName, opts)
 		}
 	}
-	return err
+	if err != nil && opts.ForceCreate && errors.Is(err, ErrBucketAlreadyOwnedByYou) {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if mode != nil {
+		if err = c.SetObjectLockConfig(ctx, bucketName, mode, validity, unit); err != nil {
+			return err
+		}
+	}
+	if bucketTags != nil {
+		if err = c.SetBucketTagging(ctx, bucketName, bucketTags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultRetentionConfig returns the object lock configuration of a
+// default retention, whose validity is a whole number of days.
+func defaultRetentionConfig(r Retention) (*RetentionMode, *uint, *ValidityUnit, error) {
+	const day = 24 * time.Hour
+	if !r.Mode.IsValid() {
+		return nil, nil, nil, errInvalidArgument(r.Mode.String() + " unsupported retention mode")
+	}
+	if r.Validity <= 0 || r.Validity%day != 0 {
+		return nil, nil, nil, errInvalidArgument("Default retention must be a whole number of days")
+	}
+	validity, unit := uint(r.Validity/day), Days
+	if validity%365 == 0 {
+		validity, unit = validity/365, Years
+	}
+	return &r.Mode, &validity, &unit, nil
 }
 
 func (c *Client) doMakeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) (err error) {
 	defer func() {
 		// Save the location into cache on a successful makeBucket response.
 		if err == nil {
-			c.bucketLocCache.Set(bucketName, opts.Region)
+			c.bucketLocCache.set(c.bucketLocationEndpoint(ctx, bucketName), bucketName, opts.Region)
 		}
 	}()
 
@@ -73,18 +136,27 @@ func (c *Client) doMakeBucket(ctx context.Context, bucketName string, opts MakeB
 	if opts.ForceCreate {
 		headers.Add("x-minio-force-create", "true")
 	}
+	opts.Grants.Marshal(headers)
 	reqMetadata.customHeader = headers
 
-	// If location is not 'us-east-1' create bucket location config.
-	if opts.Region != "us-east-1" && opts.Region != "" {
+	// Directory buckets are created in the availability zone of their
+	// name, other buckets outside of 'us-east-1' in their region.
+	zoneID := s3utils.S3ExpressAvailabilityZoneID(bucketName)
+	if zoneID != "" || (opts.Region != "us-east-1" && opts.Region != "") {
 		createBucketConfig := createBucketConfiguration{}
-		createBucketConfig.Location = opts.Region
+		if zoneID != "" {
+			createBucketConfig.DirectoryLocation = &directoryBucketLocation{Name: zoneID, Type: "AvailabilityZone"}
+			createBucketConfig.Bucket = &directoryBucketInfo{DataRedundancy: "SingleAvailabilityZone", Type: "Directory"}
+		} else {
+			createBucketConfig.Location = opts.Region
+		}
 		var createBucketConfigBytes []byte
 		createBucketConfigBytes, err = xml.Marshal(createBucketConfig)
 		if err != nil {
 			return err
 		}
-		reqMetadata.contentMD5Base64 = sumMD5Base64(createBucketConfigBytes)
+		reqMetadata.contentMD5Base64 = c.contentMD5Base64(createBucketConfigBytes)
+		reqMetadata.md5Required = true
 		reqMetadata.contentSHA256Hex = sum256Hex(createBucketConfigBytes)
 		reqMetadata.contentBody = bytes.NewReader(createBucketConfigBytes)
 		reqMetadata.contentLength = int64(len(createBucketConfigBytes))
@@ -114,8 +186,30 @@ type MakeBucketOptions struct {
 	// Enable object locking
 	ObjectLocking bool
 
-	// ForceCreate - this is a MinIO specific extension.
+	// ForceCreate - this is a MinIO specific extension. Creating a
+	// bucket which is already owned by the caller succeeds, instead of
+	// failing with ErrBucketAlreadyOwnedByYou, and its default retention
+	// and tags are still set.
 	ForceCreate bool
+
+	// DefaultRetention is the default retention of the objects of the
+	// bucket, its validity must be a whole number of days. It requires
+	// ObjectLocking.
+	DefaultRetention *Retention
+
+	// Tags are set on the bucket once created.
+	Tags map[string]string
+
+	// Grants are the permissions granted on the bucket, e.g. to other
+	// accounts by canonical user ID.
+	Grants Grants
+
+	// OutpostID creates the bucket on the S3 on Outposts outpost with
+	// the ID, e.g. op-01ac5d28a6a232904. The bucket is then managed by
+	// its ARN and its objects are accessed through access points.
+	OutpostID string
+
+	requestExtensions
 }
 
 // MakeBucket creates a new bucket with bucketName with a context to control cancellations and timeouts.
@@ -125,6 +219,14 @@ type MakeBucketOptions struct {
 //
 // For Amazon S3 for more supported regions - http://docs.aws.amazon.com/general/latest/gr/rande.html
 // For Google Cloud Storage for more supported regions - https://cloud.google.com/storage/docs/bucket-locations
+//
+// S3 Express One Zone directory buckets, e.g. name--usw2-az1--x-s3, are
+// created in the availability zone of their name.
+//
+// The default retention and the tags are set once the bucket is created,
+// the bucket is not removed when setting them fails.
 func (c *Client) MakeBucket(ctx context.Context, bucketName string, opts MakeBucketOptions) (err error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	return c.makeBucket(ctx, bucketName, opts)
 }
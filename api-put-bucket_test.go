@@ -0,0 +1,136 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+func TestMakeBucketNamingProfile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+
+	newClient := func(profile s3utils.NamingProfile) *Client {
+		clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:         credentials.NewStaticV4("access", "secret", ""),
+			Region:        "us-east-1",
+			NamingProfile: profile,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clnt
+	}
+
+	ctx := context.Background()
+	if err := newClient(s3utils.NamingDefault).MakeBucket(ctx, "Legacy_Bucket", MakeBucketOptions{}); err != nil {
+		t.Fatalf("expected the default rules to accept the name, got %v", err)
+	}
+	if err := newClient(s3utils.NamingAWS).MakeBucket(ctx, "Legacy_Bucket", MakeBucketOptions{}); err == nil {
+		t.Fatal("expected the AWS rules to reject the name")
+	}
+	// Endpoints of unknown servers accept all object names.
+	if _, err := newClient(s3utils.NamingDefault).PutObject(ctx, "bucket", "a/../b", strings.NewReader("data"), 4, PutObjectOptions{}); err != nil {
+		t.Fatalf("expected the default rules to accept the object name, got %v", err)
+	}
+	if _, err := newClient(s3utils.NamingMinIO).PutObject(ctx, "bucket", "a/../b", strings.NewReader("data"), 4, PutObjectOptions{}); err == nil {
+		t.Fatal("expected the MinIO rules to reject the object name")
+	}
+}
+
+func TestMakeBucketOptions(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests = make(map[string]string)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests[r.URL.RawQuery] = string(body)
+		mu.Unlock()
+		if r.URL.RawQuery == "" && strings.HasPrefix(r.URL.Path, "/owned") {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`<Error><Code>BucketAlreadyOwnedByYou</Code></Error>`))
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	opts := MakeBucketOptions{
+		ObjectLocking:    true,
+		DefaultRetention: &Retention{Mode: Governance, Validity: 30 * 24 * time.Hour},
+		Tags:             map[string]string{"team": "storage"},
+	}
+
+	err = clnt.MakeBucket(ctx, "owned", opts)
+	if !errors.Is(err, ErrBucketAlreadyOwnedByYou) {
+		t.Fatalf("expected ErrBucketAlreadyOwnedByYou, got %v", err)
+	}
+	opts.ForceCreate = true
+	if err = clnt.MakeBucket(ctx, "owned", opts); err != nil {
+		t.Fatal(err)
+	}
+	if lock := requests["object-lock="]; !strings.Contains(lock, "<Mode>GOVERNANCE</Mode><Days>30</Days>") {
+		t.Errorf("unexpected object lock configuration %s", lock)
+	}
+	if tagging := requests["tagging="]; !strings.Contains(tagging, "<Key>team</Key><Value>storage</Value>") {
+		t.Errorf("unexpected tagging %s", tagging)
+	}
+
+	clear(requests)
+	if err = clnt.MakeBucket(ctx, "bucket--usw2-az1--x-s3", MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	config := requests[""]
+	if !strings.Contains(config, "<Location><Name>usw2-az1</Name><Type>AvailabilityZone</Type></Location>") ||
+		!strings.Contains(config, "<Bucket><DataRedundancy>SingleAvailabilityZone</DataRedundancy><Type>Directory</Type></Bucket>") ||
+		strings.Contains(config, "LocationConstraint") {
+		t.Errorf("unexpected directory bucket configuration %s", config)
+	}
+
+	clear(requests)
+	for _, retention := range []Retention{{Mode: Governance, Validity: time.Hour}, {Validity: 24 * time.Hour}} {
+		if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{ObjectLocking: true, DefaultRetention: &retention}); err == nil {
+			t.Errorf("expected retention %v to be rejected", retention)
+		}
+	}
+	if err = clnt.MakeBucket(ctx, "bucket", MakeBucketOptions{DefaultRetention: opts.DefaultRetention}); err == nil {
+		t.Error("expected default retention without object locking to be rejected")
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no requests, got %v", requests)
+	}
+}
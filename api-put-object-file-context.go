@@ -19,15 +19,25 @@ package minio
 
 import (
 	"context"
+	"io"
 	"mime"
 	"os"
 	"path/filepath"
+	"syscall"
 
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
 // FPutObject - Create an object in a bucket, with contents from file at filePath. Allows request cancellation.
+//
+// The parts of multipart uploads are sent from the file without copies,
+// with sendfile(2), over plain HTTP when the payload is unsigned, i.e.
+// with DisableContentSha256, and neither trailing checksums, progress nor
+// SSE-C are used. TLS encrypts in userspace, so it never uses sendfile.
 func (c *Client) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts PutObjectOptions) (info UploadInfo, err error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return UploadInfo{}, err
@@ -62,3 +72,108 @@ func (c *Client) FPutObject(ctx context.Context, bucketName, objectName, filePat
 	}
 	return c.PutObject(ctx, bucketName, objectName, fileReader, fileSize, opts)
 }
+
+// zeroCopyParts returns true if the parts of an upload from a file are
+// sent from the file as is, which lets net/http send them with
+// sendfile(2). It requires plain HTTP, as TLS encrypts in userspace, and
+// an unsigned payload without trailers, progress or SSE-C.
+func (c *Client) zeroCopyParts(opts PutObjectOptions) bool {
+	if opts.Progress != nil || opts.progress != nil || opts.SendContentMd5 {
+		return false
+	}
+	if opts.ServerSideEncryption != nil && opts.ServerSideEncryption.Type() == encrypt.SSEC {
+		return false
+	}
+	return c.overrideSignerType.IsV4() && !c.secure && opts.DisableContentSha256
+}
+
+// filePart is a part of a file which net/http sends with sendfile(2),
+// as it exposes the descriptor of the file. It is read from the offset
+// of the file, which sendfile advances.
+type filePart struct {
+	f          *os.File
+	start, end int64
+}
+
+// openFilePart opens the file r again to send size bytes of it from
+// offset concurrently with other parts.
+func openFilePart(r io.ReaderAt, offset, size int64) (*filePart, error) {
+	f, ok := r.(*os.File)
+	if !ok {
+		return nil, errInvalidArgument("not a file")
+	}
+	part, err := os.Open(f.Name())
+	if err != nil {
+		return nil, err
+	}
+	// The file may have been replaced since it was opened.
+	fi, err := f.Stat()
+	if err != nil {
+		part.Close()
+		return nil, err
+	}
+	partFi, err := part.Stat()
+	if err != nil || !os.SameFile(fi, partFi) {
+		part.Close()
+		return nil, errInvalidArgument("file changed")
+	}
+	if _, err = part.Seek(offset, io.SeekStart); err != nil {
+		part.Close()
+		return nil, err
+	}
+	return &filePart{f: part, start: offset, end: offset + size}, nil
+}
+
+func (p *filePart) Read(b []byte) (int, error) {
+	pos, err := p.f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	if pos >= p.end {
+		return 0, io.EOF
+	}
+	if int64(len(b)) > p.end-pos {
+		b = b[:p.end-pos]
+	}
+	return p.f.Read(b)
+}
+
+// Seek seeks relative to the start of the part.
+func (p *filePart) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		offset += p.start
+	case io.SeekCurrent:
+		pos, err := p.f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, err
+		}
+		offset += pos
+	case io.SeekEnd:
+		offset += p.end
+	default:
+		return 0, errInvalidArgument("invalid whence")
+	}
+	if offset < p.start {
+		return 0, errInvalidArgument("negative position")
+	}
+	if _, err := p.f.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return offset - p.start, nil
+}
+
+// SyscallConn returns the descriptor of the file for sendfile(2).
+func (p *filePart) SyscallConn() (syscall.RawConn, error) {
+	return p.f.SyscallConn()
+}
+
+// Close does nothing, so that the part can be sent again by retries.
+func (p *filePart) Close() error {
+	return nil
+}
+
+// close closes the file.
+func (p *filePart) close() error {
+	return p.f.Close()
+}
@@ -102,7 +102,11 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 	partsInfo := make(map[int]ObjectPart)
 
 	// Create a buffer.
-	buf := make([]byte, partSize)
+	buf, releaseBuf, err := c.allocBuffer(ctx, partSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer releaseBuf()
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -127,7 +131,7 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
-		rd := newHook(bytes.NewReader(buf[:length]), opts.Progress)
+		rd := newHook(opts.progress.part(bytes.NewReader(buf[:length]), partNumber), opts.Progress)
 
 		// Checksums..
 		var (
@@ -318,7 +322,7 @@ func (c *Client) uploadPart(ctx context.Context, p uploadPartParams) (ObjectPart
 	// Unless you are using a customer-provided encryption key, you don't need
 	// to specify the encryption parameters in each UploadPart request.
 	if p.sse != nil && p.sse.Type() == encrypt.SSEC {
-		p.sse.Marshal(p.customHeader)
+		encrypt.SSE(p.sse).Marshal(p.customHeader)
 	}
 
 	reqMetadata := requestMetadata{
@@ -30,6 +30,7 @@ import (
 	"sync"
 
 	"github.com/google/uuid"
+	md5simd "github.com/minio/md5-simd"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
@@ -115,7 +116,8 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 		return UploadInfo{}, err
 	}
 
-	withChecksum := c.trailingHeaderSupport
+	withChecksum := c.putTrailingChecksums(opts)
+	zeroCopy := !withChecksum && c.zeroCopyParts(opts)
 
 	// Aborts the multipart upload in progress, if the
 	// function returns any error, since we do not resume
@@ -185,7 +187,13 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 					partSize = lastPartSize
 				}
 
-				sectionReader := newHook(io.NewSectionReader(reader, readOffset, partSize), opts.Progress)
+				sectionReader := newHook(opts.progress.part(io.NewSectionReader(reader, readOffset, partSize), uploadReq.PartNum), opts.Progress)
+				var partFile *filePart
+				if zeroCopy {
+					if f, err := openFilePart(reader, readOffset, partSize); err == nil {
+						partFile, sectionReader = f, f
+					}
+				}
 				trailer := make(http.Header, 1)
 				if withChecksum {
 					crc := opts.AutoChecksum.Hasher()
@@ -209,6 +217,9 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 					trailer:      trailer,
 				}
 				objPart, err := c.uploadPart(ctx, p)
+				if partFile != nil {
+					partFile.close()
+				}
 				if err != nil {
 					uploadedPartsCh <- uploadedPartRes{
 						Error: err,
@@ -317,8 +328,11 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
 	customHeader := make(http.Header)
 	crc := opts.AutoChecksum.Hasher()
-	md5Hash := c.md5Hasher()
-	defer md5Hash.Close()
+	var md5Hash md5simd.Hasher
+	if opts.SendContentMd5 {
+		md5Hash = c.md5Hasher()
+		defer md5Hash.Close()
+	}
 
 	// Total data read and written to server. should be equal to 'size' at the end of the call.
 	var totalUploadedSize int64
@@ -327,7 +341,11 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 	partsInfo := make(map[int]ObjectPart)
 
 	// Create a buffer.
-	buf := make([]byte, partSize)
+	buf, releaseBuf, err := c.allocBuffer(ctx, partSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer releaseBuf()
 
 	// Avoid declaring variables in the for loop
 	var md5Base64 string
@@ -370,7 +388,7 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
-		hooked := newHook(bytes.NewReader(buf[:length]), opts.Progress)
+		hooked := newHook(opts.progress.part(bytes.NewReader(buf[:length]), partNumber), opts.Progress)
 		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: hooked, partNumber: partNumber, md5Base64: md5Base64, size: partSize, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
 		objPart, uerr := c.uploadPart(ctx, p)
 		if uerr != nil {
@@ -483,8 +501,16 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 
 	// Create a buffer.
 	nBuffers := int64(opts.NumThreads)
+	if c.bufferMemory != nil {
+		// Do not buffer more parts than fit in the memory budget.
+		nBuffers = max(min(nBuffers, c.bufferMemory.size/partSize), 1)
+	}
 	bufs := make(chan []byte, nBuffers)
-	all := make([]byte, nBuffers*partSize)
+	all, releaseBufs, err := c.allocBuffer(ctx, nBuffers*partSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer releaseBufs()
 	for i := int64(0); i < nBuffers; i++ {
 		bufs <- all[i*partSize : i*partSize+partSize]
 	}
@@ -555,7 +581,7 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 				bucketName:   bucketName,
 				objectName:   objectName,
 				uploadID:     uploadID,
-				reader:       bytes.NewReader(buf[:length]),
+				reader:       opts.progress.part(bytes.NewReader(buf[:length]), partNumber),
 				partNumber:   partNumber,
 				md5Base64:    md5Base64,
 				size:         int64(length),
@@ -683,7 +709,11 @@ func (c *Client) putObject(ctx context.Context, bucketName, objectName string, r
 			}
 		} else {
 			// Create a buffer.
-			buf := make([]byte, size)
+			buf, releaseBuf, err := c.allocBuffer(ctx, size)
+			if err != nil {
+				return UploadInfo{}, err
+			}
+			defer releaseBuf()
 
 			length, err := readFull(reader, buf)
 			if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
@@ -700,7 +730,7 @@ func (c *Client) putObject(ctx context.Context, bucketName, objectName string, r
 
 	// Update progress reader appropriately to the latest offset as we
 	// read from the source.
-	progressReader := newHook(reader, opts.Progress)
+	progressReader := newHook(opts.progress.part(reader, 0), opts.Progress)
 
 	// This function does not calculate sha256 and md5sum for payload.
 	// Execute put object.
@@ -732,13 +762,14 @@ func (c *Client) putObjectDo(ctx context.Context, bucketName, objectName string,
 		streamSha256:     !opts.DisableContentSha256,
 	}
 	// Add CRC when client supports it, MD5 is not set, not Google and we don't add SHA256 to chunks.
-	addCrc := c.trailingHeaderSupport && md5Base64 == "" && !s3utils.IsGoogleEndpoint(*c.endpointURL) && (opts.DisableContentSha256 || c.secure)
+	addCrc := c.putTrailingChecksums(opts) && md5Base64 == "" && !s3utils.IsGoogleEndpoint(*c.endpointURL) && (opts.DisableContentSha256 || c.secure)
 	if opts.Checksum.IsSet() {
 		reqMetadata.addCrc = &opts.Checksum
 	} else if addCrc {
 		// If user has added checksums, don't add them ourselves.
 		for k := range opts.UserMetadata {
-			if strings.HasPrefix(strings.ToLower(k), "x-amz-checksum-") {
+			k = strings.ToLower(k)
+			if strings.HasPrefix(k, "x-amz-checksum-") && k != amzChecksumAlgo && k != amzChecksumMode {
 				addCrc = false
 			}
 		}
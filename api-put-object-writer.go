@@ -0,0 +1,85 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+)
+
+// ObjectWriter uploads the data written to it as an object, see
+// Client.NewObjectWriter.
+type ObjectWriter struct {
+	pw *io.PipeWriter
+
+	done chan struct{}
+	info UploadInfo
+	err  error
+}
+
+// NewObjectWriter returns a writer which uploads the data written to it
+// as an object. The data is buffered into parts of opts.PartSize, which
+// are uploaded in the background while writing continues, the upload
+// completes on Close.
+//
+// The size of the object is unknown, so the default part size allows
+// objects of up to 5TiB and is large, set opts.PartSize to limit the
+// memory used. Set opts.ConcurrentStreamParts and opts.NumThreads to
+// upload several parts concurrently.
+//
+// Writes fail with the error of the upload once it fails, the upload
+// is aborted by CloseWithError or by canceling ctx.
+func (c *Client) NewObjectWriter(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) *ObjectWriter {
+	pr, pw := io.Pipe()
+	w := &ObjectWriter{
+		pw:   pw,
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(w.done)
+		w.info, w.err = c.PutObject(ctx, bucketName, objectName, pr, -1, opts)
+		// Fail the writes once the upload failed.
+		pr.CloseWithError(w.err)
+	}()
+	return w
+}
+
+// Write writes p to the object.
+func (w *ObjectWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Close completes the upload and waits until it is done.
+func (w *ObjectWriter) Close() error {
+	return w.CloseWithError(nil)
+}
+
+// CloseWithError aborts the upload with err, or completes it if err is
+// nil, and waits until it is done. It returns the error of the upload.
+func (w *ObjectWriter) CloseWithError(err error) error {
+	w.pw.CloseWithError(err)
+	<-w.done
+	return w.err
+}
+
+// Info returns the result of the upload, it is only valid after Close
+// returned no error.
+func (w *ObjectWriter) Info() UploadInfo {
+	<-w.done
+	return w.info
+}
@@ -0,0 +1,109 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestObjectWriter(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		parts     = make(map[string]string)
+		completed bool
+		aborted   bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && query.Has("uploads"):
+			fmt.Fprint(w, `<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><UploadId>upload</UploadId></InitiateMultipartUploadResult>`)
+		case r.Method == http.MethodPut && query.Has("partNumber"):
+			io.Copy(io.Discard, r.Body)
+			size := r.Header.Get("X-Amz-Decoded-Content-Length")
+			if size == "" {
+				size = strconv.FormatInt(r.ContentLength, 10)
+			}
+			parts[query.Get("partNumber")] = size
+			w.Header().Set("ETag", `"etag"`)
+		case r.Method == http.MethodPost && query.Has("uploadId"):
+			completed = true
+			fmt.Fprint(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-2"</ETag></CompleteMultipartUploadResult>`)
+		case r.Method == http.MethodDelete:
+			aborted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := bytes.Repeat([]byte("a"), minPartSize+100)
+	w := clnt.NewObjectWriter(context.Background(), "bucket", "object", PutObjectOptions{PartSize: minPartSize})
+	for i := 0; i < len(data); i += 1000 {
+		if _, err = w.Write(data[i:min(i+1000, len(data))]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 2 || parts["1"] != strconv.Itoa(minPartSize) || parts["2"] != "100" || !completed {
+		t.Fatalf("unexpected upload: parts %v, completed %v", parts, completed)
+	}
+	if info := w.Info(); info.ETag != "etag-2" || info.Size != int64(len(data)) {
+		t.Fatalf("unexpected upload info: %+v", info)
+	}
+
+	errAbort := errors.New("abort")
+	w = clnt.NewObjectWriter(context.Background(), "bucket", "object", PutObjectOptions{PartSize: minPartSize})
+	if _, err = w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.CloseWithError(errAbort); !errors.Is(err, errAbort) {
+		t.Fatalf("expected the abort error, got %v", err)
+	}
+	if !aborted {
+		t.Fatal("expected the multipart upload to be aborted")
+	}
+	if _, err = w.Write(data); err == nil {
+		t.Fatal("expected writes to fail after the upload failed")
+	}
+}
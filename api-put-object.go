@@ -24,15 +24,17 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"math"
 	"net/http"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/tags"
-	"golang.org/x/net/http/httpguts"
 )
 
 // ReplicationStatus represents replication status of object
@@ -105,13 +107,56 @@ type PutObjectOptions struct {
 	// This will disable content MD5 checksums if set.
 	Checksum ChecksumType
 
+	// UnsignedPayloadTrailer sends the content unsigned with a trailing
+	// checksum (STREAMING-UNSIGNED-PAYLOAD-TRAILER), which skips the
+	// SHA256 of the payload while its integrity is still verified by the
+	// server. The checksum is AutoChecksum, CRC32C by default. It enables
+	// trailing checksums for the upload even if the client was created
+	// without "TrailingHeaders:true" and requires a TLS connection.
+	UnsignedPayloadTrailer bool
+
 	// ConcurrentStreamParts will create NumThreads buffers of PartSize bytes,
 	// fill them serially and upload them in parallel.
 	// This can be used for faster uploads on non-seekable or slow-to-seek input.
 	ConcurrentStreamParts bool
-	Internal              AdvancedPutOptions
+
+	// MultipartThreshold is the size above which objects are uploaded in
+	// parts, smaller objects are uploaded with a single PUT. It defaults
+	// to the part size, 16MiB if PartSize is not set, and is capped to
+	// the largest single PUT of the endpoint, 5GiB for AWS S3. When set,
+	// streams of unknown size are buffered up to the threshold to upload
+	// them with a single PUT if they fit.
+	MultipartThreshold uint64
+
+	// SizeHint is the expected size of streams of unknown size, the part
+	// size is chosen for it when PartSize is not set instead of for the
+	// largest object, i.e. 5TiB. Uploads of streams larger than 10000
+	// parts of the chosen size fail.
+	SizeHint int64
+
+	// Grants are the permissions granted on the object, e.g. to other
+	// accounts by canonical user ID.
+	Grants Grants
+
+	Internal AdvancedPutOptions
+
+	// Credentials of the request, overrides the credentials
+	// of the Client, see WithCredentials.
+	Credentials *credentials.Credentials
+
+	// RetryPolicy of the request, overrides the retry policy
+	// of the Client, see WithRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// ProgressFunc receives the progress of the upload, including the
+	// part numbers and the retries of parts. Unlike Progress it knows
+	// the size of the upload.
+	ProgressFunc ProgressFunc
 
 	customHeaders http.Header
+	progress      *transferProgress
+
+	requestExtensions
 }
 
 // SetMatchETag if etag matches while PUT MinIO returns an error
@@ -194,7 +239,7 @@ func (opts PutObjectOptions) Header() (header http.Header) {
 	}
 
 	if opts.ServerSideEncryption != nil {
-		opts.ServerSideEncryption.Marshal(header)
+		encrypt.SSE(opts.ServerSideEncryption).Marshal(header)
 	}
 
 	if opts.StorageClass != "" {
@@ -236,6 +281,8 @@ func (opts PutObjectOptions) Header() (header http.Header) {
 		}
 	}
 
+	opts.Grants.Marshal(header)
+
 	for k, v := range opts.UserMetadata {
 		if isAmzHeader(k) || isStandardHeader(k) || isStorageClassHeader(k) || isMinioHeader(k) {
 			header.Set(k, v)
@@ -254,13 +301,16 @@ func (opts PutObjectOptions) Header() (header http.Header) {
 
 // validate() checks if the UserMetadata map has standard headers or and raises an error if so.
 func (opts PutObjectOptions) validate(c *Client) (err error) {
-	for k, v := range opts.UserMetadata {
-		if !httpguts.ValidHeaderFieldName(k) || isStandardHeader(k) || isSSEHeader(k) || isStorageClassHeader(k) || isMinioHeader(k) {
+	for k := range opts.UserMetadata {
+		if isStandardHeader(k) || isSSEHeader(k) || isStorageClassHeader(k) || isMinioHeader(k) {
 			return errInvalidArgument(k + " unsupported user defined metadata name")
 		}
-		if !httpguts.ValidHeaderFieldValue(v) {
-			return errInvalidArgument(v + " unsupported user defined metadata value")
-		}
+	}
+	if err = c.validateMetadata(opts.UserMetadata, opts.UserTags); err != nil {
+		return err
+	}
+	if err = opts.Grants.validate(); err != nil {
+		return err
 	}
 	if opts.Mode != "" && !opts.Mode.IsValid() {
 		return errInvalidArgument(opts.Mode.String() + " unsupported retention mode")
@@ -268,6 +318,9 @@ func (opts PutObjectOptions) validate(c *Client) (err error) {
 	if opts.LegalHold != "" && !opts.LegalHold.IsValid() {
 		return errInvalidArgument(opts.LegalHold.String() + " unsupported legal-hold status")
 	}
+	if opts.StorageClass != "" && !StorageClass(opts.StorageClass).IsSupportedBy(*c.endpointURL) {
+		return errInvalidArgument(opts.StorageClass + " unsupported storage class")
+	}
 
 	checkCrc := false
 	for k := range opts.UserMetadata {
@@ -279,7 +332,9 @@ func (opts PutObjectOptions) validate(c *Client) (err error) {
 
 	if opts.Checksum.IsSet() || checkCrc {
 		switch {
-		case !c.trailingHeaderSupport:
+		case c.trailingHeaderSupport && c.quirks().noTrailingChecksums:
+			return errInvalidArgument("Checksum cannot be used with " + c.Profile().String() + " endpoints")
+		case !c.putTrailingChecksums(opts):
 			return errInvalidArgument("Checksum requires Client with TrailingHeaders enabled")
 		case c.overrideSignerType.IsV2():
 			return errInvalidArgument("Checksum cannot be used with v2 signatures")
@@ -288,6 +343,25 @@ func (opts PutObjectOptions) validate(c *Client) (err error) {
 		}
 	}
 
+	if opts.SendContentMd5 && c.disableMD5 {
+		return errInvalidArgument("SendContentMd5 cannot be used with DisableMD5")
+	}
+
+	if opts.UnsignedPayloadTrailer {
+		switch {
+		case !c.secure:
+			return errInvalidArgument("UnsignedPayloadTrailer requires a TLS connection")
+		case !c.overrideSignerType.IsV4():
+			return errInvalidArgument("UnsignedPayloadTrailer requires v4 signatures")
+		case c.quirks().noTrailingChecksums:
+			return errInvalidArgument("UnsignedPayloadTrailer cannot be used with " + c.Profile().String() + " endpoints")
+		case s3utils.IsGoogleEndpoint(*c.endpointURL):
+			return errInvalidArgument("UnsignedPayloadTrailer cannot be used with GCS endpoints")
+		case opts.SendContentMd5:
+			return errInvalidArgument("UnsignedPayloadTrailer cannot be used with SendContentMd5")
+		}
+	}
+
 	return nil
 }
 
@@ -320,42 +394,97 @@ func (a completedParts) Less(i, j int) bool { return a[i].PartNumber < a[j].Part
 func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64,
 	opts PutObjectOptions,
 ) (info UploadInfo, err error) {
+	opts = mergePutOptions(ctx, opts)
 	if size < 0 && opts.DisableMultipart {
 		return UploadInfo{}, errors.New("object size must be provided with disable multipart upload")
 	}
+	ctx = WithCredentials(ctx, opts.Credentials)
+	ctx = WithRetryPolicy(ctx, opts.RetryPolicy)
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
 
 	err = opts.validate(c)
 	if err != nil {
 		return UploadInfo{}, err
 	}
+	if err = s3utils.ValidateObjectKey(objectName, c.namingProfile); err != nil {
+		return UploadInfo{}, err
+	}
+	opts.progress = newTransferProgress(ctx, size, opts.ProgressFunc)
 
 	// Check for largest object size allowed.
-	if size > int64(maxMultipartPutObjectSize) {
-		return UploadInfo{}, errEntityTooLarge(size, maxMultipartPutObjectSize, bucketName, objectName)
+	l := c.Limits()
+	if size > l.MaxObjectSize {
+		return UploadInfo{}, errEntityTooLarge(size, l.MaxObjectSize, bucketName, objectName)
+	}
+	if opts.DisableMultipart && size > l.MaxPutObjectSize {
+		return UploadInfo{}, errEntityTooLarge(size, l.MaxPutObjectSize, bucketName, objectName)
 	}
 
+	if opts.Checksum.IsSet() || opts.AutoChecksum.IsSet() || !c.defaultChecksumType().IsSet() || !c.putTrailingChecksums(opts) {
+		return c.putObjectCommon(ctx, bucketName, objectName, reader, size, opts)
+	}
+
+	// Uploads with the default checksum of the client are retried with
+	// a weaker checksum if the endpoint rejects it, as long as the
+	// reader can be rewound.
+	seeker, _ := reader.(io.Seeker)
+	var offset int64
+	if seeker != nil {
+		if offset, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+			seeker = nil
+		}
+	}
+	for {
+		defaultChecksum := c.defaultChecksumType()
+		attemptOpts := opts
+		attemptOpts.AutoChecksum = defaultChecksum
+		attemptOpts.UserMetadata = maps.Clone(opts.UserMetadata)
+		info, err = c.putObjectCommon(ctx, bucketName, objectName, reader, size, attemptOpts)
+		if err == nil || !isChecksumRejected(err) || !c.downgradeChecksum(defaultChecksum) || seeker == nil {
+			return info, err
+		}
+		if _, err = seeker.Seek(offset, io.SeekStart); err != nil {
+			return UploadInfo{}, err
+		}
+	}
+}
+
+// putObjectCommon uploads an object with validated options, see
+// PutObject.
+func (c *Client) putObjectCommon(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, opts PutObjectOptions) (UploadInfo, error) {
 	if opts.Checksum.IsSet() {
 		opts.AutoChecksum = opts.Checksum
 		opts.SendContentMd5 = false
 	}
 
-	if c.trailingHeaderSupport {
+	if c.putTrailingChecksums(opts) {
 		opts.AutoChecksum.SetDefault(ChecksumCRC32C)
 		addAutoChecksumHeaders(&opts)
 	}
 
+	threshold, err := c.multipartThreshold(opts)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	if size < 0 && opts.PartSize == 0 && opts.SizeHint > 0 {
+		_, partSize, _, err := OptimalPartInfo(min(opts.SizeHint, maxMultipartPutObjectSize), 0)
+		if err != nil {
+			return UploadInfo{}, err
+		}
+		opts.PartSize = uint64(max(partSize, minPartSize))
+	}
+
+	if c.useGCSInterop() && !opts.DisableMultipart && (size < 0 || size > threshold) {
+		return c.putObjectResumable(ctx, bucketName, objectName, reader, size, opts)
+	}
+
 	// NOTE: Streaming signature is not supported by GCS.
 	if s3utils.IsGoogleEndpoint(*c.endpointURL) {
 		return c.putObject(ctx, bucketName, objectName, reader, size, opts)
 	}
 
-	partSize := opts.PartSize
-	if opts.PartSize == 0 {
-		partSize = minPartSize
-	}
-
 	if c.overrideSignerType.IsV2() {
-		if size >= 0 && size < int64(partSize) || opts.DisableMultipart {
+		if size >= 0 && size <= threshold || opts.DisableMultipart {
 			return c.putObject(ctx, bucketName, objectName, reader, size, opts)
 		}
 		return c.putObjectMultipart(ctx, bucketName, objectName, reader, size, opts)
@@ -365,19 +494,54 @@ func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, r
 		if opts.DisableMultipart {
 			return UploadInfo{}, errors.New("no length provided and multipart disabled")
 		}
+		if opts.MultipartThreshold > 0 {
+			// Streams up to the threshold are uploaded with a single PUT,
+			// like objects of known size.
+			buf, releaseBuf, err := c.allocBuffer(ctx, threshold+1)
+			if err != nil {
+				return UploadInfo{}, err
+			}
+			defer releaseBuf()
+			n, rerr := readFull(reader, buf)
+			switch rerr {
+			case io.EOF, io.ErrUnexpectedEOF:
+				return c.putObject(ctx, bucketName, objectName, bytes.NewReader(buf[:n]), int64(n), opts)
+			case nil:
+				reader = io.MultiReader(bytes.NewReader(buf), reader)
+			default:
+				return UploadInfo{}, rerr
+			}
+		}
 		if opts.ConcurrentStreamParts && opts.NumThreads > 1 {
 			return c.putObjectMultipartStreamParallel(ctx, bucketName, objectName, reader, opts)
 		}
 		return c.putObjectMultipartStreamNoLength(ctx, bucketName, objectName, reader, opts)
 	}
 
-	if size <= int64(partSize) || opts.DisableMultipart {
+	if size <= threshold || opts.DisableMultipart {
 		return c.putObject(ctx, bucketName, objectName, reader, size, opts)
 	}
 
 	return c.putObjectMultipartStream(ctx, bucketName, objectName, reader, size, opts)
 }
 
+// multipartThreshold returns the size above which objects are uploaded
+// in parts, see PutObjectOptions.MultipartThreshold.
+func (c *Client) multipartThreshold(opts PutObjectOptions) (int64, error) {
+	l := c.Limits()
+	if opts.PartSize > uint64(l.MaxPartSize) {
+		return 0, l.CheckPartSize(int64(min(opts.PartSize, math.MaxInt64)), true)
+	}
+	threshold := opts.MultipartThreshold
+	if threshold == 0 {
+		threshold = opts.PartSize
+	}
+	if threshold == 0 {
+		threshold = minPartSize
+	}
+	return int64(min(threshold, uint64(l.MaxPutObjectSize))), nil
+}
+
 func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketName, objectName string, reader io.Reader, opts PutObjectOptions) (info UploadInfo, err error) {
 	// Input validation.
 	if err = s3utils.CheckValidBucketName(bucketName); err != nil {
@@ -419,7 +583,11 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	partsInfo := make(map[int]ObjectPart)
 
 	// Create a buffer.
-	buf := make([]byte, partSize)
+	buf, releaseBuf, err := c.allocBuffer(ctx, partSize)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer releaseBuf()
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -458,7 +626,7 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
-		rd := newHook(bytes.NewReader(buf[:length]), opts.Progress)
+		rd := newHook(opts.progress.part(bytes.NewReader(buf[:length]), partNumber), opts.Progress)
 
 		// Proceed to upload the part.
 		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
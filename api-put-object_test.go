@@ -17,12 +17,21 @@
 package minio
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 )
 
@@ -131,7 +140,7 @@ func Test_SSEHeaders(t *testing.T) {
 			opts := PutObjectOptions{
 				ServerSideEncryption: tc.sse(),
 			}
-			c.bucketLocCache.Set("test", "region")
+			c.bucketLocCache.set(c.endpointURL.Host, "test", "region")
 			c.initiateMultipartUpload(context.Background(), "test", "test", opts)
 			for s, vls := range tc.initiateMultipartUploadHeaders {
 				if !reflect.DeepEqual(rt.request.Header[s], vls) {
@@ -166,3 +175,280 @@ func Test_SSEHeaders(t *testing.T) {
 		})
 	}
 }
+
+func TestPutObjectUnsignedPayloadTrailer(t *testing.T) {
+	const data = "hello world"
+	var (
+		contentSha256 string
+		body          string
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentSha256 = r.Header.Get("X-Amz-Content-Sha256")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("ETag", `"etag"`)
+	}))
+	defer srv.Close()
+
+	crc := ChecksumCRC32C.Hasher()
+	crc.Write([]byte(data))
+	trailer := "x-amz-checksum-crc32c:" + base64.StdEncoding.EncodeToString(crc.Sum(nil))
+
+	testCases := []struct {
+		secure        bool
+		unsigned      bool
+		contentSha256 string
+		trailer       bool
+		shouldFail    bool
+	}{
+		{secure: true, unsigned: true, contentSha256: unsignedPayloadTrailer, trailer: true},
+		{secure: true, contentSha256: unsignedPayload},
+		{unsigned: true, shouldFail: true},
+	}
+	for i, testCase := range testCases {
+		clnt, err := New(strings.TrimPrefix(srv.URL, "https://"), &Options{
+			Creds:     credentials.NewStaticV4("access", "secret", ""),
+			Region:    "us-east-1",
+			Secure:    testCase.secure,
+			Transport: srv.Client().Transport,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		contentSha256, body = "", ""
+		_, err = clnt.PutObject(context.Background(), "bucket", "object", strings.NewReader(data), int64(len(data)), PutObjectOptions{
+			UnsignedPayloadTrailer: testCase.unsigned,
+		})
+		if testCase.shouldFail {
+			if err == nil {
+				t.Errorf("test %d: expected to fail", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		if contentSha256 != testCase.contentSha256 {
+			t.Errorf("test %d: expected X-Amz-Content-Sha256 %s, got %s", i+1, testCase.contentSha256, contentSha256)
+		}
+		if strings.Contains(body, trailer) != testCase.trailer || !strings.Contains(body, data) {
+			t.Errorf("test %d: unexpected body %q", i+1, body)
+		}
+	}
+}
+
+func TestFPutObjectZeroCopyParts(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), (2*minPartSize+100)/10)
+	path := filepath.Join(t.TempDir(), "object")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mu    sync.Mutex
+		parts = map[int][]byte{}
+		flaky = 1
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case q.Has("uploads"):
+			io.WriteString(w, "<InitiateMultipartUploadResult><UploadId>upload</UploadId></InitiateMultipartUploadResult>")
+		case q.Has("partNumber"):
+			partNumber, _ := strconv.Atoi(q.Get("partNumber"))
+			b, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			if partNumber == 2 && flaky > 0 {
+				flaky--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				io.WriteString(w, "<Error><Code>SlowDown</Code></Error>")
+				return
+			}
+			if r.Header.Get("X-Amz-Content-Sha256") != unsignedPayload {
+				t.Errorf("part %d: expected an unsigned payload, got %s", partNumber, r.Header.Get("X-Amz-Content-Sha256"))
+			}
+			parts[partNumber] = b
+			w.Header().Set("ETag", `"part"`)
+		case q.Has("uploadId"):
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag"</ETag></CompleteMultipartUploadResult>`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.FPutObject(context.Background(), "bucket", "object", path, PutObjectOptions{
+		PartSize:             minPartSize,
+		DisableContentSha256: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(parts))
+	}
+	for i := 1; i <= 3; i++ {
+		start := int64(i-1) * minPartSize
+		if !bytes.Equal(parts[i], data[start:min(start+minPartSize, int64(len(data)))]) {
+			t.Errorf("part %d: unexpected content", i)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	part, err := openFilePart(f, 10, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer part.close()
+	b, err := io.ReadAll(part)
+	if err != nil || string(b) != "01234" {
+		t.Errorf("expected the part to be read, got %q, %v", b, err)
+	}
+	if n, err := part.Seek(2, io.SeekStart); err != nil || n != 2 {
+		t.Fatalf("unexpected seek %d, %v", n, err)
+	}
+	if b, _ = io.ReadAll(part); string(b) != "234" {
+		t.Errorf("expected the part to be read from its start, got %q", b)
+	}
+	if _, err = openFilePart(strings.NewReader("data"), 0, 4); err == nil {
+		t.Error("expected a reader which is not a file to fail")
+	}
+}
+
+func TestPutObjectMultipartThreshold(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		parts    []int64
+		received int64
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		q := r.URL.Query()
+		switch {
+		case q.Has("uploads"):
+			io.WriteString(w, `<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><UploadId>upload</UploadId></InitiateMultipartUploadResult>`)
+			return
+		case q.Has("uploadId") && r.Method == http.MethodPost:
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-2"</ETag></CompleteMultipartUploadResult>`)
+			return
+		}
+		size := r.ContentLength
+		if decoded := r.Header.Get("X-Amz-Decoded-Content-Length"); decoded != "" {
+			size, _ = strconv.ParseInt(decoded, 10, 64)
+		}
+		io.Copy(io.Discard, r.Body)
+		received += size
+		if q.Has("partNumber") {
+			// Parts may be uploaded in parallel.
+			partNumber, _ := strconv.Atoi(q.Get("partNumber"))
+			if len(parts) < partNumber {
+				parts = append(parts, make([]int64, partNumber-len(parts))...)
+			}
+			parts[partNumber-1] = size
+		}
+		w.Header().Set("ETag", `"etag"`)
+	}))
+	defer srv.Close()
+
+	const mib = 1 << 20
+	testCases := []struct {
+		size    int64
+		unknown bool
+		opts    PutObjectOptions
+		profile Profile
+		parts   []int64
+		err     bool
+	}{
+		{size: 10 * mib},
+		{size: 10 * mib, opts: PutObjectOptions{MultipartThreshold: 5 * mib}, parts: []int64{10 * mib}},
+		{size: 20 * mib, opts: PutObjectOptions{MultipartThreshold: 32 * mib}},
+		{size: 20 * mib, opts: PutObjectOptions{PartSize: 8 * mib}, parts: []int64{8 * mib, 8 * mib, 4 * mib}},
+		{size: mib, unknown: true, opts: PutObjectOptions{MultipartThreshold: 5 * mib}},
+		{size: 5 * mib, unknown: true, opts: PutObjectOptions{MultipartThreshold: 5 * mib}},
+		{size: 6 * mib, unknown: true, opts: PutObjectOptions{MultipartThreshold: 5 * mib, PartSize: 5 * mib}, parts: []int64{5 * mib, mib}},
+		{size: 20 * mib, unknown: true, opts: PutObjectOptions{SizeHint: 100 * mib}, parts: []int64{16 * mib, 4 * mib}},
+		// Streams up to the threshold are uploaded with a single PUT,
+		// whatever the part size.
+		{size: 8 * mib, unknown: true, opts: PutObjectOptions{MultipartThreshold: 10 * mib, PartSize: 5 * mib}},
+		{size: 12 * mib, unknown: true, opts: PutObjectOptions{MultipartThreshold: 10 * mib, PartSize: 5 * mib}, parts: []int64{5 * mib, 5 * mib, 2 * mib}},
+		{size: mib, profile: ProfileB2, opts: PutObjectOptions{PartSize: 5 << 30}, err: true},
+		{size: mib, profile: ProfileR2, opts: PutObjectOptions{PartSize: 5 << 30}, err: true},
+	}
+	for i, testCase := range testCases {
+		c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:   credentials.NewStaticV4("access", "secret", ""),
+			Region:  "us-east-1",
+			Profile: testCase.profile,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts, received = nil, 0
+		var reader io.Reader = bytes.NewReader(make([]byte, testCase.size))
+		size := testCase.size
+		if testCase.unknown {
+			reader, size = io.MultiReader(reader), -1
+		}
+		_, err = c.PutObject(context.Background(), "bucket", "object", reader, size, testCase.opts)
+		if testCase.err {
+			if err == nil {
+				t.Errorf("Test %d: expected an error", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if received != testCase.size {
+			t.Errorf("Test %d: expected %d bytes, got %d", i+1, testCase.size, received)
+		}
+		if !reflect.DeepEqual(parts, testCase.parts) {
+			t.Errorf("Test %d: expected parts %v, got %v", i+1, testCase.parts, parts)
+		}
+	}
+}
+
+func TestZeroCopyParts(t *testing.T) {
+	ssec, err := encrypt.NewSSEC(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		secure   bool
+		opts     PutObjectOptions
+		expected bool
+	}{
+		{false, PutObjectOptions{DisableContentSha256: true}, true},
+		{false, PutObjectOptions{}, false},
+		// TLS encrypts in userspace.
+		{true, PutObjectOptions{DisableContentSha256: true}, false},
+		{true, PutObjectOptions{}, false},
+		{false, PutObjectOptions{DisableContentSha256: true, SendContentMd5: true}, false},
+		{false, PutObjectOptions{DisableContentSha256: true, Progress: strings.NewReader("")}, false},
+		{false, PutObjectOptions{DisableContentSha256: true, ServerSideEncryption: ssec}, false},
+	}
+	for i, testCase := range testCases {
+		clnt, err := New("localhost:9000", &Options{
+			Creds:  credentials.NewStaticV4("access", "secret", ""),
+			Secure: testCase.secure,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if zeroCopy := clnt.zeroCopyParts(testCase.opts); zeroCopy != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, zeroCopy)
+		}
+	}
+}
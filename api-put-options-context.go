@@ -0,0 +1,111 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"maps"
+)
+
+type putOptionsContextKey struct{}
+
+// WithPutOptions returns a copy of ctx carrying opts, uploads made with
+// the returned context by PutObject and FPutObject use the options of opts
+// which are not set by the call. This allows layers which only pass the
+// context through to set e.g. the storage class, tags or server-side
+// encryption of uploads. User metadata, tags, grants, custom headers and
+// query parameters are merged, the values of the call win.
+//
+// Options of an outer context are merged with opts the same way.
+func WithPutOptions(ctx context.Context, opts PutObjectOptions) context.Context {
+	opts = mergePutOptions(ctx, opts)
+	return context.WithValue(ctx, putOptionsContextKey{}, opts)
+}
+
+// mergePutOptions returns opts with the options of ctx which are not set
+// in opts.
+func mergePutOptions(ctx context.Context, opts PutObjectOptions) PutObjectOptions {
+	defaults, ok := ctx.Value(putOptionsContextKey{}).(PutObjectOptions)
+	if !ok {
+		return opts
+	}
+	opts.UserMetadata = mergeMaps(defaults.UserMetadata, opts.UserMetadata)
+	opts.UserTags = mergeMaps(defaults.UserTags, opts.UserTags)
+	if len(defaults.customHeaders) > 0 {
+		header := defaults.customHeaders.Clone()
+		maps.Copy(header, opts.customHeaders)
+		opts.customHeaders = header
+	}
+	opts.requestExtensions = opts.requestExtensions.merge(defaults.requestExtensions)
+
+	setDefault(&opts.Progress, defaults.Progress)
+	setDefault(&opts.ContentType, defaults.ContentType)
+	setDefault(&opts.ContentEncoding, defaults.ContentEncoding)
+	setDefault(&opts.ContentDisposition, defaults.ContentDisposition)
+	setDefault(&opts.ContentLanguage, defaults.ContentLanguage)
+	setDefault(&opts.CacheControl, defaults.CacheControl)
+	setDefault(&opts.Expires, defaults.Expires)
+	setDefault(&opts.Mode, defaults.Mode)
+	setDefault(&opts.RetainUntilDate, defaults.RetainUntilDate)
+	setDefault(&opts.ServerSideEncryption, defaults.ServerSideEncryption)
+	setDefault(&opts.NumThreads, defaults.NumThreads)
+	setDefault(&opts.StorageClass, defaults.StorageClass)
+	setDefault(&opts.WebsiteRedirectLocation, defaults.WebsiteRedirectLocation)
+	setDefault(&opts.PartSize, defaults.PartSize)
+	setDefault(&opts.LegalHold, defaults.LegalHold)
+	setDefault(&opts.SendContentMd5, defaults.SendContentMd5)
+	setDefault(&opts.DisableContentSha256, defaults.DisableContentSha256)
+	setDefault(&opts.DisableMultipart, defaults.DisableMultipart)
+	setDefault(&opts.AutoChecksum, defaults.AutoChecksum)
+	setDefault(&opts.Checksum, defaults.Checksum)
+	setDefault(&opts.UnsignedPayloadTrailer, defaults.UnsignedPayloadTrailer)
+	setDefault(&opts.ConcurrentStreamParts, defaults.ConcurrentStreamParts)
+	setDefault(&opts.Credentials, defaults.Credentials)
+	setDefault(&opts.RetryPolicy, defaults.RetryPolicy)
+	setDefault(&opts.MultipartThreshold, defaults.MultipartThreshold)
+	setDefault(&opts.SizeHint, defaults.SizeHint)
+	// Grants are merged by permission.
+	defaultPermissions := defaults.Grants.permissions()
+	for i, p := range opts.Grants.permissions() {
+		if p.grantees == nil {
+			*p.list = defaultPermissions[i].grantees
+		}
+	}
+	if opts.ProgressFunc == nil {
+		opts.ProgressFunc = defaults.ProgressFunc
+	}
+	return opts
+}
+
+// setDefault sets *v to def if *v is the zero value.
+func setDefault[T comparable](v *T, def T) {
+	var zero T
+	if *v == zero {
+		*v = def
+	}
+}
+
+// mergeMaps returns the entries of defaults and m, the values of m win.
+func mergeMaps(defaults, m map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return m
+	}
+	merged := maps.Clone(defaults)
+	maps.Copy(merged, m)
+	return merged
+}
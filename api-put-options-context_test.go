@@ -0,0 +1,100 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestMergePutOptions(t *testing.T) {
+	ctx := WithPutOptions(context.Background(), PutObjectOptions{
+		StorageClass: "REDUCED_REDUNDANCY",
+		UserTags:     map[string]string{"tenant": "a", "env": "prod"},
+		Grants: Grants{
+			Read:        []Grantee{CanonicalUserGrantee("reader")},
+			FullControl: []Grantee{CanonicalUserGrantee("owner")},
+		},
+	})
+	ctx = WithPutOptions(ctx, PutObjectOptions{
+		ContentType:        "text/plain",
+		MultipartThreshold: 64 << 20,
+		SizeHint:           1 << 30,
+	})
+
+	opts := mergePutOptions(ctx, PutObjectOptions{
+		StorageClass: "STANDARD",
+		UserTags:     map[string]string{"env": "dev"},
+		SizeHint:     1 << 20,
+		Grants:       Grants{Read: []Grantee{CanonicalUserGrantee("other")}},
+	})
+	if opts.StorageClass != "STANDARD" || opts.ContentType != "text/plain" {
+		t.Fatalf("unexpected options: %+v", opts)
+	}
+	if opts.MultipartThreshold != 64<<20 || opts.SizeHint != 1<<20 {
+		t.Fatalf("unexpected multipart options: %d, %d", opts.MultipartThreshold, opts.SizeHint)
+	}
+	if len(opts.UserTags) != 2 || opts.UserTags["tenant"] != "a" || opts.UserTags["env"] != "dev" {
+		t.Fatalf("unexpected tags: %v", opts.UserTags)
+	}
+	expectedGrants := Grants{
+		Read:        []Grantee{CanonicalUserGrantee("other")},
+		FullControl: []Grantee{CanonicalUserGrantee("owner")},
+	}
+	if !reflect.DeepEqual(opts.Grants, expectedGrants) {
+		t.Fatalf("expected grants %+v, got %+v", expectedGrants, opts.Grants)
+	}
+
+	opts = mergePutOptions(context.Background(), PutObjectOptions{})
+	if opts.StorageClass != "" || opts.UserTags != nil {
+		t.Fatalf("unexpected options without context options: %+v", opts)
+	}
+}
+
+func TestPutObjectContextOptions(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Header().Set("ETag", `"etag"`)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithPutOptions(context.Background(), PutObjectOptions{
+		StorageClass: "REDUCED_REDUNDANCY",
+		UserTags:     map[string]string{"tenant": "a"},
+	})
+	if _, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if header.Get("X-Amz-Storage-Class") != "REDUCED_REDUNDANCY" || header.Get("X-Amz-Tagging") != "tenant=a" {
+		t.Fatalf("unexpected headers: %v", header)
+	}
+}
@@ -0,0 +1,160 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// adminAPIPrefix is the path prefix of the MinIO admin API.
+const adminAPIPrefix = "/minio/admin/v3"
+
+// adminRequest returns a request of the MinIO admin API of the endpoint.
+func (c *Client) adminRequest(method, path string, query url.Values, body []byte) controlRequest {
+	region := c.region
+	if region == "" {
+		region = "us-east-1"
+	}
+	return controlRequest{
+		method:      method,
+		scheme:      c.endpointURL.Scheme,
+		host:        c.endpointURL.Host,
+		path:        adminAPIPrefix + path,
+		query:       query,
+		region:      region,
+		serviceType: signer.ServiceTypeS3,
+		body:        body,
+	}
+}
+
+// SetRemoteTarget adds a remote target of a MinIO bucket, e.g. the
+// destination of bucket replication, and returns its ARN. The ARN is the
+// destination of the replication rules of the target, see
+// replication.Options.DestBucket. The credentials of the target are
+// encrypted with the secret key of the client.
+func (c *Client) SetRemoteTarget(ctx context.Context, bucketName string, target *replication.BucketTarget) (string, error) {
+	return c.setRemoteTarget(ctx, bucketName, target, nil)
+}
+
+// UpdateRemoteTarget updates the properties ops of a remote target of a
+// MinIO bucket, which is identified by target.SourceBucket and
+// target.Arn, and returns its ARN.
+func (c *Client) UpdateRemoteTarget(ctx context.Context, target *replication.BucketTarget, ops ...replication.TargetUpdateType) (string, error) {
+	if target == nil || target.Arn == "" {
+		return "", errInvalidArgument("Remote target has no ARN.")
+	}
+	if len(ops) == 0 {
+		return "", errInvalidArgument("No properties of the remote target to update.")
+	}
+	return c.setRemoteTarget(ctx, target.SourceBucket, target, ops)
+}
+
+func (c *Client) setRemoteTarget(ctx context.Context, bucketName string, target *replication.BucketTarget, ops []replication.TargetUpdateType) (string, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return "", err
+	}
+	if target == nil {
+		return "", errInvalidArgument("Remote target cannot be nil.")
+	}
+	if err := target.Validate(); err != nil {
+		return "", errInvalidArgument(err.Error())
+	}
+	t := *target
+	if t.Type == "" {
+		t.Type = replication.ReplicationService
+	}
+	t.SourceBucket = bucketName
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	value, err := c.getCredentials(ctx)
+	if err != nil {
+		return "", err
+	}
+	body, err := encryptAdminData(value.SecretAccessKey, data)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{"bucket": {bucketName}}
+	if len(ops) > 0 {
+		query.Set("update", "true")
+		for _, op := range ops {
+			query.Add("op", strconv.Itoa(int(op)))
+		}
+	}
+	resp, err := c.executeControl(ctx, c.adminRequest(http.MethodPut, "/set-remote-target", query, body))
+	defer closeResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	var arn string
+	if err = json.NewDecoder(resp.Body).Decode(&arn); err != nil {
+		return "", err
+	}
+	return arn, nil
+}
+
+// ListRemoteTargets returns the remote targets of a MinIO bucket with
+// their health, arnType selects the targets of a service, e.g.
+// replication.ReplicationService, all targets are returned if it is
+// empty. The remote targets of all buckets are returned if bucketName is
+// empty.
+func (c *Client) ListRemoteTargets(ctx context.Context, bucketName string, arnType replication.ServiceType) ([]replication.BucketTarget, error) {
+	if bucketName != "" {
+		if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+			return nil, err
+		}
+	}
+	query := url.Values{"bucket": {bucketName}, "type": {string(arnType)}}
+	resp, err := c.executeControl(ctx, c.adminRequest(http.MethodGet, "/list-remote-targets", query, nil))
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	var targets []replication.BucketTarget
+	if err = json.NewDecoder(resp.Body).Decode(&targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// RemoveRemoteTarget removes the remote target with the ARN from a MinIO
+// bucket.
+func (c *Client) RemoveRemoteTarget(ctx context.Context, bucketName, arn string) error {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return err
+	}
+	if arn == "" {
+		return errInvalidArgument("Remote target ARN cannot be empty.")
+	}
+	query := url.Values{"bucket": {bucketName}, "arn": {arn}}
+	resp, err := c.executeControl(ctx, c.adminRequest(http.MethodDelete, "/remove-remote-target", query, nil))
+	closeResponse(resp)
+	return err
+}
@@ -0,0 +1,164 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+// decryptAdminData decrypts a payload encrypted by encryptAdminData.
+func decryptAdminData(password string, data []byte) ([]byte, error) {
+	if len(data) < adminSaltSize+1+8 || data[adminSaltSize] != adminArgon2idAESGCM {
+		return nil, errors.New("invalid payload")
+	}
+	aead, err := adminKey(password, data[:adminSaltSize])
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, data[adminSaltSize+1:adminSaltSize+9])
+	data = data[adminSaltSize+9:]
+	associatedData := aead.Seal([]byte{0x00}, nonce, nil, nil)
+
+	var plaintext []byte
+	for seqNum := uint32(1); ; seqNum++ {
+		binary.LittleEndian.PutUint32(nonce[len(nonce)-4:], seqNum)
+		fragment := adminFragmentSize + aead.Overhead()
+		if len(data) <= fragment {
+			associatedData[0] = 0x80
+			return aead.Open(plaintext, nonce, data, associatedData)
+		}
+		if plaintext, err = aead.Open(plaintext, nonce, data[:fragment], associatedData); err != nil {
+			return nil, err
+		}
+		data = data[fragment:]
+	}
+}
+
+func TestEncryptAdminData(t *testing.T) {
+	for _, size := range []int{0, 1, adminFragmentSize, adminFragmentSize + 1, 3*adminFragmentSize + 7} {
+		data := []byte(strings.Repeat("x", size))
+		ciphertext, err := encryptAdminData("secret", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plaintext, err := decryptAdminData("secret", ciphertext)
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if string(plaintext) != string(data) {
+			t.Errorf("size %d: plaintext does not match", size)
+		}
+		if _, err = decryptAdminData("other", ciphertext); err == nil {
+			t.Errorf("size %d: decrypted with the wrong password", size)
+		}
+	}
+}
+
+func TestRemoteTargets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /minio/admin/v3/set-remote-target":
+			body, _ := io.ReadAll(r.Body)
+			data, err := decryptAdminData("secret", body)
+			if err != nil {
+				t.Errorf("unexpected payload: %v", err)
+			}
+			var target replication.BucketTarget
+			if err = json.Unmarshal(data, &target); err != nil {
+				t.Error(err)
+			}
+			if query.Get("bucket") != "bucket" || target.SourceBucket != "bucket" || target.Type != replication.ReplicationService {
+				t.Errorf("unexpected target %+v of %s", target, r.URL)
+			}
+			if query.Get("update") == "true" {
+				if ops := query["op"]; len(ops) != 2 || ops[0] != "1" || ops[1] != "4" {
+					t.Errorf("unexpected update of %v", ops)
+				}
+			}
+			json.NewEncoder(w).Encode("arn:minio:replication::id:target")
+		case "GET /minio/admin/v3/list-remote-targets":
+			if query.Get("bucket") != "bucket" || query.Get("type") != "replication" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode([]replication.BucketTarget{{Arn: "arn:minio:replication::id:target", Online: true}})
+		case "DELETE /minio/admin/v3/remove-remote-target":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"Code":"XMinioAdminRemoteTargetNotFound","Message":"not found"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	target := &replication.BucketTarget{
+		Endpoint:     "remote:9000",
+		TargetBucket: "target",
+		Credentials:  &replication.TargetCredentials{AccessKey: "remote", SecretKey: "remote-secret"},
+	}
+	if _, err = clnt.SetRemoteTarget(ctx, "bucket", &replication.BucketTarget{}); err == nil {
+		t.Fatal("expected invalid target to fail")
+	}
+	arn, err := clnt.SetRemoteTarget(ctx, "bucket", target)
+	if err != nil || arn != "arn:minio:replication::id:target" {
+		t.Fatalf("unexpected ARN %q, %v", arn, err)
+	}
+
+	target.SourceBucket, target.Arn = "bucket", arn
+	target.BandwidthLimit = 1 << 20
+	if _, err = clnt.UpdateRemoteTarget(ctx, target); err == nil {
+		t.Fatal("expected update without properties to fail")
+	}
+	if _, err = clnt.UpdateRemoteTarget(ctx, target, replication.CredentialsUpdateType, replication.BandwidthLimitUpdateType); err != nil {
+		t.Fatal(err)
+	}
+
+	targets, err := clnt.ListRemoteTargets(ctx, "bucket", replication.ReplicationService)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 || targets[0].Arn != arn || !targets[0].Online {
+		t.Fatalf("unexpected targets %+v", targets)
+	}
+
+	err = clnt.RemoveRemoteTarget(ctx, "bucket", arn)
+	if errResp := ToErrorResponse(err); errResp.Code != "XMinioAdminRemoteTargetNotFound" || errResp.StatusCode != http.StatusNotFound {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// RemoveObjectsForceOptions represents options specified by user for
+// RemoveObjectsForce call
+type RemoveObjectsForceOptions struct {
+	// GovernanceBypass removes the versions retained in governance mode,
+	// which requires the s3:BypassGovernanceRetention permission.
+	GovernanceBypass bool
+
+	// ClearLegalHold turns off the legal hold of the versions under
+	// legal hold before removing them.
+	ClearLegalHold bool
+}
+
+// RemoveObjectForceResult is the result of the removal of an object by
+// RemoveObjectsForce.
+type RemoveObjectForceResult struct {
+	RemoveObjectResult
+
+	// Locked is set when the object could not be removed because of its
+	// legal hold or retention, Err is the error of the removal.
+	Locked bool
+
+	// Object lock of the object, when its removal was denied.
+	RetentionMode   RetentionMode
+	RetainUntilDate time.Time
+	LegalHold       bool
+
+	// GovernanceBypassed is set when the object was removed bypassing
+	// its governance retention.
+	GovernanceBypassed bool
+	// LegalHoldCleared is set when the legal hold of the object was
+	// turned off to remove it.
+	LegalHoldCleared bool
+}
+
+// RemoveObjectsForce removes objects or object versions like
+// RemoveObjectVersions and handles the versions which are protected by
+// object lock. Governance retention is bypassed and legal holds are
+// cleared as permitted by opts, the versions which still cannot be
+// removed are reported as locked along with their retention.
+func (c *Client) RemoveObjectsForce(ctx context.Context, bucketName string, objects iter.Seq[ObjectVersion], opts RemoveObjectsForceOptions) (iter.Seq[RemoveObjectForceResult], error) {
+	results, err := c.RemoveObjectVersions(ctx, bucketName, objects, RemoveObjectsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return func(yield func(RemoveObjectForceResult) bool) {
+		for res := range results {
+			forceRes := RemoveObjectForceResult{RemoveObjectResult: res}
+			if res.Err != nil && isObjectLockedErr(res.Err) {
+				forceRes = c.removeLockedObject(ctx, bucketName, res, opts)
+			}
+			if !yield(forceRes) {
+				return
+			}
+		}
+	}, nil
+}
+
+// isObjectLockedErr tells whether a removal may have been denied by
+// object lock.
+func isObjectLockedErr(err error) bool {
+	switch ToErrorResponse(err).Code {
+	case AccessDenied, ObjectLocked:
+		return true
+	}
+	return false
+}
+
+// removeLockedObject removes a version whose removal was denied, if its
+// object lock and opts permit it.
+func (c *Client) removeLockedObject(ctx context.Context, bucketName string, res RemoveObjectResult, opts RemoveObjectsForceOptions) RemoveObjectForceResult {
+	forceRes := RemoveObjectForceResult{RemoveObjectResult: res}
+	info, err := c.StatObject(ctx, bucketName, res.ObjectName, StatObjectOptions{VersionID: res.ObjectVersionID})
+	if err != nil {
+		// The removal error is more relevant.
+		return forceRes
+	}
+	legalHold := info.ObjectLockLegalHold == LegalHoldEnabled
+	retained := info.ObjectLockMode.IsValid() && info.ObjectLockRetainUntilDate.After(time.Now())
+	if !legalHold && !retained {
+		// Denied for another reason.
+		return forceRes
+	}
+	forceRes.LegalHold = legalHold
+	if retained {
+		forceRes.RetentionMode, forceRes.RetainUntilDate = info.ObjectLockMode, info.ObjectLockRetainUntilDate
+	}
+	if (legalHold && !opts.ClearLegalHold) ||
+		(retained && (info.ObjectLockMode == Compliance || !opts.GovernanceBypass)) {
+		forceRes.Locked = true
+		return forceRes
+	}
+
+	if legalHold {
+		off := LegalHoldDisabled
+		if err = c.PutObjectLegalHold(ctx, bucketName, res.ObjectName, PutObjectLegalHoldOptions{
+			VersionID: res.ObjectVersionID,
+			Status:    &off,
+		}); err != nil {
+			forceRes.Err = err
+			return forceRes
+		}
+		forceRes.LegalHoldCleared = true
+	}
+	removed := c.removeObject(ctx, bucketName, res.ObjectName, RemoveObjectOptions{
+		VersionID:        res.ObjectVersionID,
+		GovernanceBypass: retained,
+	})
+	forceRes.RemoveObjectResult = removed
+	forceRes.ObjectName, forceRes.ObjectVersionID = res.ObjectName, res.ObjectVersionID
+	forceRes.GovernanceBypassed = retained && removed.Err == nil
+	return forceRes
+}
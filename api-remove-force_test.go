@@ -0,0 +1,178 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestRemoveObjectsForce(t *testing.T) {
+	type objectLock struct {
+		legalHold bool
+		mode      RetentionMode
+		until     time.Time
+	}
+	future := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	var (
+		mu    sync.Mutex
+		locks map[string]*objectLock
+	)
+	// removable tells whether a version may be removed, the version
+	// "denied" is denied by a policy.
+	removable := func(versionID string, bypass bool) bool {
+		lock := locks[versionID]
+		if versionID == "denied" || lock.legalHold {
+			return false
+		}
+		return lock.mode == "" || (lock.mode == Governance && bypass)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		q := r.URL.Query()
+		versionID := q.Get("versionId")
+		switch {
+		case q.Has("delete"):
+			var req deleteMultiObjects
+			if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			io.WriteString(w, `<DeleteResult>`)
+			for _, object := range req.Objects {
+				if removable(object.VersionID, r.Header.Get(amzBypassGovernance) == "true") {
+					io.WriteString(w, `<Deleted><Key>`+object.Key+`</Key><VersionId>`+object.VersionID+`</VersionId></Deleted>`)
+					continue
+				}
+				io.WriteString(w, `<Error><Key>`+object.Key+`</Key><VersionId>`+object.VersionID+`</VersionId><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`)
+			}
+			io.WriteString(w, `</DeleteResult>`)
+		case q.Has("legal-hold"):
+			locks[versionID].legalHold = false
+		case r.Method == http.MethodHead:
+			lock := locks[versionID]
+			if lock.legalHold {
+				w.Header().Set(amzLegalHoldHeader, "ON")
+			}
+			if lock.mode != "" {
+				w.Header().Set(amzLockMode, string(lock.mode))
+				w.Header().Set(amzLockRetainUntil, lock.until.Format(time.RFC3339))
+			}
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		case r.Method == http.MethodDelete:
+			if !removable(versionID, r.Header.Get(amzBypassGovernance) == "true") {
+				w.WriteHeader(http.StatusForbidden)
+				io.WriteString(w, `<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Results by version, "removed", "locked" or "failed" followed by
+	// the flags of the result.
+	testCases := []struct {
+		opts    RemoveObjectsForceOptions
+		results []string
+	}{
+		{
+			results: []string{"removed", "locked hold", "locked GOVERNANCE", "locked COMPLIANCE", "locked hold GOVERNANCE", "failed"},
+		},
+		{
+			opts:    RemoveObjectsForceOptions{GovernanceBypass: true},
+			results: []string{"removed", "locked hold", "removed GOVERNANCE bypassed", "locked COMPLIANCE", "locked hold GOVERNANCE", "failed"},
+		},
+		{
+			opts:    RemoveObjectsForceOptions{GovernanceBypass: true, ClearLegalHold: true},
+			results: []string{"removed", "removed hold cleared", "removed GOVERNANCE bypassed", "locked COMPLIANCE", "removed hold GOVERNANCE cleared bypassed", "failed"},
+		},
+	}
+	versions := []string{"free", "hold", "governance", "compliance", "both", "denied"}
+	for i, testCase := range testCases {
+		locks = map[string]*objectLock{
+			"free":       {},
+			"hold":       {legalHold: true},
+			"governance": {mode: Governance, until: future},
+			"compliance": {mode: Compliance, until: future},
+			"both":       {legalHold: true, mode: Governance, until: future},
+			"denied":     {},
+		}
+		var objects []ObjectVersion
+		for _, versionID := range versions {
+			objects = append(objects, ObjectVersion{Key: "object", VersionID: versionID})
+		}
+		results, err := c.RemoveObjectsForce(context.Background(), "bucket", slices.Values(objects), testCase.opts)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		var got []string
+		for res := range results {
+			var result []string
+			switch {
+			case res.Locked:
+				result = append(result, "locked")
+			case res.Err != nil:
+				result = append(result, "failed")
+			default:
+				result = append(result, "removed")
+			}
+			if res.LegalHold {
+				result = append(result, "hold")
+			}
+			if res.RetentionMode != "" {
+				result = append(result, string(res.RetentionMode))
+				if !res.RetainUntilDate.Equal(future) {
+					t.Errorf("Test %d: expected retention until %v, got %v", i+1, future, res.RetainUntilDate)
+				}
+			}
+			if res.LegalHoldCleared {
+				result = append(result, "cleared")
+			}
+			if res.GovernanceBypassed {
+				result = append(result, "bypassed")
+			}
+			if res.ObjectVersionID != versions[len(got)] {
+				t.Errorf("Test %d: expected version %s, got %s", i+1, versions[len(got)], res.ObjectVersionID)
+			}
+			got = append(got, strings.Join(result, " "))
+		}
+		if !slices.Equal(got, testCase.results) {
+			t.Errorf("Test %d: expected %q, got %q", i+1, testCase.results, got)
+		}
+	}
+}
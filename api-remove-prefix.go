@@ -0,0 +1,237 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// RemovePrefixOptions represents options specified by user for RemovePrefix call
+type RemovePrefixOptions struct {
+	// WithVersions removes all object versions and delete
+	// markers under the prefix, otherwise only the latest
+	// versions are removed (creating delete markers on
+	// versioned buckets).
+	WithVersions bool
+
+	// GovernanceBypass removes versions locked in governance mode.
+	GovernanceBypass bool
+
+	// Parallel is the number of concurrent bulk delete requests,
+	// defaults to 1.
+	Parallel int
+
+	// QPS limits the number of bulk delete requests per second
+	// across all workers, unlimited when zero.
+	QPS float64
+
+	// DryRun only lists the objects that would be removed.
+	DryRun bool
+
+	// Progress if set receives updated statistics after every
+	// batch of up to 1000 objects, it is closed when RemovePrefix
+	// returns. Sends are blocking, the channel must be drained.
+	Progress chan<- RemovePrefixStats
+}
+
+// RemovePrefixStats contains the statistics of a RemovePrefix call.
+type RemovePrefixStats struct {
+	// Number of objects (or versions) listed under the prefix.
+	Listed int64
+	// Number of bytes of the listed objects.
+	ListedBytes int64
+	// Number of objects (or versions) removed, or that would
+	// have been removed in dry-run mode.
+	Removed int64
+	// Number of objects (or versions) that could not be removed.
+	Failed int64
+	// Number of listed objects (or versions) whose removal was not
+	// attempted, e.g. after a cancellation or a listing error. Listed
+	// is the sum of Removed, Failed and Skipped once RemovePrefix
+	// returns.
+	Skipped int64
+	// Time spent so far.
+	Elapsed time.Duration
+}
+
+// RemovePrefix removes all objects under prefix, i.e. `rm -r`. Objects are
+// listed recursively and removed in batches of up to 1000 objects using
+// bulk deletes. The final statistics are returned along with the first
+// error encountered, remaining objects are still attempted when
+// individual objects fail to be removed.
+func (c *Client) RemovePrefix(ctx context.Context, bucketName, prefix string, opts RemovePrefixOptions) (RemovePrefixStats, error) {
+	if opts.Progress != nil {
+		defer close(opts.Progress)
+	}
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return RemovePrefixStats{}, err
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = 1
+	}
+	if opts.QPS < 0 {
+		return RemovePrefixStats{}, errInvalidArgument("QPS cannot be negative")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		stats    RemovePrefixStats
+		firstErr error
+		start    = time.Now()
+	)
+	setErr := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	report := func(update func(*RemovePrefixStats)) {
+		mu.Lock()
+		update(&stats)
+		stats.Elapsed = time.Since(start)
+		snapshot := stats
+		mu.Unlock()
+		if opts.Progress != nil {
+			select {
+			case opts.Progress <- snapshot:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	skip := func(batch []ObjectVersion) {
+		mu.Lock()
+		stats.Skipped += int64(len(batch))
+		mu.Unlock()
+	}
+
+	var limiter <-chan time.Time
+	if opts.QPS > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / opts.QPS))
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+
+	batchCh := make(chan []ObjectVersion)
+	var wg sync.WaitGroup
+	for range opts.Parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batchCh {
+				if opts.DryRun {
+					report(func(s *RemovePrefixStats) { s.Removed += int64(len(batch)) })
+					continue
+				}
+				if limiter != nil {
+					select {
+					case <-limiter:
+					case <-ctx.Done():
+						skip(batch)
+						continue
+					}
+				}
+				results, err := c.RemoveObjectVersions(ctx, bucketName, slices.Values(batch), RemoveObjectsOptions{
+					GovernanceBypass: opts.GovernanceBypass,
+				})
+				if err != nil {
+					mu.Lock()
+					setErr(err)
+					mu.Unlock()
+					report(func(s *RemovePrefixStats) { s.Failed += int64(len(batch)) })
+					continue
+				}
+				var removed, failed int64
+				for res := range results {
+					if res.Err != nil {
+						failed++
+						mu.Lock()
+						setErr(res.Err)
+						mu.Unlock()
+						continue
+					}
+					removed++
+				}
+				report(func(s *RemovePrefixStats) {
+					s.Removed += removed
+					s.Failed += failed
+				})
+			}
+		}()
+	}
+
+	maxEntries := c.Limits().MaxDeleteObjects
+	batch := make([]ObjectVersion, 0, maxEntries)
+	var listErr error
+	for object := range c.ListObjectsIter(ctx, bucketName, ListObjectsOptions{
+		Prefix:       prefix,
+		Recursive:    true,
+		WithVersions: opts.WithVersions,
+	}) {
+		if object.Err != nil {
+			listErr = object.Err
+			break
+		}
+		mu.Lock()
+		stats.Listed++
+		stats.ListedBytes += object.Size
+		mu.Unlock()
+
+		batch = append(batch, ObjectVersion{Key: object.Key, VersionID: object.VersionID})
+		if len(batch) < maxEntries {
+			continue
+		}
+		select {
+		case batchCh <- batch:
+		case <-ctx.Done():
+			skip(batch)
+		}
+		batch = make([]ObjectVersion, 0, maxEntries)
+	}
+	switch {
+	case len(batch) == 0:
+	case listErr != nil:
+		skip(batch)
+	default:
+		select {
+		case batchCh <- batch:
+		case <-ctx.Done():
+			skip(batch)
+		}
+	}
+	close(batchCh)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	stats.Elapsed = time.Since(start)
+	if listErr != nil {
+		return stats, listErr
+	}
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return stats, firstErr
+}
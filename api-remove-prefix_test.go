@@ -0,0 +1,255 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// removePrefixTestServer lists objects dir/0..dir/<objects-1> of 10 bytes
+// and records the bulk deletes, keys ending with "fail" are not removed.
+type removePrefixTestServer struct {
+	objects int
+	fail    map[string]bool
+	// delay of bulk deletes.
+	delay time.Duration
+
+	mu       sync.Mutex
+	batches  []int
+	inflight int
+	// maxInflight is the largest number of concurrent bulk deletes.
+	maxInflight int
+}
+
+func (s *removePrefixTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet:
+		fmt.Fprint(w, `<ListBucketResult><IsTruncated>false</IsTruncated>`)
+		for i := range s.objects {
+			key := fmt.Sprintf("dir/%d", i)
+			if s.fail[key] {
+				key += "fail"
+			}
+			fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>10</Size></Contents>`, key)
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+	case r.Method == http.MethodPost && r.URL.Query().Has("delete"):
+		var req deleteMultiObjects
+		if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.batches = append(s.batches, len(req.Objects))
+		s.inflight++
+		s.maxInflight = max(s.maxInflight, s.inflight)
+		s.mu.Unlock()
+		time.Sleep(s.delay)
+		s.mu.Lock()
+		s.inflight--
+		s.mu.Unlock()
+
+		fmt.Fprint(w, `<DeleteResult>`)
+		for _, obj := range req.Objects {
+			if strings.HasSuffix(obj.Key, "fail") {
+				fmt.Fprintf(w, `<Error><Key>%s</Key><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`, obj.Key)
+			} else {
+				fmt.Fprintf(w, `<Deleted><Key>%s</Key></Deleted>`, obj.Key)
+			}
+		}
+		fmt.Fprint(w, `</DeleteResult>`)
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+	}
+}
+
+func newRemovePrefixTestClient(t *testing.T, s *removePrefixTestServer) *Client {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// drainRemovePrefixProgress collects the progress of RemovePrefix until
+// the channel is closed.
+func drainRemovePrefixProgress(progress <-chan RemovePrefixStats) <-chan []RemovePrefixStats {
+	done := make(chan []RemovePrefixStats, 1)
+	go func() {
+		var updates []RemovePrefixStats
+		for stats := range progress {
+			updates = append(updates, stats)
+		}
+		done <- updates
+	}()
+	return done
+}
+
+func TestRemovePrefix(t *testing.T) {
+	s := &removePrefixTestServer{objects: 2500, fail: map[string]bool{"dir/7": true, "dir/1234": true}}
+	c := newRemovePrefixTestClient(t, s)
+
+	progress := make(chan RemovePrefixStats)
+	updates := drainRemovePrefixProgress(progress)
+	stats, err := c.RemovePrefix(context.Background(), "bucket", "dir/", RemovePrefixOptions{Progress: progress})
+	if ToErrorResponse(err).Code != AccessDenied {
+		t.Fatalf("expected AccessDenied error, got %v", err)
+	}
+	if stats.Listed != 2500 || stats.ListedBytes != 25000 || stats.Removed != 2498 || stats.Failed != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+	// Batches of up to 1000 objects.
+	if !slices.Equal(s.batches, []int{1000, 1000, 500}) {
+		t.Errorf("expected batches [1000 1000 500], got %v", s.batches)
+	}
+
+	// The channel is closed once RemovePrefix returns.
+	select {
+	case got := <-updates:
+		if len(got) != 3 {
+			t.Fatalf("expected 3 progress updates, got %d", len(got))
+		}
+		if last := got[len(got)-1]; last.Removed != stats.Removed || last.Failed != stats.Failed {
+			t.Errorf("expected last progress %+v, got %+v", stats, last)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("progress channel was not closed")
+	}
+
+	// The channel is also closed on errors.
+	progress = make(chan RemovePrefixStats)
+	updates = drainRemovePrefixProgress(progress)
+	if _, err = c.RemovePrefix(context.Background(), "invalid_bucket", "", RemovePrefixOptions{Progress: progress}); err == nil {
+		t.Error("expected invalid bucket name to fail")
+	}
+	select {
+	case <-updates:
+	case <-time.After(5 * time.Second):
+		t.Fatal("progress channel was not closed")
+	}
+}
+
+func TestRemovePrefixDryRun(t *testing.T) {
+	s := &removePrefixTestServer{objects: 1500}
+	c := newRemovePrefixTestClient(t, s)
+
+	stats, err := c.RemovePrefix(context.Background(), "bucket", "dir/", RemovePrefixOptions{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Listed != 1500 || stats.Removed != 1500 || stats.Failed != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+	if len(s.batches) != 0 {
+		t.Errorf("expected no bulk deletes, got %v", s.batches)
+	}
+}
+
+func TestRemovePrefixLimits(t *testing.T) {
+	testCases := []struct {
+		opts        RemovePrefixOptions
+		maxInflight int
+		minElapsed  time.Duration
+	}{
+		{RemovePrefixOptions{}, 1, 0},
+		{RemovePrefixOptions{Parallel: 1}, 1, 0},
+		{RemovePrefixOptions{Parallel: 2}, 2, 0},
+		// The first request waits for the first tick, requests may
+		// overlap if slower than the rate.
+		{RemovePrefixOptions{Parallel: 4, QPS: 20}, 0, 4 * 50 * time.Millisecond},
+	}
+	for i, testCase := range testCases {
+		s := &removePrefixTestServer{objects: 4000, delay: 20 * time.Millisecond}
+		c := newRemovePrefixTestClient(t, s)
+
+		start := time.Now()
+		stats, err := c.RemovePrefix(context.Background(), "bucket", "dir/", testCase.opts)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if elapsed := time.Since(start); elapsed < testCase.minElapsed {
+			t.Errorf("Test %d: expected at least %v, took %v", i+1, testCase.minElapsed, elapsed)
+		}
+		if stats.Removed != 4000 {
+			t.Errorf("Test %d: expected 4000 removed objects, got %d", i+1, stats.Removed)
+		}
+		if testCase.maxInflight > 0 && s.maxInflight != testCase.maxInflight {
+			t.Errorf("Test %d: expected %d concurrent bulk deletes, got %d", i+1, testCase.maxInflight, s.maxInflight)
+		}
+	}
+
+	c := newRemovePrefixTestClient(t, &removePrefixTestServer{})
+	if _, err := c.RemovePrefix(context.Background(), "bucket", "", RemovePrefixOptions{QPS: -1}); err == nil {
+		t.Error("expected negative QPS to fail")
+	}
+}
+
+func TestRemovePrefixCancel(t *testing.T) {
+	s := &removePrefixTestServer{objects: 3000}
+	c := newRemovePrefixTestClient(t, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	progress := make(chan RemovePrefixStats)
+	type result struct {
+		stats RemovePrefixStats
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		stats, err := c.RemovePrefix(ctx, "bucket", "dir/", RemovePrefixOptions{Progress: progress})
+		done <- result{stats, err}
+	}()
+
+	// Cancel once the first batch is removed.
+	if first := <-progress; first.Removed != 1000 {
+		t.Errorf("expected 1000 removed objects, got %+v", first)
+	}
+	cancel()
+	for range progress {
+	}
+
+	res := <-done
+	if !errors.Is(res.err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", res.err)
+	}
+	// The statistics of the work done before the cancellation are kept.
+	if res.stats.Removed != 1000 || res.stats.Listed < 1000 || res.stats.Removed+res.stats.Failed+res.stats.Skipped != res.stats.Listed {
+		t.Errorf("unexpected stats %+v", res.stats)
+	}
+	if res.stats.Elapsed <= 0 {
+		t.Errorf("expected elapsed time, got %v", res.stats.Elapsed)
+	}
+}
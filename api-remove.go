@@ -21,12 +21,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"errors"
 	"io"
 	"iter"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
@@ -41,18 +43,52 @@ type BucketOptions = RemoveBucketOptions
 // useful when endpoint is MinIO
 type RemoveBucketOptions struct {
 	ForceDelete bool
+
+	// The options below are used when ForceDelete is set and the
+	// endpoint does not support force deletes, the bucket is then
+	// emptied by the client, see RemovePrefix.
+
+	// GovernanceBypass removes versions locked in governance mode.
+	GovernanceBypass bool
+
+	// Parallel is the number of concurrent bulk delete requests,
+	// defaults to 1.
+	Parallel int
+
+	// Progress if set receives updated statistics while the bucket is
+	// emptied by the client, it is closed when RemoveBucketWithOptions
+	// returns. Sends are blocking, the channel must be drained.
+	Progress chan<- RemovePrefixStats
+
+	requestExtensions
 }
 
 // RemoveBucketWithOptions deletes the bucket name.
 //
 // All objects (including all object versions and delete markers)
 // in the bucket will be deleted forcibly if bucket options set
-// ForceDelete to 'true'.
+// ForceDelete to 'true'. Endpoints which do not support force deletes,
+// i.e. other than MinIO, refuse to delete the bucket while it is not
+// empty, the client then aborts its incomplete uploads, removes all its
+// object versions and deletes it again.
 func (c *Client) RemoveBucketWithOptions(ctx context.Context, bucketName string, opts RemoveBucketOptions) error {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+	if opts.Progress != nil {
+		// Closed by RemovePrefix when the bucket is emptied.
+		defer func() {
+			if opts.Progress != nil {
+				close(opts.Progress)
+			}
+		}()
+	}
+
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
 	}
+	if bucket, ok := outpostsBucketOf(bucketName); ok {
+		return c.removeOutpostsBucket(ctx, bucket)
+	}
 
 	// Build headers.
 	headers := make(http.Header)
@@ -60,6 +96,37 @@ func (c *Client) RemoveBucketWithOptions(ctx context.Context, bucketName string,
 		headers.Set(minIOForceDelete, "true")
 	}
 
+	err := c.deleteBucket(ctx, bucketName, headers)
+	if err == nil || !opts.ForceDelete || !errors.Is(err, ErrBucketNotEmpty) {
+		return err
+	}
+
+	// The force delete header was ignored, empty the bucket.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for upload := range c.listIncompleteUploads(ctx, bucketName, "", true) {
+		if upload.Err != nil {
+			return upload.Err
+		}
+		if err = c.abortMultipartUpload(ctx, bucketName, upload.Key, upload.UploadID); err != nil && !errors.Is(err, ErrNoSuchUpload) {
+			return err
+		}
+	}
+	progress := opts.Progress
+	opts.Progress = nil
+	if _, err = c.RemovePrefix(ctx, bucketName, "", RemovePrefixOptions{
+		WithVersions:     true,
+		GovernanceBypass: opts.GovernanceBypass,
+		Parallel:         opts.Parallel,
+		Progress:         progress,
+	}); err != nil {
+		return err
+	}
+	return c.deleteBucket(ctx, bucketName, nil)
+}
+
+// deleteBucket deletes a bucket with the given headers.
+func (c *Client) deleteBucket(ctx context.Context, bucketName string, headers http.Header) error {
 	// Execute DELETE on bucket.
 	resp, err := c.executeMethod(ctx, http.MethodDelete, requestMetadata{
 		bucketName:       bucketName,
@@ -77,7 +144,7 @@ func (c *Client) RemoveBucketWithOptions(ctx context.Context, bucketName string,
 	}
 
 	// Remove the location from cache on a successful delete.
-	c.bucketLocCache.Delete(bucketName)
+	c.bucketLocCache.delete(c.bucketLocationEndpoint(ctx, bucketName), bucketName)
 	return nil
 }
 
@@ -90,25 +157,10 @@ func (c *Client) RemoveBucket(ctx context.Context, bucketName string) error {
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
 	}
-	// Execute DELETE on bucket.
-	resp, err := c.executeMethod(ctx, http.MethodDelete, requestMetadata{
-		bucketName:       bucketName,
-		contentSHA256Hex: emptySHA256Hex,
-	})
-	defer closeResponse(resp)
-	if err != nil {
-		return err
+	if bucket, ok := outpostsBucketOf(bucketName); ok {
+		return c.removeOutpostsBucket(ctx, bucket)
 	}
-	if resp != nil {
-		if resp.StatusCode != http.StatusNoContent {
-			return httpRespToErrorResponse(resp, bucketName, "")
-		}
-	}
-
-	// Remove the location from cache on a successful delete.
-	c.bucketLocCache.Delete(bucketName)
-
-	return nil
+	return c.deleteBucket(ctx, bucketName, nil)
 }
 
 // AdvancedRemoveOptions intended for internal use by replication
@@ -126,10 +178,23 @@ type RemoveObjectOptions struct {
 	GovernanceBypass bool
 	VersionID        string
 	Internal         AdvancedRemoveOptions
+
+	// Credentials of the request, overrides the credentials
+	// of the Client, see WithCredentials.
+	Credentials *credentials.Credentials
+
+	// RetryPolicy of the request, overrides the retry policy
+	// of the Client, see WithRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	requestExtensions
 }
 
 // RemoveObject removes an object from a bucket.
 func (c *Client) RemoveObject(ctx context.Context, bucketName, objectName string, opts RemoveObjectOptions) error {
+	ctx = WithCredentials(ctx, opts.Credentials)
+	ctx = WithRetryPolicy(ctx, opts.RetryPolicy)
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
@@ -289,12 +354,16 @@ func processRemoveMultiObjectsResponse(body io.Reader, resultCh chan<- RemoveObj
 // RemoveObjectsOptions represents options specified by user for RemoveObjects call
 type RemoveObjectsOptions struct {
 	GovernanceBypass bool
+
+	requestExtensions
 }
 
 // RemoveObjects removes multiple objects from a bucket while
 // it is possible to specify objects versions which are received from
 // objectsCh. Remove failures are sent back via error channel.
 func (c *Client) RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectError {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	errorCh := make(chan RemoveObjectError, 1)
 
 	// Validate if bucket name is valid.
@@ -341,6 +410,8 @@ func (c *Client) RemoveObjects(ctx context.Context, bucketName string, objectsCh
 // canceled, or a remote call failed, the provided iterator will no
 // longer accept more objects.
 func (c *Client) RemoveObjectsWithIter(ctx context.Context, bucketName string, objectsIter iter.Seq[ObjectInfo], opts RemoveObjectsOptions) (iter.Seq[RemoveObjectResult], error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Validate if bucket name is valid.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return nil, err
@@ -366,6 +437,8 @@ func (c *Client) RemoveObjectsWithIter(ctx context.Context, bucketName string, o
 // objectsCh. Remove results, successes and failures are sent back via
 // RemoveObjectResult channel
 func (c *Client) RemoveObjectsWithResult(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, opts RemoveObjectsOptions) <-chan RemoveObjectResult {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	resultCh := make(chan RemoveObjectResult, 1)
 
 	// Validate if bucket name is valid.
@@ -411,7 +484,7 @@ func hasInvalidXMLChar(str string) bool {
 
 // Generate and call MultiDelete S3 requests based on entries received from the iterator.
 func (c *Client) removeObjectsIter(ctx context.Context, bucketName string, objectsIter iter.Seq[ObjectInfo], yield func(RemoveObjectResult) bool, opts RemoveObjectsOptions) {
-	maxEntries := 1000
+	maxEntries := c.Limits().MaxDeleteObjects
 	urlValues := make(url.Values)
 	urlValues.Set("delete", "")
 
@@ -435,7 +508,8 @@ func (c *Client) removeObjectsIter(ctx context.Context, bucketName string, objec
 			queryValues:      urlValues,
 			contentBody:      bytes.NewReader(removeBytes),
 			contentLength:    int64(len(removeBytes)),
-			contentMD5Base64: sumMD5Base64(removeBytes),
+			contentMD5Base64: c.contentMD5Base64(removeBytes),
+			md5Required:      true,
 			contentSHA256Hex: sum256Hex(removeBytes),
 			customHeader:     headers,
 		})
@@ -549,7 +623,7 @@ func (c *Client) removeObjectsIter(ctx context.Context, bucketName string, objec
 
 // Generate and call MultiDelete S3 requests based on entries received from objectsCh
 func (c *Client) removeObjects(ctx context.Context, bucketName string, objectsCh <-chan ObjectInfo, resultCh chan<- RemoveObjectResult, opts RemoveObjectsOptions) {
-	maxEntries := 1000
+	maxEntries := c.Limits().MaxDeleteObjects
 	finish := false
 	urlValues := make(url.Values)
 	urlValues.Set("delete", "")
@@ -612,7 +686,8 @@ func (c *Client) removeObjects(ctx context.Context, bucketName string, objectsCh
 			queryValues:          urlValues,
 			contentBody:          bytes.NewReader(removeBytes),
 			contentLength:        int64(len(removeBytes)),
-			contentMD5Base64:     sumMD5Base64(removeBytes),
+			contentMD5Base64:     c.contentMD5Base64(removeBytes),
+			md5Required:          true,
 			contentSHA256Hex:     sum256Hex(removeBytes),
 			customHeader:         headers,
 			expect200OKWithError: true,
@@ -641,6 +716,220 @@ func (c *Client) removeObjects(ctx context.Context, bucketName string, objectsCh
 	}
 }
 
+// ObjectVersion identifies an object, or a specific version of an
+// object, to be removed with RemoveObjectVersions.
+type ObjectVersion struct {
+	Key       string
+	VersionID string
+
+	// GovernanceBypass removes this version even if it is locked
+	// in governance mode.
+	GovernanceBypass bool
+}
+
+// RemoveObjectVersions bulk deletes multiple objects or object versions
+// from a bucket. Exactly one result is produced for each input, in the
+// same order as the input. Removal errors reported by the server are
+// returned as ErrorResponse, carrying the server error Code. Once ctx is
+// done, the objects not yet removed fail with the error of ctx.
+//
+// Governance bypass is applied when set on the object or in opts, since
+// bypass is set per request objects are batched accordingly.
+func (c *Client) RemoveObjectVersions(ctx context.Context, bucketName string, objects iter.Seq[ObjectVersion], opts RemoveObjectsOptions) (iter.Seq[RemoveObjectResult], error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
+	// Validate if bucket name is valid.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if objects == nil {
+		return nil, errInvalidArgument("Objects iter can never by nil")
+	}
+
+	return func(yield func(RemoveObjectResult) bool) {
+		maxEntries := c.Limits().MaxDeleteObjects
+
+		var (
+			batch       []ObjectInfo
+			batchBypass bool
+		)
+		flush := func() bool {
+			defer func() { batch = batch[:0] }()
+			for _, res := range c.removeObjectsBatch(ctx, bucketName, batch, batchBypass) {
+				if !yield(res) {
+					return false
+				}
+			}
+			return true
+		}
+
+		for object := range objects {
+			if err := ctx.Err(); err != nil {
+				// The pending batch and the remaining objects fail
+				// with the error of the context.
+				for _, obj := range batch {
+					if !yield(RemoveObjectResult{ObjectName: obj.Key, ObjectVersionID: obj.VersionID, Err: err}) {
+						return
+					}
+				}
+				batch = batch[:0]
+				if !yield(RemoveObjectResult{ObjectName: object.Key, ObjectVersionID: object.VersionID, Err: err}) {
+					return
+				}
+				continue
+			}
+			bypass := opts.GovernanceBypass || object.GovernanceBypass
+			if len(batch) > 0 && (bypass != batchBypass || len(batch) >= maxEntries) {
+				if !flush() {
+					return
+				}
+			}
+
+			if hasInvalidXMLChar(object.Key) {
+				// Use single DELETE so the object name will be in the request URL instead of the multi-delete XML document.
+				if !flush() {
+					return
+				}
+				res := c.removeObject(ctx, bucketName, object.Key, RemoveObjectOptions{
+					VersionID:        object.VersionID,
+					GovernanceBypass: bypass,
+				})
+				res.ObjectName, res.ObjectVersionID = object.Key, object.VersionID
+				if !yield(res) {
+					return
+				}
+				continue
+			}
+
+			batchBypass = bypass
+			batch = append(batch, ObjectInfo{Key: object.Key, VersionID: object.VersionID})
+		}
+		flush()
+	}, nil
+}
+
+// removeObjectsBatch removes a batch of objects with a single multi delete
+// call, the results are returned in the order of the batch.
+func (c *Client) removeObjectsBatch(ctx context.Context, bucketName string, batch []ObjectInfo, governanceBypass bool) []RemoveObjectResult {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	urlValues := make(url.Values)
+	urlValues.Set("delete", "")
+
+	headers := make(http.Header)
+	if governanceBypass {
+		// Set the bypass goverenance retention header
+		headers.Set(amzBypassGovernance, "true")
+	}
+
+	results := make([]RemoveObjectResult, len(batch))
+	for i, obj := range batch {
+		results[i] = RemoveObjectResult{ObjectName: obj.Key, ObjectVersionID: obj.VersionID}
+	}
+	failAll := func(err error) []RemoveObjectResult {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results
+	}
+
+	removeBytes := generateRemoveMultiObjectsRequest(batch)
+	resp, err := c.executeMethod(ctx, http.MethodPost, requestMetadata{
+		bucketName:           bucketName,
+		queryValues:          urlValues,
+		contentBody:          bytes.NewReader(removeBytes),
+		contentLength:        int64(len(removeBytes)),
+		contentMD5Base64:     c.contentMD5Base64(removeBytes),
+		md5Required:          true,
+		contentSHA256Hex:     sum256Hex(removeBytes),
+		customHeader:         headers,
+		expect200OKWithError: true,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return failAll(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return failAll(httpRespToErrorResponse(resp, bucketName, ""))
+	}
+
+	rmResult := &deleteMultiObjectsResult{}
+	if err = xmlDecoder(resp.Body, rmResult); err != nil {
+		return failAll(err)
+	}
+
+	// Map the response entries back to the batch, the server does not
+	// guarantee any order and the same key may be present multiple
+	// times with different versions.
+	type objectKey struct{ key, versionID string }
+	matched := make([]bool, len(batch))
+	pending := make(map[objectKey][]int, len(batch))
+	for i, obj := range batch {
+		k := objectKey{obj.Key, obj.VersionID}
+		pending[k] = append(pending[k], i)
+	}
+	index := func(key, versionID string) (int, bool) {
+		k := objectKey{key, versionID}
+		idxs := pending[k]
+		if len(idxs) == 0 {
+			// Servers may omit the version of unversioned deletes.
+			k = objectKey{key, ""}
+			if idxs = pending[k]; len(idxs) == 0 {
+				return 0, false
+			}
+		}
+		pending[k] = idxs[1:]
+		matched[idxs[0]] = true
+		return idxs[0], true
+	}
+
+	for _, obj := range rmResult.DeletedObjects {
+		if i, ok := index(obj.Key, obj.VersionID); ok {
+			results[i].DeleteMarker = obj.DeleteMarker
+			results[i].DeleteMarkerVersionID = obj.DeleteMarkerVersionID
+		}
+	}
+	for _, obj := range rmResult.UnDeletedObjects {
+		i, ok := index(obj.Key, obj.VersionID)
+		if !ok {
+			continue
+		}
+		// Version does not exist is not an error.
+		switch obj.Code {
+		case InvalidArgument, NoSuchVersion:
+			continue
+		}
+		results[i].Err = ErrorResponse{
+			Code:       obj.Code,
+			Message:    obj.Message,
+			BucketName: bucketName,
+			Key:        obj.Key,
+			RequestID:  resp.Header.Get("x-amz-request-id"),
+			HostID:     resp.Header.Get("x-amz-id-2"),
+			Server:     resp.Header.Get("Server"),
+		}
+	}
+
+	// Objects missing from the response were not reported deleted.
+	for i, ok := range matched {
+		if ok {
+			continue
+		}
+		results[i].Err = ErrorResponse{
+			Code:       InternalError,
+			Message:    "The object is missing from the response of the server.",
+			BucketName: bucketName,
+			Key:        batch[i].Key,
+			RequestID:  resp.Header.Get("x-amz-request-id"),
+			HostID:     resp.Header.Get("x-amz-id-2"),
+			Server:     resp.Header.Get("Server"),
+		}
+	}
+	return results
+}
+
 // RemoveIncompleteUpload aborts an partially uploaded object.
 func (c *Client) RemoveIncompleteUpload(ctx context.Context, bucketName, objectName string) error {
 	// Input validation.
@@ -0,0 +1,307 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestRemoveObjectVersionsOrder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		// Respond out of order, errors first.
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+<Error><Key>c</Key><VersionId>v3</VersionId><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>
+<Deleted><Key>b</Key><DeleteMarker>true</DeleteMarker><DeleteMarkerVersionId>dm1</DeleteMarkerVersionId></Deleted>
+<Deleted><Key>a</Key><VersionId>v1</VersionId></Deleted>
+</DeleteResult>`))
+	}))
+	defer ts.Close()
+
+	srv, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clnt, err := New(srv.Host, &Options{
+		Creds:  credentials.NewStaticV4("foo", "foo12345", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objects := []ObjectVersion{
+		{Key: "a", VersionID: "v1"},
+		{Key: "b"},
+		{Key: "c", VersionID: "v3"},
+	}
+	results, err := clnt.RemoveObjectVersions(context.Background(), "bucket", slices.Values(objects), RemoveObjectsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []RemoveObjectResult
+	for res := range results {
+		got = append(got, res)
+	}
+	if len(got) != len(objects) {
+		t.Fatalf("Expected %d results, got %d", len(objects), len(got))
+	}
+	for i, obj := range objects {
+		if got[i].ObjectName != obj.Key || got[i].ObjectVersionID != obj.VersionID {
+			t.Fatalf("Result %d: expected %s/%s, got %s/%s", i, obj.Key, obj.VersionID, got[i].ObjectName, got[i].ObjectVersionID)
+		}
+	}
+	if got[0].Err != nil || got[1].Err != nil {
+		t.Fatalf("Unexpected errors: %v, %v", got[0].Err, got[1].Err)
+	}
+	if !got[1].DeleteMarker || got[1].DeleteMarkerVersionID != "dm1" {
+		t.Fatalf("Expected delete marker to be reported, got %+v", got[1])
+	}
+	if code := ToErrorResponse(got[2].Err).Code; code != AccessDenied {
+		t.Fatalf("Expected %s, got %s", AccessDenied, code)
+	}
+}
+
+func TestRemoveObjectVersionsMissing(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Neither deleted nor failed: b and the second version of a.
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+<Deleted><Key>a</Key><VersionId>v1</VersionId></Deleted>
+<Deleted><Key>c</Key></Deleted>
+</DeleteResult>`))
+	}))
+	defer ts.Close()
+
+	srv, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clnt, err := New(srv.Host, &Options{
+		Creds:  credentials.NewStaticV4("foo", "foo12345", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objects := []ObjectVersion{
+		{Key: "a", VersionID: "v1"},
+		{Key: "a", VersionID: "v2"},
+		{Key: "b"},
+		{Key: "c"},
+	}
+	results, err := clnt.RemoveObjectVersions(context.Background(), "bucket", slices.Values(objects), RemoveObjectsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var failed []string
+	for res := range results {
+		if res.Err != nil {
+			if code := ToErrorResponse(res.Err).Code; code != InternalError {
+				t.Fatalf("Expected %s, got %s", InternalError, code)
+			}
+			failed = append(failed, res.ObjectName+"/"+res.ObjectVersionID)
+		}
+	}
+	if expected := []string{"a/v2", "b/"}; !slices.Equal(failed, expected) {
+		t.Fatalf("Expected failed objects %v, got %v", expected, failed)
+	}
+}
+
+func TestRemoveObjectVersionsCancel(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req deleteMultiObjects
+		if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `<DeleteResult>`)
+		for _, obj := range req.Objects {
+			fmt.Fprintf(w, `<Deleted><Key>%s</Key></Deleted>`, obj.Key)
+		}
+		fmt.Fprint(w, `</DeleteResult>`)
+	}))
+	defer ts.Close()
+
+	srv, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clnt, err := New(srv.Host, &Options{
+		Creds:  credentials.NewStaticV4("foo", "foo12345", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Canceled while the second batch is pending.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	objects := func(yield func(ObjectVersion) bool) {
+		for i := range 2500 {
+			if i == 1500 {
+				cancel()
+			}
+			if !yield(ObjectVersion{Key: fmt.Sprintf("object%d", i)}) {
+				return
+			}
+		}
+	}
+	results, err := clnt.RemoveObjectVersions(ctx, "bucket", objects, RemoveObjectsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []RemoveObjectResult
+	for res := range results {
+		got = append(got, res)
+	}
+	if len(got) != 2500 {
+		t.Fatalf("Expected 2500 results, got %d", len(got))
+	}
+	for i, res := range got {
+		if res.ObjectName != fmt.Sprintf("object%d", i) {
+			t.Fatalf("Result %d: expected object%d, got %s", i, i, res.ObjectName)
+		}
+		if i < 1000 && res.Err != nil {
+			t.Fatalf("Result %d: unexpected error %v", i, res.Err)
+		}
+		if i >= 1000 && !errors.Is(res.Err, context.Canceled) {
+			t.Fatalf("Result %d: expected context.Canceled, got %v", i, res.Err)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("Expected 1 bulk delete, got %d", requests)
+	}
+}
+
+func TestRemoveBucketForceFallback(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		versions = []ObjectVersion{{Key: "a", VersionID: "a2"}, {Key: "a", VersionID: "a1"}, {Key: "b", VersionID: "b1"}}
+		uploads  = map[string]string{"u1": "c"}
+		forced   []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		q := r.URL.Query()
+		switch {
+		case r.Method == http.MethodDelete && q.Has("uploadId"):
+			delete(uploads, q.Get("uploadId"))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete:
+			forced = append(forced, r.Header.Get(minIOForceDelete))
+			if len(versions) > 0 || len(uploads) > 0 {
+				w.WriteHeader(http.StatusConflict)
+				io.WriteString(w, `<Error><Code>BucketNotEmpty</Code></Error>`)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case q.Has("uploads"):
+			io.WriteString(w, `<ListMultipartUploadsResult><Bucket>bucket</Bucket><IsTruncated>false</IsTruncated>`)
+			for id, key := range uploads {
+				fmt.Fprintf(w, `<Upload><Key>%s</Key><UploadId>%s</UploadId></Upload>`, key, id)
+			}
+			io.WriteString(w, `</ListMultipartUploadsResult>`)
+		case q.Has("versions"):
+			io.WriteString(w, `<ListVersionsResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`)
+			for _, v := range versions {
+				fmt.Fprintf(w, `<Version><Key>%s</Key><VersionId>%s</VersionId></Version>`, v.Key, v.VersionID)
+			}
+			io.WriteString(w, `</ListVersionsResult>`)
+		case r.Method == http.MethodPost && q.Has("delete"):
+			var res deleteMultiObjectsResult
+			var body struct {
+				Objects []deleteObject `xml:"Object"`
+			}
+			if err := xml.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			for _, obj := range body.Objects {
+				versions = slices.DeleteFunc(versions, func(v ObjectVersion) bool {
+					return v.Key == obj.Key && v.VersionID == obj.VersionID
+				})
+				res.DeletedObjects = append(res.DeletedObjects, deletedObject{Key: obj.Key, VersionID: obj.VersionID})
+			}
+			xml.NewEncoder(w).Encode(res)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	srv, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clnt, err := New(srv.Host, &Options{
+		Creds:  credentials.NewStaticV4("foo", "foo12345", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err = clnt.RemoveBucketWithOptions(ctx, "bucket", RemoveBucketOptions{}); !errors.Is(err, ErrBucketNotEmpty) {
+		t.Fatalf("expected ErrBucketNotEmpty without ForceDelete, got %v", err)
+	}
+
+	progress := make(chan RemovePrefixStats)
+	done := make(chan RemovePrefixStats)
+	go func() {
+		var last RemovePrefixStats
+		for last = range progress {
+		}
+		done <- last
+	}()
+	if err = clnt.RemoveBucketWithOptions(ctx, "bucket", RemoveBucketOptions{ForceDelete: true, Progress: progress}); err != nil {
+		t.Fatal(err)
+	}
+	if stats := <-done; stats.Removed != 3 {
+		t.Errorf("expected 3 removed versions, got %+v", stats)
+	}
+	if len(versions) != 0 || len(uploads) != 0 {
+		t.Errorf("expected the bucket to be emptied, got %v %v", versions, uploads)
+	}
+	if !slices.Equal(forced, []string{"", "true", ""}) {
+		t.Errorf("unexpected force delete headers %q", forced)
+	}
+}
@@ -0,0 +1,260 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// RestoreAction is the action taken on an object by RestorePrefixToTime.
+type RestoreAction string
+
+const (
+	// RestoreUnchanged means the current version is the version as of
+	// the time, or the object was deleted at the time and still is.
+	RestoreUnchanged RestoreAction = "unchanged"
+	// RestoreCopied means the version as of the time was copied over
+	// the current version.
+	RestoreCopied RestoreAction = "copied"
+	// RestoreRecreated means the object was deleted since the time and
+	// the version as of the time was copied to recreate it.
+	RestoreRecreated RestoreAction = "recreated"
+	// RestoreRemoved means the object did not exist at the time and was
+	// removed, see RestorePrefixOptions.RemoveNewer.
+	RestoreRemoved RestoreAction = "removed"
+	// RestoreSkipped means the object did not exist at the time and was
+	// kept.
+	RestoreSkipped RestoreAction = "skipped"
+)
+
+// RestorePrefixOptions represents options specified by user for
+// RestorePrefixToTime call
+type RestorePrefixOptions struct {
+	// DryRun only reports the actions that would be taken.
+	DryRun bool
+
+	// RemoveNewer removes the objects created after the time, otherwise
+	// they are kept. Objects are removed with delete markers, so that
+	// they can be restored.
+	RemoveNewer bool
+
+	// Parallel is the number of objects restored concurrently, defaults
+	// to 1.
+	Parallel int
+}
+
+// RestoredObject is the result of the restore of an object.
+type RestoredObject struct {
+	Key    string
+	Action RestoreAction
+	// VersionID is the version as of the time, empty if the object did
+	// not exist or was deleted at the time.
+	VersionID string
+	// CurrentVersionID is the latest version or delete marker of the
+	// object before the restore.
+	CurrentVersionID string
+	// RestoredVersionID is the version created by the restore, i.e. the
+	// copy or the delete marker.
+	RestoredVersionID string
+	Err               error
+}
+
+// RestorePrefixReport is the report of a RestorePrefixToTime call.
+type RestorePrefixReport struct {
+	// Objects are the results of the objects under the prefix, sorted
+	// by key.
+	Objects []RestoredObject
+
+	// Number of objects by action, objects that could not be restored
+	// are only counted as failed.
+	Unchanged int64
+	Copied    int64
+	Recreated int64
+	Removed   int64
+	Skipped   int64
+	Failed    int64
+
+	// Time spent.
+	Elapsed time.Duration
+}
+
+// RestorePrefixToTime restores all objects under prefix of a versioned
+// bucket to their state at t. The version which was the latest at t is
+// copied over the current version of every object, deleted objects are
+// recreated. The report is returned along with the first error
+// encountered, remaining objects are still attempted when individual
+// objects fail to be restored.
+func (c *Client) RestorePrefixToTime(ctx context.Context, bucketName, prefix string, t time.Time, opts RestorePrefixOptions) (RestorePrefixReport, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return RestorePrefixReport{}, err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(prefix); err != nil {
+		return RestorePrefixReport{}, err
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		report   RestorePrefixReport
+		firstErr error
+		start    = time.Now()
+	)
+
+	objectCh := make(chan []ObjectInfo)
+	var wg sync.WaitGroup
+	for range opts.Parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for versions := range objectCh {
+				res := restoreObjectAction(versions, t, opts.RemoveNewer)
+				if !opts.DryRun {
+					c.restoreObject(ctx, bucketName, &res)
+				}
+
+				mu.Lock()
+				report.Objects = append(report.Objects, res)
+				switch {
+				case res.Err != nil:
+					report.Failed++
+					if firstErr == nil {
+						firstErr = res.Err
+					}
+				case res.Action == RestoreUnchanged:
+					report.Unchanged++
+				case res.Action == RestoreCopied:
+					report.Copied++
+				case res.Action == RestoreRecreated:
+					report.Recreated++
+				case res.Action == RestoreRemoved:
+					report.Removed++
+				case res.Action == RestoreSkipped:
+					report.Skipped++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	// Versions are listed by key, newest first.
+	var (
+		versions []ObjectInfo
+		listErr  error
+	)
+	send := func() {
+		if len(versions) == 0 {
+			return
+		}
+		select {
+		case objectCh <- versions:
+		case <-ctx.Done():
+		}
+		versions = nil
+	}
+	for version := range c.ListObjectsIter(ctx, bucketName, ListObjectsOptions{
+		Prefix:       prefix,
+		Recursive:    true,
+		WithVersions: true,
+	}) {
+		if version.Err != nil {
+			listErr = version.Err
+			break
+		}
+		if len(versions) > 0 && versions[0].Key != version.Key {
+			send()
+		}
+		versions = append(versions, version)
+	}
+	if listErr == nil {
+		send()
+	}
+	close(objectCh)
+	wg.Wait()
+
+	slices.SortFunc(report.Objects, func(a, b RestoredObject) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	report.Elapsed = time.Since(start)
+	if listErr != nil {
+		return report, listErr
+	}
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return report, firstErr
+}
+
+// restoreObjectAction returns the action restoring an object to its state
+// at t, versions are the versions of the object, newest first.
+func restoreObjectAction(versions []ObjectInfo, t time.Time, removeNewer bool) RestoredObject {
+	current := versions[0]
+	res := RestoredObject{Key: current.Key, CurrentVersionID: current.VersionID}
+
+	var then *ObjectInfo
+	for i := range versions {
+		if !versions[i].LastModified.After(t) {
+			then = &versions[i]
+			break
+		}
+	}
+
+	switch {
+	case then == nil || then.IsDeleteMarker:
+		// The object did not exist at t.
+		switch {
+		case current.IsDeleteMarker:
+			res.Action = RestoreUnchanged
+		case removeNewer:
+			res.Action = RestoreRemoved
+		default:
+			res.Action = RestoreSkipped
+		}
+	case then.VersionID == current.VersionID:
+		res.VersionID, res.Action = then.VersionID, RestoreUnchanged
+	case current.IsDeleteMarker:
+		res.VersionID, res.Action = then.VersionID, RestoreRecreated
+	default:
+		res.VersionID, res.Action = then.VersionID, RestoreCopied
+	}
+	return res
+}
+
+// restoreObject takes the action of res.
+func (c *Client) restoreObject(ctx context.Context, bucketName string, res *RestoredObject) {
+	switch res.Action {
+	case RestoreCopied, RestoreRecreated:
+		var copied BatchCopyResult
+		res.Err = c.batchCopyObject(ctx, CopyDestOptions{Bucket: bucketName, Object: res.Key},
+			CopySrcOptions{Bucket: bucketName, Object: res.Key, VersionID: res.VersionID}, &copied)
+		res.RestoredVersionID = copied.TargetVersionID
+	case RestoreRemoved:
+		removed := c.removeObject(ctx, bucketName, res.Key, RemoveObjectOptions{})
+		res.Err, res.RestoredVersionID = removed.Err, removed.DeleteMarkerVersionID
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestRestorePrefixToTime(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case q.Has("versions"):
+			io.WriteString(w, `<ListVersionsResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`+
+				// Modified since.
+				`<Version><Key>a</Key><VersionId>a3</VersionId><IsLatest>true</IsLatest><LastModified>2025-01-03T00:00:00.000Z</LastModified></Version>`+
+				`<Version><Key>a</Key><VersionId>a2</VersionId><LastModified>2025-01-02T00:00:00.000Z</LastModified></Version>`+
+				`<Version><Key>a</Key><VersionId>a1</VersionId><LastModified>2025-01-01T00:00:00.000Z</LastModified></Version>`+
+				// Deleted since.
+				`<DeleteMarker><Key>b</Key><VersionId>b2</VersionId><IsLatest>true</IsLatest><LastModified>2025-01-03T00:00:00.000Z</LastModified></DeleteMarker>`+
+				`<Version><Key>b</Key><VersionId>b1</VersionId><LastModified>2025-01-01T00:00:00.000Z</LastModified></Version>`+
+				// Created since.
+				`<Version><Key>c</Key><VersionId>c1</VersionId><IsLatest>true</IsLatest><LastModified>2025-01-03T00:00:00.000Z</LastModified></Version>`+
+				// Not modified since.
+				`<Version><Key>d</Key><VersionId>d1</VersionId><IsLatest>true</IsLatest><LastModified>2025-01-01T00:00:00.000Z</LastModified></Version>`+
+				// Deleted before.
+				`<DeleteMarker><Key>e</Key><VersionId>e2</VersionId><IsLatest>true</IsLatest><LastModified>2025-01-01T00:00:00.000Z</LastModified></DeleteMarker>`+
+				`<Version><Key>e</Key><VersionId>e1</VersionId><LastModified>2024-12-31T00:00:00.000Z</LastModified></Version>`+
+				`</ListVersionsResult>`)
+			return
+		case r.Method == http.MethodHead:
+			w.Header().Set("Content-Length", "3")
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Header().Set("X-Amz-Version-Id", q.Get("versionId"))
+			return
+		}
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("X-Amz-Copy-Source"))
+		mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			w.Header().Set("X-Amz-Version-Id", "copy")
+			io.WriteString(w, `<CopyObjectResult><ETag>"etag"</ETag><LastModified>2025-01-04T00:00:00.000Z</LastModified></CopyObjectResult>`)
+		case http.MethodDelete:
+			w.Header().Set("X-Amz-Version-Id", "marker")
+			w.Header().Set("X-Amz-Delete-Marker", "true")
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2025, time.January, 2, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		opts     RestorePrefixOptions
+		actions  []RestoredObject
+		requests []string
+	}{
+		{
+			opts: RestorePrefixOptions{DryRun: true, Parallel: 2},
+			actions: []RestoredObject{
+				{Key: "a", Action: RestoreCopied, VersionID: "a2", CurrentVersionID: "a3"},
+				{Key: "b", Action: RestoreRecreated, VersionID: "b1", CurrentVersionID: "b2"},
+				{Key: "c", Action: RestoreSkipped, CurrentVersionID: "c1"},
+				{Key: "d", Action: RestoreUnchanged, VersionID: "d1", CurrentVersionID: "d1"},
+				{Key: "e", Action: RestoreUnchanged, CurrentVersionID: "e2"},
+			},
+		},
+		{
+			opts: RestorePrefixOptions{RemoveNewer: true, Parallel: 2},
+			actions: []RestoredObject{
+				{Key: "a", Action: RestoreCopied, VersionID: "a2", CurrentVersionID: "a3", RestoredVersionID: "copy"},
+				{Key: "b", Action: RestoreRecreated, VersionID: "b1", CurrentVersionID: "b2", RestoredVersionID: "copy"},
+				{Key: "c", Action: RestoreRemoved, CurrentVersionID: "c1", RestoredVersionID: "marker"},
+				{Key: "d", Action: RestoreUnchanged, VersionID: "d1", CurrentVersionID: "d1"},
+				{Key: "e", Action: RestoreUnchanged, CurrentVersionID: "e2"},
+			},
+			requests: []string{
+				"DELETE /bucket/c ",
+				"PUT /bucket/a bucket/a?versionId=a2",
+				"PUT /bucket/b bucket/b?versionId=b1",
+			},
+		},
+	}
+	for i, testCase := range testCases {
+		requests = nil
+		report, err := c.RestorePrefixToTime(context.Background(), "bucket", "", at, testCase.opts)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if !slices.Equal(report.Objects, testCase.actions) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.actions, report.Objects)
+		}
+		if report.Copied != 1 || report.Recreated != 1 || report.Unchanged != 2 || report.Removed+report.Skipped != 1 {
+			t.Errorf("Test %d: unexpected counts %+v", i+1, report)
+		}
+		slices.Sort(requests)
+		if !slices.Equal(requests, testCase.requests) {
+			t.Errorf("Test %d: expected requests %q, got %q", i+1, testCase.requests, requests)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"encoding/xml"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/tags"
@@ -140,19 +141,66 @@ func (r *RestoreRequest) SetOutputLocation(v OutputLocation) {
 	r.OutputLocation = &v
 }
 
+// SetSelectOutput configures a SELECT type restore, the results of the
+// select query are written under prefix in the bucket bucketName which
+// may differ from the bucket of the archived object.
+func (r *RestoreRequest) SetSelectOutput(params SelectParameters, bucketName, prefix string) {
+	r.SetType(RestoreSelect)
+	r.SetSelectParameters(params)
+	r.SetOutputLocation(OutputLocation{
+		S3: S3{
+			BucketName: bucketName,
+			Prefix:     prefix,
+		},
+	})
+}
+
+// RestoreObjectResult contains the response of a successful RestoreObject call.
+type RestoreObjectResult struct {
+	// AlreadyRestored is set when the object already has a restored
+	// copy, in that case only the expiry of the copy is updated.
+	AlreadyRestored bool
+	// OutputPath is the path where SELECT type restore results are
+	// written, relative to the output bucket.
+	OutputPath string
+}
+
+// ParseRestoreInfo parses the value of the x-amz-restore header, e.g.
+// `ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`.
+func ParseRestoreInfo(restore string) (RestoreInfo, error) {
+	ongoing, expTime, err := amzRestoreToStruct(restore)
+	if err != nil {
+		return RestoreInfo{}, err
+	}
+	return RestoreInfo{OngoingRestore: ongoing, ExpiryTime: expTime}, nil
+}
+
 // RestoreObject is a implementation of https://docs.aws.amazon.com/AmazonS3/latest/API/API_RestoreObject.html AWS S3 API
 func (c *Client) RestoreObject(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) error {
+	_, err := c.RestoreObjectWithResult(ctx, bucketName, objectName, versionID, req)
+	return err
+}
+
+// RestoreObjectWithResult is like RestoreObject but additionally returns
+// whether the object was already restored and the output path of SELECT
+// type restores.
+func (c *Client) RestoreObjectWithResult(ctx context.Context, bucketName, objectName, versionID string, req RestoreRequest) (RestoreObjectResult, error) {
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
-		return err
+		return RestoreObjectResult{}, err
 	}
 	if err := s3utils.CheckValidObjectName(objectName); err != nil {
-		return err
+		return RestoreObjectResult{}, err
+	}
+	if req.OutputLocation != nil {
+		if err := s3utils.CheckValidBucketName(req.OutputLocation.S3.BucketName); err != nil {
+			return RestoreObjectResult{}, err
+		}
 	}
 
 	restoreRequestBytes, err := xml.Marshal(req)
 	if err != nil {
-		return err
+		return RestoreObjectResult{}, err
 	}
 
 	urlValues := make(url.Values)
@@ -166,17 +214,62 @@ func (c *Client) RestoreObject(ctx context.Context, bucketName, objectName, vers
 		bucketName:       bucketName,
 		objectName:       objectName,
 		queryValues:      urlValues,
-		contentMD5Base64: sumMD5Base64(restoreRequestBytes),
+		contentMD5Base64: c.contentMD5Base64(restoreRequestBytes),
+		md5Required:      true,
 		contentSHA256Hex: sum256Hex(restoreRequestBytes),
 		contentBody:      bytes.NewReader(restoreRequestBytes),
 		contentLength:    int64(len(restoreRequestBytes)),
+		expectStatus:     http.StatusAccepted,
 	})
 	defer closeResponse(resp)
 	if err != nil {
-		return err
+		return RestoreObjectResult{}, err
 	}
 	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
-		return httpRespToErrorResponse(resp, bucketName, "")
+		return RestoreObjectResult{}, httpRespToErrorResponse(resp, bucketName, "")
+	}
+	return RestoreObjectResult{
+		AlreadyRestored: resp.StatusCode == http.StatusOK && req.Type == nil,
+		OutputPath:      resp.Header.Get(amzRestoreOutputPath),
+	}, nil
+}
+
+// WaitForRestore polls the version versionID of the object, the latest
+// version if empty, every pollInterval until its restore has completed,
+// the restored copy is then available for reads. The latest ObjectInfo
+// of the version is returned. An error is returned if no restore was
+// requested for the version.
+func (c *Client) WaitForRestore(ctx context.Context, bucketName, objectName, versionID string, pollInterval time.Duration) (ObjectInfo, error) {
+	if pollInterval <= 0 {
+		return ObjectInfo{}, errInvalidArgument("Poll interval must be greater than zero.")
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{VersionID: versionID})
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		if info.Restore == nil {
+			return info, ErrorResponse{
+				StatusCode: http.StatusConflict,
+				Code:       InvalidObjectState,
+				Message:    "No restore request found for the object.",
+				BucketName: bucketName,
+				Key:        objectName,
+				RequestID:  "minio",
+			}
+		}
+		if !info.Restore.OngoingRestore {
+			return info, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return info, ctx.Err()
+		case <-ticker.C:
+		}
 	}
-	return nil
 }
@@ -0,0 +1,166 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// restoreTestServer completes the restore of an archived version after
+// a number of HEAD requests.
+type restoreTestServer struct {
+	mu sync.Mutex
+	// pending is the number of HEAD requests until the restore completes,
+	// no restore is in progress if negative.
+	pending int
+	// stats are the versions of the HEAD requests.
+	stats []string
+	// status of the restore requests.
+	status int
+}
+
+func (s *restoreTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodHead:
+		s.stats = append(s.stats, r.URL.Query().Get("versionId"))
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", "0")
+		switch {
+		case s.pending > 0:
+			s.pending--
+			w.Header().Set("X-Amz-Restore", `ongoing-request="true"`)
+		case s.pending == 0:
+			w.Header().Set("X-Amz-Restore", `ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`)
+		}
+	case http.MethodPost:
+		if r.URL.Query().Get("versionId") != "v1" {
+			http.Error(w, "unexpected version", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set(amzRestoreOutputPath, "output/path")
+		w.WriteHeader(s.status)
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+	}
+}
+
+func newRestoreTestClient(t *testing.T, s *restoreTestServer) *Client {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestRestoreObjectWithResult(t *testing.T) {
+	selectType := RestoreSelect
+	testCases := []struct {
+		status int
+		req    RestoreRequest
+		result RestoreObjectResult
+	}{
+		{http.StatusAccepted, RestoreRequest{}, RestoreObjectResult{OutputPath: "output/path"}},
+		{http.StatusOK, RestoreRequest{}, RestoreObjectResult{AlreadyRestored: true, OutputPath: "output/path"}},
+		// SELECT restores are not restored copies.
+		{http.StatusOK, RestoreRequest{Type: &selectType}, RestoreObjectResult{OutputPath: "output/path"}},
+	}
+	for i, testCase := range testCases {
+		c := newRestoreTestClient(t, &restoreTestServer{status: testCase.status})
+		result, err := c.RestoreObjectWithResult(context.Background(), "bucket", "object", "v1", testCase.req)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if result != testCase.result {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.result, result)
+		}
+	}
+
+	c := newRestoreTestClient(t, &restoreTestServer{status: http.StatusConflict})
+	if _, err := c.RestoreObjectWithResult(context.Background(), "bucket", "object", "v1", RestoreRequest{}); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestWaitForRestore(t *testing.T) {
+	// Pending restores are polled until they complete.
+	s := &restoreTestServer{pending: 2}
+	c := newRestoreTestClient(t, s)
+	info, err := c.WaitForRestore(context.Background(), "bucket", "object", "v1", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Restore == nil || info.Restore.OngoingRestore || info.Restore.ExpiryTime.IsZero() {
+		t.Errorf("expected a completed restore, got %+v", info.Restore)
+	}
+	if expected := []string{"v1", "v1", "v1"}; strings.Join(s.stats, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected HEAD requests of versions %v, got %v", expected, s.stats)
+	}
+
+	// Completed restores are not polled.
+	s = &restoreTestServer{}
+	c = newRestoreTestClient(t, s)
+	if _, err = c.WaitForRestore(context.Background(), "bucket", "object", "", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.stats) != 1 || s.stats[0] != "" {
+		t.Errorf("expected a single HEAD request of the latest version, got %v", s.stats)
+	}
+
+	// Objects without restore fail.
+	c = newRestoreTestClient(t, &restoreTestServer{pending: -1})
+	if _, err = c.WaitForRestore(context.Background(), "bucket", "object", "", time.Millisecond); ToErrorResponse(err).Code != InvalidObjectState {
+		t.Errorf("expected %s, got %v", InvalidObjectState, err)
+	}
+
+	if _, err = c.WaitForRestore(context.Background(), "bucket", "object", "", 0); ToErrorResponse(err).Code != InvalidArgument {
+		t.Errorf("expected %s, got %v", InvalidArgument, err)
+	}
+}
+
+func TestWaitForRestoreCancel(t *testing.T) {
+	s := &restoreTestServer{pending: 1 << 30}
+	c := newRestoreTestClient(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.WaitForRestore(ctx, "bucket", "object", "v1", 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.stats) < 2 {
+		t.Errorf("expected the restore to be polled, got %d requests", len(s.stats))
+	}
+}
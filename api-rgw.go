@@ -0,0 +1,133 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+const (
+	// rgwHeaderPrefix is the prefix of the headers of Ceph RGW.
+	rgwHeaderPrefix = "X-Rgw-"
+
+	// rgwNextAppendPosition is the attribute of appendable objects of
+	// Ceph RGW with the offset of the next append.
+	rgwNextAppendPosition = "next-append-position"
+)
+
+// rgwAttributes returns the x-rgw-* headers of h without their prefix
+// in lower case, nil if there are none.
+func rgwAttributes(h http.Header) StringMap {
+	var attrs StringMap
+	for k, v := range h {
+		if !strings.HasPrefix(k, rgwHeaderPrefix) || len(v) == 0 {
+			continue
+		}
+		if attrs == nil {
+			attrs = make(StringMap)
+		}
+		attrs[strings.ToLower(strings.TrimPrefix(k, rgwHeaderPrefix))] = v[0]
+	}
+	return attrs
+}
+
+// RGWBucketStats are the statistics of a bucket of Ceph RGW.
+type RGWBucketStats struct {
+	// Number of objects of the bucket.
+	ObjectCount int64
+	// Bytes used by the objects of the bucket.
+	BytesUsed int64
+	// Quotas of the bucket, -1 if unlimited.
+	QuotaMaxObjects int64
+	QuotaMaxSize    int64
+	// All x-rgw-* attributes of the bucket without their prefix.
+	Attributes StringMap
+}
+
+// GetRGWBucketStats returns the statistics of a bucket of Ceph RGW,
+// which are gathered from the shards of the index of the bucket.
+func (c *Client) GetRGWBucketStats(ctx context.Context, bucketName string) (RGWBucketStats, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return RGWBucketStats{}, err
+	}
+
+	urlValues := make(url.Values)
+	urlValues.Set("read-stats", "true")
+	resp, err := c.executeMethod(ctx, http.MethodHead, requestMetadata{
+		bucketName:       bucketName,
+		queryValues:      urlValues,
+		contentSHA256Hex: emptySHA256Hex,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return RGWBucketStats{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return RGWBucketStats{}, httpRespToErrorResponse(resp, bucketName, "")
+	}
+
+	stats := RGWBucketStats{
+		QuotaMaxObjects: -1,
+		QuotaMaxSize:    -1,
+		Attributes:      rgwAttributes(resp.Header),
+	}
+	for attr, v := range map[string]*int64{
+		"object-count":         &stats.ObjectCount,
+		"bytes-used":           &stats.BytesUsed,
+		"quota-bucket-objects": &stats.QuotaMaxObjects,
+		"quota-bucket-size":    &stats.QuotaMaxSize,
+	} {
+		s, ok := stats.Attributes[attr]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return RGWBucketStats{}, ErrorResponse{
+				Code:       InternalError,
+				Message:    "x-rgw-" + attr + " is not an integer",
+				BucketName: bucketName,
+			}
+		}
+		*v = n
+	}
+	return stats, nil
+}
+
+// rgwAppendPosition returns the offset at which data is appended to an
+// object of Ceph RGW, objects which do not exist are created by the
+// first append.
+func (c *Client) rgwAppendPosition(ctx context.Context, bucketName, objectName string) (int64, error) {
+	info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{})
+	if err != nil {
+		if ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if pos, ok := info.RGWAttributes[rgwNextAppendPosition]; ok {
+		return strconv.ParseInt(pos, 10, 64)
+	}
+	return info.Size, nil
+}
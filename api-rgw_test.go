@@ -0,0 +1,150 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// newRGWTestServer returns a server emulating the extensions of Ceph RGW.
+func newRGWTestServer(t *testing.T) (*httptest.Server, *url.Values) {
+	var (
+		mu        sync.Mutex
+		object    []byte
+		exists    bool
+		listQuery url.Values
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		query := r.URL.Query()
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/bucket/":
+			if query.Get("read-stats") != "true" {
+				t.Errorf("unexpected query %v", query)
+			}
+			w.Header().Set("X-RGW-Object-Count", "3")
+			w.Header().Set("X-RGW-Bytes-Used", "1024")
+			w.Header().Set("X-RGW-Quota-Bucket-Size", "-1")
+			w.Header().Set("X-RGW-Quota-Bucket-Objects", "100")
+		case r.Method == http.MethodHead:
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+			w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+			w.Header().Set("X-RGW-Object-Type", "Appendable")
+			w.Header().Set("X-RGW-Next-Append-Position", strconv.Itoa(len(object)))
+		case r.Method == http.MethodPut:
+			if !query.Has("append") || query.Get("position") != strconv.Itoa(len(object)) {
+				t.Errorf("unexpected append query %v with object of size %d", query, len(object))
+			}
+			if r.Header.Get("X-Amz-Write-Offset-Bytes") != "" {
+				t.Error("unexpected S3 Express append header")
+			}
+			data, _ := io.ReadAll(r.Body)
+			object, exists = append(object, data...), true
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("X-RGW-Next-Append-Position", strconv.Itoa(len(object)))
+		case r.Method == http.MethodGet:
+			listQuery = query
+			w.Write([]byte(`<ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated></ListBucketResult>`))
+		}
+	}))
+	return srv, &listQuery
+}
+
+func newRGWTestClient(t *testing.T, srv *httptest.Server, profile Profile) *Client {
+	t.Helper()
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:   credentials.NewStaticV4("access", "secret", ""),
+		Region:  "us-east-1",
+		Profile: profile,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clnt
+}
+
+func TestRGWAppendObject(t *testing.T) {
+	srv, _ := newRGWTestServer(t)
+	defer srv.Close()
+	clnt := newRGWTestClient(t, srv, ProfileRGW)
+
+	ctx := context.Background()
+	for i, data := range []string{"abcd", "efg"} {
+		info, err := clnt.AppendObject(ctx, "bucket", "object", strings.NewReader(data), int64(len(data)), AppendObjectOptions{DisableContentSha256: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := int64([]int{4, 7}[i]); info.Size != expected {
+			t.Fatalf("append %d: expected size %d, got %d", i+1, expected, info.Size)
+		}
+	}
+
+	info, err := clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.RGWAttributes["object-type"] != "Appendable" || info.RGWAttributes["next-append-position"] != "7" {
+		t.Fatalf("unexpected RGW attributes %v", info.RGWAttributes)
+	}
+}
+
+func TestGetRGWBucketStats(t *testing.T) {
+	srv, _ := newRGWTestServer(t)
+	defer srv.Close()
+	clnt := newRGWTestClient(t, srv, ProfileRGW)
+
+	stats, err := clnt.GetRGWBucketStats(context.Background(), "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.ObjectCount != 3 || stats.BytesUsed != 1024 || stats.QuotaMaxObjects != 100 || stats.QuotaMaxSize != -1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestRGWUnorderedListing(t *testing.T) {
+	srv, listQuery := newRGWTestServer(t)
+	defer srv.Close()
+
+	for _, profile := range []Profile{ProfileRGW, ProfileS3} {
+		clnt := newRGWTestClient(t, srv, profile)
+		for obj := range clnt.ListObjects(context.Background(), "bucket", ListObjectsOptions{AllowUnordered: true}) {
+			if obj.Err != nil {
+				t.Fatal(obj.Err)
+			}
+		}
+		if unordered := listQuery.Get("allow-unordered") == "true"; unordered != (profile == ProfileRGW) {
+			t.Errorf("%s: unexpected listing query %v", profile, *listQuery)
+		}
+	}
+}
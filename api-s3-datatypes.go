@@ -118,6 +118,12 @@ type Version struct {
 	isDeleteMarker bool
 }
 
+// IsDeleteMarker tells whether the version is a delete marker, i.e. a
+// <DeleteMarker> of the response.
+func (v Version) IsDeleteMarker() bool {
+	return v.isDeleteMarker
+}
+
 // ListVersionsResult is an element in the list object versions response
 // and has a special Unmarshaler because we need to preserver the order
 // of <Version>  and <DeleteMarker> in ListVersionsResult.Versions slice
@@ -274,6 +280,13 @@ type initiator struct {
 type copyObjectResult struct {
 	ETag         string
 	LastModified time.Time // time string format "2006-01-02T15:04:05.000Z"
+
+	// Checksum values of copied parts.
+	ChecksumCRC32     string
+	ChecksumCRC32C    string
+	ChecksumSHA1      string
+	ChecksumSHA256    string
+	ChecksumCRC64NVME string
 }
 
 // ObjectPart container for particular part of an object.
@@ -426,8 +439,23 @@ type completeMultipartUpload struct {
 
 // createBucketConfiguration container for bucket configuration.
 type createBucketConfiguration struct {
-	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CreateBucketConfiguration" json:"-"`
-	Location string   `xml:"LocationConstraint"`
+	XMLName           xml.Name                 `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CreateBucketConfiguration" json:"-"`
+	Location          string                   `xml:"LocationConstraint,omitempty"`
+	DirectoryLocation *directoryBucketLocation `xml:"Location,omitempty"`
+	Bucket            *directoryBucketInfo     `xml:"Bucket,omitempty"`
+}
+
+// directoryBucketLocation container for the location of a directory
+// bucket.
+type directoryBucketLocation struct {
+	Name string
+	Type string
+}
+
+// directoryBucketInfo container for the type of a directory bucket.
+type directoryBucketInfo struct {
+	DataRedundancy string
+	Type           string
 }
 
 // deleteObject container for Delete element in MultiObjects Delete XML request
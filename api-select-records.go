@@ -0,0 +1,166 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// SelectRecordBatch is a batch of select records stored by column, like
+// an Arrow record batch. Columns holds the names of the columns in the
+// order they were first seen, Values holds the values of every column
+// with one value per row, nil if the row has no value for the column.
+//
+// Values are decoded from JSON, numbers are json.Number so they can be
+// appended to Arrow builders of any numeric type without losing
+// precision, which keeps this package free of an Arrow dependency.
+type SelectRecordBatch struct {
+	Columns []string
+	Values  [][]any
+	NumRows int
+}
+
+// Column returns the values of the named column, or nil if the batch has
+// no such column.
+func (b *SelectRecordBatch) Column(name string) []any {
+	for i, column := range b.Columns {
+		if column == name {
+			return b.Values[i]
+		}
+	}
+	return nil
+}
+
+// SelectRecordBatchReader reads the records of a select request with JSON
+// output serialization as record batches, see SelectResults.RecordBatches.
+//
+//	reader := results.RecordBatches(1024)
+//	for reader.Next() {
+//		batch := reader.Batch()
+//		...
+//	}
+//	if err := reader.Err(); err != nil {
+//		...
+//	}
+type SelectRecordBatchReader struct {
+	dec       *json.Decoder
+	batchSize int
+	batch     *SelectRecordBatch
+	err       error
+}
+
+// RecordBatches returns a reader of the results as batches of at most
+// batchSize records, the query must use JSON output serialization.
+func (s *SelectResults) RecordBatches(batchSize int) *SelectRecordBatchReader {
+	dec := json.NewDecoder(s)
+	dec.UseNumber()
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &SelectRecordBatchReader{dec: dec, batchSize: batchSize}
+}
+
+// Next reads the next batch, it returns false once all records were read
+// or reading failed, see Err.
+func (r *SelectRecordBatchReader) Next() bool {
+	r.batch = nil
+	if r.err != nil {
+		return false
+	}
+	batch := &SelectRecordBatch{}
+	index := make(map[string]int)
+	for batch.NumRows < r.batchSize {
+		names, values, err := r.decodeRecord()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				r.err = err
+			}
+			break
+		}
+		for j, name := range names {
+			i, ok := index[name]
+			if !ok {
+				i = len(batch.Columns)
+				index[name] = i
+				batch.Columns = append(batch.Columns, name)
+				// The previous rows have no value for the new column.
+				batch.Values = append(batch.Values, make([]any, batch.NumRows, r.batchSize))
+			}
+			batch.Values[i] = append(batch.Values[i], values[j])
+		}
+		batch.NumRows++
+		for i := range batch.Values {
+			if len(batch.Values[i]) < batch.NumRows {
+				batch.Values[i] = append(batch.Values[i], nil)
+			}
+		}
+	}
+	if batch.NumRows == 0 {
+		return false
+	}
+	r.batch = batch
+	return true
+}
+
+// decodeRecord decodes the next JSON object, keeping the order of its
+// names. Objects with duplicate names are rejected, they have no single
+// value per column.
+func (r *SelectRecordBatchReader) decodeRecord() (names []string, values []any, err error) {
+	tok, err := r.dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, nil, fmt.Errorf("select record is not a JSON object: %v", tok)
+	}
+	for r.dec.More() {
+		tok, err := r.dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		name := tok.(string)
+		if slices.Contains(names, name) {
+			return nil, nil, fmt.Errorf("select record has duplicate name %q", name)
+		}
+		var value any
+		if err := r.dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		names = append(names, name)
+		values = append(values, value)
+	}
+	// The closing delimiter of the object.
+	if _, err := r.dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	return names, values, nil
+}
+
+// Batch returns the batch read by the last call of Next.
+func (r *SelectRecordBatchReader) Batch() *SelectRecordBatch {
+	return r.batch
+}
+
+// Err returns the error which stopped reading, if any.
+func (r *SelectRecordBatchReader) Err() error {
+	return r.err
+}
@@ -0,0 +1,135 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSelectRecordBatches(t *testing.T) {
+	pr, pw := io.Pipe()
+	go func() {
+		io.Copy(pw, strings.NewReader(`{"name":"a","size":1}
+{"name":"b","size":2,"tier":"hot"}
+{"size":3}
+`))
+		pw.Close()
+	}()
+	reader := (&SelectResults{pipeReader: pr}).RecordBatches(2)
+
+	var batches []*SelectRecordBatch
+	for reader.Next() {
+		batches = append(batches, reader.Batch())
+	}
+	if err := reader.Err(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []*SelectRecordBatch{
+		{
+			Columns: []string{"name", "size", "tier"},
+			Values: [][]any{
+				{"a", "b"},
+				{json.Number("1"), json.Number("2")},
+				{nil, "hot"},
+			},
+			NumRows: 2,
+		},
+		{
+			Columns: []string{"size"},
+			Values:  [][]any{{json.Number("3")}},
+			NumRows: 1,
+		},
+	}
+	if !reflect.DeepEqual(batches, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, batches)
+	}
+	if column := batches[0].Column("tier"); !reflect.DeepEqual(column, []any{nil, "hot"}) {
+		t.Errorf("unexpected column %v", column)
+	}
+	if column := batches[1].Column("tier"); column != nil {
+		t.Errorf("unexpected column %v", column)
+	}
+}
+
+func TestSelectRecordBatchesError(t *testing.T) {
+	testCases := []string{
+		"{\"a\":1}\n[1]\n",
+		"{\"a\":1}\n{\"a\":2,\"b\":3,\"a\":4}\n",
+	}
+	for i, testCase := range testCases {
+		pr, pw := io.Pipe()
+		go func() {
+			io.Copy(pw, strings.NewReader(testCase))
+			pw.Close()
+		}()
+		reader := (&SelectResults{pipeReader: pr}).RecordBatches(10)
+		if !reader.Next() || reader.Batch().NumRows != 1 {
+			t.Fatalf("Test %d: expected the batch of the records before the error", i+1)
+		}
+		if reader.Next() || reader.Err() == nil {
+			t.Fatalf("Test %d: expected an error", i+1)
+		}
+	}
+}
+
+func TestSelectObjectOptionsValidate(t *testing.T) {
+	testCases := []struct {
+		opts    SelectObjectOptions
+		success bool
+	}{
+		{SelectObjectOptions{
+			InputSerialization:  SelectObjectInputSerialization{Parquet: &ParquetInputOptions{}},
+			OutputSerialization: SelectObjectOutputSerialization{JSON: &JSONOutputOptions{}},
+		}, true},
+		{SelectObjectOptions{
+			InputSerialization:  SelectObjectInputSerialization{CompressionType: SelectCompressionNONE, Parquet: &ParquetInputOptions{}},
+			OutputSerialization: SelectObjectOutputSerialization{CSV: &CSVOutputOptions{}},
+		}, true},
+		{SelectObjectOptions{
+			InputSerialization:  SelectObjectInputSerialization{CompressionType: SelectCompressionGZIP, CSV: &CSVInputOptions{}},
+			OutputSerialization: SelectObjectOutputSerialization{CSV: &CSVOutputOptions{}},
+		}, true},
+		{SelectObjectOptions{
+			InputSerialization:  SelectObjectInputSerialization{CompressionType: SelectCompressionGZIP, Parquet: &ParquetInputOptions{}},
+			OutputSerialization: SelectObjectOutputSerialization{JSON: &JSONOutputOptions{}},
+		}, false},
+		{SelectObjectOptions{
+			InputSerialization:  SelectObjectInputSerialization{Parquet: &ParquetInputOptions{}, CSV: &CSVInputOptions{}},
+			OutputSerialization: SelectObjectOutputSerialization{JSON: &JSONOutputOptions{}},
+		}, false},
+		{SelectObjectOptions{
+			OutputSerialization: SelectObjectOutputSerialization{JSON: &JSONOutputOptions{}},
+		}, false},
+		{SelectObjectOptions{
+			InputSerialization: SelectObjectInputSerialization{Parquet: &ParquetInputOptions{}},
+		}, false},
+	}
+	for i, testCase := range testCases {
+		err := testCase.opts.validate()
+		if testCase.success && err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+		if !testCase.success && err == nil {
+			t.Errorf("Test %d: expected an error", i+1)
+		}
+	}
+}
@@ -90,7 +90,9 @@ const (
 	JSONLinesType    JSONType = "LINES"
 )
 
-// ParquetInputOptions parquet input specific options
+// ParquetInputOptions parquet input specific options, Parquet objects
+// may only be queried if the server supports it and the compression of
+// the input must be NONE since Parquet compresses the columns itself.
 type ParquetInputOptions struct{}
 
 // CSVInputOptions csv input specific options
@@ -360,17 +362,42 @@ type SelectObjectOptions struct {
 	RequestProgress      struct {
 		Enabled bool
 	}
+
+	requestExtensions
 }
 
 // Header returns the http.Header representation of the SelectObject options.
 func (o SelectObjectOptions) Header() http.Header {
 	headers := make(http.Header)
 	if o.ServerSideEncryption != nil && o.ServerSideEncryption.Type() == encrypt.SSEC {
-		o.ServerSideEncryption.Marshal(headers)
+		encrypt.SSE(o.ServerSideEncryption).Marshal(headers)
 	}
 	return headers
 }
 
+// validate checks that one input and one output serialization is set and
+// that Parquet input is not compressed.
+func (o SelectObjectOptions) validate() error {
+	in := o.InputSerialization
+	inputs := 0
+	for _, set := range []bool{in.Parquet != nil, in.CSV != nil, in.JSON != nil} {
+		if set {
+			inputs++
+		}
+	}
+	if inputs != 1 {
+		return errInvalidArgument("Exactly one of CSV, JSON or Parquet input serialization must be set")
+	}
+	if in.Parquet != nil && in.CompressionType != "" && in.CompressionType != SelectCompressionNONE {
+		return errInvalidArgument("Parquet input does not support compression type " + string(in.CompressionType))
+	}
+	out := o.OutputSerialization
+	if (out.CSV == nil) == (out.JSON == nil) {
+		return errInvalidArgument("Exactly one of CSV or JSON output serialization must be set")
+	}
+	return nil
+}
+
 // SelectObjectType - is the parameter which defines what type of object the
 // operation is being performed on.
 type SelectObjectType string
@@ -439,6 +466,8 @@ const (
 
 // SelectObjectContent is a implementation of http://docs.aws.amazon.com/AmazonS3/latest/API/RESTObjectSELECTContent.html AWS S3 API.
 func (c *Client) SelectObjectContent(ctx context.Context, bucketName, objectName string, opts SelectObjectOptions) (*SelectResults, error) {
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
+
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return nil, err
@@ -446,6 +475,9 @@ func (c *Client) SelectObjectContent(ctx context.Context, bucketName, objectName
 	if err := s3utils.CheckValidObjectName(objectName); err != nil {
 		return nil, err
 	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
 
 	selectReqBytes, err := xml.Marshal(opts)
 	if err != nil {
@@ -462,7 +494,8 @@ func (c *Client) SelectObjectContent(ctx context.Context, bucketName, objectName
 		objectName:       objectName,
 		queryValues:      urlValues,
 		customHeader:     opts.Header(),
-		contentMD5Base64: sumMD5Base64(selectReqBytes),
+		contentMD5Base64: c.contentMD5Base64(selectReqBytes),
+		md5Required:      true,
 		contentSHA256Hex: sum256Hex(selectReqBytes),
 		contentBody:      bytes.NewReader(selectReqBytes),
 		contentLength:    int64(len(selectReqBytes)),
@@ -0,0 +1,73 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// Signer signs the signature V4 requests of a Client. A custom Signer set
+// with Options.CustomSigner allows to sign with secret keys held by a HSM
+// or KMS, or to route signing through a gateway. The credentials passed
+// are those returned by Options.Creds, the secret key may be a placeholder
+// if the Signer does not need it (credentials without secret key are
+// anonymous and not signed).
+//
+// Requests signed with signature V2, to S3 Express One Zone directory
+// buckets and to Multi-Region Access Points are always signed by the
+// signer package.
+type Signer interface {
+	// SignV4 adds the signature V4 authorization header to req for
+	// region, signed at signingTime. The X-Amz-Content-Sha256 header is
+	// already set. If trailer is not empty the payload is sent aws-chunked
+	// with the unsigned trailer, see signer.SignV4Trailer.
+	SignV4(req *http.Request, creds credentials.Value, region string, trailer http.Header, signingTime time.Time) (*http.Request, error)
+
+	// PresignV4 adds the signature V4 query parameters to req for
+	// region, valid from signingTime for expires seconds.
+	PresignV4(req *http.Request, creds credentials.Value, region string, expires int64, signingTime time.Time) (*http.Request, error)
+
+	// StreamingSignV4 signs req with the streaming signature V4, the
+	// payload of dataLen bytes is signed chunk by chunk while it is
+	// read. Trailing headers, if any, are set in req.Trailer.
+	StreamingSignV4(req *http.Request, creds credentials.Value, region string, dataLen int64, reqTime time.Time) (*http.Request, error)
+}
+
+// DefaultSigner returns the Signer used when Options.CustomSigner is not
+// set, custom signers may delegate to it.
+func DefaultSigner() Signer {
+	return defaultSigner{}
+}
+
+type defaultSigner struct{}
+
+func (defaultSigner) SignV4(req *http.Request, creds credentials.Value, region string, trailer http.Header, signingTime time.Time) (*http.Request, error) {
+	return signer.SignV4TrailerWithTime(*req, creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken, region, trailer, signingTime), nil
+}
+
+func (defaultSigner) PresignV4(req *http.Request, creds credentials.Value, region string, expires int64, signingTime time.Time) (*http.Request, error) {
+	return signer.PreSignV4WithTime(*req, creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken, region, expires, signingTime), nil
+}
+
+func (defaultSigner) StreamingSignV4(req *http.Request, creds credentials.Value, region string, dataLen int64, reqTime time.Time) (*http.Request, error) {
+	return signer.StreamingSignV4(req, creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken, region, dataLen, reqTime, newSHA256Hasher()), nil
+}
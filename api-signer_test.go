@@ -0,0 +1,97 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// hsmSigner signs with a secret key unknown to the client.
+type hsmSigner struct {
+	calls atomic.Int32
+}
+
+func (s *hsmSigner) SignV4(req *http.Request, creds credentials.Value, region string, trailer http.Header, signingTime time.Time) (*http.Request, error) {
+	s.calls.Add(1)
+	creds.SecretAccessKey = "hsm-secret"
+	return DefaultSigner().SignV4(req, creds, region, trailer, signingTime)
+}
+
+func (s *hsmSigner) PresignV4(req *http.Request, creds credentials.Value, region string, expires int64, signingTime time.Time) (*http.Request, error) {
+	s.calls.Add(1)
+	creds.SecretAccessKey = "hsm-secret"
+	return DefaultSigner().PresignV4(req, creds, region, expires, signingTime)
+}
+
+func (s *hsmSigner) StreamingSignV4(req *http.Request, creds credentials.Value, region string, dataLen int64, reqTime time.Time) (*http.Request, error) {
+	s.calls.Add(1)
+	creds.SecretAccessKey = "hsm-secret"
+	return DefaultSigner().StreamingSignV4(req, creds, region, dataLen, reqTime)
+}
+
+func TestCustomSigner(t *testing.T) {
+	var authorization atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization.Store(r.Header.Get("Authorization"))
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", "0")
+	}))
+	defer srv.Close()
+
+	s := &hsmSigner{}
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:        credentials.NewStaticV4("access-key", "placeholder", ""),
+		Region:       "us-east-1",
+		CustomSigner: s,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := authorization.Load().(string); !strings.HasPrefix(v, "AWS4-HMAC-SHA256 Credential=access-key/") {
+		t.Fatalf("Unexpected authorization header %q", v)
+	}
+
+	if _, err = c.PutObject(context.Background(), "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := c.PresignedGetObject(context.Background(), "bucket", "object", time.Hour, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Query().Get("X-Amz-Signature") == "" {
+		t.Fatalf("Presigned URL is not signed: %s", u)
+	}
+
+	if n := s.calls.Load(); n != 3 {
+		t.Fatalf("Expected 3 calls to the custom signer, got %d", n)
+	}
+}
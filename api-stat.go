@@ -19,7 +19,9 @@ package minio
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"sync"
 
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
@@ -31,6 +33,9 @@ func (c *Client) BucketExists(ctx context.Context, bucketName string) (bool, err
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return false, err
 	}
+	if bucket, ok := outpostsBucketOf(bucketName); ok {
+		return c.outpostsBucketExists(ctx, bucket)
+	}
 
 	// Execute HEAD on bucketName.
 	resp, err := c.executeMethod(ctx, http.MethodHead, requestMetadata{
@@ -39,14 +44,14 @@ func (c *Client) BucketExists(ctx context.Context, bucketName string) (bool, err
 	})
 	defer closeResponse(resp)
 	if err != nil {
-		if ToErrorResponse(err).Code == NoSuchBucket {
+		if errors.Is(err, ErrNoSuchBucket) {
 			return false, nil
 		}
 		return false, err
 	}
 	if resp != nil {
 		resperr := httpRespToErrorResponse(resp, bucketName, "")
-		if ToErrorResponse(resperr).Code == NoSuchBucket {
+		if errors.Is(resperr, ErrNoSuchBucket) {
 			return false, nil
 		}
 		if resp.StatusCode != http.StatusOK {
@@ -59,6 +64,9 @@ func (c *Client) BucketExists(ctx context.Context, bucketName string) (bool, err
 // StatObject verifies if object exists, you have permission to access it
 // and returns information about the object.
 func (c *Client) StatObject(ctx context.Context, bucketName, objectName string, opts StatObjectOptions) (ObjectInfo, error) {
+	ctx = WithCredentials(ctx, opts.Credentials)
+	ctx = WithRetryPolicy(ctx, opts.RetryPolicy)
+	ctx = withRequestExtensions(ctx, opts.requestExtensions)
 	// Input validation.
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return ObjectInfo{}, ErrorResponse{
@@ -122,3 +130,50 @@ func (c *Client) StatObject(ctx context.Context, bucketName, objectName string,
 
 	return ToObjectInfo(bucketName, objectName, resp.Header)
 }
+
+// StatObjectsOptions represents options specified by user for StatObjects call
+type StatObjectsOptions struct {
+	// StatObjectOptions are used for every object, a VersionID
+	// should usually not be set.
+	StatObjectOptions
+
+	// Parallel is the number of concurrent HEAD requests,
+	// defaults to 4.
+	Parallel int
+}
+
+// StatObjects performs StatObject on all keys concurrently. The results are
+// returned in the order of the keys, the ObjectInfo of a key that could not
+// be stat'ed has Key and Err set.
+func (c *Client) StatObjects(ctx context.Context, bucketName string, keys []string, opts StatObjectsOptions) ([]ObjectInfo, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = totalWorkers
+	}
+
+	results := make([]ObjectInfo, len(keys))
+	idxCh := make(chan int)
+	var wg sync.WaitGroup
+	for range min(opts.Parallel, len(keys)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idxCh {
+				info, err := c.StatObject(ctx, bucketName, keys[i], opts.StatObjectOptions)
+				if err != nil {
+					info.Key = keys[i]
+					info.Err = err
+				}
+				results[i] = info
+			}
+		}()
+	}
+	for i := range keys {
+		idxCh <- i
+	}
+	close(idxCh)
+	wg.Wait()
+	return results, nil
+}
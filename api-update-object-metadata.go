@@ -0,0 +1,149 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// MetadataPatch describes a change to the metadata of an existing object,
+// fields left empty are preserved from the current object.
+type MetadataPatch struct {
+	// SetUserMetadata adds or overwrites user metadata keys, keys
+	// may be given with or without the `x-amz-meta-` prefix.
+	SetUserMetadata map[string]string
+	// RemoveUserMetadata removes user metadata keys.
+	RemoveUserMetadata []string
+
+	ContentType        string
+	ContentEncoding    string
+	ContentDisposition string
+	ContentLanguage    string
+	CacheControl       string
+	Expires            time.Time
+
+	// VersionID of the object to update, latest version if empty.
+	VersionID string
+
+	// ServerSideEncryption must be set to the SSE-C key of the
+	// object if it is encrypted with a customer provided key.
+	ServerSideEncryption encrypt.ServerSide
+}
+
+// UpdateObjectMetadata updates the metadata of an object in-place, by
+// copying the object onto itself with the metadata directive REPLACE.
+// The current metadata of the object is fetched and merged with the patch
+// so callers do not have to reconstruct all the headers themselves.
+//
+// On versioned buckets a new version of the object is created.
+func (c *Client) UpdateObjectMetadata(ctx context.Context, bucketName, objectName string, patch MetadataPatch) (UploadInfo, error) {
+	info, err := c.StatObject(ctx, bucketName, objectName, StatObjectOptions{
+		ServerSideEncryption: patch.ServerSideEncryption,
+		VersionID:            patch.VersionID,
+	})
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	dst := CopyDestOptions{
+		Bucket:             bucketName,
+		Object:             objectName,
+		Encryption:         patch.ServerSideEncryption,
+		UserMetadata:       mergeUserMetadata(info.UserMetadata, patch.SetUserMetadata, patch.RemoveUserMetadata),
+		ReplaceMetadata:    true,
+		ContentType:        info.ContentType,
+		ContentEncoding:    info.Metadata.Get("Content-Encoding"),
+		ContentDisposition: info.Metadata.Get("Content-Disposition"),
+		ContentLanguage:    info.Metadata.Get("Content-Language"),
+		CacheControl:       info.Metadata.Get("Cache-Control"),
+		Expires:            info.Expires,
+	}
+
+	// A metadata REPLACE resets the storage class and the server side
+	// encryption to the bucket defaults, preserve them.
+	if sc := info.Metadata.Get(amzStorageClass); sc != "" {
+		dst.UserMetadata[amzStorageClass] = sc
+	}
+	if patch.ServerSideEncryption == nil {
+		for _, k := range []string{"X-Amz-Server-Side-Encryption", "X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"} {
+			if v := info.Metadata.Get(k); v != "" {
+				dst.UserMetadata[k] = v
+			}
+		}
+	}
+
+	if patch.ContentType != "" {
+		dst.ContentType = patch.ContentType
+	}
+	if patch.ContentEncoding != "" {
+		dst.ContentEncoding = patch.ContentEncoding
+	}
+	if patch.ContentDisposition != "" {
+		dst.ContentDisposition = patch.ContentDisposition
+	}
+	if patch.ContentLanguage != "" {
+		dst.ContentLanguage = patch.ContentLanguage
+	}
+	if patch.CacheControl != "" {
+		dst.CacheControl = patch.CacheControl
+	}
+	if !patch.Expires.IsZero() {
+		dst.Expires = patch.Expires
+	}
+
+	src := CopySrcOptions{
+		Bucket:     bucketName,
+		Object:     objectName,
+		VersionID:  patch.VersionID,
+		MatchETag:  info.ETag,
+		Encryption: patch.ServerSideEncryption,
+	}
+
+	if info.Size > maxPartSize {
+		return c.ComposeObject(ctx, dst, src)
+	}
+	return c.CopyObject(ctx, dst, src)
+}
+
+// mergeUserMetadata applies the set and remove operations on the current
+// user metadata, keys are compared case-insensitively.
+func mergeUserMetadata(current map[string]string, set map[string]string, remove []string) map[string]string {
+	canonical := func(k string) string {
+		if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+			k = k[len("x-amz-meta-"):]
+		}
+		return http.CanonicalHeaderKey(k)
+	}
+
+	merged := make(map[string]string, len(current)+len(set))
+	for k, v := range current {
+		merged[canonical(k)] = v
+	}
+	for _, k := range remove {
+		delete(merged, canonical(k))
+	}
+	for k, v := range set {
+		merged[canonical(k)] = v
+	}
+	return merged
+}
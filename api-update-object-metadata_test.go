@@ -0,0 +1,100 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestUpdateObjectMetadata(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		copied http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead:
+			for k, v := range map[string]string{
+				"ETag":                         `"etag"`,
+				"Last-Modified":                "Mon, 02 Jan 2006 15:04:05 GMT",
+				"Content-Length":               "4",
+				"Content-Type":                 "text/plain",
+				"Cache-Control":                "max-age=60",
+				"X-Amz-Meta-Foo":               "1",
+				"X-Amz-Meta-Bar":               "2",
+				amzStorageClass:                "STANDARD_IA",
+				"X-Amz-Server-Side-Encryption": "aws:kms",
+				"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": "my-key",
+			} {
+				w.Header().Set(k, v)
+			}
+		case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+			mu.Lock()
+			copied = r.Header.Clone()
+			mu.Unlock()
+			io.WriteString(w, `<CopyObjectResult><ETag>"etag2"</ETag><LastModified>2006-01-02T15:04:05Z</LastModified></CopyObjectResult>`)
+		default:
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.UpdateObjectMetadata(context.Background(), "bucket", "object", MetadataPatch{
+		SetUserMetadata:    map[string]string{"foo": "10", "new": "3"},
+		RemoveUserMetadata: []string{"bar"},
+		ContentLanguage:    "en",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"X-Amz-Copy-Source":                           "bucket/object",
+		"X-Amz-Metadata-Directive":                    "REPLACE",
+		"X-Amz-Copy-Source-If-Match":                  "etag",
+		"X-Amz-Meta-Foo":                              "10",
+		"X-Amz-Meta-New":                              "3",
+		"X-Amz-Meta-Bar":                              "",
+		"Content-Type":                                "text/plain",
+		"Content-Language":                            "en",
+		"Cache-Control":                               "max-age=60",
+		amzStorageClass:                               "STANDARD_IA",
+		"X-Amz-Server-Side-Encryption":                "aws:kms",
+		"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": "my-key",
+	}
+	for k, v := range expected {
+		if got := copied.Get(k); got != v {
+			t.Errorf("expected %s %q, got %q", k, v, got)
+		}
+	}
+}
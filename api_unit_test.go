@@ -18,7 +18,16 @@
 package minio
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -228,3 +237,180 @@ func TestMakeTargetURL(t *testing.T) {
 		}
 	}
 }
+
+// TestMakeTargetURLFIPS - testing makeTargetURL() with FIPS endpoints.
+func TestMakeTargetURLFIPS(t *testing.T) {
+	enabled, disabled := true, false
+	testCases := []struct {
+		dualstack      *bool
+		bucketLocation string
+		expectedHost   string
+	}{
+		{nil, "", "mybucket.s3-fips.dualstack.us-east-1.amazonaws.com"},
+		{&enabled, "us-gov-west-1", "mybucket.s3-fips.dualstack.us-gov-west-1.amazonaws.com"},
+		{&disabled, "us-west-2", "mybucket.s3-fips.us-west-2.amazonaws.com"},
+		{&disabled, "cn-north-1", "mybucket.s3.cn-north-1.amazonaws.com.cn"},
+	}
+	for i, testCase := range testCases {
+		c, err := New("s3.amazonaws.com", &Options{
+			Creds:        credentials.NewStaticV4("foo", "bar", ""),
+			Secure:       true,
+			UseFIPS:      true,
+			UseDualStack: testCase.dualstack,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := c.makeTargetURL("mybucket", "", testCase.bucketLocation, true, nil)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if u.Host != testCase.expectedHost {
+			t.Errorf("Test %d: expected host %s, got %s", i+1, testCase.expectedHost, u.Host)
+		}
+	}
+}
+
+// TestUseDualStack - testing the dual-stack endpoints of Options.UseDualStack.
+func TestUseDualStack(t *testing.T) {
+	enabled, disabled := true, false
+	testCases := []struct {
+		dualstack    *bool
+		expectedHost string
+	}{
+		{nil, "mybucket.s3.dualstack.us-west-2.amazonaws.com"},
+		{&enabled, "mybucket.s3.dualstack.us-west-2.amazonaws.com"},
+		{&disabled, "mybucket.s3.us-west-2.amazonaws.com"},
+	}
+	for i, testCase := range testCases {
+		c, err := New("s3.amazonaws.com", &Options{
+			Creds:        credentials.NewStaticV4("foo", "bar", ""),
+			Secure:       true,
+			UseDualStack: testCase.dualstack,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := c.makeTargetURL("mybucket", "", "us-west-2", true, nil)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if u.Host != testCase.expectedHost {
+			t.Errorf("Test %d: expected host %s, got %s", i+1, testCase.expectedHost, u.Host)
+		}
+	}
+}
+
+// Tests merging of user metadata for metadata-only updates.
+func TestMergeUserMetadata(t *testing.T) {
+	current := map[string]string{"Foo": "1", "Bar": "2", "Baz": "3"}
+	merged := mergeUserMetadata(current, map[string]string{"x-amz-meta-foo": "10", "new-key": "4"}, []string{"X-Amz-Meta-Bar", "baz"})
+	expected := map[string]string{"Foo": "10", "New-Key": "4"}
+	if len(merged) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, merged)
+	}
+	for k, v := range expected {
+		if merged[k] != v {
+			t.Fatalf("Expected %s=%s, got %v", k, v, merged)
+		}
+	}
+	if current["Foo"] != "1" || len(current) != 3 {
+		t.Fatal("Current metadata must not be modified")
+	}
+}
+
+// Tests that no MD5 is sent with DisableMD5.
+func TestDisableMD5(t *testing.T) {
+	var mu sync.Mutex
+	var headers []http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		sum := sha256.Sum256(b)
+		r.Header.Set("Body-Sha256", base64.StdEncoding.EncodeToString(sum[:]))
+		r.Header.Set("Request-Method", r.Method)
+		r.Header.Set("Request-Query", r.URL.RawQuery)
+		mu.Lock()
+		headers = append(headers, r.Header)
+		mu.Unlock()
+		query := r.URL.Query()
+		switch {
+		case query.Has("delete"):
+			io.WriteString(w, "<DeleteResult></DeleteResult>")
+		case query.Has("versioning") && r.Method == http.MethodGet:
+			io.WriteString(w, "<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>")
+		case query.Has("uploads"):
+			io.WriteString(w, "<InitiateMultipartUploadResult><UploadId>upload</UploadId></InitiateMultipartUploadResult>")
+		case query.Has("partNumber"):
+			w.Header().Set("ETag", `"etag"`)
+		case query.Has("uploadId"):
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-2"</ETag></CompleteMultipartUploadResult>`)
+		}
+	}))
+	defer srv.Close()
+
+	// Requests S3 requires a Content-MD5 or another checksum for.
+	md5Required := func(h http.Header) bool {
+		query, _ := url.ParseQuery(h.Get("Request-Query"))
+		return query.Has("delete") || (query.Has("versioning") && h.Get("Request-Method") == http.MethodPut)
+	}
+
+	testCases := []struct {
+		disableMD5 bool
+	}{
+		{disableMD5: true},
+		{disableMD5: false},
+	}
+	for i, testCase := range testCases {
+		clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:      credentials.NewStaticV4("access", "secret", ""),
+			Region:     "us-east-1",
+			DisableMD5: testCase.disableMD5,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers = nil
+		objectsCh := make(chan ObjectInfo, 1)
+		objectsCh <- ObjectInfo{Key: "object"}
+		close(objectsCh)
+		for err := range clnt.RemoveObjects(context.Background(), "bucket", objectsCh, RemoveObjectsOptions{}) {
+			t.Fatalf("test %d: %v", i+1, err.Err)
+		}
+		if err = clnt.SetBucketVersioning(context.Background(), "bucket", BucketVersioningConfiguration{Status: "Enabled"}); err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		if _, err = clnt.GetBucketVersioning(context.Background(), "bucket"); err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		size := int64(6 << 20)
+		if _, err = clnt.PutObject(context.Background(), "bucket", "object", bytes.NewReader(make([]byte, size)), size, PutObjectOptions{PartSize: 5 << 20}); err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		if len(headers) != 7 {
+			t.Fatalf("test %d: expected 7 requests, got %d", i+1, len(headers))
+		}
+		for _, h := range headers {
+			switch {
+			case !md5Required(h):
+				if h.Get("Content-Md5") != "" || h.Get("X-Amz-Checksum-Sha256") != "" || h.Get("X-Amz-Sdk-Checksum-Algorithm") == "SHA256" {
+					t.Errorf("test %d: unexpected MD5 or SHA256 checksum, got %v", i+1, h)
+				}
+			case testCase.disableMD5:
+				if h.Get("Content-Md5") != "" {
+					t.Errorf("test %d: unexpected Content-Md5", i+1)
+				}
+				if h.Get("X-Amz-Checksum-Sha256") != h.Get("Body-Sha256") || h.Get("X-Amz-Sdk-Checksum-Algorithm") != "SHA256" {
+					t.Errorf("test %d: expected the SHA256 checksum of the body, got %v", i+1, h)
+				}
+			default:
+				if h.Get("Content-Md5") == "" || h.Get("X-Amz-Checksum-Sha256") != "" {
+					t.Errorf("test %d: expected Content-Md5 only, got %v", i+1, h)
+				}
+			}
+		}
+		_, err = clnt.PutObject(context.Background(), "bucket", "object", strings.NewReader("hello"), 5, PutObjectOptions{SendContentMd5: true})
+		if testCase.disableMD5 == (err == nil) {
+			t.Errorf("test %d: unexpected PutObject result %v", i+1, err)
+		}
+	}
+}
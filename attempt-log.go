@@ -0,0 +1,182 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// FailedAttempt describes a failed attempt of a request.
+type FailedAttempt struct {
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+
+	// Time the attempt was sent.
+	Time time.Time
+
+	// Endpoint is the host the attempt was sent to.
+	Endpoint string
+
+	// StatusCode of the response, zero when no response was received.
+	StatusCode int
+
+	// RequestID and HostID of the response, empty when no response
+	// was received.
+	RequestID string
+	HostID    string
+
+	// Err is the reason of the failure.
+	Err error
+}
+
+// attemptHistory is the history of the failed attempts of a request.
+type attemptHistory struct {
+	attempts []FailedAttempt
+}
+
+// add records a failed attempt, res may be nil.
+func (h *attemptHistory) add(attempt int, start time.Time, req *http.Request, res *http.Response, err error) {
+	a := FailedAttempt{
+		Attempt: attempt,
+		Time:    start,
+		Err:     err,
+	}
+	if req != nil {
+		a.Endpoint = req.URL.Host
+	}
+	if res != nil {
+		a.StatusCode = res.StatusCode
+		a.RequestID = res.Header.Get("x-amz-request-id")
+		a.HostID = res.Header.Get("x-amz-id-2")
+	}
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		if errResp.RequestID != "" {
+			a.RequestID = errResp.RequestID
+		}
+		if errResp.HostID != "" {
+			a.HostID = errResp.HostID
+		}
+	}
+	h.attempts = append(h.attempts, a)
+}
+
+// attach returns err with the attempt history. ErrorResponse values keep
+// their type, those of retried requests record the history. The error of *url.Error values returned by the
+// transport is wrapped in a RequestError and other errors, e.g. the errors
+// of the context, are returned as is.
+func (h *attemptHistory) attach(err error) error {
+	if err == nil || len(h.attempts) == 0 ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	switch e := err.(type) {
+	case ErrorResponse:
+		if len(h.attempts) > 1 {
+			e.history = h
+		}
+		return e
+	case *url.Error:
+		last := h.attempts[len(h.attempts)-1]
+		urlErr := *e
+		urlErr.Err = &RequestError{
+			Err:       e.Err,
+			Endpoint:  last.Endpoint,
+			RequestID: last.RequestID,
+			HostID:    last.HostID,
+			history:   h,
+		}
+		return &urlErr
+	}
+	return err
+}
+
+// RequestError is the error of the *url.Error returned for requests which
+// failed in the transport, e.g. network errors after all retries. It
+// unwraps to the error of the last attempt.
+type RequestError struct {
+	Err error
+
+	// Endpoint is the host of the last attempt.
+	Endpoint string
+
+	// RequestID and HostID of the last attempt, if it received a
+	// response.
+	RequestID string
+	HostID    string
+
+	history *attemptHistory
+}
+
+// Error returns the error of the last attempt.
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// Timeout tells whether the error of the last attempt is a timeout, see
+// url.Error.Timeout.
+func (e *RequestError) Timeout() bool {
+	var t interface{ Timeout() bool }
+	return errors.As(e.Err, &t) && t.Timeout()
+}
+
+// Temporary tells whether the error of the last attempt is temporary, see
+// url.Error.Temporary.
+func (e *RequestError) Temporary() bool {
+	var t interface{ Temporary() bool }
+	return errors.As(e.Err, &t) && t.Temporary()
+}
+
+// Attempts returns the number of attempts of the request.
+func (e *RequestError) Attempts() int {
+	return len(e.AttemptLog())
+}
+
+// AttemptLog returns the failed attempts of the request.
+func (e *RequestError) AttemptLog() []FailedAttempt {
+	if e.history == nil {
+		return nil
+	}
+	return e.history.attempts
+}
+
+// AttemptLog returns the failed attempts of the request of err, nil if
+// err is not returned by a retried request.
+func AttemptLog(err error) []FailedAttempt {
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		if errResp.history == nil {
+			return nil
+		}
+		return errResp.history.attempts
+	}
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.AttemptLog()
+	}
+	return nil
+}
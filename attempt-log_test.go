@@ -0,0 +1,176 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestAttemptLog(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := requests.Add(1)
+		w.Header().Set("x-amz-request-id", "req-"+strconv.Itoa(int(n)))
+		w.Header().Set("x-amz-id-2", "host")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	c, err := New(host, &Options{
+		Creds:       credentials.NewStaticV4("access", "secret", ""),
+		Region:      "us-east-1",
+		RetryPolicy: StandardRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{})
+	// Error responses of retried requests keep their type.
+	errResp, ok := err.(ErrorResponse)
+	if !ok {
+		t.Fatalf("Expected ErrorResponse, got %T: %v", err, err)
+	}
+	// Error responses stay comparable.
+	if errResp != ToErrorResponse(err) {
+		t.Fatalf("Expected equal error responses")
+	}
+	log := AttemptLog(err)
+	if len(log) != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", len(log))
+	}
+	for i, a := range log {
+		if a.Attempt != i+1 || a.StatusCode != http.StatusServiceUnavailable || a.Endpoint != host || a.HostID != "host" {
+			t.Errorf("Unexpected attempt %d: %+v", i+1, a)
+		}
+		if want := "req-" + strconv.Itoa(i+1); a.RequestID != want {
+			t.Errorf("Expected request ID %s, got %s", want, a.RequestID)
+		}
+	}
+	if errResp.RequestID != "req-3" {
+		t.Errorf("Expected request ID req-3, got %s", errResp.RequestID)
+	}
+
+	// Transport errors are wrapped in a RequestError.
+	srv.Close()
+	_, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{})
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) {
+		t.Fatalf("Expected RequestError, got %T: %v", err, err)
+	}
+	if reqErr.Endpoint != host || reqErr.Attempts() != 3 {
+		t.Errorf("Unexpected request error: %+v", reqErr)
+	}
+	if _, ok := err.(*url.Error); !ok {
+		t.Errorf("Expected *url.Error, got %T", err)
+	}
+	if log = AttemptLog(err); len(log) != 3 || log[2].Err == nil || log[2].StatusCode != 0 {
+		t.Errorf("Unexpected attempt log: %+v", log)
+	}
+	if AttemptLog(errors.New("other")) != nil {
+		t.Error("Expected no attempt log")
+	}
+}
+
+func TestAttemptLogNotRetried(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Errors of requests sent once keep their type.
+	_, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{})
+	if errResp, ok := err.(ErrorResponse); !ok || errResp.Code != NoSuchKey {
+		t.Fatalf("Expected NoSuchKey ErrorResponse, got %T: %v", err, err)
+	}
+	if AttemptLog(err) != nil {
+		t.Error("Expected no attempt log")
+	}
+}
+
+func TestAttemptLogCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// Canceled while waiting to retry the request.
+		time.AfterFunc(50*time.Millisecond, cancel)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:       credentials.NewStaticV4("access", "secret", ""),
+		Region:      "us-east-1",
+		RetryPolicy: StandardRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The error of the context is returned as is.
+	if _, err = c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, got %T: %v", err, err)
+	}
+}
+
+func TestAttemptLogNetworkError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	closed := strings.TrimPrefix(srv.URL, "http://")
+	srv.Close()
+
+	testCases := []string{
+		// Dial failure.
+		closed,
+		// DNS failure.
+		"minio-go-test.invalid",
+	}
+	for i, endpoint := range testCases {
+		c, err := New(endpoint, &Options{
+			Creds:       credentials.NewStaticV4("access", "secret", ""),
+			Region:      "us-east-1",
+			RetryPolicy: StandardRetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.BucketExists(context.Background(), "bucket")
+		if !IsNetworkOrHostDown(err, false) {
+			t.Errorf("Test %d: expected a network error, got %T: %v", i+1, err, err)
+		}
+		if log := AttemptLog(err); len(log) != 2 {
+			t.Errorf("Test %d: expected 2 attempts, got %d", i+1, len(log))
+		}
+	}
+}
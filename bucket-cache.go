@@ -25,10 +25,97 @@ import (
 	"path"
 
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/kvcache"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/signer"
 )
 
+// BucketLocationCache caches the regions of buckets. It can be shared
+// between clients with Options.BucketLocationCache, exported and
+// imported to avoid looking up the regions of buckets at startup.
+//
+// Regions are cached per endpoint the buckets are resolved to, so
+// buckets of the same name on different endpoints never share a region.
+type BucketLocationCache struct {
+	regions kvcache.Cache[bucketLocationKey, string]
+}
+
+// bucketLocationKey identifies a bucket of an endpoint.
+type bucketLocationKey struct {
+	endpoint   string
+	bucketName string
+}
+
+// NewBucketLocationCache returns a cache of the regions of buckets
+// seeded with regions, see Import.
+func NewBucketLocationCache(regions map[string]map[string]string) *BucketLocationCache {
+	cache := &BucketLocationCache{}
+	cache.Import(regions)
+	return cache
+}
+
+// Export returns the cached regions of buckets, which maps endpoints
+// to bucket names to regions.
+func (b *BucketLocationCache) Export() map[string]map[string]string {
+	regions := make(map[string]map[string]string)
+	b.regions.Range(func(key bucketLocationKey, region string) bool {
+		if regions[key.endpoint] == nil {
+			regions[key.endpoint] = make(map[string]string)
+		}
+		regions[key.endpoint][key.bucketName] = region
+		return true
+	})
+	return regions
+}
+
+// Import adds regions, which maps endpoints to bucket names to regions,
+// to the cache. Endpoints are the host of the endpoint buckets are
+// resolved to, e.g. "s3.amazonaws.com" or "play.min.io:9000", the host of
+// the client endpoint without an EndpointResolver.
+func (b *BucketLocationCache) Import(regions map[string]map[string]string) {
+	for endpoint, buckets := range regions {
+		for bucketName, region := range buckets {
+			b.regions.Set(bucketLocationKey{endpoint: endpoint, bucketName: bucketName}, region)
+		}
+	}
+}
+
+// get returns the cached region of a bucket of an endpoint.
+func (b *BucketLocationCache) get(endpoint, bucketName string) (string, bool) {
+	return b.regions.Get(bucketLocationKey{endpoint: endpoint, bucketName: bucketName})
+}
+
+// set caches the region of a bucket of an endpoint.
+func (b *BucketLocationCache) set(endpoint, bucketName, region string) {
+	b.regions.Set(bucketLocationKey{endpoint: endpoint, bucketName: bucketName}, region)
+}
+
+// delete removes the cached region of a bucket of an endpoint.
+func (b *BucketLocationCache) delete(endpoint, bucketName string) {
+	b.regions.Delete(bucketLocationKey{endpoint: endpoint, bucketName: bucketName})
+}
+
+// BucketLocationCache returns the cache of the regions of buckets of the
+// client, e.g. to export it or share it with other clients.
+func (c *Client) BucketLocationCache() *BucketLocationCache {
+	return c.bucketLocCache
+}
+
+// InvalidateBucketLocation removes the region of a bucket from the cache,
+// it is looked up again by the next request to the bucket.
+func (c *Client) InvalidateBucketLocation(bucketName string) {
+	c.bucketLocCache.delete(c.bucketLocationEndpoint(context.Background(), bucketName), bucketName)
+}
+
+// bucketLocationEndpoint returns the host of the endpoint the location of
+// bucketName is looked up from, which the cached region is keyed by.
+func (c *Client) bucketLocationEndpoint(ctx context.Context, bucketName string) string {
+	if endpointURL, err := c.resolveEndpoint(ctx, bucketName, ""); err == nil {
+		return endpointURL.Host
+	}
+	return c.endpointURL.Host
+}
+
 // GetBucketLocation - get location for the bucket name from location cache, if not
 // fetch freshly by making a new request.
 func (c *Client) GetBucketLocation(ctx context.Context, bucketName string) (string, error) {
@@ -45,15 +132,41 @@ func (c *Client) getBucketLocation(ctx context.Context, bucketName string) (stri
 		return "", err
 	}
 
+	// The region of access points is the region of their ARN.
+	if accessPoint, ok := c.accessPointOf(bucketName); ok {
+		return accessPoint.Region, nil
+	}
+
+	// Regions of buckets set then no need to fetch bucket location.
+	if region, ok := c.bucketRegions[bucketName]; ok {
+		return region, nil
+	}
+
 	// Region set then no need to fetch bucket location.
 	if c.region != "" {
 		return c.region, nil
 	}
 
-	if location, ok := c.bucketLocCache.Get(bucketName); ok {
+	endpoint := c.bucketLocationEndpoint(ctx, bucketName)
+	if location, ok := c.bucketLocCache.get(endpoint, bucketName); ok {
 		return location, nil
 	}
 
+	// The region of directory buckets is the region of their
+	// availability zone.
+	if s3utils.IsAmazonEndpoint(*c.endpointURL) {
+		if location := getS3ExpressRegion(bucketName); location != "" {
+			c.bucketLocCache.set(endpoint, bucketName, location)
+			return location, nil
+		}
+	}
+
+	return c.fetchBucketLocation(ctx, bucketName)
+}
+
+// fetchBucketLocation - Get location for the bucketName from the server
+// and save it into the location map cache.
+func (c *Client) fetchBucketLocation(ctx context.Context, bucketName string) (string, error) {
 	// Initialize a new request.
 	req, err := c.getBucketLocationRequest(ctx, bucketName)
 	if err != nil {
@@ -70,7 +183,7 @@ func (c *Client) getBucketLocation(ctx context.Context, bucketName string) (stri
 	if err != nil {
 		return "", err
 	}
-	c.bucketLocCache.Set(bucketName, location)
+	c.bucketLocCache.set(c.bucketLocationEndpoint(ctx, bucketName), bucketName, location)
 	return location, nil
 }
 
@@ -135,8 +248,13 @@ func (c *Client) getBucketLocationRequest(ctx context.Context, bucketName string
 	urlValues := make(url.Values)
 	urlValues.Set("location", "")
 
+	endpointURL, err := c.resolveEndpoint(ctx, bucketName, "")
+	if err != nil {
+		return nil, err
+	}
+
 	// Set get bucket location always as path style.
-	targetURL := *c.endpointURL
+	targetURL := *endpointURL
 
 	// as it works in makeTargetURL method from api.go file
 	if h, p, err := net.SplitHostPort(targetURL.Host); err == nil {
@@ -153,7 +271,7 @@ func (c *Client) getBucketLocationRequest(ctx context.Context, bucketName string
 	var urlStr string
 
 	if isVirtualStyle {
-		urlStr = c.endpointURL.Scheme + "://" + bucketName + "." + targetURL.Host + "/?location"
+		urlStr = endpointURL.Scheme + "://" + bucketName + "." + targetURL.Host + "/?location"
 	} else {
 		targetURL.Path = path.Join(bucketName, "") + "/"
 		targetURL.RawQuery = urlValues.Encode()
@@ -170,7 +288,7 @@ func (c *Client) getBucketLocationRequest(ctx context.Context, bucketName string
 	c.setUserAgent(req)
 
 	// Get credentials from the configured credentials provider.
-	value, err := c.credsProvider.GetWithContext(c.CredContext())
+	value, err := c.getCredentials(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -209,6 +327,9 @@ func (c *Client) getBucketLocationRequest(ctx context.Context, bucketName string
 	}
 
 	req.Header.Set("X-Amz-Content-Sha256", contentSha256)
-	req = signer.SignV4(*req, accessKeyID, secretAccessKey, sessionToken, "us-east-1")
+	if c.customSigner != nil {
+		return c.customSigner.SignV4(req, value, "us-east-1", nil, c.now())
+	}
+	req = signer.SignV4TrailerWithTime(*req, accessKeyID, secretAccessKey, sessionToken, "us-east-1", nil, c.now())
 	return req, nil
 }
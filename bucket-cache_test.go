@@ -23,9 +23,12 @@ import (
 	"encoding/xml"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"path"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -347,3 +350,122 @@ func TestProcessBucketLocationResponse(t *testing.T) {
 		}
 	}
 }
+
+func TestSharedBucketLocationCache(t *testing.T) {
+	var lookups atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("location") {
+			lookups.Add(1)
+			io.WriteString(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">eu-west-2</LocationConstraint>`)
+		}
+	}))
+	defer srv.Close()
+
+	endpoint := strings.TrimPrefix(srv.URL, "http://")
+	cache := NewBucketLocationCache(map[string]map[string]string{endpoint: {"seeded": "us-west-1"}})
+	newClient := func(regions map[string]string) *Client {
+		c, err := New(endpoint, &Options{
+			Creds:               credentials.NewStaticV4("access", "secret", ""),
+			BucketRegions:       regions,
+			BucketLocationCache: cache,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	c1, c2 := newClient(nil), newClient(map[string]string{"static": "ap-south-1"})
+
+	testCases := []struct {
+		clnt    *Client
+		bucket  string
+		region  string
+		lookups int32
+	}{
+		{clnt: c1, bucket: "seeded", region: "us-west-1"},
+		{clnt: c1, bucket: "bucket", region: "eu-west-2", lookups: 1},
+		{clnt: c2, bucket: "bucket", region: "eu-west-2", lookups: 1},
+		{clnt: c2, bucket: "static", region: "ap-south-1", lookups: 1},
+		{clnt: c1, bucket: "static", region: "eu-west-2", lookups: 2},
+	}
+	for i, testCase := range testCases {
+		region, err := testCase.clnt.GetBucketLocation(context.Background(), testCase.bucket)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if region != testCase.region {
+			t.Errorf("Test %d: expected region %s, got %s", i+1, testCase.region, region)
+		}
+		if n := lookups.Load(); n != testCase.lookups {
+			t.Errorf("Test %d: expected %d lookups, got %d", i+1, testCase.lookups, n)
+		}
+	}
+
+	expected := map[string]map[string]string{endpoint: {"seeded": "us-west-1", "bucket": "eu-west-2", "static": "eu-west-2"}}
+	if exported := c2.BucketLocationCache().Export(); !reflect.DeepEqual(exported, expected) {
+		t.Errorf("Expected exported regions %v, got %v", expected, exported)
+	}
+
+	c2.InvalidateBucketLocation("bucket")
+	if _, err := c1.GetBucketLocation(context.Background(), "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	if n := lookups.Load(); n != 3 {
+		t.Errorf("Expected the invalidated region to be looked up, got %d lookups", n)
+	}
+}
+
+func TestBucketLocationCacheEndpoints(t *testing.T) {
+	newServer := func(region string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Has("location") {
+				io.WriteString(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`+region+`</LocationConstraint>`)
+			}
+		}))
+	}
+	srv1, srv2 := newServer("eu-west-2"), newServer("ap-south-1")
+	defer srv1.Close()
+	defer srv2.Close()
+
+	cache := NewBucketLocationCache(nil)
+	newClient := func(srv *httptest.Server, resolver EndpointResolver) *Client {
+		c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:               credentials.NewStaticV4("access", "secret", ""),
+			BucketLocationCache: cache,
+			EndpointResolver:    resolver,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	u2, err := url.Parse(srv2.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// c3 has the endpoint of c1 but resolves buckets to the server of c2.
+	c1, c2, c3 := newClient(srv1, nil), newClient(srv2, nil), newClient(srv1, DefaultEndpointResolver(u2))
+
+	testCases := []struct {
+		clnt   *Client
+		region string
+	}{
+		{clnt: c1, region: "eu-west-2"},
+		{clnt: c2, region: "ap-south-1"},
+		{clnt: c1, region: "eu-west-2"},
+		{clnt: c3, region: "ap-south-1"},
+	}
+	for i, testCase := range testCases {
+		region, err := testCase.clnt.GetBucketLocation(context.Background(), "bucket")
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if region != testCase.region {
+			t.Errorf("Test %d: expected region %s, got %s", i+1, testCase.region, region)
+		}
+	}
+
+	if exported := cache.Export(); len(exported) != 2 {
+		t.Errorf("Expected the regions of 2 endpoints, got %v", exported)
+	}
+}
@@ -0,0 +1,230 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// Capability tells whether a feature is supported by the server of an
+// endpoint, see Client.Capabilities.
+type Capability int8
+
+// Capabilities of features.
+const (
+	// CapabilityUnknown - the response of the server to the probe of the
+	// feature does not tell whether the feature is supported.
+	CapabilityUnknown Capability = iota
+	// CapabilitySupported - the feature is supported.
+	CapabilitySupported
+	// CapabilityUnsupported - the server responds that the feature is
+	// not implemented.
+	CapabilityUnsupported
+)
+
+func (c Capability) String() string {
+	switch c {
+	case CapabilitySupported:
+		return "supported"
+	case CapabilityUnsupported:
+		return "unsupported"
+	}
+	return "unknown"
+}
+
+// Capabilities are the features supported by the server of an endpoint,
+// see Client.Capabilities.
+type Capabilities struct {
+	// Versioning of buckets.
+	Versioning Capability
+	// Object lock, i.e. retention and legal holds of objects.
+	ObjectLock Capability
+	// Tags of buckets and objects.
+	Tagging Capability
+	// Checksums sent in trailers of uploads.
+	TrailingChecksums Capability
+	// Atomic renaming of objects.
+	Rename Capability
+	// SelectObjectContent.
+	Select Capability
+}
+
+// capabilitiesTTL is the time the capabilities of an endpoint are cached.
+const capabilitiesTTL = time.Hour
+
+type cachedCapabilities struct {
+	capabilities Capabilities
+	expiry       time.Time
+}
+
+// Capabilities returns the features supported by the server of the
+// endpoint, which allows applications to adapt to the server instead of
+// failing in the middle of an operation.
+//
+// The features are probed with requests for bucketName, which must
+// exist. The configuration of the bucket is only read, the probes of
+// trailing checksums and renaming write objects named with the prefix
+// "minio-go-probe-" which are removed once probed. A feature is supported
+// if the server handles its request, and unsupported if the server
+// responds that it is not implemented. Other responses, e.g. AccessDenied,
+// leave the capability unknown. The capabilities are cached by the client
+// for an hour.
+func (c *Client) Capabilities(ctx context.Context, bucketName string) (Capabilities, error) {
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return Capabilities{}, err
+	}
+	if cached, ok := c.capabilities.Get(bucketName); ok && time.Now().Before(cached.expiry) {
+		return cached.capabilities, nil
+	}
+	capabilities, err, _ := c.capabilitiesProbes.Do(bucketName, func() (Capabilities, error) {
+		capabilities, err := c.probeCapabilities(ctx, bucketName)
+		if err != nil {
+			return Capabilities{}, err
+		}
+		c.capabilities.Set(bucketName, cachedCapabilities{capabilities: capabilities, expiry: time.Now().Add(capabilitiesTTL)})
+		return capabilities, nil
+	})
+	return capabilities, err
+}
+
+// capabilityProbe is a request probing a feature.
+type capabilityProbe struct {
+	feature *Capability
+	method  string
+	object  string
+	query   url.Values
+	header  http.Header
+	body    []byte
+	addCrc  *ChecksumType
+
+	// supported are the error codes telling that the server handled
+	// the request as the feature.
+	supported []string
+	// ignored tells that a success means that the server ignored the
+	// feature, e.g. renaming handled as a plain upload.
+	ignored bool
+}
+
+// probeCapabilities probes the features of the server with requests for
+// bucketName.
+func (c *Client) probeCapabilities(ctx context.Context, bucketName string) (Capabilities, error) {
+	objectName := "minio-go-probe-" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	var capabilities Capabilities
+	crc := ChecksumCRC32C
+	probes := []capabilityProbe{
+		{feature: &capabilities.Versioning, method: http.MethodGet, query: url.Values{"versioning": {""}}},
+		{
+			feature: &capabilities.ObjectLock, method: http.MethodGet, query: url.Values{"object-lock": {""}},
+			supported: []string{"ObjectLockConfigurationNotFoundError"},
+		},
+		{
+			feature: &capabilities.Tagging, method: http.MethodGet, query: url.Values{"tagging": {""}},
+			supported: []string{NoSuchTagSet},
+		},
+		{
+			feature: &capabilities.Select, method: http.MethodPost, object: objectName,
+			query: url.Values{"select": {""}, "select-type": {"2"}},
+			body:  []byte("<SelectObjectContentRequest/>"),
+		},
+		{
+			// The source of the rename does not exist.
+			feature: &capabilities.Rename, method: http.MethodPut, object: objectName,
+			query:     url.Values{"renameObject": {""}},
+			header:    http.Header{"X-Amz-Rename-Source": {"/" + bucketName + "/" + objectName + ".src"}},
+			supported: []string{NoSuchKey},
+			ignored:   true,
+		},
+	}
+	if c.trailingHeaderSupport && !c.quirks().noTrailingChecksums {
+		probes = append(probes, capabilityProbe{
+			feature: &capabilities.TrailingChecksums, method: http.MethodPut, object: objectName,
+			header: http.Header{amzChecksumAlgo: {crc.String()}},
+			body:   []byte(objectName), addCrc: &crc,
+		})
+	}
+
+	for _, probe := range probes {
+		metadata := requestMetadata{
+			bucketName:    bucketName,
+			objectName:    probe.object,
+			queryValues:   probe.query,
+			customHeader:  probe.header,
+			contentBody:   bytes.NewReader(probe.body),
+			contentLength: int64(len(probe.body)),
+			addCrc:        probe.addCrc,
+			streamSha256:  probe.addCrc != nil,
+		}
+		if probe.addCrc == nil {
+			metadata.contentSHA256Hex = sum256Hex(probe.body)
+		}
+		resp, probeErr := c.executeMethod(ctx, probe.method, metadata)
+		closeResponse(resp)
+		if probeErr == nil && probe.method == http.MethodPut {
+			// Remove the object written by the probe.
+			opts := RemoveObjectOptions{VersionID: resp.Header.Get(amzVersionID)}
+			if err := c.RemoveObject(ctx, bucketName, probe.object, opts); err != nil {
+				return Capabilities{}, err
+			}
+		}
+		capability, err := probeCapability(probeErr, probe.supported...)
+		if err != nil {
+			return Capabilities{}, err
+		}
+		if probeErr == nil && probe.ignored {
+			capability = CapabilityUnknown
+		}
+		*probe.feature = capability
+	}
+	return capabilities, nil
+}
+
+// probeCapability returns the capability told by the error of a probe,
+// supported are the error codes telling that the feature is supported. It
+// returns NoSuchBucket and errors other than error responses.
+func probeCapability(err error, supported ...string) (Capability, error) {
+	if err == nil {
+		return CapabilitySupported, nil
+	}
+	var errResp ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Code == NoSuchBucket {
+		return CapabilityUnknown, err
+	}
+	switch {
+	case errResp.Code == NotImplemented || errResp.StatusCode == http.StatusNotImplemented:
+		return CapabilityUnsupported, nil
+	case slices.Contains(supported, errResp.Code):
+		return CapabilitySupported, nil
+	case errResp.Code == AccessDenied,
+		errResp.StatusCode == http.StatusNotFound || errResp.StatusCode == http.StatusForbidden:
+		// The server may respond before handling the feature.
+		return CapabilityUnknown, nil
+	}
+	// The server handled the request and rejected its arguments.
+	return CapabilitySupported, nil
+}
@@ -0,0 +1,163 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestCapabilities(t *testing.T) {
+	var requests atomic.Int32
+	var deleted sync.Map
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/missing/" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("<Error><Code>" + NoSuchBucket + "</Code></Error>"))
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, "/bucket/") {
+			t.Errorf("unexpected probe of %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		var code string
+		var status int
+		switch {
+		case r.Method == http.MethodDelete:
+			deleted.Store(query.Get("versionId"), r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case query.Has("select"):
+			code, status = NotImplemented, http.StatusNotImplemented
+		case query.Has("object-lock"):
+			code, status = "ObjectLockConfigurationNotFoundError", http.StatusNotFound
+		case query.Has("tagging"):
+			code, status = AccessDenied, http.StatusForbidden
+		case query.Has("renameObject"):
+			// Renaming handled as a plain upload.
+			w.Header().Set(amzVersionID, "rename")
+			return
+		case r.Method == http.MethodPut:
+			w.Header().Set(amzVersionID, "upload")
+			return
+		default:
+			return
+		}
+		w.WriteHeader(status)
+		w.Write([]byte("<Error><Code>" + code + "</Code></Error>"))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:           credentials.NewStaticV4("access", "secret", ""),
+		Region:          "us-east-1",
+		TrailingHeaders: true,
+		MaxRetries:      1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	capabilities, err := clnt.Capabilities(context.Background(), "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Capabilities{
+		Versioning:        CapabilitySupported,
+		ObjectLock:        CapabilitySupported,
+		Tagging:           CapabilityUnknown,
+		TrailingChecksums: CapabilitySupported,
+		Rename:            CapabilityUnknown,
+		Select:            CapabilityUnsupported,
+	}
+	if capabilities != expected {
+		t.Fatalf("expected capabilities %+v, got %+v", expected, capabilities)
+	}
+	// The objects written by the probes are removed.
+	for _, versionID := range []string{"rename", "upload"} {
+		if _, ok := deleted.Load(versionID); !ok {
+			t.Errorf("expected the removal of version %s", versionID)
+		}
+	}
+	if n := requests.Load(); n != 8 {
+		t.Fatalf("expected 8 requests, got %d", n)
+	}
+
+	// The capabilities are cached by the client.
+	if capabilities, err = clnt.Capabilities(context.Background(), "bucket"); err != nil || capabilities != expected {
+		t.Fatalf("unexpected cached capabilities %+v, %v", capabilities, err)
+	}
+	if n := requests.Load(); n != 8 {
+		t.Fatalf("expected cached capabilities, got %d requests", n)
+	}
+
+	// Clients with other credentials probe the endpoint again.
+	other, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:           credentials.NewStaticV4("other", "secret", ""),
+		Region:          "us-east-1",
+		TrailingHeaders: true,
+		MaxRetries:      1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if capabilities, err = other.Capabilities(context.Background(), "bucket"); err != nil || capabilities != expected {
+		t.Fatalf("unexpected capabilities %+v, %v", capabilities, err)
+	}
+	if n := requests.Load(); n != 16 {
+		t.Fatalf("expected 16 requests, got %d", n)
+	}
+
+	// The probed bucket must exist.
+	if _, err = clnt.Capabilities(context.Background(), "missing"); ToErrorResponse(err).Code != NoSuchBucket {
+		t.Fatalf("expected %s, got %v", NoSuchBucket, err)
+	}
+}
+
+func TestProbeCapability(t *testing.T) {
+	testCases := []struct {
+		err        error
+		capability Capability
+		fails      bool
+	}{
+		{nil, CapabilitySupported, false},
+		{ErrorResponse{Code: NoSuchBucket, StatusCode: http.StatusNotFound}, CapabilityUnknown, true},
+		{ErrorResponse{Code: NoSuchTagSet, StatusCode: http.StatusNotFound}, CapabilitySupported, false},
+		{ErrorResponse{Code: NoSuchKey, StatusCode: http.StatusNotFound}, CapabilityUnknown, false},
+		{ErrorResponse{Code: AccessDenied, StatusCode: http.StatusForbidden}, CapabilityUnknown, false},
+		{ErrorResponse{Code: InvalidArgument, StatusCode: http.StatusBadRequest}, CapabilitySupported, false},
+		{ErrorResponse{Code: NotImplemented, StatusCode: http.StatusNotImplemented}, CapabilityUnsupported, false},
+		{ErrorResponse{StatusCode: http.StatusNotImplemented}, CapabilityUnsupported, false},
+		{errors.New("connection refused"), CapabilityUnknown, true},
+	}
+	for i, testCase := range testCases {
+		// NoSuchTagSet tells that tagging is supported.
+		capability, err := probeCapability(testCase.err, NoSuchTagSet)
+		if capability != testCase.capability || (err != nil) != testCase.fails {
+			t.Errorf("test %d: expected %s, %t, got %s, %v", i+1, testCase.capability, testCase.fails, capability, err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"strings"
+)
+
+// defaultChecksumType returns the checksum of uploads, ChecksumNone if
+// the client has no default checksum, see Options.DefaultChecksum.
+func (c *Client) defaultChecksumType() ChecksumType {
+	if c.defaultChecksum == nil {
+		return ChecksumNone
+	}
+	return ChecksumType(c.defaultChecksum.Load())
+}
+
+// downgradeChecksum replaces the default checksum t rejected by the
+// endpoint with a weaker one, it returns false if there is none.
+func (c *Client) downgradeChecksum(t ChecksumType) bool {
+	weaker := t.downgrade()
+	if c.defaultChecksum == nil || !weaker.IsSet() {
+		return false
+	}
+	// Concurrent uploads may have downgraded it already.
+	c.defaultChecksum.CompareAndSwap(uint32(t), uint32(weaker))
+	return true
+}
+
+// downgrade returns the checksum used when the endpoint rejects c,
+// ChecksumNone if there is no weaker checksum.
+func (c ChecksumType) downgrade() ChecksumType {
+	switch c.Base() {
+	case ChecksumCRC64NVME:
+		return ChecksumFullObjectCRC32C
+	case ChecksumSHA256, ChecksumSHA1:
+		return ChecksumCRC32C
+	case ChecksumCRC32C:
+		return ChecksumCRC32 | c&ChecksumFullObject
+	}
+	return ChecksumNone
+}
+
+// isChecksumRejected tells whether err is the rejection of the checksum
+// algorithm of an upload by the endpoint.
+func isChecksumRejected(err error) bool {
+	errResp := ToErrorResponse(err)
+	switch errResp.Code {
+	case "InvalidRequest", "InvalidArgument", "NotImplemented":
+		return strings.Contains(strings.ToLower(errResp.Message), "checksum")
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestDefaultChecksum(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		checksums []string
+		completed string
+	)
+	rejected := map[string]bool{"CRC64NVME": true, "SHA256": true}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		checksum := strings.ToUpper(strings.TrimPrefix(r.Header.Get("X-Amz-Trailer"), "x-amz-checksum-"))
+		if algo := r.Header.Get("X-Amz-Checksum-Algorithm"); algo != "" {
+			checksum = algo
+		}
+		q := r.URL.Query()
+		if checksum != "" {
+			checksums = append(checksums, r.Method+" "+checksum)
+			if rejected[checksum] {
+				w.WriteHeader(http.StatusBadRequest)
+				io.WriteString(w, "<Error><Code>InvalidRequest</Code><Message>The checksum algorithm is not supported.</Message></Error>")
+				return
+			}
+		}
+		switch {
+		case r.Method == http.MethodHead:
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("Content-Length", "5")
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		case q.Has("uploads"):
+			io.WriteString(w, "<InitiateMultipartUploadResult><UploadId>upload</UploadId></InitiateMultipartUploadResult>")
+		case q.Has("partNumber"):
+			io.WriteString(w, `<CopyPartResult><ETag>"part"</ETag><ChecksumCRC32C>crc</ChecksumCRC32C></CopyPartResult>`)
+		case q.Has("uploadId"):
+			b, _ := io.ReadAll(r.Body)
+			completed = string(b)
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>composed</Key><ETag>"etag"</ETag></CompleteMultipartUploadResult>`)
+		default:
+			io.Copy(io.Discard, r.Body)
+			w.Header().Set("ETag", `"etag"`)
+		}
+	}))
+	defer srv.Close()
+
+	newClient := func(checksum ChecksumType) *Client {
+		clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:           credentials.NewStaticV4("access", "secret", ""),
+			Region:          "us-east-1",
+			TrailingHeaders: true,
+			DefaultChecksum: checksum,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clnt
+	}
+
+	clnt := newClient(ChecksumCRC64NVME)
+	put := func(opts PutObjectOptions) {
+		opts.DisableContentSha256 = true
+		if _, err := clnt.PutObject(context.Background(), "bucket", "object", strings.NewReader("hello"), 5, opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put(PutObjectOptions{})
+	put(PutObjectOptions{})
+	put(PutObjectOptions{Checksum: ChecksumSHA1})
+	expected := "PUT CRC64NVME,PUT CRC32C,PUT CRC32C,PUT SHA1"
+	if got := strings.Join(checksums, ","); got != expected {
+		t.Errorf("expected checksums %s, got %s", expected, got)
+	}
+	if clnt.defaultChecksumType() != ChecksumFullObjectCRC32C {
+		t.Errorf("expected the default checksum to be downgraded to CRC32C, got %s", clnt.defaultChecksumType())
+	}
+
+	checksums = nil
+	clnt = newClient(ChecksumSHA256)
+	_, err := clnt.ComposeObject(context.Background(), CopyDestOptions{Bucket: "bucket", Object: "composed"}, CopySrcOptions{Bucket: "bucket", Object: "object"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = "POST SHA256,POST CRC32C"
+	if got := strings.Join(checksums, ","); got != expected {
+		t.Errorf("expected checksums %s, got %s", expected, got)
+	}
+	if !strings.Contains(completed, "<ChecksumCRC32C>crc</ChecksumCRC32C>") {
+		t.Errorf("expected the checksums of the parts to be completed, got %s", completed)
+	}
+
+	if _, err = New("localhost:9000", &Options{DefaultChecksum: ChecksumCRC32C}); err == nil {
+		t.Error("expected DefaultChecksum without TrailingHeaders to fail")
+	}
+}
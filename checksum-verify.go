@@ -0,0 +1,191 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrChecksumMismatch is wrapped by the errors of reading objects whose
+// checksum does not match their content, see
+// GetObjectOptions.VerifyChecksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// verifiedChecksums are the checksums verified by VerifyChecksum, in
+// the order of preference.
+var verifiedChecksums = []ChecksumType{ChecksumCRC64NVME, ChecksumCRC32C, ChecksumCRC32, ChecksumSHA256, ChecksumSHA1}
+
+// objectChecksum returns the checksum of type t of the object.
+func objectChecksum(info ObjectInfo, t ChecksumType) string {
+	switch t {
+	case ChecksumCRC32:
+		return info.ChecksumCRC32
+	case ChecksumCRC32C:
+		return info.ChecksumCRC32C
+	case ChecksumSHA1:
+		return info.ChecksumSHA1
+	case ChecksumSHA256:
+		return info.ChecksumSHA256
+	case ChecksumCRC64NVME:
+		return info.ChecksumCRC64NVME
+	}
+	return ""
+}
+
+// checksumVerifier reads an object and verifies its checksum once all
+// of it was read.
+type checksumVerifier struct {
+	io.ReadCloser
+	typ    ChecksumType
+	want   string
+	size   int64
+	read   int64
+	hasher hash.Hash
+
+	// The sizes of the parts of a composite checksum not read yet and
+	// the checksums of the parts read.
+	composite bool
+	parts     []int64
+	partCount int
+	partRead  int64
+	partSums  []byte
+
+	verified bool
+	err      error
+}
+
+// newChecksumVerifier returns a reader of r verifying the checksum of
+// the object, or r if the object has no checksum which can be verified.
+func (c *Client) newChecksumVerifier(ctx context.Context, bucketName, objectName string, info ObjectInfo, opts GetObjectOptions, r io.ReadCloser) io.ReadCloser {
+	for _, t := range verifiedChecksums {
+		want := objectChecksum(info, t)
+		if want == "" {
+			continue
+		}
+		v := &checksumVerifier{ReadCloser: r, typ: t, want: want, size: info.Size, hasher: t.Hasher()}
+		if _, parts, ok := strings.Cut(want, "-"); ok {
+			partCount, err := strconv.Atoi(parts)
+			if err != nil || partCount <= 0 {
+				return r
+			}
+			// The part boundaries of composite checksums are listed
+			// with the object attributes.
+			if v.parts = c.objectPartSizes(ctx, bucketName, objectName, info.VersionID, opts); len(v.parts) != partCount {
+				return r
+			}
+			v.composite, v.partCount = true, partCount
+		}
+		return v
+	}
+	return r
+}
+
+// objectPartSizes returns the sizes of the parts of an object, nil if
+// they cannot be listed.
+func (c *Client) objectPartSizes(ctx context.Context, bucketName, objectName, versionID string, opts GetObjectOptions) []int64 {
+	var sizes []int64
+	attrOpts := ObjectAttributesOptions{VersionID: versionID, ServerSideEncryption: opts.ServerSideEncryption}
+	for {
+		attrs, err := c.GetObjectAttributes(ctx, bucketName, objectName, attrOpts)
+		if err != nil {
+			return nil
+		}
+		for _, part := range attrs.ObjectParts.Parts {
+			if part.PartNumber != len(sizes)+1 {
+				return nil
+			}
+			sizes = append(sizes, int64(part.Size))
+		}
+		if !attrs.ObjectParts.IsTruncated {
+			return sizes
+		}
+		attrOpts.PartNumberMarker = attrs.ObjectParts.NextPartNumberMarker
+	}
+}
+
+// Read reads from the object, the last bytes of the object are not
+// returned if its checksum does not match.
+func (v *checksumVerifier) Read(p []byte) (int, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	n, err := v.ReadCloser.Read(p)
+	if v.verified {
+		return n, err
+	}
+	v.write(p[:n])
+	if (v.size >= 0 && v.read >= v.size) || err == io.EOF {
+		if v.err = v.verify(); v.err != nil {
+			return 0, v.err
+		}
+		v.verified = true
+	}
+	return n, err
+}
+
+// write hashes the content of the object.
+func (v *checksumVerifier) write(p []byte) {
+	v.read += int64(len(p))
+	if !v.composite {
+		v.hasher.Write(p)
+		return
+	}
+	for len(p) > 0 && len(v.parts) > 0 {
+		n := min(int64(len(p)), v.parts[0]-v.partRead)
+		v.hasher.Write(p[:n])
+		v.partRead += n
+		p = p[n:]
+		if v.partRead == v.parts[0] {
+			v.partSums = v.hasher.Sum(v.partSums)
+			v.hasher.Reset()
+			v.parts, v.partRead = v.parts[1:], 0
+		}
+	}
+}
+
+// verify compares the checksum of the content read with the checksum
+// of the object.
+func (v *checksumVerifier) verify() error {
+	var got string
+	if v.composite {
+		h := v.typ.Hasher()
+		h.Write(v.partSums)
+		got = base64.StdEncoding.EncodeToString(h.Sum(nil)) + "-" + strconv.Itoa(v.partCount-len(v.parts))
+	} else {
+		got = base64.StdEncoding.EncodeToString(v.hasher.Sum(nil))
+	}
+	if got != v.want {
+		return fmt.Errorf("%w: %s of the object is %s, read %s", ErrChecksumMismatch, v.typ, v.want, got)
+	}
+	return nil
+}
+
+// checksumVerified returns the checksum verified by r, if any.
+func checksumVerified(r io.Reader) ChecksumType {
+	if v, ok := r.(*checksumVerifier); ok && v.verified {
+		return v.typ
+	}
+	return ChecksumNone
+}
@@ -0,0 +1,127 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestVerifyChecksum(t *testing.T) {
+	const data = "hello world"
+	sum := func(t ChecksumType, b string) []byte {
+		h := t.Hasher()
+		h.Write([]byte(b))
+		return h.Sum(nil)
+	}
+	crc32c := base64.StdEncoding.EncodeToString(sum(ChecksumCRC32C, data))
+	sha256 := base64.StdEncoding.EncodeToString(sum(ChecksumSHA256, data))
+	composite := base64.StdEncoding.EncodeToString(sum(ChecksumCRC32C, string(sum(ChecksumCRC32C, data[:6]))+string(sum(ChecksumCRC32C, data[6:])))) + "-2"
+
+	checksums := map[string]http.Header{
+		"full":      {"X-Amz-Checksum-Crc32c": {crc32c}, "X-Amz-Checksum-Type": {"FULL_OBJECT"}},
+		"sha256":    {"X-Amz-Checksum-Sha256": {sha256}},
+		"corrupt":   {"X-Amz-Checksum-Crc32c": {base64.StdEncoding.EncodeToString(sum(ChecksumCRC32C, "hello"))}},
+		"composite": {"X-Amz-Checksum-Crc32c": {composite}, "X-Amz-Checksum-Type": {"COMPOSITE"}},
+		"none":      {},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		object := strings.TrimPrefix(r.URL.Path, "/bucket/")
+		if r.URL.Query().Has("attributes") {
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			fmt.Fprint(w, `<GetObjectAttributesResponse><ObjectParts><PartsCount>2</PartsCount>`+
+				`<Part><PartNumber>1</PartNumber><Size>6</Size></Part><Part><PartNumber>2</PartNumber><Size>5</Size></Part>`+
+				`</ObjectParts></GetObjectAttributesResponse>`)
+			return
+		}
+		if r.Header.Get("X-Amz-Checksum-Mode") == "ENABLED" {
+			for k, v := range checksums[object] {
+				w.Header()[k] = v
+			}
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		io.WriteString(w, data)
+	}))
+	defer srv.Close()
+
+	newClient := func(verify bool) *Client {
+		clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:          credentials.NewStaticV4("access", "secret", ""),
+			Region:         "us-east-1",
+			VerifyChecksum: verify,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clnt
+	}
+
+	testCases := []struct {
+		object   string
+		verify   bool
+		clnt     *Client
+		verified ChecksumType
+		mismatch bool
+	}{
+		{object: "full", verify: true, clnt: newClient(false), verified: ChecksumCRC32C},
+		{object: "sha256", verify: true, clnt: newClient(false), verified: ChecksumSHA256},
+		{object: "composite", verify: true, clnt: newClient(false), verified: ChecksumCRC32C},
+		{object: "corrupt", verify: true, clnt: newClient(false), mismatch: true},
+		{object: "corrupt", clnt: newClient(false)},
+		{object: "corrupt", clnt: newClient(true), mismatch: true},
+		{object: "none", verify: true, clnt: newClient(false)},
+	}
+	for i, testCase := range testCases {
+		obj, err := testCase.clnt.GetObject(context.Background(), "bucket", testCase.object, GetObjectOptions{VerifyChecksum: testCase.verify})
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(obj)
+		if testCase.mismatch {
+			if !errors.Is(err, ErrChecksumMismatch) {
+				t.Errorf("test %d: expected checksum mismatch, got %v", i+1, err)
+			}
+			if len(b) == len(data) {
+				t.Errorf("test %d: expected the corrupt content to be withheld", i+1)
+			}
+			continue
+		}
+		if err != nil || string(b) != data {
+			t.Fatalf("test %d: unexpected content %q, %v", i+1, b, err)
+		}
+		info, err := obj.Stat()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.ChecksumVerified != testCase.verified {
+			t.Errorf("test %d: expected %s to be verified, got %s", i+1, testCase.verified, info.ChecksumVerified)
+		}
+		obj.Close()
+	}
+}
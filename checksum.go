@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"github.com/minio/crc64nvme"
+	"github.com/minio/minio-go/v7/pkg/checksum"
 )
 
 // ChecksumMode contains information about the checksum mode on the object
@@ -405,9 +406,9 @@ func (c ChecksumType) FullObjectChecksum(p []ObjectPart) (*Checksum, error) {
 		}
 		switch c {
 		case ChecksumCRC32, ChecksumCRC32C:
-			merged = crc32Combine(poly32, merged, binary.BigEndian.Uint32(pCrc), part.Size)
+			merged = checksum.CRC32Combine(poly32, merged, binary.BigEndian.Uint32(pCrc), part.Size)
 		case ChecksumCRC64NVME:
-			merged64 = crc64Combine(bits.Reverse64(crc64NVMEPolynomial), merged64, binary.BigEndian.Uint64(pCrc), part.Size)
+			merged64 = checksum.CRC64Combine(bits.Reverse64(crc64NVMEPolynomial), merged64, binary.BigEndian.Uint64(pCrc), part.Size)
 		}
 	}
 	var tmp [8]byte
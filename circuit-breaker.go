@@ -0,0 +1,196 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned for requests to a host whose circuit breaker
+// is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerOptions configure the circuit breaker of a client. The
+// circuit of a host opens after consecutive failures, i.e. network errors,
+// timeouts and 5xx responses, and requests to the host then fail with
+// ErrCircuitOpen, or are sent to the failover endpoint, for a cool-down
+// period. A single request is let through after the cool-down, its
+// success closes the circuit.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures opening
+	// the circuit, defaults to 5.
+	FailureThreshold int
+
+	// CoolDown is the duration the circuit stays open, defaults to 30
+	// seconds.
+	CoolDown time.Duration
+
+	// FailoverEndpoint receives the requests while the circuit of the
+	// endpoint is open, e.g. a replica site. It uses the scheme of the
+	// client endpoint.
+	FailoverEndpoint string
+}
+
+const (
+	defaultCircuitFailureThreshold = 5
+	defaultCircuitCoolDown         = 30 * time.Second
+)
+
+type circuitState struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// circuitBreaker tracks the circuits of hosts.
+type circuitBreaker struct {
+	threshold int
+	coolDown  time.Duration
+	failover  *url.URL
+
+	mu    sync.Mutex
+	hosts map[string]*circuitState
+}
+
+func newCircuitBreaker(opts *CircuitBreakerOptions, secure bool) (*circuitBreaker, error) {
+	cb := &circuitBreaker{
+		threshold: opts.FailureThreshold,
+		coolDown:  opts.CoolDown,
+		hosts:     make(map[string]*circuitState),
+	}
+	if cb.threshold <= 0 {
+		cb.threshold = defaultCircuitFailureThreshold
+	}
+	if cb.coolDown <= 0 {
+		cb.coolDown = defaultCircuitCoolDown
+	}
+	if opts.FailoverEndpoint != "" {
+		u, err := getEndpointURL(opts.FailoverEndpoint, secure)
+		if err != nil {
+			return nil, err
+		}
+		cb.failover = u
+	}
+	return cb, nil
+}
+
+// allow returns ErrCircuitOpen if a request to host must fail fast,
+// otherwise the request outcome must be recorded with done.
+func (cb *circuitBreaker) allow(host string) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	s, ok := cb.hosts[host]
+	if !ok || s.failures < cb.threshold {
+		return nil
+	}
+	if time.Now().Before(s.openUntil) || s.probing {
+		return &url.Error{Op: "Request", URL: host, Err: ErrCircuitOpen}
+	}
+	// Cool-down is over, let a single probe through.
+	s.probing = true
+	return nil
+}
+
+// done records the outcome of a request to host.
+func (cb *circuitBreaker) done(host string, failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	s, ok := cb.hosts[host]
+	if !failed {
+		if ok {
+			delete(cb.hosts, host)
+		}
+		return
+	}
+	if !ok {
+		s = &circuitState{}
+		cb.hosts[host] = s
+	}
+	s.failures++
+	s.probing = false
+	if s.failures >= cb.threshold {
+		s.openUntil = time.Now().Add(cb.coolDown)
+	}
+}
+
+// abort records a request to host canceled by the caller.
+func (cb *circuitBreaker) abort(host string) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if s, ok := cb.hosts[host]; ok {
+		s.probing = false
+	}
+}
+
+// circuitHost returns the endpoint host of req, without the bucket of
+// virtual host style requests.
+func circuitHost(req *http.Request, bucketName string) string {
+	if bucketName != "" {
+		if host, ok := strings.CutPrefix(req.URL.Host, bucketName+"."); ok {
+			return host
+		}
+	}
+	return req.URL.Host
+}
+
+// isCircuitFailure returns true if the outcome of a request counts as a
+// failure of the host. 501 Not Implemented is the answer of a healthy
+// host to an unsupported API, e.g. of a capability probe.
+func isCircuitFailure(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return res.StatusCode >= http.StatusInternalServerError && res.StatusCode != http.StatusNotImplemented
+}
+
+type failoverContextKey struct{}
+
+// withFailover returns a copy of ctx whose requests are sent to the
+// failover endpoint.
+func withFailover(ctx context.Context) context.Context {
+	return context.WithValue(ctx, failoverContextKey{}, true)
+}
+
+// isFailover returns true if requests made with ctx are sent to the
+// failover endpoint.
+func isFailover(ctx context.Context) bool {
+	failover, _ := ctx.Value(failoverContextKey{}).(bool)
+	return failover
+}
+
+// allowRequest returns req, or a request to the failover endpoint if the
+// circuit of the host of req is open.
+func (c *Client) allowRequest(ctx context.Context, req *http.Request, method string, metadata requestMetadata) (context.Context, *http.Request, string, error) {
+	host := circuitHost(req, metadata.bucketName)
+	err := c.circuitBreaker.allow(host)
+	if err == nil || c.circuitBreaker.failover == nil || isFailover(ctx) {
+		return ctx, req, host, err
+	}
+	ctx = withFailover(ctx)
+	if req, err = c.newRequest(ctx, method, metadata); err != nil {
+		return ctx, nil, "", err
+	}
+	host = circuitHost(req, metadata.bucketName)
+	return ctx, req, host, c.circuitBreaker.allow(host)
+}
@@ -0,0 +1,182 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestCircuitBreakerState(t *testing.T) {
+	cb, err := newCircuitBreaker(&CircuitBreakerOptions{FailureThreshold: 2, CoolDown: 10 * time.Millisecond}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cb.done("host", true)
+	if err = cb.allow("host"); err != nil {
+		t.Fatalf("Expected closed circuit after 1 failure, got %v", err)
+	}
+	cb.done("host", true)
+	if err = cb.allow("host"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected open circuit, got %v", err)
+	}
+	if err = cb.allow("other"); err != nil {
+		t.Fatalf("Expected circuits per host, got %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err = cb.allow("host"); err != nil {
+		t.Fatalf("Expected a probe after the cool-down, got %v", err)
+	}
+	if err = cb.allow("host"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected a single probe, got %v", err)
+	}
+	cb.done("host", false)
+	if err = cb.allow("host"); err != nil {
+		t.Fatalf("Expected closed circuit after a successful probe, got %v", err)
+	}
+}
+
+func TestIsCircuitFailure(t *testing.T) {
+	testCases := []struct {
+		status  int
+		err     error
+		failure bool
+	}{
+		{status: http.StatusOK},
+		{status: http.StatusNotFound},
+		{status: http.StatusInternalServerError, failure: true},
+		{status: http.StatusServiceUnavailable, failure: true},
+		// Unsupported APIs do not trip the circuit.
+		{status: http.StatusNotImplemented},
+		{err: errors.New("connection refused"), failure: true},
+	}
+	for i, testCase := range testCases {
+		var res *http.Response
+		if testCase.err == nil {
+			res = &http.Response{StatusCode: testCase.status}
+		}
+		if failure := isCircuitFailure(res, testCase.err); failure != testCase.failure {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.failure, failure)
+		}
+	}
+}
+
+func TestCircuitBreakerFailover(t *testing.T) {
+	var primaryRequests atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryRequests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+	failover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer failover.Close()
+
+	newClient := func(failoverEndpoint string) *Client {
+		c, err := New(strings.TrimPrefix(primary.URL, "http://"), &Options{
+			Creds:      credentials.NewStaticV4("access", "secret", ""),
+			Region:     "us-east-1",
+			MaxRetries: 1,
+			CircuitBreaker: &CircuitBreakerOptions{
+				FailureThreshold: 2,
+				CoolDown:         time.Minute,
+				FailoverEndpoint: failoverEndpoint,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	ctx := context.Background()
+
+	c := newClient("")
+	for range 2 {
+		if _, err := c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err == nil {
+			t.Fatal("Expected error from primary")
+		}
+	}
+	if _, err := c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected to fail fast, got %v", err)
+	}
+	if n := primaryRequests.Load(); n != 2 {
+		t.Errorf("Expected 2 requests to the primary, got %d", n)
+	}
+
+	c = newClient(strings.TrimPrefix(failover.URL, "http://"))
+	for range 2 {
+		c.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	}
+	if _, err := c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatalf("Expected failover to succeed, got %v", err)
+	}
+}
+
+func TestCircuitBreakerFailoverRetry(t *testing.T) {
+	var primaryRequests, failoverRequests atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryRequests.Add(1)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer primary.Close()
+	failover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		failoverRequests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failover.Close()
+
+	host := strings.TrimPrefix(primary.URL, "http://")
+	c, err := New(host, &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+		RetryPolicy: StandardRetryPolicy{
+			MaxAttempts: 2,
+			BaseDelay:   100 * time.Millisecond,
+			Jitter:      RetryJitterNone,
+		},
+		CircuitBreaker: &CircuitBreakerOptions{
+			FailureThreshold: 1,
+			CoolDown:         50 * time.Millisecond,
+			FailoverEndpoint: strings.TrimPrefix(failover.URL, "http://"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first attempt fails over, the retry after the cool-down goes
+	// to the primary again.
+	c.circuitBreaker.done(host, true)
+	if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	if p, f := primaryRequests.Load(), failoverRequests.Load(); p != 1 || f != 1 {
+		t.Errorf("Expected 1 request to the primary and to the failover, got %d and %d", p, f)
+	}
+}
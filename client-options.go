@@ -0,0 +1,72 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/kvcache"
+)
+
+// ClientOption overrides a setting of a client returned by With.
+type ClientOption func(*Client)
+
+// With returns a client sharing the transport and caches of c with the
+// settings overridden by opts, e.g. to use the credentials of a tenant or
+// another retry policy for a bucket. The returned client does not share
+// the health check of c.
+func (c *Client) With(opts ...ClientOption) *Client {
+	clnt := c.clone()
+	for _, opt := range opts {
+		opt(clnt)
+	}
+	return clnt
+}
+
+// OverrideRegion overrides the region of the client, an empty region
+// looks up the location of buckets.
+func OverrideRegion(region string) ClientOption {
+	return func(c *Client) {
+		c.region = region
+	}
+}
+
+// OverrideCredentials overrides the credentials of the client.
+func OverrideCredentials(creds *credentials.Credentials) ClientOption {
+	return func(c *Client) {
+		c.credsProvider = creds
+		// Sessions of directory buckets belong to the credentials
+		// they were created with.
+		c.bucketSessionCache = &kvcache.Cache[string, credentials.Value]{}
+	}
+}
+
+// OverrideRetryPolicy overrides the retry policy of the client, see
+// Options.RetryPolicy.
+func OverrideRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// OverrideTrailingHeaders enables or disables trailing headers, i.e. the
+// checksums of uploads sent as trailers, see Options.TrailingHeaders.
+func OverrideTrailingHeaders(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.trailingHeaderSupport = enabled && c.overrideSignerType.IsV4()
+	}
+}
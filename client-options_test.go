@@ -0,0 +1,72 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestClientWith(t *testing.T) {
+	var (
+		auth     atomic.Value
+		requests atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		auth.Store(r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tenant := clnt.With(
+		OverrideCredentials(credentials.NewStaticV4("tenant", "secret", "")),
+		OverrideRegion("eu-west-1"),
+		OverrideRetryPolicy(StandardRetryPolicy{MaxAttempts: 1}),
+	)
+
+	tenant.BucketExists(context.Background(), "bucket")
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected 1 request without retries, got %d", n)
+	}
+	if a := auth.Load().(string); !strings.Contains(a, "Credential=tenant/") || !strings.Contains(a, "/eu-west-1/") {
+		t.Fatalf("expected the credentials and region of the tenant, got %s", a)
+	}
+
+	requests.Store(0)
+	clnt.With(OverrideRetryPolicy(StandardRetryPolicy{MaxAttempts: 2, BaseDelay: 1})).BucketExists(context.Background(), "bucket")
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("expected 2 requests, got %d", n)
+	}
+	if a := auth.Load().(string); !strings.Contains(a, "Credential=access/") || !strings.Contains(a, "/us-east-1/") {
+		t.Fatalf("expected the credentials and region of the client, got %s", a)
+	}
+}
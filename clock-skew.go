@@ -0,0 +1,71 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"time"
+)
+
+// minClockSkewCorrection is the smallest clock offset corrected, the Date
+// header has a precision of one second.
+const minClockSkewCorrection = 2 * time.Second
+
+// now returns the current time corrected for the clock skew to the
+// server, it is the signing time of requests.
+func (c *Client) now() time.Time {
+	return time.Now().Add(c.ClockOffset())
+}
+
+// ClockOffset returns the correction applied to the local clock when
+// signing requests, it is learned from RequestTimeTooSkewed errors.
+func (c *Client) ClockOffset() time.Duration {
+	if c.clockOffset == nil {
+		return 0
+	}
+	return time.Duration(c.clockOffset.Load())
+}
+
+// skewedServerTime is the server time of a RequestTimeTooSkewed error.
+type skewedServerTime struct {
+	ServerTime time.Time
+}
+
+// correctClockSkew learns the clock offset to the server from the
+// response of a RequestTimeTooSkewed error, it returns true if the offset
+// changed and the request should be signed again.
+func (c *Client) correctClockSkew(res *http.Response, errBody []byte) bool {
+	var serverTime time.Time
+	if date, err := http.ParseTime(res.Header.Get("Date")); err == nil {
+		serverTime = date
+	} else {
+		var st skewedServerTime
+		if xml.NewDecoder(bytes.NewReader(errBody)).Decode(&st) != nil || st.ServerTime.IsZero() {
+			return false
+		}
+		serverTime = st.ServerTime
+	}
+	offset := time.Until(serverTime)
+	if d := offset - c.ClockOffset(); d > -minClockSkewCorrection && d < minClockSkewCorrection {
+		return false
+	}
+	c.clockOffset.Store(int64(offset))
+	return true
+}
@@ -0,0 +1,79 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestClockSkewCorrection(t *testing.T) {
+	serverOffset := time.Hour
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		serverTime := time.Now().Add(serverOffset).UTC()
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		reqTime, err := time.Parse(iso8601DateFormat, r.Header.Get("X-Amz-Date"))
+		if err != nil || serverTime.Sub(reqTime).Abs() > 15*time.Minute {
+			w.Header().Set("x-minio-error-code", RequestTimeTooSkewed)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+	}))
+	defer srv.Close()
+
+	// The offset applies to custom signers too.
+	for i, signer := range []Signer{nil, &hsmSigner{}} {
+		requests.Store(0)
+		c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:        credentials.NewStaticV4("access", "secret", ""),
+			Region:       "us-east-1",
+			CustomSigner: signer,
+		})
+		if err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		if n := requests.Load(); n != 2 {
+			t.Errorf("test %d: expected 2 requests, got %d", i+1, n)
+		}
+		if offset := c.ClockOffset(); (offset - serverOffset).Abs() > 2*time.Second {
+			t.Errorf("test %d: expected a clock offset of %s, got %s", i+1, serverOffset, offset)
+		}
+
+		// The offset is kept for later requests.
+		requests.Store(0)
+		if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		if n := requests.Load(); n != 1 {
+			t.Errorf("test %d: expected 1 request, got %d", i+1, n)
+		}
+	}
+}
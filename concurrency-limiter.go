@@ -0,0 +1,178 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ErrRequestQueueTimeout is returned when a request waited longer than
+// the queue timeout for the concurrency limit of the client.
+var ErrRequestQueueTimeout = errors.New("timed out waiting for a concurrent request slot")
+
+// Weights of requests against the MaxConcurrentRequests limit.
+const (
+	// HEAD, DELETE and other metadata requests.
+	RequestWeightMetadata = 1
+	// GET requests, which hold a connection while streaming.
+	RequestWeightDownload = 2
+	// Requests with a body, i.e. uploads of objects and parts.
+	RequestWeightUpload = 4
+)
+
+// DefaultRequestWeight returns the weight of a request against the
+// MaxConcurrentRequests limit.
+func DefaultRequestWeight(method string, contentLength int64) int64 {
+	switch {
+	case contentLength != 0 && (method == http.MethodPut || method == http.MethodPost):
+		return RequestWeightUpload
+	case method == http.MethodGet:
+		return RequestWeightDownload
+	default:
+		return RequestWeightMetadata
+	}
+}
+
+// weightedSemaphore is a semaphore granting weights in FIFO order.
+type weightedSemaphore struct {
+	size    int64
+	mu      sync.Mutex
+	cur     int64
+	waiters list.List
+}
+
+type semaphoreWaiter struct {
+	n     int64
+	ready chan struct{}
+}
+
+func newWeightedSemaphore(size int64) *weightedSemaphore {
+	return &weightedSemaphore{size: size}
+}
+
+// acquire acquires n, blocking until it is available or ctx is done.
+func (s *weightedSemaphore) acquire(ctx context.Context, n int64) error {
+	n = min(n, s.size)
+	s.mu.Lock()
+	if s.size-s.cur >= n && s.waiters.Len() == 0 {
+		s.cur += n
+		s.mu.Unlock()
+		return nil
+	}
+	w := semaphoreWaiter{n: n, ready: make(chan struct{})}
+	elem := s.waiters.PushBack(w)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		select {
+		case <-w.ready:
+			// Acquired while canceled, give it back.
+			s.cur -= n
+		default:
+			s.waiters.Remove(elem)
+		}
+		s.notifyWaiters()
+		s.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// release releases n acquired with acquire.
+func (s *weightedSemaphore) release(n int64) {
+	n = min(n, s.size)
+	s.mu.Lock()
+	s.cur -= n
+	s.notifyWaiters()
+	s.mu.Unlock()
+}
+
+func (s *weightedSemaphore) notifyWaiters() {
+	for {
+		front := s.waiters.Front()
+		if front == nil {
+			return
+		}
+		w := front.Value.(semaphoreWaiter)
+		if s.size-s.cur < w.n {
+			// Keep FIFO order, large requests are not starved.
+			return
+		}
+		s.cur += w.n
+		s.waiters.Remove(front)
+		close(w.ready)
+	}
+}
+
+// acquireRequest acquires the weight of a request, the returned function
+// releases it.
+func (c *Client) acquireRequest(ctx context.Context, method string, contentLength int64) (func(), error) {
+	if c.requestLimit == nil {
+		return func() {}, nil
+	}
+	weight := max(c.requestWeight(method, contentLength), 1)
+	waitCtx := ctx
+	if c.requestQueueTimeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, c.requestQueueTimeout)
+		defer cancel()
+	}
+	if err := c.requestLimit.acquire(waitCtx, weight); err != nil {
+		if ctx.Err() == nil {
+			return nil, ErrRequestQueueTimeout
+		}
+		return nil, err
+	}
+	var once sync.Once
+	return func() { once.Do(func() { c.requestLimit.release(weight) }) }, nil
+}
+
+// allocBuffer allocates a buffer of size bytes within the
+// MaxBufferMemory budget, blocking until it is available or ctx is done.
+// The returned function releases the buffer to the budget.
+func (c *Client) allocBuffer(ctx context.Context, size int64) ([]byte, func(), error) {
+	if c.bufferMemory == nil {
+		return make([]byte, size), func() {}, nil
+	}
+	if err := c.bufferMemory.acquire(ctx, size); err != nil {
+		return nil, nil, err
+	}
+	var once sync.Once
+	return make([]byte, size), func() { once.Do(func() { c.bufferMemory.release(size) }) }, nil
+}
+
+// releaseBody releases the weight of a request when its response body
+// is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
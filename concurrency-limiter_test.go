@@ -0,0 +1,189 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestWeightedSemaphore(t *testing.T) {
+	s := newWeightedSemaphore(4)
+	ctx := context.Background()
+	if err := s.acquire(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	// A waiting request blocks smaller requests behind it.
+	acquired := make(chan struct{})
+	go func() {
+		s.acquire(ctx, 4)
+		close(acquired)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	tctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := s.acquire(tctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected FIFO order, got %v", err)
+	}
+	s.release(3)
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the waiting request to acquire")
+	}
+	s.release(4)
+	if s.cur != 0 {
+		t.Errorf("Expected no weight in use, got %d", s.cur)
+	}
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:                 credentials.NewStaticV4("access", "secret", ""),
+		Region:                "us-east-1",
+		MaxConcurrentRequests: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := maxInFlight.Load(); n != 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", n)
+	}
+
+	c.requestQueueTimeout = time.Millisecond
+	release, err := c.acquireRequest(context.Background(), http.MethodPut, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); !errors.Is(err, ErrRequestQueueTimeout) {
+		t.Errorf("Expected queue timeout, got %v", err)
+	}
+}
+
+func TestMaxBufferMemory(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case q.Has("uploads"):
+			io.WriteString(w, "<InitiateMultipartUploadResult><UploadId>upload</UploadId></InitiateMultipartUploadResult>")
+		case q.Has("partNumber"):
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			io.Copy(io.Discard, r.Body)
+			time.Sleep(20 * time.Millisecond)
+			w.Header().Set("ETag", `"part"`)
+		default:
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag"</ETag></CompleteMultipartUploadResult>`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:           credentials.NewStaticV4("access", "secret", ""),
+		Region:          "us-east-1",
+		MaxBufferMemory: 2 * minPartSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 2*minPartSize)
+	testCases := []PutObjectOptions{
+		{},
+		{ConcurrentStreamParts: true, NumThreads: 4},
+	}
+	for i, opts := range testCases {
+		inFlight.Store(0)
+		maxInFlight.Store(0)
+		opts.PartSize = minPartSize
+		opts.DisableContentSha256 = true
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := c.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), -1, opts); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		wg.Wait()
+		if n := maxInFlight.Load(); n != 2 {
+			t.Errorf("test %d: expected at most 2 parts in flight, got %d", i+1, n)
+		}
+		if c.bufferMemory.cur != 0 {
+			t.Errorf("test %d: expected no buffer memory in use, got %d", i+1, c.bufferMemory.cur)
+		}
+	}
+
+	_, release, err := c.allocBuffer(context.Background(), 2*minPartSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err = c.PutObject(ctx, "bucket", "object", bytes.NewReader(data), -1, PutObjectOptions{PartSize: minPartSize}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the upload to wait for buffer memory, got %v", err)
+	}
+}
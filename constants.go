@@ -94,8 +94,11 @@ const (
 	amzTaggingCount      = "X-Amz-Tagging-Count"
 	amzExpiration        = "X-Amz-Expiration"
 	amzRestore           = "X-Amz-Restore"
+	amzRestoreOutputPath = "X-Amz-Restore-Output-Path"
 	amzReplicationStatus = "X-Amz-Replication-Status"
 	amzDeleteMarker      = "X-Amz-Delete-Marker"
+	amzMpPartsCount      = "X-Amz-Mp-Parts-Count"
+	amzBucketKeyEnabled  = "X-Amz-Server-Side-Encryption-Bucket-Key-Enabled"
 
 	// Object legal hold header
 	amzLegalHoldHeader = "X-Amz-Object-Lock-Legal-Hold"
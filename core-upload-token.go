@@ -0,0 +1,166 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// MultipartUploadToken - describes a multipart upload in progress, to
+// share it with the workers uploading its parts, e.g. on other machines.
+// Tokens are exchanged as strings, see String and
+// ParseMultipartUploadToken. They do not hold credentials or keys.
+type MultipartUploadToken struct {
+	Bucket   string `json:"bucket"`
+	Object   string `json:"object"`
+	UploadID string `json:"uploadId"`
+	// Size of the object.
+	Size int64 `json:"size"`
+	// PartSize is the size of every part but the last one.
+	PartSize int64 `json:"partSize"`
+	// Parts is the number of parts of the object.
+	Parts int `json:"parts"`
+}
+
+// String - returns the token encoded as an URL safe string.
+func (t MultipartUploadToken) String() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseMultipartUploadToken - parses a token returned by
+// MultipartUploadToken.String.
+func ParseMultipartUploadToken(s string) (MultipartUploadToken, error) {
+	var t MultipartUploadToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, errInvalidArgument("Invalid multipart upload token.")
+	}
+	if err = json.Unmarshal(b, &t); err != nil {
+		return t, errInvalidArgument("Invalid multipart upload token.")
+	}
+	if err = t.validate(); err != nil {
+		return MultipartUploadToken{}, err
+	}
+	return t, nil
+}
+
+func (t MultipartUploadToken) validate() error {
+	if err := s3utils.CheckValidBucketName(t.Bucket); err != nil {
+		return err
+	}
+	if err := s3utils.CheckValidObjectName(t.Object); err != nil {
+		return err
+	}
+	if t.UploadID == "" {
+		return errInvalidArgument("Upload ID cannot be empty.")
+	}
+	if t.Size < 0 || t.PartSize <= 0 || t.Parts < 1 || t.Parts > maxPartsCount ||
+		int64(t.Parts-1)*t.PartSize >= max(t.Size, 1) || int64(t.Parts)*t.PartSize < t.Size {
+		return errInvalidArgument("Invalid size, part size or parts of the multipart upload.")
+	}
+	return nil
+}
+
+// PartRange - returns the offset and the length in the object of a part.
+func (t MultipartUploadToken) PartRange(partNumber int) (offset, length int64, err error) {
+	if partNumber < 1 || partNumber > t.Parts {
+		return 0, 0, errInvalidArgument(fmt.Sprintf("Part number must be between 1 and %d.", t.Parts))
+	}
+	offset = int64(partNumber-1) * t.PartSize
+	return offset, min(t.PartSize, t.Size-offset), nil
+}
+
+// NewMultipartUploadToken - creates a multipart upload of an object of
+// size bytes split in parts of partSize bytes, the optimal part size is
+// used when it is zero. The parts are uploaded with PutTokenPart and the
+// upload is completed with CompleteTokenUpload.
+func (c Core) NewMultipartUploadToken(ctx context.Context, bucket, object string, size int64, partSize uint64, opts PutObjectOptions) (MultipartUploadToken, error) {
+	if size < 0 {
+		return MultipartUploadToken{}, errInvalidArgument("Size of the object must be known.")
+	}
+	t := MultipartUploadToken{Bucket: bucket, Object: object, Size: size}
+	switch {
+	case partSize > 0 && (partSize < absMinPartSize || partSize > maxPartSize):
+		return MultipartUploadToken{}, errInvalidArgument("Part size must be between 5MiB and 5GiB.")
+	case size <= int64(partSize) || (partSize == 0 && size <= minPartSize):
+		// A single part.
+		t.Parts, t.PartSize = 1, max(int64(partSize), minPartSize)
+	default:
+		var err error
+		if t.Parts, t.PartSize, _, err = OptimalPartInfo(size, partSize); err != nil {
+			return MultipartUploadToken{}, err
+		}
+	}
+
+	var err error
+	if t.UploadID, err = c.NewMultipartUpload(ctx, bucket, object, opts); err != nil {
+		return MultipartUploadToken{}, err
+	}
+	return t, nil
+}
+
+// PutTokenPart - uploads part partNumber of the upload of a token, data
+// holds the bytes of the part, see MultipartUploadToken.PartRange.
+func (c Core) PutTokenPart(ctx context.Context, t MultipartUploadToken, partNumber int, data io.Reader, opts PutObjectPartOptions) (ObjectPart, error) {
+	if err := t.validate(); err != nil {
+		return ObjectPart{}, err
+	}
+	_, length, err := t.PartRange(partNumber)
+	if err != nil {
+		return ObjectPart{}, err
+	}
+	return c.PutObjectPart(ctx, t.Bucket, t.Object, t.UploadID, partNumber, data, length, opts)
+}
+
+// CompleteTokenUpload - completes the upload of a token with the parts
+// listed on the server, i.e. the parts uploaded by all workers. It fails
+// if a part is missing or has an unexpected size.
+func (c Core) CompleteTokenUpload(ctx context.Context, t MultipartUploadToken, opts PutObjectOptions) (UploadInfo, error) {
+	if err := t.validate(); err != nil {
+		return UploadInfo{}, err
+	}
+	partsInfo, err := c.listObjectParts(ctx, t.Bucket, t.Object, t.UploadID)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	parts := make([]ObjectPart, 0, len(partsInfo))
+	for partNumber := 1; partNumber <= t.Parts; partNumber++ {
+		part, ok := partsInfo[partNumber]
+		if !ok {
+			return UploadInfo{}, errInvalidArgument(fmt.Sprintf("Part %d of %d has not been uploaded.", partNumber, t.Parts))
+		}
+		if _, length, _ := t.PartRange(partNumber); part.Size != length {
+			return UploadInfo{}, errInvalidArgument(fmt.Sprintf("Part %d is %d bytes, expected %d.", partNumber, part.Size, length))
+		}
+		parts = append(parts, part)
+	}
+	if len(partsInfo) > t.Parts {
+		return UploadInfo{}, errInvalidArgument(fmt.Sprintf("Found %d parts, the upload has %d parts.", len(partsInfo), t.Parts))
+	}
+	if err = ValidateObjectParts(parts); err != nil {
+		return UploadInfo{}, err
+	}
+	return c.CompleteMultipartUpload(ctx, t.Bucket, t.Object, t.UploadID, CompleteParts(parts), opts)
+}
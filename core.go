@@ -19,10 +19,15 @@ package minio
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"slices"
+	"sort"
 
 	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
 // Core - Inherits Client and adds new methods to expose the low level S3 APIs.
@@ -55,13 +60,54 @@ func (c Core) ListObjectsV2(bucketName, objectPrefix, startAfter, continuationTo
 	return c.listObjectsV2Query(context.Background(), bucketName, objectPrefix, continuationToken, true, false, delimiter, startAfter, maxkeys, nil)
 }
 
+// ListObjectsV2Options are the parameters of Core.ListObjectsV2WithOptions.
+type ListObjectsV2Options struct {
+	Prefix            string
+	StartAfter        string
+	ContinuationToken string
+	Delimiter         string
+	MaxKeys           int
+	// FetchOwner returns the owners of the objects.
+	FetchOwner bool
+	// WithMetadata returns the metadata of the objects, only supported
+	// by MinIO.
+	WithMetadata bool
+	// Headers are sent with the request.
+	Headers http.Header
+}
+
+// ListObjectsV2WithOptions - Lists a page of objects, the result is the
+// response of the server, to build pagination on top of it.
+func (c Core) ListObjectsV2WithOptions(ctx context.Context, bucketName string, opts ListObjectsV2Options) (ListBucketV2Result, error) {
+	return c.listObjectsV2Query(ctx, bucketName, opts.Prefix, opts.ContinuationToken, opts.FetchOwner, opts.WithMetadata,
+		opts.Delimiter, opts.StartAfter, opts.MaxKeys, opts.Headers)
+}
+
+// ListObjectVersions - Lists a page of the versions and delete markers of
+// objects, continued with the next key and version ID markers of the
+// previous page.
+func (c Core) ListObjectVersions(ctx context.Context, bucket, prefix, keyMarker, versionIDMarker, delimiter string, maxKeys int) (ListVersionsResult, error) {
+	return c.listObjectVersionsQuery(ctx, bucket, ListObjectsOptions{Prefix: prefix, MaxKeys: maxKeys}, keyMarker, versionIDMarker, delimiter)
+}
+
+// GetBucketLocation - fetches the location of a bucket from the server,
+// regardless of the region of the client and of the location cache,
+// which is updated.
+func (c Core) GetBucketLocation(ctx context.Context, bucket string) (string, error) {
+	if err := s3utils.CheckValidBucketName(bucket); err != nil {
+		return "", err
+	}
+	return c.fetchBucketLocation(ctx, bucket)
+}
+
 // CopyObject - copies an object from source object to destination object on server side.
 func (c Core) CopyObject(ctx context.Context, sourceBucket, sourceObject, destBucket, destObject string, metadata map[string]string, srcOpts CopySrcOptions, dstOpts PutObjectOptions) (ObjectInfo, error) {
 	return c.copyObjectDo(ctx, sourceBucket, sourceObject, destBucket, destObject, metadata, srcOpts, dstOpts)
 }
 
 // CopyObjectPart - creates a part in a multipart upload by copying (a
-// part of) an existing object.
+// part of) an existing object. The part has the checksums returned by
+// the server.
 func (c Core) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBucket, destObject, uploadID string,
 	partID int, startOffset, length int64, metadata map[string]string,
 ) (p CompletePart, err error) {
@@ -69,9 +115,63 @@ func (c Core) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBuck
 		partID, startOffset, length, metadata)
 }
 
+// UploadPartCopy - creates part partNumber of a multipart upload by
+// copying src on the server side. Only the bytes src.Start to src.End
+// (inclusive) of the source are copied when src.MatchRange is set. The
+// match conditions of src are checked against the source and
+// src.Encryption decrypts SSE-C sources. sse is the SSE-C key of the
+// destination, it must be the key the upload was created with.
+//
+// Objects are stitched from ranges of other objects by creating an
+// upload, copying the parts and completing the upload with the parts:
+//
+//	uploadID, err := core.NewMultipartUpload(ctx, "bucket", "video.mp4", PutObjectOptions{})
+//	part, err := core.UploadPartCopy(ctx, "bucket", "video.mp4", uploadID, 1, CopySrcOptions{
+//		Bucket: "bucket", Object: "segment-1.mp4",
+//	}, nil)
+//	info, err := core.CompleteMultipartUpload(ctx, "bucket", "video.mp4", uploadID, []CompletePart{part}, PutObjectOptions{})
+//
+// All parts but the last one must be at least 5MiB.
+func (c Core) UploadPartCopy(ctx context.Context, bucket, object, uploadID string, partNumber int,
+	src CopySrcOptions, sse encrypt.ServerSide,
+) (CompletePart, error) {
+	if err := s3utils.CheckValidBucketName(bucket); err != nil {
+		return CompletePart{}, err
+	}
+	if err := s3utils.CheckValidObjectName(object); err != nil {
+		return CompletePart{}, err
+	}
+	if uploadID == "" {
+		return CompletePart{}, errInvalidArgument("Upload ID cannot be empty.")
+	}
+	l := c.Limits()
+	if err := l.CheckPartNumber(partNumber); err != nil {
+		return CompletePart{}, errInvalidArgument(err.Error())
+	}
+	if err := src.validate(); err != nil {
+		return CompletePart{}, err
+	}
+	if src.MatchRange {
+		if err := l.CheckPartSize(src.End-src.Start+1, true); err != nil {
+			return CompletePart{}, errInvalidArgument(err.Error())
+		}
+	}
+
+	headers := make(http.Header)
+	src.Marshal(headers)
+	if src.MatchRange {
+		headers.Set("x-amz-copy-source-range", fmt.Sprintf("bytes=%d-%d", src.Start, src.End))
+	}
+	if sse != nil && sse.Type() == encrypt.SSEC {
+		sse.Marshal(headers)
+	}
+	return c.uploadPartCopy(ctx, bucket, object, uploadID, partNumber, headers)
+}
+
 // PutObject - Upload object. Uploads using single PUT call.
 func (c Core) PutObject(ctx context.Context, bucket, object string, data io.Reader, size int64, md5Base64, sha256Hex string, opts PutObjectOptions) (UploadInfo, error) {
-	hookReader := newHook(data, opts.Progress)
+	progress := newTransferProgress(ctx, size, opts.ProgressFunc)
+	hookReader := newHook(progress.part(data, 0), opts.Progress)
 	return c.putObjectDo(ctx, bucket, object, hookReader, md5Base64, sha256Hex, size, opts)
 }
 
@@ -98,6 +198,13 @@ type PutObjectPartOptions struct {
 func (c Core) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int,
 	data io.Reader, size int64, opts PutObjectPartOptions,
 ) (ObjectPart, error) {
+	l := c.Limits()
+	if err := l.CheckPartNumber(partID); err != nil {
+		return ObjectPart{}, errInvalidArgument(err.Error())
+	}
+	if err := l.CheckPartSize(size, true); err != nil {
+		return ObjectPart{}, errInvalidArgument(err.Error())
+	}
 	p := uploadPartParams{
 		bucketName:   bucket,
 		objectName:   object,
@@ -121,13 +228,100 @@ func (c Core) ListObjectParts(ctx context.Context, bucket, object, uploadID stri
 }
 
 // CompleteMultipartUpload - Concatenate uploaded parts and commit to an object.
+// The checksum of the object is a full object checksum if opts.Checksum
+// requests one, e.g. ChecksumFullObjectCRC32C, and composite otherwise.
 func (c Core) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, parts []CompletePart, opts PutObjectOptions) (UploadInfo, error) {
+	if opts.Checksum.IsSet() {
+		mode := ChecksumCompositeMode
+		if opts.Checksum.FullObjectRequested() {
+			mode = ChecksumFullObjectMode
+		}
+		opts.UserMetadata = maps.Clone(opts.UserMetadata)
+		if opts.UserMetadata == nil {
+			opts.UserMetadata = make(map[string]string, 1)
+		}
+		opts.UserMetadata[amzChecksumMode] = mode.String()
+	}
+	// Parts uploaded out of order, e.g. by several machines, are
+	// completed in ascending order.
+	parts = slices.Clone(parts)
+	sort.Stable(completedParts(parts))
 	res, err := c.completeMultipartUpload(ctx, bucket, object, uploadID, completeMultipartUpload{
 		Parts: parts,
 	}, opts)
 	return res, err
 }
 
+// MergeObjectParts - merges the parts of an upload gathered from several
+// uploaders, e.g. the parts returned by PutObjectPart on each machine, in
+// ascending order of part numbers. Part numbers may be sparse. A part
+// listed twice is kept once, unless its ETags differ, i.e. the part was
+// uploaded twice and the merged parts would be ambiguous.
+func MergeObjectParts(manifests ...[]ObjectPart) ([]ObjectPart, error) {
+	var parts []ObjectPart
+	for _, manifest := range manifests {
+		parts = append(parts, manifest...)
+	}
+	slices.SortStableFunc(parts, func(a, b ObjectPart) int {
+		return a.PartNumber - b.PartNumber
+	})
+	merged := parts[:0]
+	for _, part := range parts {
+		if n := len(merged); n > 0 && merged[n-1].PartNumber == part.PartNumber {
+			if trimEtag(merged[n-1].ETag) != trimEtag(part.ETag) {
+				return nil, errInvalidArgument(fmt.Sprintf("Part %d is listed with different ETags %s and %s.",
+					part.PartNumber, merged[n-1].ETag, part.ETag))
+			}
+			continue
+		}
+		merged = append(merged, part)
+	}
+	return merged, nil
+}
+
+// ValidateObjectParts - checks the parts of an upload before completing
+// it: the parts must be in strictly ascending order of part numbers
+// between 1 and 10000, and every part but the last one must be at least
+// 5MiB. No part may exceed 5GiB.
+func ValidateObjectParts(parts []ObjectPart) error {
+	if len(parts) == 0 {
+		return errInvalidArgument("No parts to complete the upload with.")
+	}
+	for i, part := range parts {
+		if part.PartNumber < 1 || part.PartNumber > maxPartsCount {
+			return errInvalidArgument(fmt.Sprintf("Invalid part number %d, must be between 1 and 10000.", part.PartNumber))
+		}
+		if i > 0 && part.PartNumber <= parts[i-1].PartNumber {
+			return errInvalidArgument(fmt.Sprintf("Part %d is out of order or duplicated.", part.PartNumber))
+		}
+		if part.Size > maxPartSize {
+			return errInvalidArgument(fmt.Sprintf("Part %d is %d bytes, larger than 5GiB.", part.PartNumber, part.Size))
+		}
+		if part.Size < absMinPartSize && i < len(parts)-1 {
+			return errInvalidArgument(fmt.Sprintf("Part %d is %d bytes, smaller than 5MiB and not the last part.", part.PartNumber, part.Size))
+		}
+	}
+	return nil
+}
+
+// CompleteParts - returns the parts to complete an upload with, along
+// with their checksums, see Core.CompleteMultipartUpload.
+func CompleteParts(parts []ObjectPart) []CompletePart {
+	complParts := make([]CompletePart, 0, len(parts))
+	for _, part := range parts {
+		complParts = append(complParts, CompletePart{
+			PartNumber:        part.PartNumber,
+			ETag:              part.ETag,
+			ChecksumCRC32:     part.ChecksumCRC32,
+			ChecksumCRC32C:    part.ChecksumCRC32C,
+			ChecksumSHA1:      part.ChecksumSHA1,
+			ChecksumSHA256:    part.ChecksumSHA256,
+			ChecksumCRC64NVME: part.ChecksumCRC64NVME,
+		})
+	}
+	return complParts
+}
+
 // AbortMultipartUpload - Abort an incomplete upload.
 func (c Core) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) error {
 	return c.abortMultipartUpload(ctx, bucket, object, uploadID)
@@ -20,11 +20,17 @@ package minio
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -896,3 +902,419 @@ func TestCoreMultipartUpload(t *testing.T) {
 		t.Fatal("Error: ", err)
 	}
 }
+
+func TestCoreRawOperations(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		queries []string
+		headers []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		queries = append(queries, r.Method+" "+r.URL.RawQuery)
+		headers = append(headers, r.Header.Get("X-Amz-Checksum-Type"))
+		mu.Unlock()
+		switch {
+		case q.Has("location"):
+			io.WriteString(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">EU</LocationConstraint>`)
+		case q.Has("versions"):
+			io.WriteString(w, `<ListVersionsResult><Name>bucket</Name><IsTruncated>true</IsTruncated><NextKeyMarker>b</NextKeyMarker><NextVersionIdMarker>v2</NextVersionIdMarker><Version><Key>a</Key><VersionId>v1</VersionId></Version><DeleteMarker><Key>b</Key><VersionId>v2</VersionId></DeleteMarker></ListVersionsResult>`)
+		case q.Get("list-type") == "2":
+			io.WriteString(w, `<ListBucketResult><Name>bucket</Name><IsTruncated>true</IsTruncated><NextContinuationToken>next</NextContinuationToken><Contents><Key>a</Key></Contents></ListBucketResult>`)
+		case q.Has("partNumber"):
+			io.WriteString(w, `<CopyPartResult><ETag>"part"</ETag><ChecksumCRC32C>crc</ChecksumCRC32C></CopyPartResult>`)
+		case q.Has("uploadId"):
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag"</ETag><ChecksumType>FULL_OBJECT</ChecksumType></CompleteMultipartUploadResult>`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewCore(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	location, err := c.GetBucketLocation(ctx, "bucket")
+	if err != nil || location != "eu-west-1" {
+		t.Errorf("Expected location eu-west-1, got %s, %v", location, err)
+	}
+
+	page, err := c.ListObjectsV2WithOptions(ctx, "bucket", ListObjectsV2Options{Prefix: "p/", ContinuationToken: "token", MaxKeys: 1, WithMetadata: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !page.IsTruncated || page.NextContinuationToken != "next" || len(page.Contents) != 1 {
+		t.Errorf("Unexpected page %+v", page)
+	}
+
+	versions, err := c.ListObjectVersions(ctx, "bucket", "", "a", "v0", "/", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if versions.NextKeyMarker != "b" || versions.NextVersionIDMarker != "v2" || len(versions.Versions) != 2 || !versions.Versions[1].IsDeleteMarker() {
+		t.Errorf("Unexpected versions %+v", versions)
+	}
+
+	part, err := c.CopyObjectPart(ctx, "bucket", "source", "bucket", "object", "upload", 1, 0, -1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if part.ETag != `"part"` || part.ChecksumCRC32C != "crc" {
+		t.Errorf("Unexpected part %+v", part)
+	}
+
+	info, err := c.CompleteMultipartUpload(ctx, "bucket", "object", "upload", []CompletePart{part}, PutObjectOptions{Checksum: ChecksumFullObjectCRC32C})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ChecksumMode != "FULL_OBJECT" {
+		t.Errorf("Expected a full object checksum, got %q", info.ChecksumMode)
+	}
+
+	expected := []string{
+		"GET location=",
+		"GET continuation-token=token&delimiter=&encoding-type=url&list-type=2&max-keys=1&metadata=true&prefix=p%2F",
+		"GET delimiter=%2F&encoding-type=url&key-marker=a&max-keys=2&prefix=&version-id-marker=v0&versions=",
+		"PUT partNumber=1&uploadId=upload",
+		"POST uploadId=upload",
+	}
+	if !reflect.DeepEqual(queries, expected) {
+		t.Errorf("Expected requests %q, got %q", expected, queries)
+	}
+	if headers[len(headers)-1] != "FULL_OBJECT" {
+		t.Errorf("Expected the checksum type to be sent, got %q", headers[len(headers)-1])
+	}
+}
+
+func TestCoreUploadPartCopy(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		if r.URL.Query().Get("partNumber") != "2" || r.URL.Query().Get("uploadId") != "upload" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		io.WriteString(w, `<CopyPartResult><ETag>"part"</ETag><ChecksumCRC32C>crc</ChecksumCRC32C></CopyPartResult>`)
+	}))
+	defer srv.Close()
+
+	c, err := NewCore(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcKey := encrypt.DefaultPBKDF([]byte("source"), []byte("bucket/segment"))
+	dstKey := encrypt.DefaultPBKDF([]byte("destination"), []byte("bucket/video"))
+	src := CopySrcOptions{
+		Bucket:     "bucket",
+		Object:     "segment",
+		VersionID:  "v1",
+		MatchETag:  "etag",
+		MatchRange: true,
+		Start:      10,
+		End:        19,
+		Encryption: srcKey,
+	}
+	part, err := c.UploadPartCopy(context.Background(), "bucket", "video", "upload", 2, src, dstKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if part.PartNumber != 2 || part.ETag != `"part"` || part.ChecksumCRC32C != "crc" {
+		t.Errorf("Unexpected part %+v", part)
+	}
+	for k, v := range map[string]string{
+		"X-Amz-Copy-Source":          "bucket/segment?versionId=v1",
+		"X-Amz-Copy-Source-Range":    "bytes=10-19",
+		"X-Amz-Copy-Source-If-Match": "etag",
+		"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Algorithm": "AES256",
+		"X-Amz-Server-Side-Encryption-Customer-Algorithm":             "AES256",
+	} {
+		if got := header.Get(k); got != v {
+			t.Errorf("Expected %s to be %q, got %q", k, v, got)
+		}
+	}
+
+	testCases := []struct {
+		uploadID   string
+		partNumber int
+		src        CopySrcOptions
+	}{
+		{uploadID: "", partNumber: 1, src: src},
+		{uploadID: "upload", partNumber: 0, src: src},
+		{uploadID: "upload", partNumber: 10001, src: src},
+		{uploadID: "upload", partNumber: 1, src: CopySrcOptions{Bucket: "bucket", Object: "segment", MatchRange: true, Start: 10, End: 9}},
+	}
+	for i, testCase := range testCases {
+		if _, err = c.UploadPartCopy(context.Background(), "bucket", "video", testCase.uploadID, testCase.partNumber, testCase.src, nil); err == nil {
+			t.Errorf("Test %d: expected an error", i+1)
+		}
+	}
+}
+
+func TestMergeObjectParts(t *testing.T) {
+	part := func(n int, etag string, size int64) ObjectPart {
+		return ObjectPart{PartNumber: n, ETag: etag, Size: size}
+	}
+	const mib = 1 << 20
+	testCases := []struct {
+		manifests [][]ObjectPart
+		parts     []int
+		mergeErr  bool
+		validErr  bool
+	}{
+		// Sparse parts uploaded out of order by several uploaders.
+		{
+			manifests: [][]ObjectPart{{part(7, "c", mib)}, {part(3, "b", 5*mib), part(1, "a", 5*mib)}},
+			parts:     []int{1, 3, 7},
+		},
+		// Parts listed twice.
+		{
+			manifests: [][]ObjectPart{{part(1, "a", 5*mib), part(2, "b", mib)}, {part(2, `"b"`, mib)}},
+			parts:     []int{1, 2},
+		},
+		// Part uploaded twice.
+		{
+			manifests: [][]ObjectPart{{part(1, "a", 5*mib)}, {part(1, "b", 5*mib)}},
+			mergeErr:  true,
+		},
+		// Part smaller than 5MiB before the last part.
+		{
+			manifests: [][]ObjectPart{{part(1, "a", mib), part(2, "b", mib)}},
+			parts:     []int{1, 2},
+			validErr:  true,
+		},
+		// Part larger than 5GiB.
+		{
+			manifests: [][]ObjectPart{{part(1, "a", 5*1024*mib+1)}},
+			parts:     []int{1},
+			validErr:  true,
+		},
+		// Invalid part number.
+		{
+			manifests: [][]ObjectPart{{part(10001, "a", mib)}},
+			parts:     []int{10001},
+			validErr:  true,
+		},
+		{
+			validErr: true,
+		},
+	}
+	for i, testCase := range testCases {
+		parts, err := MergeObjectParts(testCase.manifests...)
+		if (err != nil) != testCase.mergeErr {
+			t.Errorf("Test %d: unexpected merge error %v", i+1, err)
+		}
+		if err != nil {
+			continue
+		}
+		var numbers []int
+		for _, part := range parts {
+			numbers = append(numbers, part.PartNumber)
+		}
+		if !reflect.DeepEqual(numbers, testCase.parts) {
+			t.Errorf("Test %d: expected parts %v, got %v", i+1, testCase.parts, numbers)
+		}
+		if err = ValidateObjectParts(parts); (err != nil) != testCase.validErr {
+			t.Errorf("Test %d: unexpected validation error %v", i+1, err)
+		}
+	}
+
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-2"</ETag></CompleteMultipartUploadResult>`)
+	}))
+	defer srv.Close()
+	c, err := NewCore(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := CompleteParts([]ObjectPart{{PartNumber: 9, ETag: "b", ChecksumCRC32C: "crc"}, {PartNumber: 2, ETag: "a"}})
+	if _, err = c.CompleteMultipartUpload(context.Background(), "bucket", "object", "upload", parts, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, "<PartNumber>2</PartNumber><ETag>a</ETag></Part><Part><PartNumber>9</PartNumber><ETag>b</ETag><ChecksumCRC32C>crc</ChecksumCRC32C>") {
+		t.Errorf("Expected the parts to be completed in order, got %s", body)
+	}
+	if parts[0].PartNumber != 9 {
+		t.Error("Expected the parts of the caller to be left unchanged")
+	}
+}
+
+func TestMultipartUploadToken(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		parts    = make(map[int]int64)
+		complete string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		q := r.URL.Query()
+		switch {
+		case q.Has("uploads"):
+			io.WriteString(w, `<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><UploadId>upload</UploadId></InitiateMultipartUploadResult>`)
+		case r.Method == http.MethodPut:
+			// Parts are sent with streaming signatures.
+			n, _ := strconv.ParseInt(r.Header.Get("X-Amz-Decoded-Content-Length"), 10, 64)
+			partNumber, _ := strconv.Atoi(q.Get("partNumber"))
+			parts[partNumber] = n
+			w.Header().Set("ETag", `"etag-`+q.Get("partNumber")+`"`)
+		case r.Method == http.MethodGet:
+			io.WriteString(w, `<ListPartsResult><Bucket>bucket</Bucket><Key>object</Key><UploadId>upload</UploadId><IsTruncated>false</IsTruncated>`)
+			for partNumber, size := range parts {
+				io.WriteString(w, `<Part><PartNumber>`+strconv.Itoa(partNumber)+`</PartNumber><ETag>"etag-`+strconv.Itoa(partNumber)+`"</ETag><Size>`+strconv.FormatInt(size, 10)+`</Size></Part>`)
+			}
+			io.WriteString(w, `</ListPartsResult>`)
+		case r.Method == http.MethodPost:
+			b, _ := io.ReadAll(r.Body)
+			complete = string(b)
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-3"</ETag></CompleteMultipartUploadResult>`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewCore(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	const mib = 1 << 20
+	data := bytes.Repeat([]byte("a"), 12*mib)
+
+	token, err := c.NewMultipartUploadToken(ctx, "bucket", "object", int64(len(data)), 5*mib, PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.UploadID != "upload" || token.Parts != 3 || token.PartSize != 5*mib {
+		t.Fatalf("Unexpected token %+v", token)
+	}
+
+	// Workers upload the parts assigned to them out of order.
+	put := func(partNumber int) {
+		workerToken, err := ParseMultipartUploadToken(token.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		offset, length, err := workerToken.PartRange(partNumber)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.PutTokenPart(ctx, workerToken, partNumber, bytes.NewReader(data[offset:offset+length]), PutObjectPartOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put(3)
+	put(1)
+	if _, err = c.CompleteTokenUpload(ctx, token, PutObjectOptions{}); err == nil {
+		t.Fatal("Expected the upload to be incomplete")
+	}
+	put(2)
+	if parts[1] != 5*mib || parts[2] != 5*mib || parts[3] != 2*mib {
+		t.Errorf("Unexpected parts %v", parts)
+	}
+	if _, err = c.CompleteTokenUpload(ctx, token, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(complete, "<Part>") != 3 || !strings.Contains(complete, "<PartNumber>1</PartNumber>") {
+		t.Errorf("Unexpected complete request %s", complete)
+	}
+
+	for i, s := range []string{
+		"",
+		"invalid",
+		MultipartUploadToken{Bucket: "bucket", Object: "object", Size: 10, PartSize: 5 * mib, Parts: 1}.String(),
+		MultipartUploadToken{Bucket: "bucket", Object: "object", UploadID: "upload", Size: 10 * mib, PartSize: 5 * mib, Parts: 3}.String(),
+	} {
+		if _, err = ParseMultipartUploadToken(s); err == nil {
+			t.Errorf("Test %d: expected an invalid token", i+1)
+		}
+	}
+}
+
+func TestCoreListEncodingType(t *testing.T) {
+	const (
+		key    = "dir x/a\nb\x01c+%"
+		prefix = "dir x/"
+	)
+	enc := url.QueryEscape
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("encoding-type") != "url" {
+			t.Errorf("%s: expected encoding-type url, got %q", r.URL, q.Get("encoding-type"))
+		}
+		switch {
+		case q.Has("uploadId"):
+			fmt.Fprintf(w, `<ListPartsResult><Bucket>bucket</Bucket><Key>%s</Key><UploadId>upload</UploadId><EncodingType>url</EncodingType></ListPartsResult>`, enc(key))
+		case q.Has("uploads"):
+			fmt.Fprintf(w, `<ListMultipartUploadsResult><Bucket>bucket</Bucket><KeyMarker>%[1]s</KeyMarker><NextKeyMarker>%[1]s</NextKeyMarker><Prefix>%[2]s</Prefix><EncodingType>url</EncodingType><Upload><Key>%[1]s</Key><UploadId>upload</UploadId></Upload></ListMultipartUploadsResult>`, enc(key), enc(prefix))
+		case q.Has("versions"):
+			fmt.Fprintf(w, `<ListVersionsResult><Name>bucket</Name><Prefix>%[2]s</Prefix><KeyMarker>%[1]s</KeyMarker><NextKeyMarker>%[1]s</NextKeyMarker><EncodingType>url</EncodingType><IsTruncated>false</IsTruncated><Version><Key>%[1]s</Key><VersionId>v1</VersionId></Version></ListVersionsResult>`, enc(key), enc(prefix))
+		case q.Get("list-type") == "2":
+			fmt.Fprintf(w, `<ListBucketResult><Name>bucket</Name><Prefix>%[2]s</Prefix><StartAfter>%[1]s</StartAfter><EncodingType>url</EncodingType><IsTruncated>false</IsTruncated><Contents><Key>%[1]s</Key></Contents></ListBucketResult>`, enc(key), enc(prefix))
+		default:
+			fmt.Fprintf(w, `<ListBucketResult><Name>bucket</Name><Prefix>%[2]s</Prefix><Marker>%[1]s</Marker><NextMarker>%[1]s</NextMarker><EncodingType>url</EncodingType><IsTruncated>false</IsTruncated><Contents><Key>%[1]s</Key></Contents></ListBucketResult>`, enc(key), enc(prefix))
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	core := Core{clnt}
+	ctx := context.Background()
+
+	v2, err := core.ListObjectsV2("bucket", prefix, key, "", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v2.Contents[0].Key != key || v2.Prefix != prefix || v2.StartAfter != key {
+		t.Errorf("unexpected V2 listing %q %q %q", v2.Contents[0].Key, v2.Prefix, v2.StartAfter)
+	}
+	v1, err := core.ListObjects("bucket", prefix, key, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v1.Contents[0].Key != key || v1.Prefix != prefix || v1.Marker != key || v1.NextMarker != key {
+		t.Errorf("unexpected V1 listing %q %q %q %q", v1.Contents[0].Key, v1.Prefix, v1.Marker, v1.NextMarker)
+	}
+	versions, err := core.ListObjectVersions(ctx, "bucket", prefix, key, "", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if versions.Versions[0].Key != key || versions.Prefix != prefix || versions.KeyMarker != key || versions.NextKeyMarker != key {
+		t.Errorf("unexpected versions listing %q %q %q %q", versions.Versions[0].Key, versions.Prefix, versions.KeyMarker, versions.NextKeyMarker)
+	}
+	uploads, err := core.ListMultipartUploads(ctx, "bucket", prefix, key, "", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uploads.Uploads[0].Key != key || uploads.Prefix != prefix || uploads.KeyMarker != key || uploads.NextKeyMarker != key {
+		t.Errorf("unexpected uploads listing %q %q %q %q", uploads.Uploads[0].Key, uploads.Prefix, uploads.KeyMarker, uploads.NextKeyMarker)
+	}
+	parts, err := core.ListObjectParts(ctx, "bucket", "object", "upload", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parts.Key != key {
+		t.Errorf("unexpected parts listing key %q", parts.Key)
+	}
+}
@@ -60,11 +60,15 @@ func (c *Client) CreateSession(ctx context.Context, bucketName string, sessionMo
 		return credentials.Value{}, err
 	}
 
-	v, ok := c.bucketSessionCache.Get(bucketName)
-	if ok && v.Expiration.After(time.Now().Add(10*time.Second)) {
-		// Verify if the credentials will not expire
-		// in another 10 seconds, if not we renew it again.
-		return v, nil
+	// Sessions of per-request credentials are not cached.
+	cacheable := requestCredentials(ctx) == nil
+	if cacheable {
+		v, ok := c.bucketSessionCache.Get(bucketName)
+		if ok && v.Expiration.After(time.Now().Add(10*time.Second)) {
+			// Verify if the credentials will not expire
+			// in another 10 seconds, if not we renew it again.
+			return v, nil
+		}
 	}
 
 	req, err := c.createSessionRequest(ctx, bucketName, sessionMode)
@@ -88,14 +92,23 @@ func (c *Client) CreateSession(ctx context.Context, bucketName string, sessionMo
 		return credentials.Value{}, err
 	}
 
-	defer c.bucketSessionCache.Set(bucketName, cred)
-
-	return credentials.Value{
+	cred = credentials.Value{
 		AccessKeyID:     credSession.Credentials.AccessKey,
 		SecretAccessKey: credSession.Credentials.SecretKey,
 		SessionToken:    credSession.Credentials.SessionToken,
 		Expiration:      credSession.Credentials.Expiration,
-	}, nil
+	}
+	if cacheable {
+		c.bucketSessionCache.Set(bucketName, cred)
+	}
+	return cred, nil
+}
+
+// useExpressSession tells whether the requests of bucketName are
+// authenticated with the session of an S3 Express directory bucket.
+func (c *Client) useExpressSession(bucketName string) bool {
+	return s3utils.IsS3ExpressBucket(bucketName) && s3utils.IsAmazonEndpoint(*c.endpointURL) &&
+		!c.overrideSignerType.IsAnonymous()
 }
 
 // createSessionRequest - Wrapper creates a new CreateSession request.
@@ -107,8 +120,16 @@ func (c *Client) createSessionRequest(ctx context.Context, bucketName string, se
 	// Set get bucket location always as path style.
 	targetURL := *c.endpointURL
 
-	// Fetch new host based on the bucket location.
-	host := getS3ExpressEndpoint(c.region, s3utils.IsS3ExpressBucket(bucketName))
+	location, err := c.getBucketLocation(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions are created by the zonal endpoint of the bucket.
+	host := getS3ExpressZonalEndpoint(location, bucketName)
+	if host == "" {
+		host = targetURL.Host
+	}
 
 	// as it works in makeTargetURL method from api.go file
 	if h, p, err := net.SplitHostPort(host); err == nil {
@@ -142,7 +163,7 @@ func (c *Client) createSessionRequest(ctx context.Context, bucketName string, se
 	c.setUserAgent(req)
 
 	// Get credentials from the configured credentials provider.
-	value, err := c.credsProvider.GetWithContext(c.CredContext())
+	value, err := c.getCredentials(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +198,6 @@ func (c *Client) createSessionRequest(ctx context.Context, bucketName string, se
 
 	req.Header.Set("X-Amz-Content-Sha256", contentSha256)
 	req.Header.Set("x-amz-create-session-mode", string(sessionMode))
-	req = signer.SignV4Express(*req, accessKeyID, secretAccessKey, sessionToken, c.region)
+	req = signer.SignV4Express(*req, accessKeyID, secretAccessKey, sessionToken, location)
 	return req, nil
 }
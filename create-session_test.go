@@ -0,0 +1,135 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestExpressSession(t *testing.T) {
+	const (
+		bucket = "bucket--usw2-az1--x-s3"
+		host   = bucket + ".s3express-usw2-az1.us-west-2.amazonaws.com"
+	)
+	var (
+		mu       sync.Mutex
+		sessions int
+		expire   bool
+	)
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		respond := func(status int, body string) *http.Response {
+			return &http.Response{
+				StatusCode: status,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}
+		}
+		if req.URL.Host != host {
+			t.Errorf("unexpected host %s", req.URL.Host)
+		}
+		auth := req.Header.Get("Authorization")
+		if req.URL.Query().Has("session") {
+			sessions++
+			if mode := req.Header.Get("X-Amz-Create-Session-Mode"); mode != string(SessionReadWrite) {
+				t.Errorf("unexpected session mode %q", mode)
+			}
+			if !strings.Contains(auth, "Credential=access/") || !strings.Contains(auth, "/us-west-2/s3express/aws4_request") {
+				t.Errorf("unexpected session authorization %s", auth)
+			}
+			return respond(http.StatusOK, fmt.Sprintf(`<CreateSessionResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Credentials>`+
+				`<SessionToken>token%d</SessionToken><SecretAccessKey>session-secret</SecretAccessKey>`+
+				`<AccessKeyId>session%d</AccessKeyId><Expiration>%s</Expiration></Credentials></CreateSessionResult>`,
+				sessions, sessions, time.Now().Add(5*time.Minute).UTC().Format(time.RFC3339))), nil
+		}
+		if token := req.Header.Get("X-Amz-S3session-Token"); token != fmt.Sprintf("token%d", sessions) {
+			t.Errorf("unexpected session token %q", token)
+		}
+		if req.Header.Get("X-Amz-Security-Token") != "" {
+			t.Error("unexpected security token")
+		}
+		if !strings.Contains(auth, fmt.Sprintf("Credential=session%d/", sessions)) || !strings.Contains(auth, "/us-west-2/s3express/aws4_request") {
+			t.Errorf("unexpected authorization %s", auth)
+		}
+		if expire {
+			expire = false
+			return respond(http.StatusBadRequest, `<Error><Code>ExpiredToken</Code><Message>The provided token has expired.</Message></Error>`), nil
+		}
+		return respond(http.StatusOK, `<Tagging><TagSet><Tag><Key>k</Key><Value>v</Value></Tag></TagSet></Tagging>`), nil
+	})
+
+	clnt, err := New("s3.amazonaws.com", &Options{
+		Creds:     credentials.NewStaticV4("access", "secret", ""),
+		Secure:    true,
+		Transport: rt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := clnt.GetObjectTagging(context.Background(), bucket, "object", GetObjectTaggingOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if sessions != 1 {
+		t.Fatalf("expected the session to be cached, got %d sessions", sessions)
+	}
+
+	expire = true
+	if _, err := clnt.GetObjectTagging(context.Background(), bucket, "object", GetObjectTaggingOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if sessions != 2 {
+		t.Fatalf("expected a new session after the session expired, got %d sessions", sessions)
+	}
+}
+
+func TestGetS3ExpressZonalEndpoint(t *testing.T) {
+	testCases := []struct {
+		region, bucket, endpoint string
+	}{
+		{"", "bucket--usw2-az1--x-s3", "s3express-usw2-az1.us-west-2.amazonaws.com"},
+		{"us-east-1", "bucket--use1-az4--x-s3", "s3express-use1-az4.us-east-1.amazonaws.com"},
+		{"eu-north-1", "bucket--eun1-az1--x-s3", "s3express-eun1-az1.eu-north-1.amazonaws.com"},
+		{"", "bucket--xyz1-az1--x-s3", ""},
+		{"us-east-1", "bucket", ""},
+	}
+	for i, testCase := range testCases {
+		if endpoint := getS3ExpressZonalEndpoint(testCase.region, testCase.bucket); endpoint != testCase.endpoint {
+			t.Errorf("Test %d: expected %q, got %q", i+1, testCase.endpoint, endpoint)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/singleflight"
+)
+
+// DNSResolver resolves host names into IP addresses, *net.Resolver
+// implements it.
+type DNSResolver interface {
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+}
+
+const (
+	// Delay before racing the next address of a host (Happy Eyeballs).
+	dnsFallbackDelay = 300 * time.Millisecond
+
+	// Duration an address failing to connect is tried last.
+	dnsUnhealthyDuration = 30 * time.Second
+)
+
+type dnsCacheEntry struct {
+	addrs   []string
+	expires time.Time
+	next    int
+}
+
+// dnsCache is a dialer caching host lookups for ttl. Addresses of a host
+// are rotated between dials, the ones which recently failed to connect
+// are tried last, and dials race the next address after a delay.
+type dnsCache struct {
+	dialer    *net.Dialer
+	resolver  DNSResolver
+	ttl       time.Duration
+	lookups   singleflight.Group[string, []string]
+	mu        sync.Mutex
+	entries   map[string]*dnsCacheEntry
+	unhealthy map[string]time.Time
+}
+
+func newDNSCache(dialer *net.Dialer, resolver DNSResolver, ttl time.Duration) *dnsCache {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &dnsCache{
+		dialer:    dialer,
+		resolver:  resolver,
+		ttl:       ttl,
+		entries:   make(map[string]*dnsCacheEntry),
+		unhealthy: make(map[string]time.Time),
+	}
+}
+
+// lookup returns the addresses of host in dialing order.
+func (d *dnsCache) lookup(ctx context.Context, host string) ([]string, error) {
+	now := time.Now()
+	d.mu.Lock()
+	e, ok := d.entries[host]
+	d.mu.Unlock()
+	if !ok || now.After(e.expires) {
+		addrs, err, _ := d.lookups.Do(host, func() ([]string, error) {
+			return d.resolver.LookupHost(ctx, host)
+		})
+		switch {
+		case err == nil && len(addrs) > 0:
+			e = &dnsCacheEntry{addrs: interleaveAddrs(addrs), expires: now.Add(d.ttl)}
+			d.mu.Lock()
+			d.entries[host] = e
+			d.mu.Unlock()
+		case !ok:
+			if err == nil {
+				err = &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+			}
+			return nil, err
+		}
+		// Keep using stale addresses when the resolver fails.
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	start := e.next % len(e.addrs)
+	e.next++
+	healthy := make([]string, 0, len(e.addrs))
+	var failed []string
+	for i := range e.addrs {
+		addr := e.addrs[(start+i)%len(e.addrs)]
+		if until, ok := d.unhealthy[addr]; ok && now.Before(until) {
+			failed = append(failed, addr)
+			continue
+		}
+		delete(d.unhealthy, addr)
+		healthy = append(healthy, addr)
+	}
+	return append(healthy, failed...), nil
+}
+
+func (d *dnsCache) setHealthy(addr string, healthy bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if healthy {
+		delete(d.unhealthy, addr)
+	} else {
+		d.unhealthy[addr] = time.Now().Add(dnsUnhealthyDuration)
+	}
+}
+
+// DialContext dials addr with the cached addresses of its host.
+func (d *dnsCache) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if net.ParseIP(host) != nil {
+		return d.dialer.DialContext(ctx, network, addr)
+	}
+	addrs, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		conn net.Conn
+		addr string
+		err  error
+	}
+	results := make(chan result, len(addrs))
+	next, pending := 0, 0
+	dialNext := func() {
+		ip := addrs[next]
+		next++
+		pending++
+		go func() {
+			conn, err := d.dialer.DialContext(ctx, network, net.JoinHostPort(ip, port))
+			results <- result{conn: conn, addr: ip, err: err}
+		}()
+	}
+
+	dialNext()
+	timer := time.NewTimer(dnsFallbackDelay)
+	defer timer.Stop()
+	var firstErr error
+	for pending > 0 {
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				d.setHealthy(r.addr, true)
+				// Close connections of the dials still racing.
+				go func(pending int) {
+					for ; pending > 0; pending-- {
+						if r := <-results; r.conn != nil {
+							r.conn.Close()
+						}
+					}
+				}(pending)
+				return r.conn, nil
+			}
+			if ctx.Err() == nil {
+				d.setHealthy(r.addr, false)
+			}
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			if next < len(addrs) {
+				dialNext()
+				timer.Reset(dnsFallbackDelay)
+			}
+		case <-timer.C:
+			if next < len(addrs) {
+				dialNext()
+				timer.Reset(dnsFallbackDelay)
+			}
+		}
+	}
+	return nil, firstErr
+}
+
+// interleaveAddrs orders addrs alternating between address families,
+// starting with the family of the first address (RFC 8305).
+func interleaveAddrs(addrs []string) []string {
+	var primary, fallback []string
+	isV4 := func(addr string) bool {
+		ip := net.ParseIP(addr)
+		return ip != nil && ip.To4() != nil
+	}
+	firstV4 := isV4(addrs[0])
+	for _, addr := range addrs {
+		if isV4(addr) == firstV4 {
+			primary = append(primary, addr)
+		} else {
+			fallback = append(fallback, addr)
+		}
+	}
+	out := make([]string, 0, len(addrs))
+	for i := 0; i < len(primary) || i < len(fallback); i++ {
+		if i < len(primary) {
+			out = append(out, primary[i])
+		}
+		if i < len(fallback) {
+			out = append(out, fallback[i])
+		}
+	}
+	return out
+}
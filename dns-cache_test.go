@@ -0,0 +1,87 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testDNSResolver struct {
+	addrs   []string
+	lookups atomic.Int32
+}
+
+func (r *testDNSResolver) LookupHost(context.Context, string) ([]string, error) {
+	r.lookups.Add(1)
+	return r.addrs, nil
+}
+
+func TestDNSCache(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+
+	// Nothing listens on 127.0.0.2, the dial fails over to 127.0.0.1.
+	resolver := &testDNSResolver{addrs: []string{"127.0.0.2", "127.0.0.1"}}
+	d := newDNSCache(&net.Dialer{Timeout: time.Second}, resolver, time.Minute)
+	for range 3 {
+		conn, err := d.DialContext(context.Background(), "tcp", net.JoinHostPort("s3.example.com", port))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if host, _, _ := net.SplitHostPort(conn.RemoteAddr().String()); host != "127.0.0.1" {
+			t.Errorf("Expected connection to 127.0.0.1, got %s", host)
+		}
+		conn.Close()
+	}
+	if n := resolver.lookups.Load(); n != 1 {
+		t.Errorf("Expected 1 lookup, got %d", n)
+	}
+	addrs, err := d.lookup(context.Background(), "s3.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addrs[len(addrs)-1] != "127.0.0.2" {
+		t.Errorf("Expected the failed address to be tried last, got %v", addrs)
+	}
+}
+
+func TestInterleaveAddrs(t *testing.T) {
+	got := interleaveAddrs([]string{"::1", "::2", "::3", "10.0.0.1", "10.0.0.2"})
+	want := []string{"::1", "10.0.0.1", "::2", "10.0.0.2", "::3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
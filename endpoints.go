@@ -17,6 +17,12 @@
 
 package minio
 
+import (
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
 type awsS3Endpoint struct {
 	endpoint          string
 	dualstackEndpoint string
@@ -255,6 +261,40 @@ func getS3ExpressEndpoint(region string, zonal bool) (endpoint string) {
 	return s3ExpEndpoint.regionalEndpoint
 }
 
+// getS3ExpressRegion returns the region of the availability zone of an
+// S3 Express directory bucket, or an empty string if it is unknown.
+func getS3ExpressRegion(bucketName string) string {
+	az := s3utils.S3ExpressAvailabilityZoneID(bucketName)
+	if az == "" {
+		return ""
+	}
+	for region, endpoint := range awsS3ExpressEndpointMap {
+		for _, zonal := range endpoint.zonalEndpoints {
+			if strings.HasPrefix(zonal, "s3express-"+az+".") {
+				return region
+			}
+		}
+	}
+	return ""
+}
+
+// getS3ExpressZonalEndpoint returns the zonal endpoint of the availability
+// zone of an S3 Express directory bucket in region, the region of the
+// availability zone is used if region is empty.
+func getS3ExpressZonalEndpoint(region, bucketName string) string {
+	az := s3utils.S3ExpressAvailabilityZoneID(bucketName)
+	if az == "" {
+		return ""
+	}
+	if region == "" {
+		region = getS3ExpressRegion(bucketName)
+		if region == "" {
+			return ""
+		}
+	}
+	return "s3express-" + az + "." + region + ".amazonaws.com"
+}
+
 // getS3Endpoint get Amazon S3 endpoint based on the bucket location.
 func getS3Endpoint(bucketLocation string, useDualstack bool) (endpoint string) {
 	s3Endpoint, ok := awsS3EndpointMap[bucketLocation]
@@ -270,3 +310,18 @@ func getS3Endpoint(bucketLocation string, useDualstack bool) (endpoint string) {
 	}
 	return s3Endpoint.endpoint
 }
+
+// getS3FIPSEndpoint get Amazon S3 FIPS endpoint based on the bucket location,
+// regions without FIPS endpoints (China) use the regular endpoint.
+func getS3FIPSEndpoint(bucketLocation string, useDualstack bool) (endpoint string) {
+	if bucketLocation == "" {
+		bucketLocation = "us-east-1"
+	}
+	if strings.HasPrefix(bucketLocation, "cn-") {
+		return getS3Endpoint(bucketLocation, useDualstack)
+	}
+	if useDualstack {
+		return "s3-fips.dualstack." + bucketLocation + ".amazonaws.com"
+	}
+	return "s3-fips." + bucketLocation + ".amazonaws.com"
+}
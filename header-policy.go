@@ -0,0 +1,101 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// HeaderPolicy modifies the headers of a request before it is signed.
+// objectName is empty for the requests of buckets, query are the query
+// parameters of the request, e.g. to tell apart the uploads of parts.
+type HeaderPolicy func(method, bucketName, objectName string, query url.Values, header http.Header)
+
+// sseHeaderPrefix is the prefix of the headers of server-side encryption.
+const sseHeaderPrefix = "X-Amz-Server-Side-Encryption"
+
+// isObjectUpload returns true if a request uploads an object, i.e. it is
+// a PutObject, CopyObject or the initiation of a multipart upload.
+func isObjectUpload(method string, metadata requestMetadata) bool {
+	if metadata.objectName == "" {
+		return false
+	}
+	switch method {
+	case http.MethodPut:
+		return len(metadata.queryValues) == 0
+	case http.MethodPost:
+		return len(metadata.queryValues) == 1 && metadata.queryValues.Has("uploads")
+	}
+	return false
+}
+
+// isObjectRead returns true if a request is the GET or HEAD request of
+// an object, or of a version or part of it.
+func isObjectRead(method string, metadata requestMetadata) bool {
+	if metadata.objectName == "" || (method != http.MethodGet && method != http.MethodHead) {
+		return false
+	}
+	for k := range metadata.queryValues {
+		if k != "versionId" && k != "partNumber" && !strings.HasPrefix(k, "response-") {
+			return false
+		}
+	}
+	return true
+}
+
+// setDefaultHeaders sets the headers of defaults which are not set in h.
+// Default server-side encryption headers are not set if h sets one, the
+// encryption of the call replaces the default encryption.
+func setDefaultHeaders(h, defaults http.Header) {
+	var sse bool
+	for k := range h {
+		if strings.HasPrefix(k, sseHeaderPrefix) {
+			sse = true
+			break
+		}
+	}
+	for k, v := range defaults {
+		k = http.CanonicalHeaderKey(k)
+		if _, ok := h[k]; ok || (sse && strings.HasPrefix(k, sseHeaderPrefix)) {
+			continue
+		}
+		h[k] = slices.Clone(v)
+	}
+}
+
+// applyHeaderPolicy sets the default headers of a request, checks the
+// headers rejected by the provider and calls the header policy of the
+// client.
+func (c *Client) applyHeaderPolicy(req *http.Request, metadata requestMetadata) error {
+	switch {
+	case len(c.defaultPutHeaders) > 0 && isObjectUpload(req.Method, metadata):
+		setDefaultHeaders(req.Header, c.defaultPutHeaders)
+	case len(c.defaultGetHeaders) > 0 && isObjectRead(req.Method, metadata):
+		setDefaultHeaders(req.Header, c.defaultGetHeaders)
+	}
+	if err := c.checkDisallowedHeaders(req.Header); err != nil {
+		return err
+	}
+	if c.headerPolicy != nil {
+		c.headerPolicy(req.Method, metadata.bucketName, metadata.objectName, metadata.queryValues, req.Header)
+	}
+	return nil
+}
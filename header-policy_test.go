@@ -0,0 +1,119 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestSetDefaultHeaders(t *testing.T) {
+	testCases := []struct {
+		header, defaults, want http.Header
+	}{
+		{
+			header:   http.Header{},
+			defaults: http.Header{"cache-control": {"no-cache"}},
+			want:     http.Header{"Cache-Control": {"no-cache"}},
+		},
+		{
+			header:   http.Header{"Cache-Control": {"max-age=60"}},
+			defaults: http.Header{"Cache-Control": {"no-cache"}},
+			want:     http.Header{"Cache-Control": {"max-age=60"}},
+		},
+		{
+			header:   http.Header{"X-Amz-Server-Side-Encryption-Customer-Algorithm": {"AES256"}},
+			defaults: http.Header{"X-Amz-Server-Side-Encryption": {"aws:kms"}, "X-Amz-Meta-Tenant": {"a"}},
+			want: http.Header{
+				"X-Amz-Server-Side-Encryption-Customer-Algorithm": {"AES256"},
+				"X-Amz-Meta-Tenant": {"a"},
+			},
+		},
+	}
+	for i, testCase := range testCases {
+		setDefaultHeaders(testCase.header, testCase.defaults)
+		if len(testCase.header) != len(testCase.want) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.want, testCase.header)
+			continue
+		}
+		for k := range testCase.want {
+			if testCase.header.Get(k) != testCase.want.Get(k) {
+				t.Errorf("Test %d: expected %v, got %v", i+1, testCase.want, testCase.header)
+			}
+		}
+	}
+}
+
+func TestDefaultHeaders(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		headers = make(map[string]http.Header)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers[r.Method] = r.Header.Clone()
+		mu.Unlock()
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+	}))
+	defer srv.Close()
+
+	var policyCalls []string
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:             credentials.NewStaticV4("access", "secret", ""),
+		Region:            "us-east-1",
+		DefaultPutHeaders: http.Header{"X-Amz-Server-Side-Encryption": {"AES256"}, "Cache-Control": {"no-cache"}},
+		DefaultGetHeaders: http.Header{"X-Amz-Expected-Bucket-Owner": {"123"}},
+		HeaderPolicy: func(method, _, objectName string, _ url.Values, header http.Header) {
+			policyCalls = append(policyCalls, method+" "+objectName)
+			header.Set("X-Tenant", "tenant")
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err = clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{CacheControl: "max-age=60"}); err != nil {
+		t.Fatal(err)
+	}
+	put := headers[http.MethodPut]
+	if put.Get("X-Amz-Server-Side-Encryption") != "AES256" || put.Get("Cache-Control") != "max-age=60" || put.Get("X-Tenant") != "tenant" {
+		t.Fatalf("unexpected headers of the upload: %v", put)
+	}
+	if put.Get("X-Amz-Expected-Bucket-Owner") != "" {
+		t.Fatal("unexpected default GET header in the upload")
+	}
+
+	if _, err = clnt.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	head := headers[http.MethodHead]
+	if head.Get("X-Amz-Expected-Bucket-Owner") != "123" || head.Get("Cache-Control") != "" {
+		t.Fatalf("unexpected headers of the stat: %v", head)
+	}
+	if len(policyCalls) != 2 || policyCalls[1] != "HEAD object" {
+		t.Fatalf("unexpected calls of the header policy: %v", policyCalls)
+	}
+}
@@ -0,0 +1,312 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HealthCheckOptions configure the health check of a client.
+type HealthCheckOptions struct {
+	// Interval of the health checks, at least one second.
+	Interval time.Duration
+
+	// Timeout of a probe, defaults to 3 seconds.
+	Timeout time.Duration
+
+	// ReadBucket and ReadObject enable read probes, a HEAD request
+	// of a known object at every interval.
+	ReadBucket string
+	ReadObject string
+
+	// WriteBucket enables write probes, a tiny object written below
+	// WritePrefix and deleted at every interval. WritePrefix defaults
+	// to ".minio-health/".
+	WriteBucket string
+	WritePrefix string
+
+	// LatencySLO is the maximum latency of a healthy probe, slower
+	// probes count as failures. Zero disables the check.
+	LatencySLO time.Duration
+
+	// LatencyWindow is the number of probes of the rolling latency
+	// percentiles, defaults to 100.
+	LatencyWindow int
+
+	// OnStateChange is called when the client goes online or offline,
+	// e.g. to update a load balancer. It must not block.
+	OnStateChange func(online bool)
+}
+
+// LatencyPercentiles are the percentiles of probe latencies.
+type LatencyPercentiles struct {
+	P50, P90, P99 time.Duration
+
+	// Samples is the number of probes of the percentiles.
+	Samples int
+}
+
+// HealthStats are the statistics of the probes of a health check.
+type HealthStats struct {
+	Online bool
+
+	// Read and Write are the latencies of the read and write probes.
+	Read  LatencyPercentiles
+	Write LatencyPercentiles
+
+	// SLOViolations is the number of probes slower than the latency
+	// SLO.
+	SLOViolations uint64
+
+	// LastError is the error of the last failed probe.
+	LastError error
+}
+
+const (
+	defaultHealthProbeTimeout  = 3 * time.Second
+	defaultHealthLatencyWindow = 100
+	defaultHealthProbePrefix   = ".minio-health/"
+)
+
+// healthChecker tracks the probes of a health check.
+type healthChecker struct {
+	opts HealthCheckOptions
+
+	mu            sync.Mutex
+	read, write   latencyWindow
+	sloViolations uint64
+	lastErr       error
+}
+
+// latencyWindow is a ring buffer of latencies.
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+func (w *latencyWindow) add(d time.Duration, size int) {
+	if len(w.samples) < size {
+		w.samples = append(w.samples, d)
+		return
+	}
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % size
+}
+
+func (w *latencyWindow) percentiles() LatencyPercentiles {
+	n := len(w.samples)
+	if n == 0 {
+		return LatencyPercentiles{}
+	}
+	sorted := slices.Clone(w.samples)
+	slices.Sort(sorted)
+	at := func(p int) time.Duration {
+		return sorted[min((n*p+99)/100, n)-1]
+	}
+	return LatencyPercentiles{P50: at(50), P90: at(90), P99: at(99), Samples: n}
+}
+
+type healthProbeContextKey struct{}
+
+// isHealthProbe returns true if ctx is the context of a probe, probes
+// are sent while the client is offline.
+func isHealthProbe(ctx context.Context) bool {
+	probe, _ := ctx.Value(healthProbeContextKey{}).(bool)
+	return probe
+}
+
+// setHealthStatus changes the health status if it is from, OnStateChange
+// of the health check is called on the transition.
+func (c *Client) setHealthStatus(from, to int32) {
+	if !atomic.CompareAndSwapInt32(&c.healthStatus, from, to) {
+		return
+	}
+	if hc := c.healthCheck.Load(); hc != nil && hc.opts.OnStateChange != nil {
+		hc.opts.OnStateChange(to == online)
+	}
+}
+
+// HealthCheck starts a healthcheck to see if endpoint is up.
+// Returns a context cancellation function, to stop the health check,
+// and an error if health check is already started.
+func (c *Client) HealthCheck(hcDuration time.Duration) (context.CancelFunc, error) {
+	return c.HealthCheckWithOptions(HealthCheckOptions{Interval: hcDuration})
+}
+
+// HealthCheckWithOptions starts a healthcheck like HealthCheck. Without
+// read or write probes the endpoint is only checked while the client is
+// offline. With probes they are sent at every interval, and the client
+// goes offline when a probe fails, i.e. it fails with a network error or
+// a 5xx response, or is slower than the latency SLO.
+func (c *Client) HealthCheckWithOptions(opts HealthCheckOptions) (context.CancelFunc, error) {
+	if atomic.LoadInt32(&c.healthStatus) != unknown {
+		return nil, fmt.Errorf("health check is running")
+	}
+	if opts.Interval < 1*time.Second {
+		return nil, fmt.Errorf("health check duration should be at least 1 second")
+	}
+	if (opts.ReadBucket == "") != (opts.ReadObject == "") {
+		return nil, errInvalidArgument("Both ReadBucket and ReadObject must be set for read probes.")
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultHealthProbeTimeout
+	}
+	if opts.LatencyWindow <= 0 {
+		opts.LatencyWindow = defaultHealthLatencyWindow
+	}
+	if opts.WritePrefix == "" {
+		opts.WritePrefix = defaultHealthProbePrefix
+	}
+	hc := &healthChecker{opts: opts}
+	c.healthCheck.Store(hc)
+
+	probeBucketName := randString(60, rand.NewSource(time.Now().UnixNano()), "probe-health-")
+	ctx, cancelFn := context.WithCancel(context.Background())
+	atomic.StoreInt32(&c.healthStatus, offline)
+
+	check := func() {
+		if hc.hasProbes() {
+			if c.runProbes(ctx, hc) {
+				c.setHealthStatus(offline, online)
+			} else {
+				c.setHealthStatus(online, offline)
+			}
+			return
+		}
+		// Do health check ONLY if the connection is marked offline
+		if !c.IsOffline() {
+			return
+		}
+		gctx, gcancel := context.WithTimeout(ctx, opts.Timeout)
+		_, err := c.getBucketLocation(gctx, probeBucketName)
+		gcancel()
+		if !IsNetworkOrHostDown(err, false) {
+			switch ToErrorResponse(err).Code {
+			case NoSuchBucket, AccessDenied, "":
+				c.setHealthStatus(offline, online)
+			}
+		}
+	}
+	// Change to online, if we can connect.
+	check()
+
+	go func(duration time.Duration) {
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				atomic.StoreInt32(&c.healthStatus, unknown)
+				c.healthCheck.CompareAndSwap(hc, nil)
+				return
+			case <-timer.C:
+				check()
+				timer.Reset(duration)
+			}
+		}
+	}(opts.Interval)
+	return cancelFn, nil
+}
+
+// HealthStats returns the statistics of the running health check.
+func (c *Client) HealthStats() HealthStats {
+	stats := HealthStats{Online: c.IsOnline()}
+	hc := c.healthCheck.Load()
+	if hc == nil {
+		return stats
+	}
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	stats.Read = hc.read.percentiles()
+	stats.Write = hc.write.percentiles()
+	stats.SLOViolations = hc.sloViolations
+	stats.LastError = hc.lastErr
+	return stats
+}
+
+func (hc *healthChecker) hasProbes() bool {
+	return hc.opts.ReadBucket != "" || hc.opts.WriteBucket != ""
+}
+
+// record records the outcome of a probe, it returns false if the probe
+// failed.
+func (hc *healthChecker) record(w *latencyWindow, latency time.Duration, err error) bool {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if isProbeFailure(err) {
+		hc.lastErr = err
+		return false
+	}
+	w.add(latency, hc.opts.LatencyWindow)
+	if hc.opts.LatencySLO > 0 && latency > hc.opts.LatencySLO {
+		hc.sloViolations++
+		hc.lastErr = fmt.Errorf("probe latency %s exceeds the latency SLO of %s", latency, hc.opts.LatencySLO)
+		return false
+	}
+	return true
+}
+
+// isProbeFailure returns true if a probe failed with a network error or
+// a 5xx response, other error responses mean that the endpoint serves
+// requests.
+func isProbeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if IsNetworkOrHostDown(err, false) {
+		return true
+	}
+	errResp := ToErrorResponse(err)
+	return errResp.Code == "" || errResp.StatusCode >= http.StatusInternalServerError
+}
+
+// runProbes runs the read and write probes, it returns true if all of
+// them succeeded.
+func (c *Client) runProbes(ctx context.Context, hc *healthChecker) bool {
+	ctx = context.WithValue(ctx, healthProbeContextKey{}, true)
+	// Probes report the health of the endpoint, they are not retried.
+	ctx = WithRetryPolicy(ctx, StandardRetryPolicy{MaxAttempts: 1})
+	healthy := true
+	if hc.opts.ReadBucket != "" {
+		pctx, cancel := context.WithTimeout(ctx, hc.opts.Timeout)
+		start := time.Now()
+		_, err := c.StatObject(pctx, hc.opts.ReadBucket, hc.opts.ReadObject, StatObjectOptions{})
+		cancel()
+		healthy = hc.record(&hc.read, time.Since(start), err) && healthy
+	}
+	if hc.opts.WriteBucket != "" {
+		pctx, cancel := context.WithTimeout(ctx, hc.opts.Timeout)
+		object := hc.opts.WritePrefix + randString(60, rand.NewSource(time.Now().UnixNano()), "probe-")
+		start := time.Now()
+		_, err := c.PutObject(pctx, hc.opts.WriteBucket, object, bytes.NewReader(nil), 0, PutObjectOptions{DisableMultipart: true})
+		if err == nil {
+			err = c.RemoveObject(pctx, hc.opts.WriteBucket, object, RemoveObjectOptions{})
+		}
+		cancel()
+		healthy = hc.record(&hc.write, time.Since(start), err) && healthy
+	}
+	return healthy
+}
@@ -22,8 +22,12 @@ import (
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
 func TestHealthCheck(t *testing.T) {
@@ -67,3 +71,80 @@ func TestHealthCheck(t *testing.T) {
 		t.Fatal("Expected online but found offline")
 	}
 }
+
+func TestHealthCheckProbes(t *testing.T) {
+	var failing atomic.Bool
+	var writes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		case http.MethodPut:
+			if !strings.HasPrefix(r.URL.Path, "/bucket/.minio-health/") {
+				t.Errorf("Unexpected write probe %s", r.URL.Path)
+			}
+			writes.Add(1)
+			w.Header().Set("ETag", `"etag"`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transitions := make(chan bool, 10)
+	hcancel, err := clnt.HealthCheckWithOptions(HealthCheckOptions{
+		Interval:      time.Second,
+		ReadBucket:    "bucket",
+		ReadObject:    "object",
+		WriteBucket:   "bucket",
+		OnStateChange: func(online bool) { transitions <- online },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hcancel()
+
+	if !clnt.IsOnline() || !<-transitions {
+		t.Fatal("Expected online after the first probes")
+	}
+	stats := clnt.HealthStats()
+	if stats.Read.Samples != 1 || stats.Write.Samples != 1 || writes.Load() != 1 {
+		t.Fatalf("Unexpected health stats %+v", stats)
+	}
+
+	failing.Store(true)
+	select {
+	case online := <-transitions:
+		if online {
+			t.Fatal("Expected offline transition")
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Expected offline after failed probes")
+	}
+	if !clnt.IsOffline() || clnt.HealthStats().LastError == nil {
+		t.Fatal("Expected offline with the probe error")
+	}
+}
+
+func TestLatencyWindow(t *testing.T) {
+	var w latencyWindow
+	for i := 1; i <= 150; i++ {
+		w.add(time.Duration(i), 100)
+	}
+	p := w.percentiles()
+	if p.Samples != 100 || p.P50 != 100 || p.P90 != 140 || p.P99 != 149 {
+		t.Errorf("Unexpected percentiles %+v", p)
+	}
+}
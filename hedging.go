@@ -0,0 +1,177 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Hedging configures hedged read requests. A GET or HEAD request which
+// has not received response headers within Delay is sent again, and the
+// first response which is not retryable, e.g. not a 5xx or SlowDown, is
+// used while the other requests are canceled. Failed requests are not
+// sent again but retried as usual once all of them failed.
+type Hedging struct {
+	// Delay before sending another request, zero disables hedging.
+	Delay time.Duration
+
+	// MaxAttempts is the maximum number of concurrent requests,
+	// including the first one, defaults to 2.
+	MaxAttempts int
+}
+
+// send sends req with the client timeouts, concurrency limit and
+// middlewares.
+func (c *Client) send(req *http.Request, op OperationInfo, contentLength int64) (*http.Response, error) {
+	release, err := c.acquireRequest(req.Context(), req.Method, contentLength)
+	if err != nil {
+		return nil, err
+	}
+	req, timeouts := c.withTimeouts(req, contentLength)
+	res, err := c.roundTrip()(op, req)
+	if err == nil && res == nil {
+		err = errInvalidArgument("Response is empty. " + reportIssue)
+	}
+	res, err = timeouts.finish(req, res, err)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	res.Body = &releaseBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+// sendHedged sends req, hedged with additional requests if enabled.
+func (c *Client) sendHedged(ctx context.Context, op OperationInfo, metadata requestMetadata, req *http.Request) (*http.Response, error) {
+	method := op.Method
+	h := c.hedging
+	if h.Delay <= 0 || (method != http.MethodGet && method != http.MethodHead) || metadata.contentBody != nil {
+		return c.send(req, op, metadata.contentLength)
+	}
+	maxAttempts := h.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 2
+	}
+
+	type result struct {
+		res *http.Response
+		err error
+		idx int
+	}
+	results := make(chan result, maxAttempts)
+	cancels := make([]context.CancelFunc, 0, maxAttempts)
+	launch := func() {
+		hctx, cancel := context.WithCancel(ctx)
+		idx := len(cancels)
+		cancels = append(cancels, cancel)
+		// Hedged requests are copies of the first request, sent to its
+		// resolved endpoint, e.g. the failover endpoint chosen by the
+		// circuit breaker, they have no body.
+		r := req.WithContext(hctx)
+		if idx > 0 {
+			r = req.Clone(hctx)
+		}
+		go func() {
+			res, err := c.send(r, op, metadata.contentLength)
+			results <- result{res: res, err: err, idx: idx}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(h.Delay)
+	defer timer.Stop()
+	var (
+		firstErr error
+		// fallback is the first retryable response, returned if no
+		// request succeeds.
+		fallback *result
+	)
+	for pending := 1; pending > 0; {
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil && !isHedgeRetryable(r.res) {
+				// Cancel the other requests and discard their responses.
+				for i, cancel := range cancels {
+					if i != r.idx {
+						cancel()
+					}
+				}
+				if fallback != nil {
+					closeResponse(fallback.res)
+				}
+				go func(pending int) {
+					for ; pending > 0; pending-- {
+						if r := <-results; r.res != nil {
+							closeResponse(r.res)
+						}
+					}
+				}(pending)
+				r.res.Body = &hedgedBody{ReadCloser: r.res.Body, cancel: cancels[r.idx]}
+				return r.res, nil
+			}
+			// Failed requests are not sent again, the retries are
+			// left to the caller.
+			switch {
+			case r.err == nil && fallback == nil:
+				fallback = &r
+			case r.err == nil:
+				closeResponse(r.res)
+				cancels[r.idx]()
+			default:
+				cancels[r.idx]()
+				if firstErr == nil {
+					firstErr = r.err
+				}
+			}
+		case <-timer.C:
+			if len(cancels) < maxAttempts {
+				launch()
+				pending++
+				timer.Reset(h.Delay)
+			}
+		}
+	}
+	if fallback != nil {
+		fallback.res.Body = &hedgedBody{ReadCloser: fallback.res.Body, cancel: cancels[fallback.idx]}
+		return fallback.res, nil
+	}
+	return nil, firstErr
+}
+
+// isHedgeRetryable returns true if res must not win the race of hedged
+// requests, i.e. server errors and throttling.
+func isHedgeRetryable(res *http.Response) bool {
+	return res.StatusCode >= http.StatusInternalServerError || isHTTPStatusRetryable(res.StatusCode)
+}
+
+// hedgedBody is the response body of the winning hedged request, its
+// context is canceled when it is closed.
+type hedgedBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *hedgedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,178 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestHedging(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1)%2 == 1 {
+			// Every other request is stuck.
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", "5")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write([]byte("hello"))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:   credentials.NewStaticV4("access", "secret", ""),
+		Region:  "us-east-1",
+		Hedging: Hedging{Delay: 20 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	start := time.Now()
+	if _, err = c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.executeMethod(ctx, http.MethodGet, requestMetadata{bucketName: "bucket", objectName: "object", contentSHA256Hex: emptySHA256Hex})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(resp.Body)
+	closeResponse(resp)
+	if err != nil || string(data) != "hello" {
+		t.Fatalf("Expected hello, got %q, %v", data, err)
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("Expected hedged requests to finish quickly, took %s", d)
+	}
+	if n := requests.Load(); n != 4 {
+		t.Errorf("Expected 4 requests, got %d", n)
+	}
+}
+
+func TestHedgingRetryable(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bucket/slow" && requests.Add(1) == 1 {
+			// The first request succeeds after its hedge is throttled.
+			time.Sleep(100 * time.Millisecond)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/bucket/failing" {
+			requests.Add(1)
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`<Error><Code>SlowDown</Code><Message>Please reduce your request rate.</Message></Error>`))
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+		Hedging:    Hedging{Delay: 20 * time.Millisecond, MaxAttempts: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err = c.StatObject(ctx, "bucket", "slow", StatObjectOptions{}); err != nil {
+		t.Fatalf("Expected the slow request to win, got %v", err)
+	}
+
+	// Failed requests are not sent again by the hedging.
+	requests.Store(0)
+	c.hedging.Delay = time.Second
+	if _, err = c.StatObject(ctx, "bucket", "failing", StatObjectOptions{}); ToErrorResponse(err).StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected a 503 error, got %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected 1 request, got %d", n)
+	}
+}
+
+func TestHedgingFailover(t *testing.T) {
+	var primaryRequests, failoverRequests atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryRequests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+	failover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failoverRequests.Add(1) == 1 {
+			// The first request is stuck.
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer failover.Close()
+
+	c, err := New(strings.TrimPrefix(primary.URL, "http://"), &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+		Hedging:    Hedging{Delay: 20 * time.Millisecond},
+		CircuitBreaker: &CircuitBreakerOptions{
+			FailureThreshold: 2,
+			CoolDown:         time.Minute,
+			FailoverEndpoint: strings.TrimPrefix(failover.URL, "http://"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for range 2 {
+		c.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	}
+
+	// The hedged request is sent to the failover endpoint as well.
+	if _, err = c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatalf("Expected failover to succeed, got %v", err)
+	}
+	if n := primaryRequests.Load(); n != 2 {
+		t.Errorf("Expected 2 requests to the primary, got %d", n)
+	}
+	if n := failoverRequests.Load(); n != 2 {
+		t.Errorf("Expected 2 requests to the failover, got %d", n)
+	}
+}
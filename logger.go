@@ -0,0 +1,144 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+const redacted = "**REDACTED**"
+
+// redactedHeaders are the headers whose values are never logged.
+var redactedHeaders = []string{
+	"X-Amz-Security-Token",
+	"X-Amz-S3session-Token",
+	"Cookie",
+	"Set-Cookie",
+	encrypt.SseCustomerKey,
+	encrypt.SseCopyCustomerKey,
+}
+
+// redactedQueryParams are the query parameters of presigned requests
+// whose values are never logged.
+var redactedQueryParams = []string{
+	"X-Amz-Signature",
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"X-Amz-S3session-Token",
+	"Signature",
+	"AWSAccessKeyId",
+}
+
+// redactHeader returns a copy of h with the credentials and SSE-C keys
+// redacted.
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	if auth := h.Get("Authorization"); auth != "" {
+		h.Set("Authorization", redactSignature(auth))
+	}
+	for _, k := range redactedHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
+
+// redactURL returns u with the signature and credentials of presigned
+// URLs redacted.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	var changed bool
+	for _, k := range redactedQueryParams {
+		if q.Has(k) {
+			q.Set(k, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+	r := *u
+	r.RawQuery = q.Encode()
+	return r.String()
+}
+
+// headerAttrs returns the attributes of the redacted values of h.
+func headerAttrs(h http.Header) []any {
+	h = redactHeader(h)
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	attrs := make([]any, 0, len(h))
+	for _, k := range keys {
+		v := h[k]
+		if len(v) == 1 {
+			attrs = append(attrs, slog.String(k, v[0]))
+		} else {
+			attrs = append(attrs, slog.Any(k, v))
+		}
+	}
+	return attrs
+}
+
+// logRequest logs a request sent by the client. Successful requests are
+// logged with LevelDebug including the redacted headers, error responses
+// with LevelInfo and requests which failed without a response with
+// LevelWarn.
+func (c *Client) logRequest(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	ctx := req.Context()
+	level := slog.LevelDebug
+	switch {
+	case err != nil:
+		level = slog.LevelWarn
+	case resp.StatusCode >= http.StatusBadRequest:
+		level = slog.LevelInfo
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL)),
+		slog.Duration("duration", duration),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		c.logger.Log(ctx, level, "request failed", attrs...)
+		return
+	}
+	attrs = append(attrs,
+		slog.Int("status", resp.StatusCode),
+		slog.String("request_id", resp.Header.Get("x-amz-request-id")),
+	)
+	if c.logger.Enabled(ctx, slog.LevelDebug) {
+		attrs = append(attrs,
+			slog.Group("request_header", headerAttrs(req.Header)...),
+			slog.Group("response_header", headerAttrs(resp.Header)...),
+		)
+	}
+	c.logger.Log(ctx, level, "request", attrs...)
+}
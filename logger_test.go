@@ -0,0 +1,102 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://s3.amazonaws.com/bucket/object?X-Amz-Signature=abc&X-Amz-Credential=AKIA%2F20250101&versionId=1")
+	got := redactURL(u)
+	if strings.Contains(got, "abc") || strings.Contains(got, "AKIA") || !strings.Contains(got, "versionId=1") {
+		t.Errorf("Unexpected redacted URL %s", got)
+	}
+	u, _ = url.Parse("https://s3.amazonaws.com/bucket/object?versionId=1")
+	if got = redactURL(u); got != u.String() {
+		t.Errorf("Expected %s, got %s", u, got)
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	h := make(http.Header)
+	h.Set("x-amz-security-token", "session-token")
+	h.Set("x-amz-s3session-token", "express-session-token")
+	h.Set("x-amz-request-id", "req-1")
+	got := redactHeader(h)
+	for _, k := range []string{"X-Amz-Security-Token", "X-Amz-S3session-Token"} {
+		if v := got.Get(k); v != redacted {
+			t.Errorf("Expected %s to be redacted, got %q", k, v)
+		}
+	}
+	if v := got.Get("X-Amz-Request-Id"); v != "req-1" {
+		t.Errorf("Expected X-Amz-Request-Id req-1, got %q", v)
+	}
+	if v := h.Get("X-Amz-S3session-Token"); v != "express-session-token" {
+		t.Errorf("Expected the header to be unmodified, got %q", v)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-amz-request-id", "req-1")
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", "session-token"),
+		Region: "us-east-1",
+		Logger: slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sse := encrypt.DefaultPBKDF([]byte("password"), []byte("salt"))
+	c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{ServerSideEncryption: sse})
+
+	out := buf.String()
+	for _, want := range []string{"level=INFO", "method=HEAD", "status=404", "request_id=req-1", "Signature=**REDACTED**"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in log output %s", want, out)
+		}
+	}
+	if strings.Contains(out, "session-token") || strings.Contains(out, "Credential=access") {
+		t.Errorf("Credentials leaked into log output %s", out)
+	}
+	h := make(http.Header)
+	sse.Marshal(h)
+	if key := h.Get(encrypt.SseCustomerKey); strings.Contains(out, key) {
+		t.Errorf("SSE-C key leaked into log output %s", out)
+	}
+}
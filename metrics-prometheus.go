@@ -0,0 +1,180 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets are the latency histogram buckets of
+// PrometheusMetrics in seconds.
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// PrometheusMetrics is a MetricsCollector exposing the request metrics in
+// the Prometheus text format, it is a http.Handler which can be served
+// as a scrape target. The metrics are labeled by operation:
+//
+//	<namespace>_requests_total{operation,code}
+//	<namespace>_request_errors_total{operation}
+//	<namespace>_request_retries_total{operation}
+//	<namespace>_request_latency_seconds{operation} (histogram)
+//	<namespace>_sent_bytes_total{operation}
+//	<namespace>_received_bytes_total{operation}
+type PrometheusMetrics struct {
+	namespace string
+	buckets   []float64
+
+	mu         sync.Mutex
+	requests   map[[2]string]uint64
+	operations map[string]*operationMetrics
+}
+
+type operationMetrics struct {
+	errors        uint64
+	retries       uint64
+	sentBytes     uint64
+	receivedBytes uint64
+	latency       []uint64 // per bucket, non-cumulative
+	latencyCount  uint64
+	latencySum    float64
+}
+
+// NewPrometheusMetrics returns a PrometheusMetrics with metric names
+// prefixed by namespace, defaults to "minio_client".
+func NewPrometheusMetrics(namespace string) *PrometheusMetrics {
+	if namespace == "" {
+		namespace = "minio_client"
+	}
+	return &PrometheusMetrics{
+		namespace:  namespace,
+		buckets:    DefaultLatencyBuckets,
+		requests:   make(map[[2]string]uint64),
+		operations: make(map[string]*operationMetrics),
+	}
+}
+
+// RecordRequest implements MetricsCollector.
+func (p *PrometheusMetrics) RecordRequest(m RequestMetrics) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	op, ok := p.operations[m.Operation]
+	if !ok {
+		op = &operationMetrics{latency: make([]uint64, len(p.buckets)+1)}
+		p.operations[m.Operation] = op
+	}
+	if m.StatusCode == 0 {
+		op.errors++
+	} else {
+		p.requests[[2]string{m.Operation, strconv.Itoa(m.StatusCode)}]++
+	}
+	if m.Attempt > 1 {
+		op.retries++
+	}
+	if m.BytesSent > 0 {
+		op.sentBytes += uint64(m.BytesSent)
+	}
+	op.receivedBytes += uint64(m.BytesReceived)
+	secs := m.Latency.Seconds()
+	i, _ := slices.BinarySearch(p.buckets, secs)
+	op.latency[i]++
+	op.latencyCount++
+	op.latencySum += secs
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (p *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	p.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text format to w.
+func (p *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	header := func(name, typ, help string) {
+		fmt.Fprintf(bw, "# HELP %s_%s %s\n# TYPE %s_%s %s\n", p.namespace, name, help, p.namespace, name, typ)
+	}
+
+	keys := make([][2]string, 0, len(p.requests))
+	for k := range p.requests {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b [2]string) int {
+		if a[0] != b[0] {
+			return strings.Compare(a[0], b[0])
+		}
+		return strings.Compare(a[1], b[1])
+	})
+	header("requests_total", "counter", "Requests which received a response.")
+	for _, k := range keys {
+		fmt.Fprintf(bw, "%s_requests_total{operation=%s,code=%s} %d\n", p.namespace, strconv.Quote(k[0]), strconv.Quote(k[1]), p.requests[k])
+	}
+
+	ops := make([]string, 0, len(p.operations))
+	for op := range p.operations {
+		ops = append(ops, op)
+	}
+	slices.Sort(ops)
+	counter := func(name, help string, value func(*operationMetrics) uint64) {
+		header(name, "counter", help)
+		for _, op := range ops {
+			fmt.Fprintf(bw, "%s_%s{operation=%s} %d\n", p.namespace, name, strconv.Quote(op), value(p.operations[op]))
+		}
+	}
+	counter("request_errors_total", "Requests which failed without a response.", func(m *operationMetrics) uint64 { return m.errors })
+	counter("request_retries_total", "Requests which were retries.", func(m *operationMetrics) uint64 { return m.retries })
+	counter("sent_bytes_total", "Bytes sent in request bodies.", func(m *operationMetrics) uint64 { return m.sentBytes })
+	counter("received_bytes_total", "Bytes received in response bodies.", func(m *operationMetrics) uint64 { return m.receivedBytes })
+
+	header("request_latency_seconds", "histogram", "Latency until the response headers were received.")
+	for _, op := range ops {
+		m := p.operations[op]
+		label := strconv.Quote(op)
+		var cumulative uint64
+		for i, le := range p.buckets {
+			cumulative += m.latency[i]
+			fmt.Fprintf(bw, "%s_request_latency_seconds_bucket{operation=%s,le=\"%s\"} %d\n", p.namespace, label, strconv.FormatFloat(le, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(bw, "%s_request_latency_seconds_bucket{operation=%s,le=\"+Inf\"} %d\n", p.namespace, label, m.latencyCount)
+		fmt.Fprintf(bw, "%s_request_latency_seconds_sum{operation=%s} %s\n", p.namespace, label, strconv.FormatFloat(m.latencySum, 'g', -1, 64))
+		fmt.Fprintf(bw, "%s_request_latency_seconds_count{operation=%s} %d\n", p.namespace, label, m.latencyCount)
+	}
+	err := bw.Flush()
+	return cw.n, err
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,224 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RequestMetrics describes a request attempt sent by the client.
+type RequestMetrics struct {
+	// Operation is the S3 API name of the request, e.g. GetObject.
+	Operation  string
+	Method     string
+	BucketName string
+	ObjectName string
+
+	// Attempt is the number of the attempt, starting at 1. Attempts
+	// greater than 1 are retries.
+	Attempt int
+
+	// StatusCode of the response, zero when no response was received.
+	StatusCode int
+
+	// Err is the error of the request, without a response, or of
+	// reading the response body.
+	Err error
+
+	// Latency until the response headers were received.
+	Latency time.Duration
+
+	// Duration until the response body was closed.
+	Duration time.Duration
+
+	// BytesSent is the size of the request body, -1 if unknown.
+	BytesSent int64
+
+	// BytesReceived is the number of response body bytes read.
+	BytesReceived int64
+}
+
+// MetricsCollector receives the metrics of all requests of a client, see
+// PrometheusMetrics. RecordRequest is called concurrently when the
+// response body of a request is closed, or when it fails without a
+// response.
+type MetricsCollector interface {
+	RecordRequest(m RequestMetrics)
+}
+
+// recordRequest records the metrics of a request, the response body is
+// wrapped to record them once it is closed.
+func (c *Client) recordRequest(m RequestMetrics, start time.Time, res *http.Response, err error) {
+	m.Latency = time.Since(start)
+	if err != nil || res == nil {
+		m.Err = err
+		m.Duration = m.Latency
+		c.metrics.RecordRequest(m)
+		return
+	}
+	m.StatusCode = res.StatusCode
+	res.Body = &metricsBody{ReadCloser: res.Body, metrics: m, start: start, collector: c.metrics}
+}
+
+// metricsBody counts the bytes read from a response body.
+type metricsBody struct {
+	io.ReadCloser
+	metrics   RequestMetrics
+	start     time.Time
+	collector MetricsCollector
+	once      sync.Once
+}
+
+func (b *metricsBody) Read(p []byte) (n int, err error) {
+	n, err = b.ReadCloser.Read(p)
+	b.metrics.BytesReceived += int64(n)
+	if err != nil && err != io.EOF {
+		b.metrics.Err = err
+	}
+	return n, err
+}
+
+func (b *metricsBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.metrics.Duration = time.Since(b.start)
+		b.collector.RecordRequest(b.metrics)
+	})
+	return err
+}
+
+// subresourceOperations are the operation names of bucket and object
+// subresources, prefixed with the method.
+var subresourceOperations = map[string]string{
+	"acl":                 "Acl",
+	"cors":                "BucketCors",
+	"encryption":          "BucketEncryption",
+	"legal-hold":          "ObjectLegalHold",
+	"lifecycle":           "BucketLifecycleConfiguration",
+	"location":            "BucketLocation",
+	"notification":        "BucketNotificationConfiguration",
+	"object-lock":         "ObjectLockConfiguration",
+	"policy":              "BucketPolicy",
+	"policyStatus":        "BucketPolicyStatus",
+	"replication":         "BucketReplication",
+	"retention":           "ObjectRetention",
+	"tagging":             "Tagging",
+	"versioning":          "BucketVersioning",
+	"website":             "BucketWebsite",
+	"accelerate":          "BucketAccelerateConfiguration",
+	"requestPayment":      "BucketRequestPayment",
+	"logging":             "BucketLogging",
+	"ownershipControls":   "BucketOwnershipControls",
+	"publicAccessBlock":   "PublicAccessBlock",
+	"intelligent-tiering": "BucketIntelligentTieringConfiguration",
+}
+
+var methodVerbs = map[string]string{
+	http.MethodGet:    "Get",
+	http.MethodPut:    "Put",
+	http.MethodDelete: "Delete",
+	http.MethodHead:   "Head",
+	http.MethodPost:   "Post",
+}
+
+// operationName returns the S3 API name of a request.
+func operationName(method string, metadata requestMetadata) string {
+	q := metadata.queryValues
+	has := func(key string) bool {
+		_, ok := q[key]
+		return ok
+	}
+	object := metadata.objectName != ""
+	switch {
+	case metadata.bucketName == "":
+		if method == http.MethodGet {
+			return "ListBuckets"
+		}
+	case has("uploadId"):
+		switch method {
+		case http.MethodPut:
+			if metadata.customHeader.Get("X-Amz-Copy-Source") != "" {
+				return "UploadPartCopy"
+			}
+			return "UploadPart"
+		case http.MethodPost:
+			return "CompleteMultipartUpload"
+		case http.MethodDelete:
+			return "AbortMultipartUpload"
+		case http.MethodGet:
+			return "ListParts"
+		}
+	case has("uploads"):
+		if method == http.MethodPost {
+			return "CreateMultipartUpload"
+		}
+		return "ListMultipartUploads"
+	case has("delete") && method == http.MethodPost:
+		return "DeleteObjects"
+	case has("select") && method == http.MethodPost:
+		return "SelectObjectContent"
+	case has("restore") && method == http.MethodPost:
+		return "RestoreObject"
+	case has("attributes"):
+		return "GetObjectAttributes"
+	case has("session"):
+		return "CreateSession"
+	case has("versions"):
+		return "ListObjectVersions"
+	}
+	verb := methodVerbs[method]
+	for key, name := range subresourceOperations {
+		if has(key) {
+			if name == "Tagging" || name == "Acl" {
+				if object {
+					return verb + "Object" + name
+				}
+				return verb + "Bucket" + name
+			}
+			return verb + name
+		}
+	}
+	if !object {
+		switch method {
+		case http.MethodGet:
+			if q.Get("list-type") == "2" {
+				return "ListObjectsV2"
+			}
+			return "ListObjects"
+		case http.MethodPut:
+			return "CreateBucket"
+		case http.MethodHead:
+			return "HeadBucket"
+		case http.MethodDelete:
+			return "DeleteBucket"
+		}
+	}
+	switch method {
+	case http.MethodPut:
+		if metadata.customHeader.Get("X-Amz-Copy-Source") != "" {
+			return "CopyObject"
+		}
+		return "PutObject"
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return verb + "Object"
+	}
+	return method
+}
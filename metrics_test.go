@@ -0,0 +1,127 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestOperationName(t *testing.T) {
+	testCases := []struct {
+		method   string
+		metadata requestMetadata
+		expected string
+	}{
+		{http.MethodGet, requestMetadata{}, "ListBuckets"},
+		{http.MethodGet, requestMetadata{bucketName: "b", queryValues: url.Values{"list-type": {"2"}}}, "ListObjectsV2"},
+		{http.MethodHead, requestMetadata{bucketName: "b"}, "HeadBucket"},
+		{http.MethodGet, requestMetadata{bucketName: "b", objectName: "o"}, "GetObject"},
+		{http.MethodPut, requestMetadata{bucketName: "b", objectName: "o"}, "PutObject"},
+		{http.MethodPut, requestMetadata{bucketName: "b", objectName: "o", customHeader: http.Header{"X-Amz-Copy-Source": {"/b/x"}}}, "CopyObject"},
+		{http.MethodPut, requestMetadata{bucketName: "b", objectName: "o", queryValues: url.Values{"uploadId": {"u"}, "partNumber": {"1"}}}, "UploadPart"},
+		{http.MethodPost, requestMetadata{bucketName: "b", objectName: "o", queryValues: url.Values{"uploads": {""}}}, "CreateMultipartUpload"},
+		{http.MethodPost, requestMetadata{bucketName: "b", queryValues: url.Values{"delete": {""}}}, "DeleteObjects"},
+		{http.MethodGet, requestMetadata{bucketName: "b", queryValues: url.Values{"policy": {""}}}, "GetBucketPolicy"},
+		{http.MethodPut, requestMetadata{bucketName: "b", objectName: "o", queryValues: url.Values{"tagging": {""}}}, "PutObjectTagging"},
+		{http.MethodDelete, requestMetadata{bucketName: "b", queryValues: url.Values{"tagging": {""}}}, "DeleteBucketTagging"},
+	}
+	for i, testCase := range testCases {
+		if got := operationName(testCase.method, testCase.metadata); got != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, got)
+		}
+	}
+}
+
+type testMetricsCollector struct {
+	requests []RequestMetrics
+}
+
+func (c *testMetricsCollector) RecordRequest(m RequestMetrics) {
+	c.requests = append(c.requests, m)
+}
+
+func TestMetricsCollector(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", "5")
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	collector := &testMetricsCollector{}
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:   credentials.NewStaticV4("access", "secret", ""),
+		Region:  "us-east-1",
+		Metrics: collector,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.executeMethod(context.Background(), http.MethodGet, requestMetadata{bucketName: "bucket", objectName: "object", contentSHA256Hex: emptySHA256Hex})
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	closeResponse(resp)
+
+	if len(collector.requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(collector.requests))
+	}
+	first, second := collector.requests[0], collector.requests[1]
+	if first.StatusCode != http.StatusServiceUnavailable || first.Attempt != 1 || first.Operation != "GetObject" {
+		t.Errorf("Unexpected first request %+v", first)
+	}
+	if second.StatusCode != http.StatusOK || second.Attempt != 2 || second.BytesReceived != 5 {
+		t.Errorf("Unexpected second request %+v", second)
+	}
+
+	prom := NewPrometheusMetrics("")
+	for _, m := range collector.requests {
+		prom.RecordRequest(m)
+	}
+	var buf bytes.Buffer
+	if _, err = prom.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`minio_client_requests_total{operation="GetObject",code="200"} 1`,
+		`minio_client_requests_total{operation="GetObject",code="503"} 1`,
+		`minio_client_request_retries_total{operation="GetObject"} 1`,
+		`minio_client_received_bytes_total{operation="GetObject"} 5`,
+		`minio_client_request_latency_seconds_count{operation="GetObject"} 2`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("Expected %q in\n%s", line, buf.String())
+		}
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import "net/http"
+
+// OperationInfo describes the S3 operation of a request.
+type OperationInfo struct {
+	// Name is the S3 API name of the operation, e.g. PutObject.
+	Name       string
+	Method     string
+	BucketName string
+	ObjectName string
+
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+}
+
+// RoundTripFunc sends a signed request of an operation.
+type RoundTripFunc func(op OperationInfo, req *http.Request) (*http.Response, error)
+
+// Middleware wraps the RoundTripFunc sending requests, e.g. to audit
+// operations, inject headers or faults. Requests are signed, changes to
+// signed headers or the URL require signing the request again.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use adds middlewares wrapping every request of the client, including
+// retries. The first middleware added is the outermost one. Use must not
+// be called concurrently with requests.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares[:len(c.middlewares):len(c.middlewares)], middlewares...)
+}
+
+// roundTrip returns the RoundTripFunc wrapped by the middlewares.
+func (c *Client) roundTrip() RoundTripFunc {
+	next := func(_ OperationInfo, req *http.Request) (*http.Response, error) {
+		return c.do(req)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+	return next
+}
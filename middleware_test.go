@@ -0,0 +1,72 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestMiddleware(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Audit-Id") != "audit" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var audit []string
+	c.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(op OperationInfo, req *http.Request) (*http.Response, error) {
+			audit = append(audit, op.Name+" "+op.BucketName+"/"+op.ObjectName)
+			return next(op, req)
+		}
+	}, func(next RoundTripFunc) RoundTripFunc {
+		return func(op OperationInfo, req *http.Request) (*http.Response, error) {
+			// Fail the first attempt, it is retried.
+			if op.Attempt == 1 {
+				return nil, errors.New("injected fault")
+			}
+			req.Header.Set("X-Audit-Id", "audit")
+			return next(op, req)
+		}
+	})
+
+	if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(audit) != 2 || audit[0] != "HeadObject bucket/object" {
+		t.Errorf("Unexpected audit log %v", audit)
+	}
+}
@@ -0,0 +1,393 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"iter"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// MirrorOptions represents options specified by user for a Mirror.
+type MirrorOptions struct {
+	// Prefix selects the objects under the prefix.
+	Prefix string
+
+	// Include selects the objects whose keys match any of the
+	// patterns, see path.Match. All objects are selected if empty.
+	Include []string
+
+	// Exclude skips the objects whose keys match any of the
+	// patterns, even if they are included.
+	Exclude []string
+
+	// Overwrite replaces the objects of the destination which differ
+	// from the source, i.e. which have a different size or are older.
+	// Otherwise they are skipped.
+	Overwrite bool
+
+	// Remove removes the objects of the destination which do not
+	// exist in the source, and the objects removed from the source
+	// while watching.
+	Remove bool
+
+	// Watch keeps the destination in sync after the initial copy by
+	// applying the notifications of changes of the source, which is
+	// supported by MinIO only. Changes are always applied, regardless
+	// of Overwrite.
+	Watch bool
+
+	// Parallel is the number of concurrent copies, defaults to 1.
+	Parallel int
+
+	// DryRun only counts the objects that would be copied or removed.
+	DryRun bool
+
+	// Progress if set receives updated statistics after every object
+	// copied or removed, it is closed when Run returns. Sends are
+	// blocking, the channel must be drained.
+	Progress chan<- MirrorStats
+}
+
+// MirrorStats contains the statistics of a Mirror.
+type MirrorStats struct {
+	// Number of objects listed in the source.
+	Listed int64
+	// Number of objects copied, or that would have been copied in
+	// dry-run mode.
+	Copied int64
+	// Number of bytes of the copied objects.
+	CopiedBytes int64
+	// Number of objects removed from the destination, or that would
+	// have been removed in dry-run mode.
+	Removed int64
+	// Number of objects already in sync or skipped as they differ.
+	Skipped int64
+	// Number of objects that could not be copied or removed.
+	Failed int64
+	// Time spent so far.
+	Elapsed time.Duration
+}
+
+// Mirror copies the objects of a bucket to another bucket, possibly of
+// another endpoint, i.e. `mc mirror`. See NewMirror.
+type Mirror struct {
+	src, dst             *Client
+	srcBucket, dstBucket string
+	opts                 MirrorOptions
+
+	mu       sync.Mutex
+	stats    MirrorStats
+	firstErr error
+	start    time.Time
+}
+
+// mirrorTask is an object to be copied or removed.
+type mirrorTask struct {
+	key    string
+	size   int64
+	remove bool
+}
+
+// NewMirror returns a Mirror of srcBucket of the src client to dstBucket
+// of the dst client. The clients may be the same, the objects are copied
+// on the server then.
+func NewMirror(src *Client, srcBucket string, dst *Client, dstBucket string, opts MirrorOptions) (*Mirror, error) {
+	if src == nil || dst == nil {
+		return nil, errInvalidArgument("Mirror clients cannot be nil.")
+	}
+	if err := s3utils.CheckValidBucketName(srcBucket); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidBucketName(dstBucket); err != nil {
+		return nil, err
+	}
+	if src == dst && srcBucket == dstBucket {
+		return nil, errInvalidArgument("Mirror source and destination cannot be the same bucket.")
+	}
+	for _, pattern := range append(opts.Include[:len(opts.Include):len(opts.Include)], opts.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errInvalidArgument("Invalid pattern " + pattern + ": " + err.Error())
+		}
+	}
+	if opts.Parallel <= 0 {
+		opts.Parallel = 1
+	}
+	return &Mirror{src: src, dst: dst, srcBucket: srcBucket, dstBucket: dstBucket, opts: opts}, nil
+}
+
+// Run copies the objects of the source which are missing in the
+// destination, removing or overwriting objects as selected by the
+// options, and then applies the changes of the source until ctx is done
+// if watching. The final statistics are returned along with the first
+// error encountered, remaining objects are still attempted when
+// individual objects fail to be copied or removed. Run must not be
+// called concurrently.
+func (m *Mirror) Run(ctx context.Context) (MirrorStats, error) {
+	if m.opts.Progress != nil {
+		defer close(m.opts.Progress)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	m.mu.Lock()
+	m.stats, m.firstErr, m.start = MirrorStats{}, nil, time.Now()
+	m.mu.Unlock()
+
+	// Listen before listing, changes made while listing are applied
+	// afterwards.
+	var liveCh <-chan notification.Info
+	if m.opts.Watch {
+		events := []notification.EventType{notification.ObjectCreatedAll}
+		if m.opts.Remove {
+			events = append(events, notification.ObjectRemovedAll)
+		}
+		filter := notification.NewListenFilter(events...).WithPrefix(m.opts.Prefix)
+		liveCh = m.src.ListenBucketNotificationWithFilter(ctx, m.srcBucket, filter)
+	}
+
+	taskCh := make(chan mirrorTask)
+	var wg sync.WaitGroup
+	for range m.opts.Parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range taskCh {
+				m.apply(ctx, task)
+			}
+		}()
+	}
+	send := func(task mirrorTask) bool {
+		select {
+		case taskCh <- task:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	diffErr := m.diff(ctx, send)
+	if diffErr == nil && liveCh != nil {
+		for info := range liveCh {
+			if info.Err != nil {
+				m.setErr(info.Err)
+				continue
+			}
+			for _, event := range info.Records {
+				key, err := event.S3.Object.ObjectName()
+				if err != nil {
+					m.setErr(err)
+					continue
+				}
+				if !m.selected(key) {
+					continue
+				}
+				task := mirrorTask{key: key, size: event.S3.Object.Size}
+				task.remove = notification.ObjectRemovedAll.Matches(event.Type())
+				if !send(task) {
+					break
+				}
+			}
+		}
+	}
+	close(taskCh)
+	wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stats.Elapsed = time.Since(m.start)
+	if diffErr != nil {
+		return m.stats, diffErr
+	}
+	if m.firstErr == nil {
+		m.firstErr = ctx.Err()
+	}
+	return m.stats, m.firstErr
+}
+
+// diff lists the source and the destination in lexical order and sends
+// the objects to be copied or removed.
+func (m *Mirror) diff(ctx context.Context, send func(mirrorTask) bool) error {
+	opts := ListObjectsOptions{Prefix: m.opts.Prefix, Recursive: true}
+	nextSrc, stopSrc := iter.Pull(m.src.ListObjectsIter(ctx, m.srcBucket, opts))
+	defer stopSrc()
+	nextDst, stopDst := iter.Pull(m.dst.ListObjectsIter(ctx, m.dstBucket, opts))
+	defer stopDst()
+
+	next := func(next func() (ObjectInfo, bool)) (*ObjectInfo, error) {
+		for {
+			object, ok := next()
+			if !ok {
+				return nil, nil
+			}
+			if object.Err != nil {
+				return nil, object.Err
+			}
+			if m.selected(object.Key) {
+				return &object, nil
+			}
+		}
+	}
+	srcObj, err := next(nextSrc)
+	if err != nil {
+		return err
+	}
+	dstObj, err := next(nextDst)
+	if err != nil {
+		return err
+	}
+	for srcObj != nil || dstObj != nil {
+		var task *mirrorTask
+		switch {
+		case dstObj == nil || (srcObj != nil && srcObj.Key < dstObj.Key):
+			m.update(func(s *MirrorStats) { s.Listed++ })
+			task = &mirrorTask{key: srcObj.Key, size: srcObj.Size}
+			srcObj, err = next(nextSrc)
+		case srcObj == nil || dstObj.Key < srcObj.Key:
+			if m.opts.Remove {
+				task = &mirrorTask{key: dstObj.Key, remove: true}
+			}
+			dstObj, err = next(nextDst)
+		default:
+			m.update(func(s *MirrorStats) { s.Listed++ })
+			if m.opts.Overwrite && (srcObj.Size != dstObj.Size || srcObj.LastModified.After(dstObj.LastModified)) {
+				task = &mirrorTask{key: srcObj.Key, size: srcObj.Size}
+			} else {
+				m.update(func(s *MirrorStats) { s.Skipped++ })
+			}
+			if srcObj, err = next(nextSrc); err == nil {
+				dstObj, err = next(nextDst)
+			}
+		}
+		if task != nil && !send(*task) {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selected tells whether the object is selected by the filters.
+func (m *Mirror) selected(key string) bool {
+	if !strings.HasPrefix(key, m.opts.Prefix) {
+		return false
+	}
+	for _, pattern := range m.opts.Exclude {
+		if ok, _ := path.Match(pattern, key); ok {
+			return false
+		}
+	}
+	for _, pattern := range m.opts.Include {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+	return len(m.opts.Include) == 0
+}
+
+// apply copies or removes an object.
+func (m *Mirror) apply(ctx context.Context, task mirrorTask) {
+	if task.remove {
+		var err error
+		if !m.opts.DryRun {
+			err = m.dst.RemoveObject(ctx, m.dstBucket, task.key, RemoveObjectOptions{})
+		}
+		m.done(ctx, err, func(s *MirrorStats) { s.Removed++ })
+		return
+	}
+	size := task.size
+	var err error
+	if !m.opts.DryRun {
+		size, err = m.copyObject(ctx, task)
+	}
+	m.done(ctx, err, func(s *MirrorStats) {
+		s.Copied++
+		s.CopiedBytes += size
+	})
+}
+
+// copyObject copies an object with its metadata, on the server if the
+// source and the destination are the same client.
+func (m *Mirror) copyObject(ctx context.Context, task mirrorTask) (int64, error) {
+	if m.src == m.dst && task.size <= maxPartSize {
+		info, err := m.dst.CopyObject(ctx,
+			CopyDestOptions{Bucket: m.dstBucket, Object: task.key},
+			CopySrcOptions{Bucket: m.srcBucket, Object: task.key})
+		return info.Size, err
+	}
+
+	obj, err := m.src.GetObject(ctx, m.srcBucket, task.key, GetObjectOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer obj.Close()
+	info, err := obj.Stat()
+	if err != nil {
+		return 0, err
+	}
+	upload, err := m.dst.PutObject(ctx, m.dstBucket, task.key, obj, info.Size, PutObjectOptions{
+		UserMetadata:       info.UserMetadata,
+		ContentType:        info.ContentType,
+		ContentEncoding:    info.Metadata.Get("Content-Encoding"),
+		ContentDisposition: info.Metadata.Get("Content-Disposition"),
+		ContentLanguage:    info.Metadata.Get("Content-Language"),
+		CacheControl:       info.Metadata.Get("Cache-Control"),
+	})
+	return upload.Size, err
+}
+
+// done records the result of a task and sends the statistics to
+// Progress.
+func (m *Mirror) done(ctx context.Context, err error, update func(*MirrorStats)) {
+	if err != nil {
+		m.setErr(err)
+		update = func(s *MirrorStats) { s.Failed++ }
+	}
+	snapshot := m.update(update)
+	if m.opts.Progress != nil {
+		select {
+		case m.opts.Progress <- snapshot:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (m *Mirror) setErr(err error) {
+	m.mu.Lock()
+	if m.firstErr == nil {
+		m.firstErr = err
+	}
+	m.mu.Unlock()
+}
+
+// update updates the statistics and returns them.
+func (m *Mirror) update(update func(*MirrorStats)) MirrorStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	update(&m.stats)
+	m.stats.Elapsed = time.Since(m.start)
+	return m.stats
+}
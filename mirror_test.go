@@ -0,0 +1,282 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type mirrorTestObject struct {
+	data    string
+	modTime time.Time
+}
+
+// mirrorTestServer is an in-memory server of the buckets src and dst.
+type mirrorTestServer struct {
+	mu      sync.Mutex
+	buckets map[string]map[string]mirrorTestObject
+	// events are sent to listeners of the src bucket.
+	events chan string
+}
+
+func (s *mirrorTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	// The lock is only held around the accesses to the objects, bodies
+	// are read and written unlocked as they may be streamed by requests
+	// to the same server.
+	switch {
+	case r.Method == http.MethodGet && key == "" && r.URL.Query().Has("events"):
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case key := <-s.events:
+				fmt.Fprintf(w, `{"Records":[{"eventName":"s3:ObjectCreated:Put","s3":{"object":{"key":%q,"size":3}}}]}`+"\n", key)
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	case r.Method == http.MethodGet && key == "":
+		prefix := r.URL.Query().Get("prefix")
+		var keys []string
+		objects := make(map[string]mirrorTestObject)
+		s.mu.Lock()
+		for k, object := range s.buckets[bucket] {
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, k)
+				objects[k] = object
+			}
+		}
+		s.mu.Unlock()
+		slices.Sort(keys)
+		fmt.Fprint(w, `<ListBucketResult><IsTruncated>false</IsTruncated>`)
+		for _, k := range keys {
+			fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>%d</Size><LastModified>%s</LastModified></Contents>`,
+				k, len(objects[k].data), objects[k].modTime.Format(time.RFC3339))
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+	case r.Method == http.MethodGet, r.Method == http.MethodHead:
+		s.mu.Lock()
+		object, ok := s.buckets[bucket][key]
+		s.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<Error><Code>NoSuchKey</Code></Error>`)
+			return
+		}
+		w.Header().Set("Last-Modified", object.modTime.Format(http.TimeFormat))
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("X-Amz-Meta-Origin", bucket)
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Content-Length", fmt.Sprint(len(object.data)))
+		if r.Method == http.MethodGet {
+			io.WriteString(w, object.data)
+		}
+	case r.Method == http.MethodPut:
+		if r.Header.Get("Content-Type") != "text/plain" || r.Header.Get("X-Amz-Meta-Origin") != "src" {
+			http.Error(w, "metadata was not copied", http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		if strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			data = decodeAWSChunked(data)
+		}
+		s.mu.Lock()
+		s.buckets[bucket][key] = mirrorTestObject{data: string(data), modTime: time.Now()}
+		s.mu.Unlock()
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodDelete:
+		s.mu.Lock()
+		delete(s.buckets[bucket], key)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+	}
+}
+
+// decodeAWSChunked returns the payload of an aws-chunked body.
+func decodeAWSChunked(body []byte) []byte {
+	var data []byte
+	for {
+		line, rest, _ := bytes.Cut(body, []byte("\r\n"))
+		size, err := strconv.ParseInt(string(bytes.SplitN(line, []byte(";"), 2)[0]), 16, 64)
+		if err != nil || size == 0 || int64(len(rest)) < size {
+			return data
+		}
+		data = append(data, rest[:size]...)
+		body = bytes.TrimPrefix(rest[size:], []byte("\r\n"))
+	}
+}
+
+func (s *mirrorTestServer) keys(bucket string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for k, object := range s.buckets[bucket] {
+		keys = append(keys, k+"="+object.data)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func newMirrorTestClients(t *testing.T, s *mirrorTestServer) (*Client, *Client) {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	var clnts []*Client
+	for range 2 {
+		clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:      credentials.NewStaticV4("access", "secret", ""),
+			Region:     "us-east-1",
+			MaxRetries: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		clnts = append(clnts, clnt)
+	}
+	return clnts[0], clnts[1]
+}
+
+func TestMirror(t *testing.T) {
+	old, now := time.Now().Add(-time.Hour), time.Now()
+	s := &mirrorTestServer{buckets: map[string]map[string]mirrorTestObject{
+		"src": {
+			"a.txt":     {"aaa", now},
+			"b.txt":     {"bbb", now},
+			"c.txt":     {"new", now},
+			"skip.log":  {"log", now},
+			"d/e.txt":   {"eee", now},
+			"d/f.txt":   {"fff", old},
+			"other.txt": {"ooo", now},
+		},
+		"dst": {
+			"b.txt":     {"bbb", now.Add(time.Minute)},
+			"c.txt":     {"old", old},
+			"d/f.txt":   {"FFF", now},
+			"gone.txt":  {"xxx", now},
+			"other.txt": {"o", now},
+		},
+	}}
+	src, dst := newMirrorTestClients(t, s)
+
+	if _, err := NewMirror(src, "src", src, "src", MirrorOptions{}); err == nil {
+		t.Fatal("expected mirror of a bucket to itself to fail")
+	}
+	if _, err := NewMirror(src, "src", dst, "dst", MirrorOptions{Include: []string{"["}}); err == nil {
+		t.Fatal("expected invalid pattern to fail")
+	}
+
+	testCases := []struct {
+		opts     MirrorOptions
+		stats    MirrorStats
+		expected []string
+	}{
+		{
+			// Only missing objects are copied.
+			opts:     MirrorOptions{Exclude: []string{"*.log", "other.txt"}, DryRun: true},
+			stats:    MirrorStats{Listed: 5, Copied: 2, CopiedBytes: 6, Skipped: 3},
+			expected: []string{"b.txt=bbb", "c.txt=old", "d/f.txt=FFF", "gone.txt=xxx", "other.txt=o"},
+		},
+		{
+			opts:     MirrorOptions{Exclude: []string{"*.log", "other.txt"}, Overwrite: true, Remove: true, Parallel: 2},
+			stats:    MirrorStats{Listed: 5, Copied: 3, CopiedBytes: 9, Removed: 1, Skipped: 2},
+			expected: []string{"a.txt=aaa", "b.txt=bbb", "c.txt=new", "d/e.txt=eee", "d/f.txt=FFF", "other.txt=o"},
+		},
+		{
+			opts:     MirrorOptions{Prefix: "d/", Include: []string{"d/e*"}, Remove: true},
+			stats:    MirrorStats{Listed: 1, Skipped: 1},
+			expected: []string{"a.txt=aaa", "b.txt=bbb", "c.txt=new", "d/e.txt=eee", "d/f.txt=FFF", "other.txt=o"},
+		},
+	}
+	for i, testCase := range testCases {
+		m, err := NewMirror(src, "src", dst, "dst", testCase.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stats, err := m.Run(context.Background())
+		if err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		stats.Elapsed = 0
+		if stats != testCase.stats {
+			t.Errorf("test %d: expected stats %+v, got %+v", i+1, testCase.stats, stats)
+		}
+		if keys := s.keys("dst"); !slices.Equal(keys, testCase.expected) {
+			t.Errorf("test %d: expected objects %v, got %v", i+1, testCase.expected, keys)
+		}
+	}
+}
+
+func TestMirrorWatch(t *testing.T) {
+	s := &mirrorTestServer{
+		buckets: map[string]map[string]mirrorTestObject{
+			"src": {"a.txt": {"aaa", time.Now()}},
+			"dst": {},
+		},
+		events: make(chan string),
+	}
+	src, dst := newMirrorTestClients(t, s)
+
+	progress := make(chan MirrorStats)
+	m, err := NewMirror(src, "src", dst, "dst", MirrorOptions{Watch: true, Progress: progress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := m.Run(ctx)
+		errCh <- err
+	}()
+
+	if stats := <-progress; stats.Copied != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	s.mu.Lock()
+	s.buckets["src"]["b.txt"] = mirrorTestObject{"bbb", time.Now()}
+	s.mu.Unlock()
+	s.events <- "b.txt"
+	if stats := <-progress; stats.Copied != 2 || stats.Failed != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if keys := s.keys("dst"); !slices.Equal(keys, []string{"a.txt=aaa", "b.txt=bbb"}) {
+		t.Errorf("unexpected objects %v", keys)
+	}
+
+	cancel()
+	for range progress {
+	}
+	if err = <-errCh; err != context.Canceled {
+		t.Fatalf("expected the mirror to be canceled, got %v", err)
+	}
+}
@@ -0,0 +1,237 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package checksum computes the checksums of objects like S3, i.e. the
+// full object and composite checksums of multipart uploads, to compare
+// local files with the checksums of uploaded objects offline.
+package checksum
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+	"math/bits"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/minio/crc64nvme"
+)
+
+// Algorithm is a checksum algorithm of S3.
+type Algorithm int
+
+// The checksum algorithms of S3.
+const (
+	CRC32 Algorithm = iota + 1
+	CRC32C
+	CRC64NVME
+	SHA1
+	SHA256
+)
+
+// crc64NVMEPolynomial is the generator polynomial of CRC64NVME.
+const crc64NVMEPolynomial = 0xad93d23594c93659
+
+// ParseAlgorithm returns the algorithm of its S3 name, e.g. "CRC32C".
+func ParseAlgorithm(s string) (Algorithm, error) {
+	for a := CRC32; a <= SHA256; a++ {
+		if strings.EqualFold(s, a.String()) {
+			return a, nil
+		}
+	}
+	return 0, errors.New("checksum: unknown algorithm " + s)
+}
+
+// String returns the S3 name of the algorithm.
+func (a Algorithm) String() string {
+	switch a {
+	case CRC32:
+		return "CRC32"
+	case CRC32C:
+		return "CRC32C"
+	case CRC64NVME:
+		return "CRC64NVME"
+	case SHA1:
+		return "SHA1"
+	case SHA256:
+		return "SHA256"
+	}
+	return "<invalid>"
+}
+
+// New returns a hash of the algorithm, nil if it is invalid.
+func (a Algorithm) New() hash.Hash {
+	switch a {
+	case CRC32:
+		return crc32.NewIEEE()
+	case CRC32C:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case CRC64NVME:
+		return crc64nvme.New()
+	case SHA1:
+		return sha1.New()
+	case SHA256:
+		return sha256.New()
+	}
+	return nil
+}
+
+// CanCombine tells whether the full object checksum of a multipart
+// upload can be computed from the checksums of its parts, which is the
+// case for the CRC algorithms.
+func (a Algorithm) CanCombine() bool {
+	return a == CRC32 || a == CRC32C || a == CRC64NVME
+}
+
+// Part is the size and the base64 encoded checksum of a part.
+type Part struct {
+	Size     int64
+	Checksum string
+}
+
+// Result are the checksums of an object.
+type Result struct {
+	Algorithm Algorithm
+	// Size of the object.
+	Size int64
+	// FullObject is the checksum of the content of the object.
+	FullObject string
+	// Composite is the checksum of the checksums of the parts followed
+	// by the number of parts, e.g. "<checksum>-3", also for objects
+	// uploaded in a single part. It is empty if the object is not
+	// uploaded in parts.
+	Composite string
+	// Parts are the checksums of the parts.
+	Parts []Part
+}
+
+// Matches tells whether s is a checksum of the object, i.e. its full
+// object checksum or its composite checksum.
+func (r Result) Matches(s string) bool {
+	if s == "" {
+		return false
+	}
+	return s == r.FullObject || s == r.Composite
+}
+
+// Compute reads r and returns its checksums of the algorithm as if it was
+// uploaded in parts of partSize bytes, the last part may be smaller. It
+// is uploaded with a single PUT if partSize is not positive.
+func Compute(r io.Reader, a Algorithm, partSize int64) (Result, error) {
+	full, part := a.New(), a.New()
+	if full == nil {
+		return Result{}, errors.New("checksum: invalid algorithm")
+	}
+	res := Result{Algorithm: a}
+	if partSize <= 0 {
+		n, err := io.Copy(full, r)
+		if err != nil {
+			return Result{}, err
+		}
+		res.Size = n
+		res.FullObject = base64.StdEncoding.EncodeToString(full.Sum(nil))
+		return res, nil
+	}
+
+	w := io.MultiWriter(full, part)
+	for {
+		n, err := io.CopyN(w, r, partSize)
+		if n > 0 || len(res.Parts) == 0 {
+			res.Size += n
+			res.Parts = append(res.Parts, Part{Size: n, Checksum: base64.StdEncoding.EncodeToString(part.Sum(nil))})
+			part.Reset()
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Result{}, err
+		}
+	}
+	res.FullObject = base64.StdEncoding.EncodeToString(full.Sum(nil))
+	// Uploads of a single part have a composite checksum too, "<checksum>-1".
+	var err error
+	if res.Composite, err = CompositeChecksum(a, res.Parts); err != nil {
+		return Result{}, err
+	}
+	return res, nil
+}
+
+// ComputeFile returns the checksums of the file, see Compute.
+func ComputeFile(path string, a Algorithm, partSize int64) (Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Result{}, err
+	}
+	defer f.Close()
+	return Compute(f, a, partSize)
+}
+
+// CompositeChecksum returns the composite checksum of the parts, the
+// checksum of their checksums followed by their number, e.g.
+// "<checksum>-3".
+func CompositeChecksum(a Algorithm, parts []Part) (string, error) {
+	h := a.New()
+	if h == nil {
+		return "", errors.New("checksum: invalid algorithm")
+	}
+	for i, part := range parts {
+		b, err := base64.StdEncoding.DecodeString(part.Checksum)
+		if err != nil || len(b) != h.Size() {
+			return "", errors.New("checksum: invalid checksum of part " + strconv.Itoa(i+1))
+		}
+		h.Write(b)
+	}
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)) + "-" + strconv.Itoa(len(parts)), nil
+}
+
+// FullObjectChecksum returns the checksum of the content of the parts
+// combined from their checksums, only the CRC algorithms can be
+// combined.
+func FullObjectChecksum(a Algorithm, parts []Part) (string, error) {
+	if !a.CanCombine() {
+		return "", errors.New("checksum: " + a.String() + " cannot be combined")
+	}
+	if len(parts) == 0 {
+		return "", errors.New("checksum: no parts")
+	}
+	size := a.New().Size()
+	var combined uint64
+	for i, part := range parts {
+		b, err := base64.StdEncoding.DecodeString(part.Checksum)
+		if err != nil || len(b) != size {
+			return "", errors.New("checksum: invalid checksum of part " + strconv.Itoa(i+1))
+		}
+		switch a {
+		case CRC32:
+			combined = uint64(CRC32Combine(crc32.IEEE, uint32(combined), binary.BigEndian.Uint32(b), part.Size))
+		case CRC32C:
+			combined = uint64(CRC32Combine(crc32.Castagnoli, uint32(combined), binary.BigEndian.Uint32(b), part.Size))
+		case CRC64NVME:
+			combined = CRC64Combine(bits.Reverse64(crc64NVMEPolynomial), combined, binary.BigEndian.Uint64(b), part.Size)
+		}
+	}
+	out := make([]byte, 8)
+	binary.BigEndian.PutUint64(out, combined)
+	return base64.StdEncoding.EncodeToString(out[8-size:]), nil
+}
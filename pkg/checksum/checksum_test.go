@@ -0,0 +1,122 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checksum
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 1000)
+	sum := func(a Algorithm, b []byte) string {
+		h := a.New()
+		h.Write(b)
+		return base64.StdEncoding.EncodeToString(h.Sum(nil))
+	}
+
+	testCases := []struct {
+		alg      Algorithm
+		partSize int64
+		parts    int
+	}{
+		{alg: CRC32, partSize: 5000, parts: 4},
+		{alg: CRC32C, partSize: 4000, parts: 4},
+		{alg: CRC64NVME, partSize: 3000, parts: 6},
+		{alg: SHA1, partSize: 7000, parts: 3},
+		// Objects fitting a part are uploaded in one part.
+		{alg: SHA256, partSize: 16000, parts: 1},
+		{alg: CRC32, partSize: 20000, parts: 1},
+		{alg: CRC32C, partSize: 0, parts: 0},
+	}
+	for i, testCase := range testCases {
+		res, err := Compute(bytes.NewReader(data), testCase.alg, testCase.partSize)
+		if err != nil {
+			t.Fatalf("test %d: %v", i+1, err)
+		}
+		if res.Size != int64(len(data)) {
+			t.Errorf("test %d: expected size %d, got %d", i+1, len(data), res.Size)
+		}
+		if want := sum(testCase.alg, data); res.FullObject != want {
+			t.Errorf("test %d: expected full object checksum %s, got %s", i+1, want, res.FullObject)
+		}
+		if len(res.Parts) != testCase.parts {
+			t.Fatalf("test %d: expected %d parts, got %d", i+1, testCase.parts, len(res.Parts))
+		}
+		if testCase.parts == 0 {
+			if res.Composite != "" {
+				t.Errorf("test %d: unexpected composite checksum %s", i+1, res.Composite)
+			}
+			continue
+		}
+
+		var sums []byte
+		for j := 0; j < len(data); j += int(testCase.partSize) {
+			b, _ := base64.StdEncoding.DecodeString(sum(testCase.alg, data[j:min(j+int(testCase.partSize), len(data))]))
+			sums = append(sums, b...)
+		}
+		want := sum(testCase.alg, sums) + "-" + string(rune('0'+testCase.parts))
+		if res.Composite != want {
+			t.Errorf("test %d: expected composite checksum %s, got %s", i+1, want, res.Composite)
+		}
+		if !res.Matches(want) || !res.Matches(res.FullObject) || res.Matches("") {
+			t.Errorf("test %d: unexpected matches", i+1)
+		}
+
+		full, err := FullObjectChecksum(testCase.alg, res.Parts)
+		if testCase.alg.CanCombine() {
+			if err != nil || full != res.FullObject {
+				t.Errorf("test %d: expected combined checksum %s, got %s, %v", i+1, res.FullObject, full, err)
+			}
+		} else if err == nil {
+			t.Errorf("test %d: expected %s not to be combined", i+1, testCase.alg)
+		}
+	}
+}
+
+func TestComputeFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "object")
+	if err := os.WriteFile(path, []byte("hello world"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	res, err := ComputeFile(path, CRC32C, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(res.Composite, "-2") || res.Parts[0].Size != 6 || res.Parts[1].Size != 5 {
+		t.Errorf("unexpected result %+v", res)
+	}
+	if _, err = ComputeFile(path, Algorithm(0), 6); err == nil {
+		t.Error("expected invalid algorithm to fail")
+	}
+}
+
+func TestParseAlgorithm(t *testing.T) {
+	for a := CRC32; a <= SHA256; a++ {
+		if got, err := ParseAlgorithm(strings.ToLower(a.String())); err != nil || got != a {
+			t.Errorf("expected %s, got %s, %v", a, got, err)
+		}
+	}
+	if _, err := ParseAlgorithm("MD5"); err == nil {
+		t.Error("expected MD5 to be unknown")
+	}
+}
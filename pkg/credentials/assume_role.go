@@ -18,7 +18,6 @@
 package credentials
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/xml"
@@ -191,32 +190,8 @@ func getAssumeRoleCredentials(clnt *http.Client, endpoint string, opts STSAssume
 	}
 	req = signer.SignV4STS(*req, opts.AccessKey, opts.SecretKey, opts.Location)
 
-	resp, err := clnt.Do(req)
-	if err != nil {
-		return AssumeRoleResponse{}, err
-	}
-	defer closeResponse(resp)
-	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		buf, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return AssumeRoleResponse{}, err
-		}
-		_, err = xmlDecodeAndBody(bytes.NewReader(buf), &errResp)
-		if err != nil {
-			var s3Err Error
-			if _, err = xmlDecodeAndBody(bytes.NewReader(buf), &s3Err); err != nil {
-				return AssumeRoleResponse{}, err
-			}
-			errResp.RequestID = s3Err.RequestID
-			errResp.STSError.Code = s3Err.Code
-			errResp.STSError.Message = s3Err.Message
-		}
-		return AssumeRoleResponse{}, errResp
-	}
-
 	a := AssumeRoleResponse{}
-	if _, err = xmlDecodeAndBody(resp.Body, &a); err != nil {
+	if err = STSRequest(clnt, req, &a); err != nil {
 		return AssumeRoleResponse{}, err
 	}
 	return a, nil
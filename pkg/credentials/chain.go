@@ -17,6 +17,12 @@
 
 package credentials
 
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
 // A Chain will search for a provider which returns credentials
 // and cache that provider until Retrieve is called again.
 //
@@ -104,3 +110,154 @@ func (c *Chain) IsExpired() bool {
 
 	return true
 }
+
+// ChainProvider is a provider of a chain created by NewChainWithOptions.
+type ChainProvider struct {
+	Provider Provider
+
+	// Timeout of a retrieval of the provider, overrides the
+	// ChainOptions Timeout. A provider whose retrieval timed
+	// out is skipped until the retrieval has returned.
+	Timeout time.Duration
+}
+
+// ChainOptions configures a chain created by NewChainWithOptions.
+type ChainOptions struct {
+	// Providers in the order they are tried, the first provider
+	// returning non-anonymous credentials is used.
+	Providers []ChainProvider
+
+	// Timeout of a retrieval for providers without their own
+	// timeout, unlimited if zero.
+	Timeout time.Duration
+
+	// ExpiryWindow if set refreshes credentials with an expiration
+	// this long before they expire, instead of relying on the
+	// expiry tracking of the provider. Credentials without an
+	// expiration are still tracked by their provider.
+	ExpiryWindow time.Duration
+
+	// OnRefresh is called with the new credentials after every
+	// successful retrieval. It is called while the credentials are
+	// locked and must not call the Credentials.
+	OnRefresh func(Value)
+}
+
+// NewChainWithOptions returns a pointer to a new Credentials object wrapping
+// a chain of providers configured by opts.
+//
+//	creds := credentials.NewChainWithOptions(credentials.ChainOptions{
+//	    Providers: []credentials.ChainProvider{
+//	        {Provider: &credentials.EnvAWS{}},
+//	        {Provider: &credentials.IAM{}, Timeout: 5 * time.Second},
+//	    },
+//	    ExpiryWindow: time.Minute,
+//	    OnRefresh: func(v credentials.Value) {
+//	        log.Println("credentials rotated, expiring at", v.Expiration)
+//	    },
+//	})
+func NewChainWithOptions(opts ChainOptions) *Credentials {
+	c := &optionsChain{
+		opts:    opts,
+		running: make([]chan struct{}, len(opts.Providers)),
+	}
+	c.opts.Providers = append([]ChainProvider{}, opts.Providers...)
+	return New(c)
+}
+
+type optionsChain struct {
+	Expiry
+
+	opts ChainOptions
+	curr Provider
+
+	// running holds the pending retrieval of a provider
+	// that timed out, closed once it has returned.
+	mu      sync.Mutex
+	running []chan struct{}
+}
+
+func (c *optionsChain) RetrieveWithCredContext(cc *CredContext) (Value, error) {
+	for i, p := range c.opts.Providers {
+		creds, err := c.retrieve(i, p, cc)
+		// Always prioritize non-anonymous providers, if any.
+		if err != nil || (creds.AccessKeyID == "" && creds.SecretAccessKey == "") {
+			continue
+		}
+		c.curr = p.Provider
+		if c.opts.ExpiryWindow > 0 && !creds.Expiration.IsZero() {
+			c.SetExpiration(creds.Expiration, c.opts.ExpiryWindow)
+		} else {
+			c.expiration = time.Time{}
+		}
+		if c.opts.OnRefresh != nil {
+			c.opts.OnRefresh(creds)
+		}
+		return creds, nil
+	}
+	c.curr = nil
+	// At this point we have exhausted all the providers and
+	// are left without any credentials return anonymous.
+	return Value{
+		SignerType: SignatureAnonymous,
+	}, nil
+}
+
+// retrieve retrieves the credentials of the i-th provider, bounded by
+// its timeout.
+func (c *optionsChain) retrieve(i int, p ChainProvider, cc *CredContext) (Value, error) {
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = c.opts.Timeout
+	}
+	if timeout <= 0 {
+		return p.Provider.RetrieveWithCredContext(cc)
+	}
+
+	c.mu.Lock()
+	if running := c.running[i]; running != nil {
+		select {
+		case <-running:
+		default:
+			c.mu.Unlock()
+			return Value{}, fmt.Errorf("credentials provider %T is still retrieving", p.Provider)
+		}
+	}
+	done := make(chan struct{})
+	c.running[i] = done
+	c.mu.Unlock()
+
+	var (
+		creds Value
+		err   error
+	)
+	go func() {
+		defer close(done)
+		creds, err = p.Provider.RetrieveWithCredContext(cc)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return creds, err
+	case <-timer.C:
+		return Value{}, fmt.Errorf("credentials provider %T timed out after %s", p.Provider, timeout)
+	}
+}
+
+func (c *optionsChain) Retrieve() (Value, error) {
+	return c.RetrieveWithCredContext(nil)
+}
+
+// IsExpired returns the expired state of the credentials if an expiry
+// window is configured and they expire, or else of the current provider.
+func (c *optionsChain) IsExpired() bool {
+	if c.curr == nil {
+		return true
+	}
+	if !c.expiration.IsZero() {
+		return c.Expiry.IsExpired()
+	}
+	return c.curr.IsExpired()
+}
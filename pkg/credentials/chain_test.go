@@ -20,6 +20,7 @@ package credentials
 import (
 	"errors"
 	"testing"
+	"time"
 )
 
 type testCredProvider struct {
@@ -148,3 +149,87 @@ func TestChainProviderWithNoValidProvider(t *testing.T) {
 		}
 	}
 }
+
+type blockingCredProvider struct {
+	release chan struct{}
+}
+
+func (s *blockingCredProvider) Retrieve() (Value, error) {
+	return s.RetrieveWithCredContext(nil)
+}
+
+func (s *blockingCredProvider) RetrieveWithCredContext(_ *CredContext) (Value, error) {
+	<-s.release
+	return Value{AccessKeyID: "BLOCKED", SecretAccessKey: "BLOCKED"}, nil
+}
+
+func (s *blockingCredProvider) IsExpired() bool {
+	return false
+}
+
+func TestChainWithOptions(t *testing.T) {
+	blocking := &blockingCredProvider{release: make(chan struct{})}
+	defer close(blocking.release)
+
+	expiration := time.Now().Add(time.Hour)
+	var refreshed []Value
+	creds := NewChainWithOptions(ChainOptions{
+		Providers: []ChainProvider{
+			{Provider: blocking, Timeout: 10 * time.Millisecond},
+			{Provider: &testCredProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", Expiration: expiration}}},
+		},
+		ExpiryWindow: 2 * time.Hour,
+		OnRefresh:    func(v Value) { refreshed = append(refreshed, v) },
+	})
+
+	v, err := creds.GetWithContext(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "AKID" {
+		t.Fatalf("Expected credentials of the second provider, got %s", v.AccessKeyID)
+	}
+	if len(refreshed) != 1 || refreshed[0].AccessKeyID != "AKID" {
+		t.Fatalf("Expected one refresh notification, got %v", refreshed)
+	}
+
+	// The expiry window is larger than the lifetime of the credentials.
+	if !creds.IsExpired() {
+		t.Fatal("Expected credentials to be expired by the expiry window")
+	}
+	if _, err = creds.GetWithContext(nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(refreshed) != 2 {
+		t.Fatalf("Expected two refresh notifications, got %d", len(refreshed))
+	}
+}
+
+func TestChainWithOptionsExpiryWindow(t *testing.T) {
+	testCases := []struct {
+		expiration time.Time
+		window     time.Duration
+		expired    bool
+	}{
+		// The expiry window replaces the expiry tracking of the provider.
+		{time.Now().Add(time.Hour), time.Minute, false},
+		{time.Now().Add(time.Hour), 2 * time.Hour, true},
+		// Without a window or an expiration the provider is used.
+		{time.Now().Add(time.Hour), 0, true},
+		{time.Time{}, time.Minute, true},
+	}
+	for i, testCase := range testCases {
+		provider := &testCredProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET", Expiration: testCase.expiration}}
+		creds := NewChainWithOptions(ChainOptions{
+			Providers:    []ChainProvider{{Provider: provider}},
+			ExpiryWindow: testCase.window,
+		})
+		if _, err := creds.GetWithContext(nil); err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		provider.expired = true
+		if expired := creds.IsExpired(); expired != testCase.expired {
+			t.Errorf("Test %d: expected expired %t, got %t", i+1, testCase.expired, expired)
+		}
+	}
+}
@@ -18,15 +18,13 @@
 package credentials
 
 import (
-	"errors"
+	"context"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/go-ini/ini"
-	"github.com/minio/minio-go/v7/internal/json"
 )
 
 // A externalProcessCredentials stores the output of a credential_process
@@ -107,17 +105,9 @@ func (p *FileAWSCredentials) retrieve() (Value, error) {
 	// the external process
 	credentialProcess := strings.TrimSpace(iniProfile.Key("credential_process").String())
 	if credentialProcess != "" {
-		args := strings.Fields(credentialProcess)
-		if len(args) <= 1 {
-			return Value{}, errors.New("invalid credential process args")
-		}
-		cmd := exec.Command(args[0], args[1:]...)
-		out, err := cmd.Output()
-		if err != nil {
-			return Value{}, err
-		}
-		var externalProcessCredentials externalProcessCredentials
-		err = json.Unmarshal([]byte(out), &externalProcessCredentials)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		externalProcessCredentials, err := runCredentialProcess(ctx, credentialProcess, nil)
 		if err != nil {
 			return Value{}, err
 		}
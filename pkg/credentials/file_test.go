@@ -147,6 +147,30 @@ func TestFileAWS(t *testing.T) {
 	}
 }
 
+func TestFileAWSProcessWithoutVersion(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("\"/bin/cat\": file does not exist")
+	}
+	dir := t.TempDir()
+	output := filepath.Join(dir, "credentials.json")
+	if err := os.WriteFile(output, []byte(`{"AccessKeyId":"accessKey","SecretAccessKey":"secret"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(file, []byte("[default]\ncredential_process = /bin/cat "+output+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The output of processes written for older SDKs has no version.
+	credValues, err := NewFileAWSCredentials(file, "default").GetWithContext(defaultCredContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credValues.AccessKeyID != "accessKey" || credValues.SecretAccessKey != "secret" {
+		t.Errorf("Unexpected credentials %#v", credValues)
+	}
+}
+
 func TestFileMinioClient(t *testing.T) {
 	os.Clearenv()
 
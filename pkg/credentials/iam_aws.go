@@ -28,6 +28,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -73,13 +74,38 @@ type IAM struct {
 		RoleARN         string
 		RoleSessionName string
 	}
+
+	// EC2 instance metadata service (IMDS) settings - https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/configuring-instance-metadata-service.html
+	IMDS struct {
+		// TokenTTL of the IMDSv2 session token, defaults to 6 hours.
+		TokenTTL time.Duration
+
+		// TokenTimeout of the IMDSv2 token request, defaults to 1 second.
+		// The token request times out if the response hop limit of the
+		// instance is too low, e.g. in containers, which then falls back
+		// to IMDSv1 unless DisableFallback is set.
+		TokenTimeout time.Duration
+
+		// DisableFallback enforces IMDSv2, no IMDSv1 requests are made
+		// if the session token cannot be fetched. Can also be set with
+		// AWS_EC2_METADATA_V1_DISABLED=true.
+		DisableFallback bool
+
+		// EndpointMode is either "IPv4" (default) or "IPv6", selecting
+		// the default endpoint if Endpoint is not set. Can also be set
+		// with AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE.
+		EndpointMode string
+	}
 }
 
 // IAM Roles for Amazon EC2
 // http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html
 const (
 	DefaultIAMRoleEndpoint      = "http://169.254.169.254"
+	DefaultIAMRoleEndpointIPv6  = "http://[fd00:ec2::254]"
 	DefaultECSRoleEndpoint      = "http://169.254.170.2"
+	DefaultEKSPodIdentityHost   = "169.254.170.23"
+	DefaultEKSPodIdentityIPv6   = "fd00:ec2::23"
 	DefaultSTSRoleEndpoint      = "https://sts.amazonaws.com"
 	DefaultIAMSecurityCredsPath = "/latest/meta-data/iam/security-credentials/"
 	TokenRequestTTLHeader       = "X-aws-ec2-metadata-token-ttl-seconds"
@@ -108,7 +134,7 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 
 	tokenFile := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE")
 	if tokenFile == "" {
-		tokenFile = m.Container.AuthorizationToken
+		tokenFile = m.Container.AuthorizationTokenFile
 	}
 
 	relativeURI := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
@@ -157,15 +183,7 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 	switch {
 	case identityFile != "":
 		if len(endpoint) == 0 {
-			if region != "" {
-				if strings.HasPrefix(region, "cn-") {
-					endpoint = "https://sts." + region + ".amazonaws.com.cn"
-				} else {
-					endpoint = "https://sts." + region + ".amazonaws.com"
-				}
-			} else {
-				endpoint = DefaultSTSRoleEndpoint
-			}
+			endpoint = stsEndpointForRegion(region)
 		}
 
 		creds := &STSWebIdentity{
@@ -204,7 +222,7 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 		if len(endpoint) == 0 {
 			endpoint = fullURI
 			var ok bool
-			if ok, err = isLoopback(endpoint); !ok {
+			if ok, err = isAllowedContainerHost(endpoint); !ok {
 				if err == nil {
 					err = fmt.Errorf("uri host is not a loopback address: %s", endpoint)
 				}
@@ -214,8 +232,11 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 
 		roleCreds, err = getEcsTaskCredentials(client, endpoint, token)
 
+	case strings.EqualFold(os.Getenv("AWS_EC2_METADATA_DISABLED"), "true"):
+		return Value{}, errors.New("EC2 instance metadata service is disabled")
+
 	default:
-		roleCreds, err = getCredentials(client, endpoint)
+		roleCreds, err = getCredentials(client, endpoint, m.imdsOptions())
 	}
 
 	if err != nil {
@@ -233,6 +254,36 @@ func (m *IAM) RetrieveWithCredContext(cc *CredContext) (Value, error) {
 	}, nil
 }
 
+// imdsOptions returns the IMDS settings, overridden by the environment.
+func (m *IAM) imdsOptions() imdsOptions {
+	opts := imdsOptions{
+		tokenTTL:        m.IMDS.TokenTTL,
+		tokenTimeout:    m.IMDS.TokenTimeout,
+		disableFallback: m.IMDS.DisableFallback,
+		endpoint:        os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"),
+	}
+	if strings.EqualFold(os.Getenv("AWS_EC2_METADATA_V1_DISABLED"), "true") {
+		opts.disableFallback = true
+	}
+	if opts.tokenTTL <= 0 {
+		opts.tokenTTL = 6 * time.Hour
+	}
+	if opts.tokenTimeout <= 0 {
+		opts.tokenTimeout = time.Second
+	}
+	mode := os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE")
+	if mode == "" {
+		mode = m.IMDS.EndpointMode
+	}
+	if opts.endpoint == "" {
+		opts.endpoint = DefaultIAMRoleEndpoint
+		if strings.EqualFold(mode, "IPv6") {
+			opts.endpoint = DefaultIAMRoleEndpointIPv6
+		}
+	}
+	return opts
+}
+
 // Retrieve retrieves credentials from the EC2 service.
 // Error will be returned if the request fails, or unable to extract
 // the desired
@@ -343,15 +394,22 @@ func getEKSPodIdentityCredentials(client *http.Client, endpoint string, tokenFil
 	return ec2RoleCredRespBody{}, fmt.Errorf("getEKSPodIdentityCredentials: no tokenFile found")
 }
 
-func fetchIMDSToken(client *http.Client, endpoint string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+type imdsOptions struct {
+	endpoint        string
+	tokenTTL        time.Duration
+	tokenTimeout    time.Duration
+	disableFallback bool
+}
+
+func fetchIMDSToken(client *http.Client, endpoint string, opts imdsOptions) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), opts.tokenTimeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint+TokenPath, nil)
 	if err != nil {
 		return "", err
 	}
-	req.Header.Add(TokenRequestTTLHeader, TokenTTL)
+	req.Header.Add(TokenRequestTTLHeader, strconv.Itoa(int(opts.tokenTTL/time.Second)))
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -372,19 +430,19 @@ func fetchIMDSToken(client *http.Client, endpoint string) (string, error) {
 //
 // If the credentials cannot be found, or there is an error
 // reading the response an error will be returned.
-func getCredentials(client *http.Client, endpoint string) (ec2RoleCredRespBody, error) {
+func getCredentials(client *http.Client, endpoint string, opts imdsOptions) (ec2RoleCredRespBody, error) {
 	if endpoint == "" {
-		endpoint = DefaultIAMRoleEndpoint
+		endpoint = opts.endpoint
 	}
 
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/configuring-instance-metadata-service.html
-	token, err := fetchIMDSToken(client, endpoint)
+	token, err := fetchIMDSToken(client, endpoint, opts)
 	if err != nil {
 		// Return only errors for valid situations, if the IMDSv2 is not enabled
 		// we will not be able to get the token, in such a situation we have
 		// to rely on IMDSv1 behavior as a fallback, this check ensures that.
 		// Refer https://github.com/minio/minio-go/issues/1866
-		if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		if opts.disableFallback || (!errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled)) {
 			return ec2RoleCredRespBody{}, err
 		}
 	}
@@ -446,6 +504,22 @@ func getCredentials(client *http.Client, endpoint string) (ec2RoleCredRespBody,
 	return respCreds, nil
 }
 
+// isAllowedContainerHost identifies if a uri's host is a loopback address
+// or one of the ECS and EKS Pod Identity agent addresses.
+// https://docs.aws.amazon.com/sdkref/latest/guide/feature-container-credentials.html
+func isAllowedContainerHost(uri string) (bool, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false, err
+	}
+	ecsHost := strings.TrimPrefix(DefaultECSRoleEndpoint, "http://")
+	switch u.Hostname() {
+	case ecsHost, DefaultEKSPodIdentityHost, DefaultEKSPodIdentityIPv6:
+		return true, nil
+	}
+	return isLoopback(uri)
+}
+
 // isLoopback identifies if a uri's host is on a loopback address
 func isLoopback(uri string) (bool, error) {
 	u, err := url.Parse(uri)
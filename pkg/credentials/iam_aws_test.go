@@ -420,3 +420,52 @@ func TestIMDSv1Blocked(t *testing.T) {
 		t.Errorf("Unexpected IMDSv2 failure %s", err)
 	}
 }
+
+func TestIMDSv2DisableFallback(t *testing.T) {
+	// The token request never completes, as with a too low hop limit.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			<-r.Context().Done()
+			return
+		}
+		switch r.URL.Path {
+		case "/latest/meta-data/iam/security-credentials/":
+			fmt.Fprintln(w, "RoleName")
+		case "/latest/meta-data/iam/security-credentials/RoleName":
+			fmt.Fprintf(w, credsRespTmpl, "2014-12-16T01:51:37Z")
+		default:
+			http.Error(w, "bad request", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	p := &IAM{Endpoint: server.URL}
+	p.IMDS.TokenTimeout = 10 * time.Millisecond
+	if _, err := p.RetrieveWithCredContext(defaultCredContext); err != nil {
+		t.Fatalf("Unexpected IMDSv1 fallback failure %s", err)
+	}
+
+	p.IMDS.DisableFallback = true
+	if _, err := p.RetrieveWithCredContext(defaultCredContext); err == nil {
+		t.Fatal("Expected failure with IMDSv1 fallback disabled")
+	}
+}
+
+func TestIsAllowedContainerHost(t *testing.T) {
+	testCases := []struct {
+		uri     string
+		allowed bool
+	}{
+		{"http://169.254.170.2/v2/credentials", true},
+		{"http://169.254.170.23/v1/credentials", true},
+		{"http://[fd00:ec2::23]/v1/credentials", true},
+		{"http://127.0.0.1:8080/creds", true},
+		{"http://169.254.169.254/creds", false},
+	}
+	for _, tc := range testCases {
+		ok, _ := isAllowedContainerHost(tc.uri)
+		if ok != tc.allowed {
+			t.Errorf("%s: expected %v, got %v", tc.uri, tc.allowed, ok)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/internal/json"
+)
+
+// A Process retrieves credentials from an external command implementing
+// the AWS credential_process contract, the command prints a JSON document
+// with Version 1 (or no Version), AccessKeyId, SecretAccessKey and optionally SessionToken
+// and Expiration. Credentials without Expiration never expire.
+//
+// https://docs.aws.amazon.com/sdkref/latest/guide/feature-process-credentials.html
+type Process struct {
+	Expiry
+
+	// Command line of the process, arguments are separated by spaces
+	// and may be quoted with single or double quotes.
+	Command string
+
+	// Timeout of the process, defaults to 1 minute.
+	Timeout time.Duration
+
+	// Env of the process, the environment of the current
+	// process is inherited if empty.
+	Env []string
+}
+
+// NewProcess returns a pointer to a new Credentials object wrapping
+// the Process provider running command.
+func NewProcess(command string) *Credentials {
+	return New(&Process{Command: command})
+}
+
+// RetrieveWithCredContext is like Retrieve, cred context is no-op for
+// process credentials.
+func (p *Process) RetrieveWithCredContext(_ *CredContext) (Value, error) {
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = time.Minute
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	creds, err := runCredentialProcess(ctx, p.Command, p.Env)
+	if err != nil {
+		return Value{}, err
+	}
+	if creds.Expiration.IsZero() {
+		// Never expires.
+		p.SetExpiration(time.Unix(1<<62, 0), 0)
+	} else {
+		p.SetExpiration(creds.Expiration, DefaultExpiryWindow)
+	}
+	return Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		Expiration:      creds.Expiration,
+		SignerType:      SignatureV4,
+	}, nil
+}
+
+// Retrieve runs the process and returns its credentials.
+func (p *Process) Retrieve() (Value, error) {
+	return p.RetrieveWithCredContext(nil)
+}
+
+// runCredentialProcess runs command and parses its output.
+func runCredentialProcess(ctx context.Context, command string, env []string) (externalProcessCredentials, error) {
+	args, err := splitCommand(command)
+	if err != nil {
+		return externalProcessCredentials{}, err
+	}
+	if len(args) == 0 {
+		return externalProcessCredentials{}, errors.New("invalid credential process args")
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	if len(env) > 0 {
+		cmd.Env = env
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return externalProcessCredentials{}, fmt.Errorf("credential process failed: %w: %s", err, msg)
+		}
+		return externalProcessCredentials{}, fmt.Errorf("credential process failed: %w", err)
+	}
+
+	var creds externalProcessCredentials
+	if err = json.Unmarshal(out, &creds); err != nil {
+		return externalProcessCredentials{}, fmt.Errorf("invalid credential process output: %w", err)
+	}
+	// Version is often omitted by processes written for older SDKs.
+	if creds.Version != 0 && creds.Version != 1 {
+		return externalProcessCredentials{}, fmt.Errorf("unsupported credential process output version %d", creds.Version)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return externalProcessCredentials{}, errors.New("credential process returned no credentials")
+	}
+	return creds, nil
+}
+
+// splitCommand splits a command line into its arguments, honoring
+// single and double quotes and backslash escapes outside single quotes.
+func splitCommand(command string) ([]string, error) {
+	var (
+		args    []string
+		arg     strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range command {
+		switch {
+		case escaped:
+			arg.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("invalid credential process command: unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,68 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestSplitCommand(t *testing.T) {
+	testCases := []struct {
+		command string
+		args    []string
+		success bool
+	}{
+		{"/bin/cat credentials.json", []string{"/bin/cat", "credentials.json"}, true},
+		{"broker", []string{"broker"}, true},
+		{`broker --profile "my profile"  'x y'`, []string{"broker", "--profile", "my profile", "x y"}, true},
+		{`broker a\ b ""`, []string{"broker", "a b", ""}, true},
+		{`broker "unterminated`, nil, false},
+	}
+	for i, tc := range testCases {
+		args, err := splitCommand(tc.command)
+		if (err == nil) != tc.success {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if tc.success && !reflect.DeepEqual(args, tc.args) {
+			t.Fatalf("Test %d: expected %q, got %q", i+1, tc.args, args)
+		}
+	}
+}
+
+func TestProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no /bin/cat on windows")
+	}
+	creds := NewProcess("/bin/cat credentials.json")
+	v, err := creds.GetWithContext(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "accessKey" || v.SecretAccessKey != "secret" || v.SessionToken != "token" {
+		t.Fatalf("Unexpected credentials %#v", v)
+	}
+	if creds.IsExpired() {
+		t.Fatal("Expected credentials to be valid")
+	}
+
+	if _, err = NewProcess("/bin/false").GetWithContext(nil); err == nil {
+		t.Fatal("Expected failing process to fail")
+	}
+}
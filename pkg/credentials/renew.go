@@ -0,0 +1,118 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"context"
+	"time"
+)
+
+// RenewOptions configures StartRenewal.
+type RenewOptions struct {
+	// Before is how long before their expiration the credentials are
+	// renewed. If zero, or not shorter than the lifetime of the
+	// credentials, the credentials are renewed when 80% of their
+	// lifetime has passed.
+	Before time.Duration
+
+	// RetryInterval is the delay before retrying a failed renewal,
+	// defaults to 10 seconds. It is also the shortest delay between
+	// renewals.
+	RetryInterval time.Duration
+
+	// OnRenew is called with the renewed credentials.
+	OnRenew func(Value)
+
+	// OnError is called when a renewal fails. The current credentials
+	// are kept until they expire.
+	OnError func(error)
+
+	// CredContext used to retrieve the credentials.
+	CredContext *CredContext
+}
+
+// StartRenewal renews the credentials in the background before they expire,
+// so requests never wait for a refresh, until ctx is canceled. Renewal stops
+// if the provider returns credentials without expiration.
+func (c *Credentials) StartRenewal(ctx context.Context, opts RenewOptions) {
+	if opts.RetryInterval <= 0 {
+		opts.RetryInterval = 10 * time.Second
+	}
+	go c.renewLoop(ctx, opts)
+}
+
+func (c *Credentials) renewLoop(ctx context.Context, opts RenewOptions) {
+	value, err := c.GetWithContext(opts.CredContext)
+	for {
+		var wait time.Duration
+		switch {
+		case err != nil:
+			if opts.OnError != nil {
+				opts.OnError(err)
+			}
+			wait = opts.RetryInterval
+		case value.Expiration.IsZero():
+			return
+		default:
+			lifetime := time.Until(value.Expiration)
+			if opts.Before > 0 && opts.Before < lifetime {
+				wait = lifetime - opts.Before
+			} else {
+				wait = time.Duration(float64(lifetime) * defaultExpiryWindow)
+			}
+			// Credentials expiring too soon must not be renewed in
+			// a tight loop.
+			wait = max(wait, opts.RetryInterval)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		var renewed Value
+		if renewed, err = c.renew(opts.CredContext); err == nil {
+			value = renewed
+			if opts.OnRenew != nil {
+				opts.OnRenew(value)
+			}
+		}
+	}
+}
+
+// renew retrieves new credentials, the current credentials
+// are kept if the provider fails.
+func (c *Credentials) renew(cc *CredContext) (Value, error) {
+	if cc == nil {
+		cc = defaultCredContext
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	creds, err := c.provider.RetrieveWithCredContext(cc)
+	if err != nil {
+		return Value{}, err
+	}
+	c.creds = creds
+	c.forceRefresh = false
+	return creds, nil
+}
@@ -0,0 +1,120 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type renewProvider struct {
+	Expiry
+	calls atomic.Int32
+}
+
+func (p *renewProvider) RetrieveWithCredContext(_ *CredContext) (Value, error) {
+	n := p.calls.Add(1)
+	if n == 2 {
+		return Value{}, errors.New("renewal failed")
+	}
+	expiration := time.Now().Add(50 * time.Millisecond)
+	p.SetExpiration(expiration, 0)
+	return Value{
+		AccessKeyID:     "access-" + strconv.Itoa(int(n)),
+		SecretAccessKey: "secret",
+		Expiration:      expiration,
+		SignerType:      SignatureV4,
+	}, nil
+}
+
+func (p *renewProvider) Retrieve() (Value, error) {
+	return p.RetrieveWithCredContext(nil)
+}
+
+func TestStartRenewal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	renewed := make(chan Value, 1)
+	failed := make(chan error, 1)
+	c := New(&renewProvider{})
+	c.StartRenewal(ctx, RenewOptions{
+		Before:        20 * time.Millisecond,
+		RetryInterval: 5 * time.Millisecond,
+		OnRenew: func(v Value) {
+			select {
+			case renewed <- v:
+			default:
+			}
+		},
+		OnError: func(err error) {
+			select {
+			case failed <- err:
+			default:
+			}
+		},
+	})
+
+	select {
+	case err := <-failed:
+		if err.Error() != "renewal failed" {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Renewal error not reported")
+	}
+
+	select {
+	case v := <-renewed:
+		if v.AccessKeyID != "access-3" {
+			t.Fatalf("Unexpected renewed credentials %s", v.AccessKeyID)
+		}
+		// The renewed credentials are returned without retrieval.
+		got, err := c.GetWithContext(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.AccessKeyID == "access-1" {
+			t.Fatalf("Credentials were not renewed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Credentials not renewed")
+	}
+}
+
+func TestStartRenewalBeforeLifetime(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Renewing earlier than the lifetime of the credentials falls
+	// back to renewing when 80% of their lifetime has passed.
+	p := &renewProvider{}
+	c := New(p)
+	c.StartRenewal(ctx, RenewOptions{
+		Before:        time.Hour,
+		RetryInterval: 5 * time.Millisecond,
+	})
+	time.Sleep(200 * time.Millisecond)
+	if n := p.calls.Load(); n < 2 || n > 10 {
+		t.Fatalf("Expected a few renewals, got %d", n)
+	}
+}
@@ -0,0 +1,342 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-ini/ini"
+	"github.com/minio/minio-go/v7/internal/json"
+)
+
+// A SSO retrieves credentials of an AWS IAM Identity Center (SSO) role,
+// using the access token cached by `aws sso login` in ~/.aws/sso/cache.
+// Expired access tokens are refreshed with the cached OIDC refresh token
+// and written back to the cache.
+type SSO struct {
+	Expiry
+
+	// Optional http Client to use when connecting to the SSO portal.
+	// (overrides default client in CredContext)
+	Client *http.Client
+
+	// StartURL of the AWS access portal (sso_start_url).
+	StartURL string
+
+	// Region of the SSO portal (sso_region).
+	Region string
+
+	// AccountID and RoleName of the role (sso_account_id, sso_role_name).
+	AccountID string
+	RoleName  string
+
+	// SessionName of the sso-session section, if the profile
+	// refers to one. The token cache is keyed by it.
+	SessionName string
+
+	// CacheDir of the token cache, defaults to ~/.aws/sso/cache.
+	CacheDir string
+
+	// PortalEndpoint and OIDCEndpoint override the regional
+	// AWS endpoints.
+	PortalEndpoint string
+	OIDCEndpoint   string
+}
+
+// NewSSO returns a pointer to a new Credentials object wrapping the SSO
+// provider, configured by profile of the AWS config file. If filename is
+// empty AWS_CONFIG_FILE or ~/.aws/config is used, if profile is empty
+// AWS_PROFILE or "default".
+func NewSSO(filename, profile string) (*Credentials, error) {
+	p, err := loadSSOProfile(filename, profile)
+	if err != nil {
+		return nil, err
+	}
+	return New(p), nil
+}
+
+func loadSSOProfile(filename, profile string) (*SSO, error) {
+	if filename == "" {
+		filename = os.Getenv("AWS_CONFIG_FILE")
+		if filename == "" {
+			homeDir, err := os.UserHomeDir()
+			if err != nil {
+				return nil, err
+			}
+			filename = filepath.Join(homeDir, ".aws", "config")
+		}
+	}
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+		if profile == "" {
+			profile = "default"
+		}
+	}
+
+	config, err := ini.Load(filename)
+	if err != nil {
+		return nil, err
+	}
+	section, err := config.GetSection("profile " + profile)
+	if err != nil && profile == "default" {
+		section, err = config.GetSection(profile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := &SSO{
+		StartURL:    section.Key("sso_start_url").String(),
+		Region:      section.Key("sso_region").String(),
+		AccountID:   section.Key("sso_account_id").String(),
+		RoleName:    section.Key("sso_role_name").String(),
+		SessionName: section.Key("sso_session").String(),
+	}
+	if p.SessionName != "" {
+		session, err := config.GetSection("sso-session " + p.SessionName)
+		if err != nil {
+			return nil, err
+		}
+		p.StartURL = session.Key("sso_start_url").String()
+		p.Region = session.Key("sso_region").String()
+	}
+	if p.StartURL == "" || p.Region == "" || p.AccountID == "" || p.RoleName == "" {
+		return nil, fmt.Errorf("profile %s is not an SSO profile", profile)
+	}
+	return p, nil
+}
+
+// ssoToken is the token cache file written by the AWS CLI.
+type ssoToken struct {
+	StartURL              string `json:"startUrl,omitempty"`
+	Region                string `json:"region,omitempty"`
+	AccessToken           string `json:"accessToken"`
+	ExpiresAt             string `json:"expiresAt"`
+	ClientID              string `json:"clientId,omitempty"`
+	ClientSecret          string `json:"clientSecret,omitempty"`
+	RegistrationExpiresAt string `json:"registrationExpiresAt,omitempty"`
+	RefreshToken          string `json:"refreshToken,omitempty"`
+}
+
+func parseSSOTime(s string) (time.Time, error) {
+	// Older versions of the AWS CLI use "UTC" instead of "Z".
+	return time.Parse(time.RFC3339, strings.Replace(s, "UTC", "Z", 1))
+}
+
+func (p *SSO) cacheFile() (string, error) {
+	dir := p.CacheDir
+	if dir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(homeDir, ".aws", "sso", "cache")
+	}
+	key := p.StartURL
+	if p.SessionName != "" {
+		key = p.SessionName
+	}
+	sum := sha1.Sum([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+// accessToken returns the cached access token, refreshed if expired.
+func (p *SSO) accessToken(client *http.Client) (string, error) {
+	file, err := p.cacheFile()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("SSO token cache not found, run aws sso login: %w", err)
+	}
+	var token ssoToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return "", err
+	}
+	expiresAt, err := parseSSOTime(token.ExpiresAt)
+	if err != nil {
+		return "", err
+	}
+	if p.CurrentTime == nil {
+		p.CurrentTime = time.Now
+	}
+	// Refresh tokens shortly before they expire.
+	if expiresAt.After(p.CurrentTime().Add(5 * time.Minute)) {
+		return token.AccessToken, nil
+	}
+
+	if token.RefreshToken == "" || token.ClientID == "" || token.ClientSecret == "" {
+		return "", errors.New("SSO access token expired, run aws sso login")
+	}
+	if regExpiresAt, err := parseSSOTime(token.RegistrationExpiresAt); err == nil && regExpiresAt.Before(p.CurrentTime()) {
+		return "", errors.New("SSO client registration expired, run aws sso login")
+	}
+	if err = p.refreshToken(client, &token); err != nil {
+		return "", err
+	}
+	// Update the refreshed fields only, keeping the fields of the cache
+	// unknown to ssoToken, e.g. those written by newer AWS CLIs.
+	var cache map[string]any
+	if err = json.Unmarshal(data, &cache); err != nil {
+		return "", err
+	}
+	cache["accessToken"] = token.AccessToken
+	cache["expiresAt"] = token.ExpiresAt
+	cache["refreshToken"] = token.RefreshToken
+	if data, err = json.Marshal(cache); err != nil {
+		return "", err
+	}
+	// The refresh token may have been rotated, the previous one is no
+	// longer valid.
+	if err = os.WriteFile(file, data, 0o600); err != nil {
+		return "", fmt.Errorf("SSO token cache update failed: %w", err)
+	}
+	return token.AccessToken, nil
+}
+
+func (p *SSO) refreshToken(client *http.Client, token *ssoToken) error {
+	endpoint := p.OIDCEndpoint
+	if endpoint == "" {
+		endpoint = "https://oidc." + p.Region + ".amazonaws.com"
+	}
+	body, err := json.Marshal(map[string]string{
+		"clientId":     token.ClientID,
+		"clientSecret": token.ClientSecret,
+		"grantType":    "refresh_token",
+		"refreshToken": token.RefreshToken,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(endpoint, "/")+"/token", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return fmt.Errorf("SSO token refresh failed: %s: %s", resp.Status, msg)
+	}
+
+	var result struct {
+		AccessToken  string `json:"accessToken"`
+		ExpiresIn    int64  `json:"expiresIn"`
+		RefreshToken string `json:"refreshToken"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	token.AccessToken = result.AccessToken
+	token.ExpiresAt = p.CurrentTime().Add(time.Duration(result.ExpiresIn) * time.Second).UTC().Format(time.RFC3339)
+	if result.RefreshToken != "" {
+		token.RefreshToken = result.RefreshToken
+	}
+	return nil
+}
+
+// RetrieveWithCredContext is like Retrieve with optional cred context.
+func (p *SSO) RetrieveWithCredContext(cc *CredContext) (Value, error) {
+	if cc == nil {
+		cc = defaultCredContext
+	}
+	client := p.Client
+	if client == nil {
+		client = cc.Client
+	}
+	if client == nil {
+		client = defaultCredContext.Client
+	}
+
+	accessToken, err := p.accessToken(client)
+	if err != nil {
+		return Value{}, err
+	}
+
+	endpoint := p.PortalEndpoint
+	if endpoint == "" {
+		endpoint = "https://portal.sso." + p.Region + ".amazonaws.com"
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/") + "/federation/credentials")
+	if err != nil {
+		return Value{}, err
+	}
+	q := url.Values{}
+	q.Set("account_id", p.AccountID)
+	q.Set("role_name", p.RoleName)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Value{}, err
+	}
+	req.Header.Set("x-amz-sso_bearer_token", accessToken)
+	resp, err := client.Do(req)
+	if err != nil {
+		return Value{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return Value{}, fmt.Errorf("SSO GetRoleCredentials failed: %s: %s", resp.Status, msg)
+	}
+
+	var result struct {
+		RoleCredentials struct {
+			AccessKeyID     string `json:"accessKeyId"`
+			SecretAccessKey string `json:"secretAccessKey"`
+			SessionToken    string `json:"sessionToken"`
+			Expiration      int64  `json:"expiration"` // Unix milliseconds
+		} `json:"roleCredentials"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Value{}, err
+	}
+
+	expiration := time.UnixMilli(result.RoleCredentials.Expiration)
+	p.SetExpiration(expiration, DefaultExpiryWindow)
+	return Value{
+		AccessKeyID:     result.RoleCredentials.AccessKeyID,
+		SecretAccessKey: result.RoleCredentials.SecretAccessKey,
+		SessionToken:    result.RoleCredentials.SessionToken,
+		Expiration:      expiration,
+		SignerType:      SignatureV4,
+	}, nil
+}
+
+// Retrieve retrieves credentials from the SSO portal.
+// Error will be returned if the request fails.
+func (p *SSO) Retrieve() (Value, error) {
+	return p.RetrieveWithCredContext(nil)
+}
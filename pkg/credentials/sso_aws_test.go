@@ -0,0 +1,118 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/internal/json"
+)
+
+func TestSSO(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config")
+	err := os.WriteFile(config, []byte(`[profile dev]
+sso_session = my-sso
+sso_account_id = 123456789012
+sso_role_name = ReadOnly
+
+[sso-session my-sso]
+sso_start_url = https://example.awsapps.com/start
+sso_region = us-east-1
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			fmt.Fprint(w, `{"accessToken":"refreshed","expiresIn":3600,"refreshToken":"refresh2","tokenType":"Bearer"}`)
+		case "/federation/credentials":
+			if r.Header.Get("x-amz-sso_bearer_token") != "refreshed" {
+				http.Error(w, "invalid token", http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Query().Get("account_id") != "123456789012" || r.URL.Query().Get("role_name") != "ReadOnly" {
+				http.Error(w, "invalid role", http.StatusForbidden)
+				return
+			}
+			fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"accessKey","secretAccessKey":"secret","sessionToken":"token","expiration":%d}}`, expiration.UnixMilli())
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	p, err := loadSSOProfile(config, "dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.CacheDir = dir
+	p.PortalEndpoint = server.URL
+	p.OIDCEndpoint = server.URL
+
+	// Write an expired token that must be refreshed.
+	cacheFile, err := p.cacheFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(cacheFile, []byte(`{"startUrl":"https://example.awsapps.com/start","region":"us-east-1","accessToken":"expired",
+"expiresAt":"2020-01-01T00:00:00Z","clientId":"client","clientSecret":"secret","refreshToken":"refresh1","unknown":{"key":"value"}}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := p.RetrieveWithCredContext(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "accessKey" || v.SecretAccessKey != "secret" || v.SessionToken != "token" {
+		t.Fatalf("Unexpected credentials %#v", v)
+	}
+	if !v.Expiration.Equal(expiration) {
+		t.Fatalf("Expected expiration %s, got %s", expiration, v.Expiration)
+	}
+
+	data, err := os.ReadFile(cacheFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var token ssoToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "refreshed" || token.RefreshToken != "refresh2" || token.ClientID != "client" {
+		t.Fatalf("Expected the refreshed token to be cached, got %#v", token)
+	}
+	// Fields unknown to the client are kept.
+	var cache map[string]any
+	if err = json.Unmarshal(data, &cache); err != nil {
+		t.Fatal(err)
+	}
+	if unknown, ok := cache["unknown"].(map[string]any); !ok || unknown["key"] != "value" {
+		t.Fatalf("Expected unknown fields to be kept, got %v", cache)
+	}
+}
@@ -18,11 +18,9 @@
 package credentials
 
 import (
-	"bytes"
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -126,32 +124,8 @@ func getClientGrantsCredentials(clnt *http.Client, endpoint string,
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := clnt.Do(req)
-	if err != nil {
-		return AssumeRoleWithClientGrantsResponse{}, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		buf, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return AssumeRoleWithClientGrantsResponse{}, err
-		}
-		_, err = xmlDecodeAndBody(bytes.NewReader(buf), &errResp)
-		if err != nil {
-			var s3Err Error
-			if _, err = xmlDecodeAndBody(bytes.NewReader(buf), &s3Err); err != nil {
-				return AssumeRoleWithClientGrantsResponse{}, err
-			}
-			errResp.RequestID = s3Err.RequestID
-			errResp.STSError.Code = s3Err.Code
-			errResp.STSError.Message = s3Err.Message
-		}
-		return AssumeRoleWithClientGrantsResponse{}, errResp
-	}
-
 	a := AssumeRoleWithClientGrantsResponse{}
-	if err = xml.NewDecoder(resp.Body).Decode(&a); err != nil {
+	if err = STSRequest(clnt, req, &a); err != nil {
 		return AssumeRoleWithClientGrantsResponse{}, err
 	}
 	return a, nil
@@ -70,6 +70,10 @@ type CustomTokenIdentity struct {
 	// (this value bounded by server).
 	RequestedExpiry time.Duration
 
+	// Session policy to apply to the generated credentials. Leave empty to
+	// use the full access policy available to the identity.
+	Policy string
+
 	// Optional, used for token revokation
 	TokenRevokeType string
 }
@@ -101,6 +105,9 @@ func (c *CustomTokenIdentity) RetrieveWithCredContext(cc *CredContext) (value Va
 	if c.RequestedExpiry != 0 {
 		v.Set("DurationSeconds", fmt.Sprintf("%d", int(c.RequestedExpiry.Seconds())))
 	}
+	if c.Policy != "" {
+		v.Set("Policy", c.Policy)
+	}
 	if c.TokenRevokeType != "" {
 		v.Set("TokenRevokeType", c.TokenRevokeType)
 	}
@@ -120,18 +127,8 @@ func (c *CustomTokenIdentity) RetrieveWithCredContext(cc *CredContext) (value Va
 		client = defaultCredContext.Client
 	}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return value, err
-	}
-
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return value, errors.New(resp.Status)
-	}
-
 	r := AssumeRoleWithCustomTokenResponse{}
-	if err = xml.NewDecoder(resp.Body).Decode(&r); err != nil {
+	if err = STSRequest(client, req, &r); err != nil {
 		return
 	}
 
@@ -177,3 +174,10 @@ func CustomTokenValidityOpt(d time.Duration) CustomTokenOpt {
 		c.RequestedExpiry = d
 	}
 }
+
+// CustomTokenPolicyOpt sets the session policy for requested credentials.
+func CustomTokenPolicyOpt(policy string) CustomTokenOpt {
+	return func(c *CustomTokenIdentity) {
+		c.Policy = policy
+	}
+}
@@ -18,11 +18,9 @@
 package credentials
 
 import (
-	"bytes"
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -174,33 +172,8 @@ func (k *LDAPIdentity) RetrieveWithCredContext(cc *CredContext) (value Value, er
 		client = defaultCredContext.Client
 	}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return value, err
-	}
-
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		buf, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return value, err
-		}
-		_, err = xmlDecodeAndBody(bytes.NewReader(buf), &errResp)
-		if err != nil {
-			var s3Err Error
-			if _, err = xmlDecodeAndBody(bytes.NewReader(buf), &s3Err); err != nil {
-				return value, err
-			}
-			errResp.RequestID = s3Err.RequestID
-			errResp.STSError.Code = s3Err.Code
-			errResp.STSError.Message = s3Err.Message
-		}
-		return value, errResp
-	}
-
 	r := AssumeRoleWithLDAPResponse{}
-	if err = xml.NewDecoder(resp.Body).Decode(&r); err != nil {
+	if err = STSRequest(client, req, &r); err != nil {
 		return
 	}
 
@@ -0,0 +1,65 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// maxSTSResponseSize is the largest STS response read.
+const maxSTSResponseSize = 10 << 20
+
+// STSRequest sends the STS request req with clnt and decodes the response
+// into result. Failed requests return an ErrorResponse, decoded from the
+// STS error or from the S3 error MinIO may reply with.
+func STSRequest(clnt *http.Client, req *http.Request, result interface{}) error {
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return err
+	}
+	defer closeResponse(resp)
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSTSResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return decodeSTSError(resp, body)
+	}
+	return xmlDecoder(bytes.NewReader(body), result)
+}
+
+func decodeSTSError(resp *http.Response, body []byte) error {
+	var errResp ErrorResponse
+	if err := xmlDecoder(bytes.NewReader(body), &errResp); err == nil {
+		return errResp
+	}
+	var s3Err Error
+	if err := xmlDecoder(bytes.NewReader(body), &s3Err); err == nil && s3Err.Code != "" {
+		errResp.RequestID = s3Err.RequestID
+		errResp.STSError.Code = s3Err.Code
+		errResp.STSError.Message = s3Err.Message
+		return errResp
+	}
+	errResp.STSError.Code = strconv.Itoa(resp.StatusCode)
+	errResp.STSError.Message = resp.Status
+	return errResp
+}
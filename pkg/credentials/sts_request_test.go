@@ -0,0 +1,81 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSTSRequest(t *testing.T) {
+	testCases := []struct {
+		status    int
+		body      string
+		code      string
+		requestID string
+	}{
+		{
+			status: http.StatusOK,
+			body:   `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials><AccessKeyId>AKID</AccessKeyId></Credentials></AssumeRoleResult></AssumeRoleResponse>`,
+		},
+		{
+			status:    http.StatusForbidden,
+			body:      `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><Error><Code>AccessDenied</Code></Error><RequestId>1234</RequestId></ErrorResponse>`,
+			code:      "AccessDenied",
+			requestID: "1234",
+		},
+		// MinIO may reply with a S3 error.
+		{
+			status:    http.StatusBadRequest,
+			body:      `<Error><Code>InvalidParameterValue</Code><RequestId>5678</RequestId></Error>`,
+			code:      "InvalidParameterValue",
+			requestID: "5678",
+		},
+		{
+			status: http.StatusBadGateway,
+			body:   "bad gateway",
+			code:   "502",
+		},
+	}
+	for i, testCase := range testCases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(testCase.status)
+			w.Write([]byte(testCase.body))
+		}))
+		req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp AssumeRoleResponse
+		err = STSRequest(srv.Client(), req, &resp)
+		srv.Close()
+
+		if testCase.code == "" {
+			if err != nil || resp.Result.Credentials.AccessKey != "AKID" {
+				t.Errorf("Test %d: unexpected response %+v: %v", i+1, resp, err)
+			}
+			continue
+		}
+		var errResp ErrorResponse
+		if !errors.As(err, &errResp) || errResp.STSError.Code != testCase.code || errResp.RequestID != testCase.requestID {
+			t.Errorf("Test %d: unexpected error %#v", i+1, err)
+		}
+	}
+}
@@ -16,12 +16,10 @@
 package credentials
 
 import (
-	"bytes"
 	"crypto/tls"
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -157,42 +155,8 @@ func (i *STSCertificateIdentity) RetrieveWithCredContext(cc *CredContext) (Value
 	clientCopy := *client
 	clientCopy.Transport = trCopy
 
-	resp, err := clientCopy.Do(req)
-	if err != nil {
-		return Value{}, err
-	}
-	if resp.Body != nil {
-		defer resp.Body.Close()
-	}
-	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		buf, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return Value{}, err
-		}
-		_, err = xmlDecodeAndBody(bytes.NewReader(buf), &errResp)
-		if err != nil {
-			var s3Err Error
-			if _, err = xmlDecodeAndBody(bytes.NewReader(buf), &s3Err); err != nil {
-				return Value{}, err
-			}
-			errResp.RequestID = s3Err.RequestID
-			errResp.STSError.Code = s3Err.Code
-			errResp.STSError.Message = s3Err.Message
-		}
-		return Value{}, errResp
-	}
-
-	const MaxSize = 10 * 1 << 20
-	var body io.Reader = resp.Body
-	if resp.ContentLength > 0 && resp.ContentLength < MaxSize {
-		body = io.LimitReader(body, resp.ContentLength)
-	} else {
-		body = io.LimitReader(body, MaxSize)
-	}
-
 	var response assumeRoleWithCertificateResponse
-	if err = xml.NewDecoder(body).Decode(&response); err != nil {
+	if err = STSRequest(&clientCopy, req, &response); err != nil {
 		return Value{}, err
 	}
 	i.SetExpiration(response.Result.Credentials.Expiration, DefaultExpiryWindow)
@@ -18,11 +18,9 @@
 package credentials
 
 import (
-	"bytes"
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -187,33 +185,8 @@ func getWebIdentityCredentials(clnt *http.Client, endpoint, roleARN, roleSession
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := clnt.Do(req)
-	if err != nil {
-		return AssumeRoleWithWebIdentityResponse{}, err
-	}
-
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		buf, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return AssumeRoleWithWebIdentityResponse{}, err
-		}
-		_, err = xmlDecodeAndBody(bytes.NewReader(buf), &errResp)
-		if err != nil {
-			var s3Err Error
-			if _, err = xmlDecodeAndBody(bytes.NewReader(buf), &s3Err); err != nil {
-				return AssumeRoleWithWebIdentityResponse{}, err
-			}
-			errResp.RequestID = s3Err.RequestID
-			errResp.STSError.Code = s3Err.Code
-			errResp.STSError.Message = s3Err.Message
-		}
-		return AssumeRoleWithWebIdentityResponse{}, errResp
-	}
-
 	a := AssumeRoleWithWebIdentityResponse{}
-	if err = xml.NewDecoder(resp.Body).Decode(&a); err != nil {
+	if err = STSRequest(clnt, req, &a); err != nil {
 		return AssumeRoleWithWebIdentityResponse{}, err
 	}
 
@@ -0,0 +1,169 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// A WebIdentityTokenFile retrieves credentials by exchanging the web identity
+// token stored in a file with STS AssumeRoleWithWebIdentity, which is the
+// flow used by Kubernetes service accounts (EKS IRSA). The token file is read
+// on every refresh, so rotated tokens are picked up.
+//
+// Credentials are refreshed ahead of their expiry, randomized by Jitter so
+// that many clients do not refresh at the same time.
+type WebIdentityTokenFile struct {
+	Expiry
+
+	// Optional http Client to use when connecting to STS.
+	// (overrides default client in CredContext)
+	Client *http.Client
+
+	// STSEndpoint to exchange the token, defaults to the regional
+	// AWS STS endpoint of Region or the global AWS STS endpoint.
+	STSEndpoint string
+
+	// Region of the AWS STS endpoint, defaults to AWS_REGION.
+	Region string
+
+	// TokenFile is the path of the token, defaults to
+	// AWS_WEB_IDENTITY_TOKEN_FILE.
+	TokenFile string
+
+	// RoleARN is the role to assume, defaults to AWS_ROLE_ARN.
+	RoleARN string
+
+	// RoleSessionName defaults to AWS_ROLE_SESSION_NAME or
+	// a generated name.
+	RoleSessionName string
+
+	// Policy is the policy where the credentials should be limited too.
+	Policy string
+
+	// DurationSeconds of the credentials, the STS default if zero.
+	DurationSeconds int
+
+	// ExpiryWindow is how long before the expiration the credentials
+	// are refreshed. If zero the credentials are refreshed when 80% of
+	// their lifetime has passed.
+	ExpiryWindow time.Duration
+
+	// Jitter adds a random duration up to Jitter to ExpiryWindow.
+	Jitter time.Duration
+}
+
+// NewWebIdentityTokenFile returns a pointer to a new Credentials object
+// wrapping the WebIdentityTokenFile, configured from the environment
+// variables AWS_WEB_IDENTITY_TOKEN_FILE, AWS_ROLE_ARN, AWS_ROLE_SESSION_NAME
+// and AWS_REGION unless overridden by opts.
+func NewWebIdentityTokenFile(opts ...func(*WebIdentityTokenFile)) *Credentials {
+	p := &WebIdentityTokenFile{
+		TokenFile:       os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"),
+		RoleARN:         os.Getenv("AWS_ROLE_ARN"),
+		RoleSessionName: os.Getenv("AWS_ROLE_SESSION_NAME"),
+		Region:          os.Getenv("AWS_REGION"),
+		Jitter:          time.Minute,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return New(p)
+}
+
+// RetrieveWithCredContext is like Retrieve with optional cred context.
+func (m *WebIdentityTokenFile) RetrieveWithCredContext(cc *CredContext) (Value, error) {
+	if cc == nil {
+		cc = defaultCredContext
+	}
+	if m.TokenFile == "" {
+		return Value{}, errors.New("web identity token file unknown")
+	}
+
+	client := m.Client
+	if client == nil {
+		client = cc.Client
+	}
+	if client == nil {
+		client = defaultCredContext.Client
+	}
+
+	endpoint := m.STSEndpoint
+	if endpoint == "" {
+		endpoint = stsEndpointForRegion(m.Region)
+	}
+
+	a, err := getWebIdentityCredentials(client, endpoint, m.RoleARN, m.RoleSessionName, m.Policy, func() (*WebIdentityToken, error) {
+		token, err := os.ReadFile(m.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		return &WebIdentityToken{
+			Token:  strings.TrimSpace(string(token)),
+			Expiry: m.DurationSeconds,
+		}, nil
+	}, "")
+	if err != nil {
+		return Value{}, err
+	}
+
+	expiration := a.Result.Credentials.Expiration
+	window := m.ExpiryWindow
+	if window <= 0 {
+		if m.CurrentTime == nil {
+			m.CurrentTime = time.Now
+		}
+		window = time.Duration(float64(expiration.Sub(m.CurrentTime())) * (1 - defaultExpiryWindow))
+	}
+	if m.Jitter > 0 {
+		window += rand.N(m.Jitter)
+	}
+	m.SetExpiration(expiration, window)
+
+	return Value{
+		AccessKeyID:     a.Result.Credentials.AccessKey,
+		SecretAccessKey: a.Result.Credentials.SecretKey,
+		SessionToken:    a.Result.Credentials.SessionToken,
+		Expiration:      expiration,
+		SignerType:      SignatureV4,
+	}, nil
+}
+
+// Retrieve retrieves credentials from STS.
+// Error will be returned if the request fails.
+func (m *WebIdentityTokenFile) Retrieve() (Value, error) {
+	return m.RetrieveWithCredContext(nil)
+}
+
+// stsEndpointForRegion returns the AWS STS endpoint of region, or the
+// global endpoint if region is empty.
+func stsEndpointForRegion(region string) string {
+	switch {
+	case region == "":
+		return DefaultSTSRoleEndpoint
+	case strings.HasPrefix(region, "cn-"):
+		return "https://sts." + region + ".amazonaws.com.cn"
+	default:
+		return "https://sts." + region + ".amazonaws.com"
+	}
+}
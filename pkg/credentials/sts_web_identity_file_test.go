@@ -0,0 +1,70 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWebIdentityTokenFile(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiration := now.Add(time.Hour)
+	server := initStsTestServer(expiration.Format(time.RFC3339))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", tokenFile)
+	t.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/app")
+	creds := NewWebIdentityTokenFile(func(p *WebIdentityTokenFile) {
+		p.STSEndpoint = server.URL
+		p.ExpiryWindow = 5 * time.Minute
+		p.Jitter = time.Minute
+		p.CurrentTime = func() time.Time { return now }
+	})
+
+	v, err := creds.GetWithContext(defaultCredContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.AccessKeyID != "accessKey" || v.SecretAccessKey != "secret" || v.SessionToken != "token" {
+		t.Fatalf("Unexpected credentials %#v", v)
+	}
+	if !v.Expiration.Equal(expiration) {
+		t.Fatalf("Expected expiration %s, got %s", expiration, v.Expiration)
+	}
+
+	p := creds.provider.(*WebIdentityTokenFile)
+	refreshAt := p.expiration
+	if refreshAt.After(expiration.Add(-5*time.Minute)) || refreshAt.Before(expiration.Add(-6*time.Minute)) {
+		t.Fatalf("Unexpected refresh time %s for expiration %s", refreshAt, expiration)
+	}
+	if p.IsExpired() {
+		t.Fatal("Expected credentials to be valid")
+	}
+	p.CurrentTime = func() time.Time { return expiration.Add(-5 * time.Minute) }
+	if !p.IsExpired() {
+		t.Fatal("Expected credentials to be refreshed ahead of expiry")
+	}
+}
@@ -0,0 +1,415 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cse implements client-side envelope encryption of objects.
+//
+// Every object is encrypted with a random 256 bit data key using AES-GCM,
+// the data key is wrapped by a KeyProvider (static AES key, RSA key or a
+// KMS) and stored in the object metadata. The envelope uses the metadata
+// formats of the AWS S3 encryption clients: objects are written in the V2
+// format by default, readable by the S3 encryption clients v2 and v3, or in
+// the V3 format with key commitment, readable by the S3 encryption clients
+// v3. Both formats are read, legacy AES-CBC objects (V1 format) are not
+// supported.
+//
+// An object is a single AES-GCM message, it is not streamed: the whole
+// object is buffered in memory while encrypting and decrypting so that no
+// plaintext is released before the object has been authenticated. Every
+// PutObject and every Object being read holds up to the buffer size of the
+// client in memory, DefaultBufferSize by default, and objects larger than
+// the buffer size are rejected with ErrTooLarge, see Client.SetBufferSize.
+package cse
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/internal/json"
+)
+
+// Envelope metadata keys, stored as user metadata.
+const (
+	metaKeyV2             = "X-Amz-Meta-X-Amz-Key-V2"
+	metaIV                = "X-Amz-Meta-X-Amz-Iv"
+	metaCEKAlgorithm      = "X-Amz-Meta-X-Amz-Cek-Alg"
+	metaWrapAlgorithm     = "X-Amz-Meta-X-Amz-Wrap-Alg"
+	metaMatDesc           = "X-Amz-Meta-X-Amz-Matdesc"
+	metaTagLength         = "X-Amz-Meta-X-Amz-Tag-Len"
+	metaUnencryptedLength = "X-Amz-Meta-X-Amz-Unencrypted-Content-Length"
+	metaKeyV1             = "X-Amz-Meta-X-Amz-Key"
+
+	tagLengthBits = "128"
+)
+
+// DefaultBufferSize is the default size of the largest object encrypted
+// or decrypted by a Client.
+const DefaultBufferSize = 64 << 20
+
+// ErrNotEncrypted is returned when reading an object without an envelope.
+var ErrNotEncrypted = errors.New("cse: object is not client-side encrypted")
+
+// Format is the envelope format of the objects written by a Client.
+type Format int
+
+const (
+	// FormatV2 is the format of the S3 encryption clients v2.
+	FormatV2 Format = iota
+	// FormatV3 is the format of the S3 encryption clients v3, the data
+	// key is committed to, objects cannot be read by older clients.
+	FormatV3
+)
+
+// Client encrypts objects before uploading them and decrypts them
+// while downloading, using an inner client for all requests.
+type Client struct {
+	inner      *minio.Client
+	keys       KeyProvider
+	format     Format
+	bufferSize int64
+}
+
+// NewClient returns a client-side encrypting client.
+func NewClient(inner *minio.Client, keys KeyProvider) (*Client, error) {
+	if inner == nil {
+		return nil, errors.New("cse: client is required")
+	}
+	if keys == nil {
+		return nil, errors.New("cse: key provider is required")
+	}
+	return &Client{inner: inner, keys: keys, bufferSize: DefaultBufferSize}, nil
+}
+
+// SetFormat sets the envelope format of the objects written by the
+// client, FormatV2 by default. Objects of both formats are read.
+func (c *Client) SetFormat(format Format) {
+	c.format = format
+}
+
+// SetBufferSize sets the size of the largest object encrypted or
+// decrypted by the client, DefaultBufferSize by default. It bounds the
+// memory used by every PutObject and every Object being read, larger
+// objects are rejected with ErrTooLarge. The size is capped at the
+// AES-GCM limit of 64GiB.
+func (c *Client) SetBufferSize(size int64) {
+	c.bufferSize = min(size, gcmMaxSize)
+}
+
+// PutObject encrypts and uploads an object. The data is read entirely
+// into memory and encrypted before the upload, large objects are uploaded
+// with multipart uploads by the inner client. Pass size -1 if the size is
+// unknown. Objects larger than the buffer size are rejected with
+// ErrTooLarge, before any data is read if the size is known.
+func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	limit := c.bufferSize
+	if objectSize >= 0 {
+		if objectSize > limit {
+			return minio.UploadInfo{}, ErrTooLarge
+		}
+		limit = objectSize
+	}
+
+	contentAlgorithm := cekAlgorithm
+	if c.format == FormatV3 {
+		contentAlgorithm = cekAlgorithmV3
+	}
+	key, err := c.keys.GenerateDataKey(ctx, contentAlgorithm)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	matDesc, err := json.Marshal(key.MaterialDescription)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	if key.MaterialDescription == nil {
+		matDesc = []byte("{}")
+	}
+	opts.UserMetadata = maps.Clone(opts.UserMetadata)
+	if opts.UserMetadata == nil {
+		opts.UserMetadata = make(map[string]string, 7)
+	}
+
+	var ciphertext []byte
+	if c.format == FormatV3 {
+		wrapAlgorithm, ok := wrapAlgorithmsV3[key.WrapAlgorithm]
+		if !ok {
+			return minio.UploadInfo{}, errUnsupportedFormat("V3 key wrapping algorithm " + key.WrapAlgorithm)
+		}
+		messageID, err := randomBytes(messageIDSize)
+		if err != nil {
+			return minio.UploadInfo{}, err
+		}
+		contentKey, commitment, err := deriveKeysV3(key.Plaintext, messageID)
+		if err != nil {
+			return minio.UploadInfo{}, err
+		}
+		if ciphertext, err = seal(reader, limit, contentKey, nonceV3, algorithmSuiteV3); err != nil {
+			return minio.UploadInfo{}, err
+		}
+		opts.UserMetadata[metaContentCipherV3] = cekAlgorithmV3
+		opts.UserMetadata[metaEncryptedDataKeyV3] = base64.StdEncoding.EncodeToString(key.Encrypted)
+		opts.UserMetadata[metaWrapAlgorithmV3] = wrapAlgorithm
+		opts.UserMetadata[metaKeyCommitmentV3] = base64.StdEncoding.EncodeToString(commitment)
+		opts.UserMetadata[metaMessageIDV3] = base64.StdEncoding.EncodeToString(messageID)
+		// The encryption context of KMS keys is stored apart from
+		// the material description.
+		if key.WrapAlgorithm == WrapKMSContext {
+			opts.UserMetadata[metaEncContextV3] = string(matDesc)
+		} else {
+			opts.UserMetadata[metaMatDescV3] = string(matDesc)
+		}
+	} else {
+		nonce, err := randomBytes(gcmNonceSize)
+		if err != nil {
+			return minio.UploadInfo{}, err
+		}
+		if ciphertext, err = seal(reader, limit, key.Plaintext, nonce, nil); err != nil {
+			return minio.UploadInfo{}, err
+		}
+		opts.UserMetadata[metaKeyV2] = base64.StdEncoding.EncodeToString(key.Encrypted)
+		opts.UserMetadata[metaIV] = base64.StdEncoding.EncodeToString(nonce)
+		opts.UserMetadata[metaCEKAlgorithm] = cekAlgorithm
+		opts.UserMetadata[metaWrapAlgorithm] = key.WrapAlgorithm
+		opts.UserMetadata[metaMatDesc] = string(matDesc)
+		opts.UserMetadata[metaTagLength] = tagLengthBits
+	}
+
+	size := int64(len(ciphertext) - gcmTagSize)
+	if objectSize >= 0 && size != objectSize {
+		return minio.UploadInfo{}, io.ErrUnexpectedEOF
+	}
+	opts.UserMetadata[metaUnencryptedLength] = strconv.FormatInt(size, 10)
+	return c.inner.PutObject(ctx, bucketName, objectName, bytes.NewReader(ciphertext), int64(len(ciphertext)), opts)
+}
+
+// StatObject returns the information of an encrypted object, the size is
+// the size of the plaintext.
+func (c *Client) StatObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	info, err := c.inner.StatObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return info, err
+	}
+	if _, err = parseEnvelope(info.Metadata); err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	info.Size = plaintextSize(info)
+	return info, nil
+}
+
+// GetObject returns a reader of the decrypted object. Range requests are
+// not supported, the whole object is always read into memory and
+// authenticated before any plaintext is returned. Objects larger than the
+// buffer size are rejected with ErrTooLarge, before any data is read.
+func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (*Object, error) {
+	if opts.Header().Get("Range") != "" || opts.PartNumber > 0 {
+		return nil, errors.New("cse: range requests are not supported")
+	}
+	obj, err := c.inner.GetObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Object{ctx: ctx, keys: c.keys, obj: obj, bufferSize: c.bufferSize}, nil
+}
+
+// Object is the decrypting reader of an encrypted object. The object is
+// read and authenticated by the first Read, which returns the
+// authentication error ErrAuthentication if the object was modified.
+type Object struct {
+	ctx        context.Context
+	keys       KeyProvider
+	obj        *minio.Object
+	bufferSize int64
+
+	once  sync.Once
+	info  minio.ObjectInfo
+	key   []byte
+	nonce []byte
+	aad   []byte
+	err   error
+
+	// reader of the authenticated plaintext.
+	reader io.Reader
+}
+
+func (o *Object) init() {
+	o.once.Do(func() {
+		info, err := o.obj.Stat()
+		if err != nil {
+			o.err = err
+			return
+		}
+		env, err := parseEnvelope(info.Metadata)
+		if err != nil {
+			o.err = err
+			return
+		}
+		dataKey, err := o.keys.DecryptDataKey(o.ctx, env.key)
+		if err != nil {
+			o.err = err
+			return
+		}
+		o.key, o.nonce = dataKey, env.nonce
+		if env.key.ContentAlgorithm == cekAlgorithmV3 {
+			if o.key, o.err = openKeyV3(dataKey, env.messageID, env.commitment); o.err != nil {
+				return
+			}
+			o.nonce, o.aad = nonceV3, algorithmSuiteV3
+		}
+		info.Size = plaintextSize(info)
+		o.info = info
+	})
+}
+
+// Read reads the decrypted object.
+func (o *Object) Read(p []byte) (int, error) {
+	if o.init(); o.err != nil {
+		return 0, o.err
+	}
+	if o.reader == nil {
+		if o.info.Size > o.bufferSize {
+			o.err = ErrTooLarge
+			return 0, o.err
+		}
+		plaintext, err := open(o.obj, o.bufferSize, o.key, o.nonce, o.aad)
+		if err != nil {
+			o.err = err
+			return 0, err
+		}
+		o.reader = bytes.NewReader(plaintext)
+	}
+	return o.reader.Read(p)
+}
+
+// Stat returns the information of the object, the size is the
+// size of the plaintext.
+func (o *Object) Stat() (minio.ObjectInfo, error) {
+	if o.init(); o.err != nil {
+		return minio.ObjectInfo{}, o.err
+	}
+	return o.info, nil
+}
+
+// Close closes the object.
+func (o *Object) Close() error {
+	return o.obj.Close()
+}
+
+func errUnsupportedFormat(format string) error {
+	return fmt.Errorf("cse: unsupported encryption format: %s", format)
+}
+
+type envelope struct {
+	key   DataKey
+	nonce []byte
+
+	// messageID and commitment of the V3 format.
+	messageID  []byte
+	commitment []byte
+}
+
+func parseEnvelope(h http.Header) (envelope, error) {
+	if h.Get(metaKeyV2) == "" {
+		switch {
+		case h.Get(metaEncryptedDataKeyV3) != "":
+			return parseEnvelopeV3(h)
+		case h.Get(metaKeyV1) != "":
+			return envelope{}, errUnsupportedFormat("V1")
+		}
+		return envelope{}, ErrNotEncrypted
+	}
+	if alg := h.Get(metaCEKAlgorithm); alg != cekAlgorithm {
+		return envelope{}, errUnsupportedFormat(alg)
+	}
+	if tagLen := h.Get(metaTagLength); tagLen != "" && tagLen != tagLengthBits {
+		return envelope{}, errUnsupportedFormat("tag length " + tagLen)
+	}
+
+	var (
+		env envelope
+		err error
+	)
+	if env.key.Encrypted, err = base64.StdEncoding.DecodeString(h.Get(metaKeyV2)); err != nil {
+		return envelope{}, err
+	}
+	if env.nonce, err = base64.StdEncoding.DecodeString(h.Get(metaIV)); err != nil {
+		return envelope{}, err
+	}
+	if len(env.nonce) != gcmNonceSize {
+		return envelope{}, errors.New("cse: invalid envelope nonce")
+	}
+	env.key.ContentAlgorithm = cekAlgorithm
+	env.key.WrapAlgorithm = h.Get(metaWrapAlgorithm)
+	if matDesc := h.Get(metaMatDesc); matDesc != "" {
+		if err = json.Unmarshal([]byte(matDesc), &env.key.MaterialDescription); err != nil {
+			return envelope{}, err
+		}
+	}
+	return env, nil
+}
+
+func parseEnvelopeV3(h http.Header) (envelope, error) {
+	if alg := h.Get(metaContentCipherV3); alg != cekAlgorithmV3 {
+		return envelope{}, errUnsupportedFormat(alg)
+	}
+
+	var (
+		env envelope
+		err error
+	)
+	if env.key.Encrypted, err = base64.StdEncoding.DecodeString(h.Get(metaEncryptedDataKeyV3)); err != nil {
+		return envelope{}, err
+	}
+	if env.messageID, err = base64.StdEncoding.DecodeString(h.Get(metaMessageIDV3)); err != nil {
+		return envelope{}, err
+	}
+	if len(env.messageID) != messageIDSize {
+		return envelope{}, errors.New("cse: invalid envelope message ID")
+	}
+	if env.commitment, err = base64.StdEncoding.DecodeString(h.Get(metaKeyCommitmentV3)); err != nil {
+		return envelope{}, err
+	}
+	if len(env.commitment) != commitmentSize {
+		return envelope{}, errors.New("cse: invalid envelope key commitment")
+	}
+	env.key.ContentAlgorithm = cekAlgorithmV3
+	env.key.WrapAlgorithm = wrapAlgorithmFromV3(h.Get(metaWrapAlgorithmV3))
+	matDesc := h.Get(metaMatDescV3)
+	if env.key.WrapAlgorithm == WrapKMSContext {
+		matDesc = h.Get(metaEncContextV3)
+	}
+	if matDesc != "" {
+		if err = json.Unmarshal([]byte(matDesc), &env.key.MaterialDescription); err != nil {
+			return envelope{}, err
+		}
+	}
+	return env, nil
+}
+
+func plaintextSize(info minio.ObjectInfo) int64 {
+	if size, err := strconv.ParseInt(info.Metadata.Get(metaUnencryptedLength), 10, 64); err == nil {
+		return size
+	}
+	return max(info.Size-gcmTagSize, 0)
+}
@@ -0,0 +1,370 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cse
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type testKMS struct {
+	key KeyProvider
+}
+
+func (k testKMS) GenerateDataKey(ctx context.Context, _ string, _ map[string]string) ([]byte, []byte, error) {
+	dk, err := k.key.GenerateDataKey(ctx, cekAlgorithm)
+	return dk.Plaintext, dk.Encrypted, err
+}
+
+func (k testKMS) Decrypt(ctx context.Context, _ string, ciphertext []byte, _ map[string]string) ([]byte, error) {
+	return k.key.DecryptDataKey(ctx, DataKey{Encrypted: ciphertext, WrapAlgorithm: WrapAESGCM, ContentAlgorithm: cekAlgorithm})
+}
+
+func testKeyProviders(t *testing.T) map[string]KeyProvider {
+	static, err := NewStaticKeyProvider(make([]byte, 32), map[string]string{"name": "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaProvider, err := NewRSAKeyProvider(rsaKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kmsProvider, err := NewKMSKeyProvider(testKMS{static}, "my-key", map[string]string{"app": "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]KeyProvider{
+		WrapAESGCM:     static,
+		WrapRSAOAEP:    rsaProvider,
+		WrapKMSContext: kmsProvider,
+	}
+}
+
+func TestKeyProviders(t *testing.T) {
+	for name, p := range testKeyProviders(t) {
+		for _, alg := range []string{cekAlgorithm, cekAlgorithmV3} {
+			key, err := p.GenerateDataKey(context.Background(), alg)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if key.WrapAlgorithm != name || key.ContentAlgorithm != alg {
+				t.Fatalf("%s: unexpected algorithms %s %s", name, key.WrapAlgorithm, key.ContentAlgorithm)
+			}
+			wrapped := DataKey{
+				Encrypted:           key.Encrypted,
+				WrapAlgorithm:       key.WrapAlgorithm,
+				ContentAlgorithm:    key.ContentAlgorithm,
+				MaterialDescription: key.MaterialDescription,
+			}
+			plaintext, err := p.DecryptDataKey(context.Background(), wrapped)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !bytes.Equal(plaintext, key.Plaintext) {
+				t.Fatalf("%s: unwrapped key does not match", name)
+			}
+
+			// The wrapped key is bound to the content encryption algorithm.
+			wrapped.ContentAlgorithm = cekAlgorithm + cekAlgorithmV3
+			if _, err = p.DecryptDataKey(context.Background(), wrapped); !errors.Is(err, ErrAuthentication) {
+				t.Fatalf("%s: expected %v, got %v", name, ErrAuthentication, err)
+			}
+		}
+	}
+}
+
+// memoryServer stores objects along with their metadata.
+type memoryServer struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	headers map[string]http.Header
+}
+
+func (s *memoryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		h := make(http.Header)
+		for k, v := range r.Header {
+			if strings.HasPrefix(k, "X-Amz-Meta-") {
+				h[k] = v
+			}
+		}
+		s.objects[r.URL.Path] = data
+		s.headers[r.URL.Path] = h
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for k, v := range s.headers[r.URL.Path] {
+			w.Header()[k] = v
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func TestClient(t *testing.T) {
+	srv := &memoryServer{objects: map[string][]byte{}, headers: map[string]http.Header{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, err := minio.New(u.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4("", "", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext := make([]byte, 100000)
+	rand.Read(plaintext)
+
+	testCases := []struct {
+		format   Format
+		metaWrap string
+		wrapAlgs map[string]string
+	}{
+		{FormatV2, metaWrapAlgorithm, map[string]string{WrapAESGCM: WrapAESGCM, WrapRSAOAEP: WrapRSAOAEP, WrapKMSContext: WrapKMSContext}},
+		{FormatV3, metaWrapAlgorithmV3, wrapAlgorithmsV3},
+	}
+	for _, testCase := range testCases {
+		for wrapAlg, p := range testKeyProviders(t) {
+			name := fmt.Sprintf("V%d %s", testCase.format+2, wrapAlg)
+			c, err := NewClient(inner, p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.SetFormat(testCase.format)
+			ctx := context.Background()
+			if _, err = c.PutObject(ctx, "bucket", "object", bytes.NewReader(plaintext), int64(len(plaintext)), minio.PutObjectOptions{}); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if bytes.Contains(srv.objects["/bucket/object"], plaintext[:64]) {
+				t.Fatalf("%s: object stored in plaintext", name)
+			}
+			if v := srv.headers["/bucket/object"].Get(testCase.metaWrap); v != testCase.wrapAlgs[wrapAlg] {
+				t.Fatalf("%s: unexpected wrap algorithm metadata %q", name, v)
+			}
+
+			info, err := c.StatObject(ctx, "bucket", "object", minio.StatObjectOptions{})
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if info.Size != int64(len(plaintext)) {
+				t.Fatalf("%s: expected size %d, got %d", name, len(plaintext), info.Size)
+			}
+
+			obj, err := c.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{})
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			data, err := io.ReadAll(obj)
+			obj.Close()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !bytes.Equal(data, plaintext) {
+				t.Fatalf("%s: decrypted object does not match", name)
+			}
+
+			// No plaintext is released for a modified object.
+			srv.objects["/bucket/object"][10] ^= 1
+			obj, err = c.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{})
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if data, err = io.ReadAll(obj); !errors.Is(err, ErrAuthentication) || len(data) != 0 {
+				t.Fatalf("%s: expected %v, got %v", name, ErrAuthentication, err)
+			}
+			obj.Close()
+		}
+	}
+}
+
+func TestClientKeyCommitment(t *testing.T) {
+	srv := &memoryServer{objects: map[string][]byte{}, headers: map[string]http.Header{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, err := minio.New(u.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4("", "", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := NewStaticKeyProvider(make([]byte, 32), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(inner, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetFormat(FormatV3)
+
+	plaintext := []byte("committed object")
+	ctx := context.Background()
+	if _, err = c.PutObject(ctx, "bucket", "object", bytes.NewReader(plaintext), int64(len(plaintext)), minio.PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	h := srv.headers["/bucket/object"]
+	for _, key := range []string{metaContentCipherV3, metaEncryptedDataKeyV3, metaKeyCommitmentV3, metaMessageIDV3, metaMatDescV3} {
+		if h.Get(key) == "" {
+			t.Fatalf("missing envelope metadata %s", key)
+		}
+	}
+	if h.Get(metaKeyV2) != "" || h.Get(metaIV) != "" {
+		t.Fatal("unexpected V2 envelope metadata")
+	}
+
+	// A different commitment or message ID fails before any data is
+	// decrypted.
+	for _, key := range []string{metaKeyCommitmentV3, metaMessageIDV3} {
+		orig := h.Get(key)
+		tampered, _ := base64.StdEncoding.DecodeString(orig)
+		tampered[0] ^= 1
+		h.Set(key, base64.StdEncoding.EncodeToString(tampered))
+
+		obj, err := c.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = obj.Read(make([]byte, 1)); !errors.Is(err, ErrAuthentication) {
+			t.Fatalf("%s: expected %v, got %v", key, ErrAuthentication, err)
+		}
+		obj.Close()
+		h.Set(key, orig)
+	}
+
+	// Objects of both formats are read whatever the format of the client.
+	c.SetFormat(FormatV2)
+	obj, err := c.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil || !bytes.Equal(data, plaintext) {
+		t.Fatalf("unexpected object %q: %v", data, err)
+	}
+}
+
+func TestClientBufferSize(t *testing.T) {
+	srv := &memoryServer{objects: map[string][]byte{}, headers: map[string]http.Header{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, err := minio.New(u.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4("", "", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := NewStaticKeyProvider(make([]byte, 32), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(inner, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetBufferSize(10)
+
+	ctx := context.Background()
+	for _, size := range []int64{11, -1} {
+		if _, err = c.PutObject(ctx, "bucket", "object", strings.NewReader("larger object"), size, minio.PutObjectOptions{}); !errors.Is(err, ErrTooLarge) {
+			t.Fatalf("Size %d: expected %v, got %v", size, ErrTooLarge, err)
+		}
+	}
+	if len(srv.objects) != 0 {
+		t.Fatal("unexpected upload of a large object")
+	}
+
+	// Objects of unknown size record their plaintext size.
+	if _, err = c.PutObject(ctx, "bucket", "object", strings.NewReader("object"), -1, minio.PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if size := srv.headers["/bucket/object"].Get(metaUnencryptedLength); size != "6" {
+		t.Fatalf("unexpected plaintext size %q", size)
+	}
+
+	c.SetBufferSize(5)
+	obj, err := c.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	// The size of objects larger than the buffer size is still reported.
+	if info, err := obj.Stat(); err != nil || info.Size != 6 {
+		t.Fatalf("unexpected size %d: %v", info.Size, err)
+	}
+	if n, err := obj.Read(make([]byte, 10)); n != 0 || !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("expected %v, got %d bytes and %v", ErrTooLarge, n, err)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cse
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"io"
+)
+
+// AES-GCM as used by the S3 encryption clients encrypts the entire object
+// as a single GCM message with the tag appended. The object is buffered
+// and sealed or opened with crypto/cipher, no plaintext is released before
+// the object has been authenticated.
+
+const (
+	gcmNonceSize = 12
+	gcmTagSize   = 16
+
+	// GCM can encrypt at most 2^32 - 2 blocks with one key and nonce.
+	gcmMaxSize = (1<<32 - 2) * aes.BlockSize
+)
+
+// ErrAuthentication is returned when reading a decrypted object if the
+// ciphertext or the envelope was modified.
+var ErrAuthentication = errors.New("cse: message authentication failed")
+
+// ErrTooLarge is returned when writing or reading an object larger than
+// the buffer size of the client, see Client.SetBufferSize.
+var ErrTooLarge = errors.New("cse: object exceeds the buffer size of the client")
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// readAll reads src entirely, up to limit bytes.
+func readAll(src io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(src, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+// seal reads the plaintext of src, up to limit bytes, and returns it
+// encrypted with the authentication tag appended.
+func seal(src io.Reader, limit int64, key, nonce, aad []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := readAll(src, limit)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(plaintext[:0], nonce, plaintext, aad), nil
+}
+
+// open reads the ciphertext of src, up to limit bytes of plaintext, and
+// returns the plaintext once the authentication tag has been verified.
+func open(src io.Reader, limit int64, key, nonce, aad []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("cse: invalid AES-GCM nonce size")
+	}
+	ciphertext, err := readAll(src, limit+gcmTagSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcmTagSize {
+		return nil, io.ErrUnexpectedEOF
+	}
+	plaintext, err := aead.Open(ciphertext[:0], nonce, ciphertext, aad)
+	if err != nil {
+		return nil, ErrAuthentication
+	}
+	return plaintext, nil
+}
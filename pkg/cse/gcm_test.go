@@ -0,0 +1,83 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cse
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSealOpen(t *testing.T) {
+	key := make([]byte, 32)
+	nonce := make([]byte, gcmNonceSize)
+	rand.Read(key)
+	rand.Read(nonce)
+
+	for i, size := range []int{0, 1, 15, 16, 17, 100, 4096, 100000} {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+		// Additional data of various sizes.
+		aad := make([]byte, []int{0, 2, 16, 17}[i%4])
+		rand.Read(aad)
+
+		ciphertext, err := seal(iotest.HalfReader(bytes.NewReader(plaintext)), int64(size), key, nonce, aad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ciphertext) != size+gcmTagSize {
+			t.Fatalf("Size %d: unexpected ciphertext size %d", size, len(ciphertext))
+		}
+		decrypted, err := open(iotest.OneByteReader(bytes.NewReader(ciphertext)), int64(size), key, nonce, aad)
+		if err != nil {
+			t.Fatalf("Size %d: %v", size, err)
+		}
+		if !bytes.Equal(decrypted, plaintext) {
+			t.Fatalf("Size %d: decrypted plaintext does not match", size)
+		}
+
+		// No plaintext is returned for a modified ciphertext.
+		ciphertext[len(ciphertext)-1] ^= 1
+		if decrypted, err = open(bytes.NewReader(ciphertext), int64(size), key, nonce, aad); !errors.Is(err, ErrAuthentication) || decrypted != nil {
+			t.Fatalf("Size %d: expected %v, got %v", size, ErrAuthentication, err)
+		}
+	}
+}
+
+func TestSealOpenLimit(t *testing.T) {
+	key := make([]byte, 32)
+	nonce := make([]byte, gcmNonceSize)
+	plaintext := make([]byte, 100)
+
+	if _, err := seal(bytes.NewReader(plaintext), 99, key, nonce, nil); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("expected %v, got %v", ErrTooLarge, err)
+	}
+	ciphertext, err := seal(bytes.NewReader(plaintext), 100, key, nonce, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = open(bytes.NewReader(ciphertext), 99, key, nonce, nil); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("expected %v, got %v", ErrTooLarge, err)
+	}
+	if _, err = open(bytes.NewReader(ciphertext[:gcmTagSize-1]), 100, key, nonce, nil); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+}
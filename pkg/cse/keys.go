@@ -0,0 +1,254 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cse
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"maps"
+)
+
+// Key wrapping algorithms, as stored in the envelope.
+const (
+	WrapAESGCM     = "AES/GCM"
+	WrapRSAOAEP    = "RSA-OAEP-SHA1"
+	WrapKMSContext = "kms+context"
+)
+
+// cekAlgorithm is the content encryption algorithm of the V2 format.
+const cekAlgorithm = "AES/GCM/NoPadding"
+
+// kmsContextCEKAlgorithm is added to the KMS encryption context to bind
+// the wrapped key to the content encryption algorithm.
+const kmsContextCEKAlgorithm = "aws:x-amz-cek-alg"
+
+// DataKey is a content encryption key along with its wrapped form.
+type DataKey struct {
+	// Plaintext is the 256 bit content encryption key, it is
+	// never stored.
+	Plaintext []byte
+	// Encrypted is the wrapped key stored in the envelope.
+	Encrypted []byte
+	// WrapAlgorithm is one of WrapAESGCM, WrapRSAOAEP or WrapKMSContext.
+	WrapAlgorithm string
+	// ContentAlgorithm is the content encryption algorithm of the
+	// envelope, the wrapped key is bound to it.
+	ContentAlgorithm string
+	// MaterialDescription is stored in the envelope, for KMS keys it
+	// is the encryption context.
+	MaterialDescription map[string]string
+}
+
+// KeyProvider generates and unwraps the data keys of objects.
+type KeyProvider interface {
+	// GenerateDataKey returns a new random data key and its
+	// wrapped form, bound to the content encryption algorithm.
+	GenerateDataKey(ctx context.Context, contentAlgorithm string) (DataKey, error)
+
+	// DecryptDataKey unwraps the Encrypted key of an envelope,
+	// Plaintext is not set.
+	DecryptDataKey(ctx context.Context, key DataKey) ([]byte, error)
+}
+
+// NewStaticKeyProvider returns a KeyProvider wrapping data keys with a
+// static 256 bit AES key using AES-GCM. The material description is
+// stored in the envelope and can be used to identify the key.
+func NewStaticKeyProvider(key []byte, materialDescription map[string]string) (KeyProvider, error) {
+	if len(key) != 32 {
+		return nil, errors.New("cse: static key must be 256 bit long")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &staticKeyProvider{aead: aead, matDesc: maps.Clone(materialDescription)}, nil
+}
+
+type staticKeyProvider struct {
+	aead    cipher.AEAD
+	matDesc map[string]string
+}
+
+func (p *staticKeyProvider) GenerateDataKey(_ context.Context, contentAlgorithm string) (DataKey, error) {
+	plaintext, err := randomBytes(32)
+	if err != nil {
+		return DataKey{}, err
+	}
+	nonce, err := randomBytes(gcmNonceSize)
+	if err != nil {
+		return DataKey{}, err
+	}
+	// The content encryption algorithm is authenticated as
+	// additional data.
+	return DataKey{
+		Plaintext:           plaintext,
+		Encrypted:           p.aead.Seal(nonce, nonce, plaintext, []byte(contentAlgorithm)),
+		WrapAlgorithm:       WrapAESGCM,
+		ContentAlgorithm:    contentAlgorithm,
+		MaterialDescription: maps.Clone(p.matDesc),
+	}, nil
+}
+
+func (p *staticKeyProvider) DecryptDataKey(_ context.Context, key DataKey) ([]byte, error) {
+	if key.WrapAlgorithm != WrapAESGCM {
+		return nil, fmt.Errorf("cse: unsupported key wrapping algorithm %q", key.WrapAlgorithm)
+	}
+	if len(key.Encrypted) < gcmNonceSize {
+		return nil, ErrAuthentication
+	}
+	plaintext, err := p.aead.Open(nil, key.Encrypted[:gcmNonceSize], key.Encrypted[gcmNonceSize:], []byte(key.ContentAlgorithm))
+	if err != nil {
+		return nil, ErrAuthentication
+	}
+	return plaintext, nil
+}
+
+// NewRSAKeyProvider returns a KeyProvider wrapping data keys with the
+// public key of privateKey using RSA-OAEP with SHA-1, as done by the S3
+// encryption clients. The material description is stored in the envelope
+// and can be used to identify the key.
+func NewRSAKeyProvider(privateKey *rsa.PrivateKey, materialDescription map[string]string) (KeyProvider, error) {
+	if privateKey == nil {
+		return nil, errors.New("cse: RSA private key is required")
+	}
+	return &rsaKeyProvider{key: privateKey, matDesc: maps.Clone(materialDescription)}, nil
+}
+
+type rsaKeyProvider struct {
+	key     *rsa.PrivateKey
+	matDesc map[string]string
+}
+
+func (p *rsaKeyProvider) GenerateDataKey(_ context.Context, contentAlgorithm string) (DataKey, error) {
+	plaintext, err := randomBytes(32)
+	if err != nil {
+		return DataKey{}, err
+	}
+	// The wrapped message is len(key) || key || content encryption algorithm.
+	msg := make([]byte, 0, 1+len(plaintext)+len(contentAlgorithm))
+	msg = append(msg, byte(len(plaintext)))
+	msg = append(msg, plaintext...)
+	msg = append(msg, contentAlgorithm...)
+	encrypted, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &p.key.PublicKey, msg, nil)
+	if err != nil {
+		return DataKey{}, err
+	}
+	return DataKey{
+		Plaintext:           plaintext,
+		Encrypted:           encrypted,
+		WrapAlgorithm:       WrapRSAOAEP,
+		ContentAlgorithm:    contentAlgorithm,
+		MaterialDescription: maps.Clone(p.matDesc),
+	}, nil
+}
+
+func (p *rsaKeyProvider) DecryptDataKey(_ context.Context, key DataKey) ([]byte, error) {
+	if key.WrapAlgorithm != WrapRSAOAEP {
+		return nil, fmt.Errorf("cse: unsupported key wrapping algorithm %q", key.WrapAlgorithm)
+	}
+	msg, err := rsa.DecryptOAEP(sha1.New(), nil, p.key, key.Encrypted, nil)
+	if err != nil {
+		return nil, ErrAuthentication
+	}
+	if len(msg) < 1 || len(msg) < 1+int(msg[0]) || string(msg[1+int(msg[0]):]) != key.ContentAlgorithm {
+		return nil, ErrAuthentication
+	}
+	return msg[1 : 1+int(msg[0])], nil
+}
+
+// KMS is the subset of a key management service used to wrap data keys,
+// e.g. AWS KMS or MinIO KES.
+type KMS interface {
+	// GenerateDataKey returns a new 256 bit data key and the key
+	// encrypted with the master key keyID, bound to the encryption
+	// context.
+	GenerateDataKey(ctx context.Context, keyID string, encryptionContext map[string]string) (plaintext, ciphertext []byte, err error)
+
+	// Decrypt decrypts a ciphertext returned by GenerateDataKey.
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte, encryptionContext map[string]string) ([]byte, error)
+}
+
+// NewKMSKeyProvider returns a KeyProvider generating data keys with the
+// master key keyID of kms. The encryption context is stored in the envelope
+// as the material description.
+func NewKMSKeyProvider(kms KMS, keyID string, encryptionContext map[string]string) (KeyProvider, error) {
+	if kms == nil {
+		return nil, errors.New("cse: KMS is required")
+	}
+	if _, ok := encryptionContext[kmsContextCEKAlgorithm]; ok {
+		return nil, fmt.Errorf("cse: encryption context key %s is reserved", kmsContextCEKAlgorithm)
+	}
+	return &kmsKeyProvider{kms: kms, keyID: keyID, context: maps.Clone(encryptionContext)}, nil
+}
+
+type kmsKeyProvider struct {
+	kms     KMS
+	keyID   string
+	context map[string]string
+}
+
+func (p *kmsKeyProvider) GenerateDataKey(ctx context.Context, contentAlgorithm string) (DataKey, error) {
+	encCtx := maps.Clone(p.context)
+	if encCtx == nil {
+		encCtx = make(map[string]string, 1)
+	}
+	encCtx[kmsContextCEKAlgorithm] = contentAlgorithm
+
+	plaintext, ciphertext, err := p.kms.GenerateDataKey(ctx, p.keyID, encCtx)
+	if err != nil {
+		return DataKey{}, err
+	}
+	if len(plaintext) != 32 {
+		return DataKey{}, errors.New("cse: KMS returned a data key that is not 256 bit long")
+	}
+	return DataKey{
+		Plaintext:           plaintext,
+		Encrypted:           ciphertext,
+		WrapAlgorithm:       WrapKMSContext,
+		ContentAlgorithm:    contentAlgorithm,
+		MaterialDescription: encCtx,
+	}, nil
+}
+
+func (p *kmsKeyProvider) DecryptDataKey(ctx context.Context, key DataKey) ([]byte, error) {
+	if key.WrapAlgorithm != WrapKMSContext {
+		return nil, fmt.Errorf("cse: unsupported key wrapping algorithm %q", key.WrapAlgorithm)
+	}
+	if key.MaterialDescription[kmsContextCEKAlgorithm] != key.ContentAlgorithm {
+		return nil, ErrAuthentication
+	}
+	return p.kms.Decrypt(ctx, p.keyID, key.Encrypted, key.MaterialDescription)
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -150,6 +150,18 @@ func SSECopy(sse ServerSide) ServerSide {
 	return sse
 }
 
+// MarshalCopySource adds the copy source headers of a SSE-C
+// encryption to h, required to read an SSE-C encrypted source object
+// in CopyObject and UploadPartCopy requests. It accepts SSE-C
+// encryptions created by NewSSEC as well as SSECopy(...). Other
+// encryption types do not apply to copy sources and are ignored.
+func MarshalCopySource(sse ServerSide, h http.Header) {
+	if sse == nil || sse.Type() != SSEC {
+		return
+	}
+	SSECopy(sse).Marshal(h)
+}
+
 type ssec [32]byte
 
 func (s ssec) Type() Type { return SSEC }
@@ -40,6 +40,13 @@ func (r *Cache[K, V]) Set(key K, value V) {
 	r.store(key, value)
 }
 
+// Range calls f for every key value in the cache until f returns false.
+func (r *Cache[K, V]) Range(f func(key K, value V) bool) {
+	r.m.Range(func(key, value any) bool {
+		return f(key.(K), value.(V))
+	})
+}
+
 func (r *Cache[K, V]) load(key K) (V, bool) {
 	value, ok := r.m.Load(key)
 	if !ok {
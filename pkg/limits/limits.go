@@ -0,0 +1,196 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package limits describes the service limits of S3 compatible
+// providers, requests exceeding them are rejected by the client before
+// they are sent.
+package limits
+
+import "fmt"
+
+// Limits are the service limits of a provider, zero values are the
+// limits of AWS S3.
+type Limits struct {
+	// MaxObjectSize is the size of the largest object.
+	MaxObjectSize int64
+	// MaxPutObjectSize is the size of the largest object uploaded with
+	// a single PUT.
+	MaxPutObjectSize int64
+	// MinPartSize is the size of the smallest part of a multipart
+	// upload, except for the last part.
+	MinPartSize int64
+	// MaxPartSize is the size of the largest part of a multipart upload.
+	MaxPartSize int64
+	// MaxPartsCount is the largest number of parts of a multipart
+	// upload, part numbers are between 1 and MaxPartsCount.
+	MaxPartsCount int
+	// MaxDeleteObjects is the largest number of objects removed by a
+	// multi object delete request.
+	MaxDeleteObjects int
+	// MaxObjectTags is the largest number of tags of an object.
+	MaxObjectTags int
+	// MaxUserMetadataSize is the largest size of the user metadata of
+	// an object, i.e. of the names and values of the x-amz-meta-
+	// headers.
+	MaxUserMetadataSize int
+}
+
+// S3 returns the limits of AWS S3, which most providers share.
+func S3() Limits {
+	return Limits{
+		MaxObjectSize:       5 << 40,
+		MaxPutObjectSize:    5 << 30,
+		MinPartSize:         5 << 20,
+		MaxPartSize:         5 << 30,
+		MaxPartsCount:       10000,
+		MaxDeleteObjects:    1000,
+		MaxObjectTags:       10,
+		MaxUserMetadataSize: 2 << 10,
+	}
+}
+
+// B2 returns the limits of the S3 compatible API of Backblaze B2.
+func B2() Limits {
+	return Limits{
+		MaxObjectSize:       10_000_000_000_000,
+		MaxPutObjectSize:    5_000_000_000,
+		MinPartSize:         5_000_000,
+		MaxPartSize:         5_000_000_000,
+		MaxPartsCount:       10000,
+		MaxDeleteObjects:    1000,
+		MaxObjectTags:       10,
+		MaxUserMetadataSize: 2 << 10,
+	}
+}
+
+// R2 returns the limits of Cloudflare R2, uploads are 5MiB smaller than on
+// AWS S3 and objects 5GiB smaller.
+func R2() Limits {
+	return Limits{
+		MaxObjectSize:       5<<40 - 5<<30,
+		MaxPutObjectSize:    5<<30 - 5<<20,
+		MinPartSize:         5 << 20,
+		MaxPartSize:         5<<30 - 5<<20,
+		MaxPartsCount:       10000,
+		MaxDeleteObjects:    1000,
+		MaxObjectTags:       10,
+		MaxUserMetadataSize: 8 << 10,
+	}
+}
+
+// WithDefaults returns the limits with the zero values replaced by the
+// limits of AWS S3.
+func (l Limits) WithDefaults() Limits {
+	orDefault := func(v *int64, d int64) {
+		if *v == 0 {
+			*v = d
+		}
+	}
+	orDefaultInt := func(v *int, d int) {
+		if *v == 0 {
+			*v = d
+		}
+	}
+	s3 := S3()
+	orDefault(&l.MaxObjectSize, s3.MaxObjectSize)
+	orDefault(&l.MaxPutObjectSize, s3.MaxPutObjectSize)
+	orDefault(&l.MinPartSize, s3.MinPartSize)
+	orDefault(&l.MaxPartSize, s3.MaxPartSize)
+	orDefaultInt(&l.MaxPartsCount, s3.MaxPartsCount)
+	orDefaultInt(&l.MaxDeleteObjects, s3.MaxDeleteObjects)
+	orDefaultInt(&l.MaxObjectTags, s3.MaxObjectTags)
+	orDefaultInt(&l.MaxUserMetadataSize, s3.MaxUserMetadataSize)
+	return l
+}
+
+// Error is returned when a request exceeds a limit.
+type Error struct {
+	// Limit is the name of the limit, e.g. "MaxPartSize".
+	Limit string
+	// Value is the value of the request.
+	Value int64
+	// Bound is the limit, a minimum for MinPartSize and a maximum
+	// otherwise.
+	Bound int64
+}
+
+func (e *Error) Error() string {
+	switch e.Limit {
+	case "MinPartSize":
+		return fmt.Sprintf("limits: %d is smaller than the %s limit of %d", e.Value, e.Limit, e.Bound)
+	case "MaxPartsCount":
+		if e.Value < 1 {
+			return fmt.Sprintf("limits: part number %d must be between 1 and %d", e.Value, e.Bound)
+		}
+	}
+	return fmt.Sprintf("limits: %d exceeds the %s limit of %d", e.Value, e.Limit, e.Bound)
+}
+
+func checkMax(limit string, value, bound int64) error {
+	if value > bound {
+		return &Error{Limit: limit, Value: value, Bound: bound}
+	}
+	return nil
+}
+
+// CheckObjectSize checks the size of an object.
+func (l Limits) CheckObjectSize(size int64) error {
+	return checkMax("MaxObjectSize", size, l.WithDefaults().MaxObjectSize)
+}
+
+// CheckPutObjectSize checks the size of an object uploaded with a single
+// PUT.
+func (l Limits) CheckPutObjectSize(size int64) error {
+	return checkMax("MaxPutObjectSize", size, l.WithDefaults().MaxPutObjectSize)
+}
+
+// CheckPartSize checks the size of a part, the size of the last part of
+// an upload may be smaller than MinPartSize.
+func (l Limits) CheckPartSize(size int64, last bool) error {
+	l = l.WithDefaults()
+	if !last && size < l.MinPartSize {
+		return &Error{Limit: "MinPartSize", Value: size, Bound: l.MinPartSize}
+	}
+	return checkMax("MaxPartSize", size, l.MaxPartSize)
+}
+
+// CheckPartNumber checks the number of a part, or the number of parts of
+// an upload.
+func (l Limits) CheckPartNumber(partNumber int) error {
+	l = l.WithDefaults()
+	if partNumber < 1 {
+		return &Error{Limit: "MaxPartsCount", Value: int64(partNumber), Bound: int64(l.MaxPartsCount)}
+	}
+	return checkMax("MaxPartsCount", int64(partNumber), int64(l.MaxPartsCount))
+}
+
+// CheckDeleteObjects checks the number of objects of a multi object
+// delete request.
+func (l Limits) CheckDeleteObjects(count int) error {
+	return checkMax("MaxDeleteObjects", int64(count), int64(l.WithDefaults().MaxDeleteObjects))
+}
+
+// CheckObjectTags checks the number of tags of an object.
+func (l Limits) CheckObjectTags(count int) error {
+	return checkMax("MaxObjectTags", int64(count), int64(l.WithDefaults().MaxObjectTags))
+}
+
+// CheckUserMetadataSize checks the size of the user metadata of an
+// object.
+func (l Limits) CheckUserMetadataSize(size int) error {
+	return checkMax("MaxUserMetadataSize", int64(size), int64(l.WithDefaults().MaxUserMetadataSize))
+}
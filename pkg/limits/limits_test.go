@@ -0,0 +1,73 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limits
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithDefaults(t *testing.T) {
+	l := Limits{MaxPartSize: 1 << 30}.WithDefaults()
+	if l.MaxPartSize != 1<<30 {
+		t.Errorf("expected MaxPartSize to be kept, got %d", l.MaxPartSize)
+	}
+	l.MaxPartSize = S3().MaxPartSize
+	if l != S3() {
+		t.Errorf("expected the limits of S3, got %+v", l)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name  string
+		err   error
+		limit string
+	}{
+		{"object size", S3().CheckObjectSize(5 << 40), ""},
+		{"object size too large", S3().CheckObjectSize(5<<40 + 1), "MaxObjectSize"},
+		{"b2 object size", B2().CheckObjectSize(6 << 40), ""},
+		{"put object size too large", S3().CheckPutObjectSize(5<<30 + 1), "MaxPutObjectSize"},
+		{"part size", S3().CheckPartSize(5<<20, false), ""},
+		{"part size too small", S3().CheckPartSize(5<<20-1, false), "MinPartSize"},
+		{"last part size", S3().CheckPartSize(1, true), ""},
+		{"part size too large", S3().CheckPartSize(5<<30+1, true), "MaxPartSize"},
+		{"b2 part size too large", B2().CheckPartSize(5<<30, true), "MaxPartSize"},
+		{"r2 put object size too large", R2().CheckPutObjectSize(5 << 30), "MaxPutObjectSize"},
+		{"r2 user metadata", R2().CheckUserMetadataSize(8 << 10), ""},
+		{"part number", S3().CheckPartNumber(10000), ""},
+		{"part number zero", S3().CheckPartNumber(0), "MaxPartsCount"},
+		{"part number too large", S3().CheckPartNumber(10001), "MaxPartsCount"},
+		{"delete objects", Limits{}.CheckDeleteObjects(1000), ""},
+		{"delete objects too many", Limits{MaxDeleteObjects: 100}.CheckDeleteObjects(101), "MaxDeleteObjects"},
+		{"object tags too many", S3().CheckObjectTags(11), "MaxObjectTags"},
+		{"user metadata too large", S3().CheckUserMetadataSize(2<<10 + 1), "MaxUserMetadataSize"},
+	}
+	for _, testCase := range testCases {
+		var lerr *Error
+		switch {
+		case testCase.limit == "" && testCase.err != nil:
+			t.Errorf("%s: unexpected error %v", testCase.name, testCase.err)
+		case testCase.limit == "":
+		case !errors.As(testCase.err, &lerr):
+			t.Errorf("%s: expected a limits error, got %v", testCase.name, testCase.err)
+		case lerr.Limit != testCase.limit:
+			t.Errorf("%s: expected limit %s, got %s (%v)", testCase.name, testCase.limit, lerr.Limit, lerr)
+		}
+	}
+}
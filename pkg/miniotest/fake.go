@@ -0,0 +1,651 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package miniotest provides an in-memory fake of the minio.API interface
+// for unit tests of code using the client.
+package miniotest
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"iter"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+// nullVersionID is the version ID of objects written while the versioning
+// of the bucket is suspended.
+const nullVersionID = "null"
+
+// Fake is an in-memory fake of minio.API. It implements buckets, objects,
+// bucket versioning and the injection of errors, the other operations
+// are forwarded to the embedded API, calling them panics if it is nil.
+//
+// Operations return minio.ErrorResponse errors with the codes of S3, so
+// they can be matched with errors.Is and the sentinel errors of minio.
+type Fake struct {
+	minio.API
+
+	// ErrorFunc is called before every operation with the name of the
+	// operation, i.e. the name of the method, and its bucket and object
+	// name. The operation fails if it returns an error. It must not call
+	// the fake.
+	ErrorFunc func(op, bucketName, objectName string) error
+
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	injected []injectedError
+}
+
+type bucket struct {
+	created    time.Time
+	versioning minio.BucketVersioningConfiguration
+	// objects are the versions of the objects, the latest last.
+	objects map[string][]*version
+	// uploads are the multipart uploads of the Server by upload ID.
+	uploads map[string]*upload
+}
+
+type version struct {
+	info minio.ObjectInfo
+	data []byte
+}
+
+type injectedError struct {
+	op, bucketName, objectName string
+	err                        error
+}
+
+var _ minio.API = (*Fake)(nil)
+
+// NewFake returns a fake without buckets.
+func NewFake() *Fake {
+	return &Fake{buckets: make(map[string]*bucket)}
+}
+
+// InjectError makes the next operation op on the object objectName of the
+// bucket bucketName fail with err. Empty arguments match any operation,
+// bucket or object. Errors are injected once, in the order of the calls.
+func (f *Fake) InjectError(op, bucketName, objectName string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.injected = append(f.injected, injectedError{op: op, bucketName: bucketName, objectName: objectName, err: err})
+}
+
+// fail returns the error op fails with, if any. f.mu must be held.
+func (f *Fake) fail(op, bucketName, objectName string) error {
+	for i, ie := range f.injected {
+		if (ie.op == "" || ie.op == op) &&
+			(ie.bucketName == "" || ie.bucketName == bucketName) &&
+			(ie.objectName == "" || ie.objectName == objectName) {
+			f.injected = slices.Delete(f.injected, i, i+1)
+			return ie.err
+		}
+	}
+	if f.ErrorFunc != nil {
+		return f.ErrorFunc(op, bucketName, objectName)
+	}
+	return nil
+}
+
+func errorResponse(statusCode int, code, message, bucketName, objectName string) minio.ErrorResponse {
+	return minio.ErrorResponse{
+		StatusCode: statusCode,
+		Code:       code,
+		Message:    message,
+		BucketName: bucketName,
+		Key:        objectName,
+	}
+}
+
+func errNoSuchBucket(bucketName string) error {
+	return errorResponse(http.StatusNotFound, minio.NoSuchBucket, "The specified bucket does not exist.", bucketName, "")
+}
+
+func errNoSuchKey(bucketName, objectName string) error {
+	return errorResponse(http.StatusNotFound, minio.NoSuchKey, "The specified key does not exist.", bucketName, objectName)
+}
+
+func errNoSuchVersion(bucketName, objectName string) error {
+	return errorResponse(http.StatusNotFound, minio.NoSuchVersion, "The specified version does not exist.", bucketName, objectName)
+}
+
+// bucket returns the bucket bucketName. f.mu must be held.
+func (f *Fake) bucket(bucketName string) (*bucket, error) {
+	if f.buckets == nil {
+		f.buckets = make(map[string]*bucket)
+	}
+	b, ok := f.buckets[bucketName]
+	if !ok {
+		return nil, errNoSuchBucket(bucketName)
+	}
+	return b, nil
+}
+
+// version returns the version versionID of an object, the latest version
+// if versionID is empty. The latest version must not be a delete marker.
+func (b *bucket) version(bucketName, objectName, versionID string) (*version, error) {
+	versions := b.objects[objectName]
+	if versionID == "" {
+		if len(versions) == 0 || versions[len(versions)-1].info.IsDeleteMarker {
+			return nil, errNoSuchKey(bucketName, objectName)
+		}
+		return versions[len(versions)-1], nil
+	}
+	for _, v := range versions {
+		if v.info.VersionID == versionID {
+			if v.info.IsDeleteMarker {
+				return nil, errorResponse(http.StatusMethodNotAllowed, minio.MethodNotAllowed,
+					"The specified method is not allowed against this resource.", bucketName, objectName)
+			}
+			return v, nil
+		}
+	}
+	return nil, errNoSuchVersion(bucketName, objectName)
+}
+
+// newVersionID returns the version ID of a new version of an object, it
+// is empty if the bucket was never versioned.
+func (b *bucket) newVersionID() string {
+	switch {
+	case b.versioning.Enabled():
+		return uuid.NewString()
+	case b.versioning.Suspended():
+		return nullVersionID
+	}
+	return ""
+}
+
+// add adds v as the latest version of an object, it replaces the null
+// version unless the versioning is enabled.
+func (b *bucket) add(objectName string, v *version) {
+	versions := b.objects[objectName]
+	if !b.versioning.Enabled() {
+		versions = slices.DeleteFunc(versions, func(v *version) bool {
+			return v.info.VersionID == "" || v.info.VersionID == nullVersionID
+		})
+	}
+	b.objects[objectName] = append(versions, v)
+}
+
+// remove removes an object like DeleteObject, a delete marker is added
+// if the bucket is versioned.
+func (b *bucket) remove(bucketName, objectName, versionID string) (minio.RemoveObjectResult, error) {
+	res := minio.RemoveObjectResult{ObjectName: objectName, ObjectVersionID: versionID}
+	versions := b.objects[objectName]
+	if versionID != "" {
+		i := slices.IndexFunc(versions, func(v *version) bool { return v.info.VersionID == versionID })
+		if i < 0 {
+			return res, errNoSuchVersion(bucketName, objectName)
+		}
+		if versions[i].info.IsDeleteMarker {
+			res.DeleteMarker = true
+			res.DeleteMarkerVersionID = versionID
+		}
+		versions = slices.Delete(versions, i, i+1)
+		if len(versions) == 0 {
+			delete(b.objects, objectName)
+		} else {
+			b.objects[objectName] = versions
+		}
+		return res, nil
+	}
+	if b.versioning.Status == "" {
+		delete(b.objects, objectName)
+		return res, nil
+	}
+	marker := &version{info: minio.ObjectInfo{
+		Key:            objectName,
+		LastModified:   time.Now().UTC(),
+		VersionID:      b.newVersionID(),
+		IsDeleteMarker: true,
+	}}
+	b.add(objectName, marker)
+	res.DeleteMarker = true
+	res.DeleteMarkerVersionID = marker.info.VersionID
+	return res, nil
+}
+
+// MakeBucket creates the bucket bucketName.
+func (f *Fake) MakeBucket(_ context.Context, bucketName string, opts minio.MakeBucketOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("MakeBucket", bucketName, ""); err != nil {
+		return err
+	}
+	return f.makeBucket(bucketName, opts.ObjectLocking)
+}
+
+// makeBucket creates a bucket, object locking enables its versioning.
+// f.mu must be held.
+func (f *Fake) makeBucket(bucketName string, objectLocking bool) error {
+	if _, err := f.bucket(bucketName); err == nil {
+		return errorResponse(http.StatusConflict, minio.BucketAlreadyOwnedByYou,
+			"Your previous request to create the named bucket succeeded and you already own it.", bucketName, "")
+	}
+	b := &bucket{
+		created: time.Now().UTC(),
+		objects: make(map[string][]*version),
+		uploads: make(map[string]*upload),
+	}
+	if objectLocking {
+		b.versioning.Status = minio.Enabled
+	}
+	f.buckets[bucketName] = b
+	return nil
+}
+
+// BucketExists returns true if the bucket bucketName exists.
+func (f *Fake) BucketExists(_ context.Context, bucketName string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("BucketExists", bucketName, ""); err != nil {
+		return false, err
+	}
+	_, err := f.bucket(bucketName)
+	return err == nil, nil
+}
+
+// ListBuckets returns the buckets sorted by name.
+func (f *Fake) ListBuckets(_ context.Context) ([]minio.BucketInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("ListBuckets", "", ""); err != nil {
+		return nil, err
+	}
+	infos := make([]minio.BucketInfo, 0, len(f.buckets))
+	for _, name := range slices.Sorted(maps.Keys(f.buckets)) {
+		infos = append(infos, minio.BucketInfo{Name: name, CreationDate: f.buckets[name].created})
+	}
+	return infos, nil
+}
+
+// RemoveBucket removes the bucket bucketName, it must be empty.
+func (f *Fake) RemoveBucket(_ context.Context, bucketName string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("RemoveBucket", bucketName, ""); err != nil {
+		return err
+	}
+	return f.removeBucket(bucketName)
+}
+
+// removeBucket removes an empty bucket. f.mu must be held.
+func (f *Fake) removeBucket(bucketName string) error {
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return err
+	}
+	if len(b.objects) > 0 {
+		return errorResponse(http.StatusConflict, minio.BucketNotEmpty,
+			"The bucket you tried to delete is not empty.", bucketName, "")
+	}
+	delete(f.buckets, bucketName)
+	return nil
+}
+
+// SetBucketVersioning sets the versioning of the bucket bucketName.
+func (f *Fake) SetBucketVersioning(_ context.Context, bucketName string, config minio.BucketVersioningConfiguration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("SetBucketVersioning", bucketName, ""); err != nil {
+		return err
+	}
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return err
+	}
+	b.versioning = config
+	return nil
+}
+
+// GetBucketVersioning returns the versioning of the bucket bucketName.
+func (f *Fake) GetBucketVersioning(_ context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("GetBucketVersioning", bucketName, ""); err != nil {
+		return minio.BucketVersioningConfiguration{}, err
+	}
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return minio.BucketVersioningConfiguration{}, err
+	}
+	return b.versioning, nil
+}
+
+// EnableVersioning enables the versioning of the bucket bucketName.
+func (f *Fake) EnableVersioning(ctx context.Context, bucketName string) error {
+	return f.SetBucketVersioning(ctx, bucketName, minio.BucketVersioningConfiguration{Status: minio.Enabled})
+}
+
+// SuspendVersioning suspends the versioning of the bucket bucketName.
+func (f *Fake) SuspendVersioning(ctx context.Context, bucketName string) error {
+	return f.SetBucketVersioning(ctx, bucketName, minio.BucketVersioningConfiguration{Status: minio.Suspended})
+}
+
+// PutObject stores the content of reader as a new version of an object.
+// The size is only checked if it is not -1.
+func (f *Fake) PutObject(_ context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	if objectSize >= 0 && int64(len(data)) != objectSize {
+		return minio.UploadInfo{}, errorResponse(http.StatusBadRequest, "IncompleteBody",
+			"You did not provide the number of bytes specified by the Content-Length HTTP header.", bucketName, objectName)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("PutObject", bucketName, objectName); err != nil {
+		return minio.UploadInfo{}, err
+	}
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	info := b.put(objectName, data, minio.ObjectInfo{
+		ContentType:  opts.ContentType,
+		UserMetadata: opts.UserMetadata,
+		UserTags:     opts.UserTags,
+	})
+	info.Bucket = bucketName
+	return info, nil
+}
+
+// put adds data as a new version of an object, with the content type,
+// user metadata and tags of info. The ETag is the MD5 sum of data unless
+// it is set in info.
+func (b *bucket) put(objectName string, data []byte, info minio.ObjectInfo) minio.UploadInfo {
+	if info.ETag == "" {
+		sum := md5.Sum(data)
+		info.ETag = hex.EncodeToString(sum[:])
+	}
+	if info.ContentType == "" {
+		info.ContentType = "application/octet-stream"
+	}
+	v := &version{
+		data: data,
+		info: minio.ObjectInfo{
+			Key:          objectName,
+			ETag:         info.ETag,
+			Size:         int64(len(data)),
+			LastModified: time.Now().UTC(),
+			ContentType:  info.ContentType,
+			UserMetadata: maps.Clone(info.UserMetadata),
+			UserTags:     maps.Clone(info.UserTags),
+			UserTagCount: len(info.UserTags),
+			VersionID:    b.newVersionID(),
+		},
+	}
+	b.add(objectName, v)
+	return minio.UploadInfo{
+		Key:          objectName,
+		ETag:         v.info.ETag,
+		Size:         v.info.Size,
+		LastModified: v.info.LastModified,
+		VersionID:    v.info.VersionID,
+	}
+}
+
+// FPutObject stores the content of the file filePath like PutObject.
+func (f *Fake) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	defer file.Close()
+	return f.PutObject(ctx, bucketName, objectName, file, -1, opts)
+}
+
+// objectInfo returns the info of the version v of an object.
+func (b *bucket) objectInfo(objectName string, v *version) minio.ObjectInfo {
+	info := v.info
+	versions := b.objects[objectName]
+	info.IsLatest = versions[len(versions)-1] == v
+	info.NumVersions = len(versions)
+	return info
+}
+
+// StatObject returns the info of an object.
+func (f *Fake) StatObject(_ context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("StatObject", bucketName, objectName); err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	v, err := b.version(bucketName, objectName, opts.VersionID)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	return b.objectInfo(objectName, v), nil
+}
+
+// GetObject returns the content of an object. Unlike the client, errors
+// of missing objects are returned by GetObject and not by the first read.
+func (f *Fake) GetObject(_ context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (*minio.Object, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("GetObject", bucketName, objectName); err != nil {
+		return nil, err
+	}
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	v, err := b.version(bucketName, objectName, opts.VersionID)
+	if err != nil {
+		return nil, err
+	}
+	return minio.NewObject(b.objectInfo(objectName, v), bytes.NewReader(v.data)), nil
+}
+
+// FGetObject writes the content of an object to the file filePath.
+func (f *Fake) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts minio.GetObjectOptions) error {
+	obj, err := f.GetObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, data, 0o666)
+}
+
+// CopyObject copies the source object to the destination object, the
+// metadata of the source is kept unless dst.ReplaceMetadata is set.
+func (f *Fake) CopyObject(_ context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("CopyObject", dst.Bucket, dst.Object); err != nil {
+		return minio.UploadInfo{}, err
+	}
+	return f.copy(dst, src)
+}
+
+// copy copies an object like CopyObject. f.mu must be held.
+func (f *Fake) copy(dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	srcBucket, err := f.bucket(src.Bucket)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	v, err := srcBucket.version(src.Bucket, src.Object, src.VersionID)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	if src.MatchETag != "" && src.MatchETag != v.info.ETag {
+		return minio.UploadInfo{}, errorResponse(http.StatusPreconditionFailed, minio.PreconditionFailed,
+			"At least one of the pre-conditions you specified did not hold", src.Bucket, src.Object)
+	}
+	dstBucket, err := f.bucket(dst.Bucket)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	info := minio.ObjectInfo{
+		ContentType:  v.info.ContentType,
+		UserMetadata: v.info.UserMetadata,
+		UserTags:     v.info.UserTags,
+	}
+	if dst.ReplaceMetadata {
+		info.UserMetadata = dst.UserMetadata
+	}
+	if dst.ReplaceTags {
+		info.UserTags = dst.UserTags
+	}
+	uploadInfo := dstBucket.put(dst.Object, v.data, info)
+	uploadInfo.Bucket = dst.Bucket
+	return uploadInfo, nil
+}
+
+// RemoveObject removes an object, or a version of it if opts.VersionID
+// is set. A delete marker is created if the bucket is versioned.
+func (f *Fake) RemoveObject(_ context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("RemoveObject", bucketName, objectName); err != nil {
+		return err
+	}
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return err
+	}
+	_, err = b.remove(bucketName, objectName, opts.VersionID)
+	return err
+}
+
+// RemoveObjects removes the objects received from objectsCh like
+// RemoveObject, errors are sent on the returned channel.
+func (f *Fake) RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, _ minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+	errorCh := make(chan minio.RemoveObjectError)
+	go func() {
+		defer close(errorCh)
+		for object := range objectsCh {
+			err := f.RemoveObject(ctx, bucketName, object.Key, minio.RemoveObjectOptions{VersionID: object.VersionID})
+			if err == nil {
+				continue
+			}
+			select {
+			case errorCh <- minio.RemoveObjectError{ObjectName: object.Key, VersionID: object.VersionID, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return errorCh
+}
+
+// ListObjects lists the objects of a bucket sorted by name, or all their
+// versions, the latest first, if opts.WithVersions is set.
+func (f *Fake) ListObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	objects, err := f.listObjects(bucketName, opts)
+	if err != nil {
+		objects = []minio.ObjectInfo{{Err: err}}
+	}
+	objectCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectCh)
+		for _, object := range objects {
+			select {
+			case objectCh <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return objectCh
+}
+
+// ListObjectsIter lists objects like ListObjects.
+func (f *Fake) ListObjectsIter(_ context.Context, bucketName string, opts minio.ListObjectsOptions) iter.Seq[minio.ObjectInfo] {
+	return func(yield func(minio.ObjectInfo) bool) {
+		objects, err := f.listObjects(bucketName, opts)
+		if err != nil {
+			yield(minio.ObjectInfo{Err: err})
+			return
+		}
+		for _, object := range objects {
+			if !yield(object) {
+				return
+			}
+		}
+	}
+}
+
+func (f *Fake) listObjects(bucketName string, opts minio.ListObjectsOptions) ([]minio.ObjectInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail("ListObjects", bucketName, opts.Prefix); err != nil {
+		return nil, err
+	}
+	b, err := f.bucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return b.list(opts), nil
+}
+
+// list lists the objects of the bucket like ListObjects, common prefixes
+// are returned as objects with the prefix as key.
+func (b *bucket) list(opts minio.ListObjectsOptions) []minio.ObjectInfo {
+	var (
+		objects  []minio.ObjectInfo
+		prefixes = make(map[string]bool)
+	)
+	for _, name := range slices.Sorted(maps.Keys(b.objects)) {
+		if !strings.HasPrefix(name, opts.Prefix) || name <= opts.StartAfter {
+			continue
+		}
+		versions := b.objects[name]
+		if !opts.WithVersions && versions[len(versions)-1].info.IsDeleteMarker {
+			continue
+		}
+		if !opts.Recursive {
+			if i := strings.Index(name[len(opts.Prefix):], "/"); i >= 0 {
+				prefix := name[:len(opts.Prefix)+i+1]
+				if !prefixes[prefix] && prefix > opts.StartAfter {
+					objects = append(objects, minio.ObjectInfo{Key: prefix})
+				}
+				prefixes[prefix] = true
+				continue
+			}
+		}
+		if !opts.WithVersions {
+			objects = append(objects, b.objectInfo(name, versions[len(versions)-1]))
+			continue
+		}
+		for i := len(versions) - 1; i >= 0; i-- {
+			objects = append(objects, b.objectInfo(name, versions[i]))
+		}
+	}
+	return objects
+}
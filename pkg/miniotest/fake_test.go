@@ -0,0 +1,163 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestFakeObjects(t *testing.T) {
+	ctx := context.Background()
+	var api minio.API = NewFake()
+
+	if _, err := api.PutObject(ctx, "bucket", "a", strings.NewReader("x"), 1, minio.PutObjectOptions{}); !errors.Is(err, minio.ErrNoSuchBucket) {
+		t.Fatalf("expected NoSuchBucket, got %v", err)
+	}
+	if err := api.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); !errors.Is(err, minio.ErrBucketAlreadyOwnedByYou) {
+		t.Fatalf("expected BucketAlreadyOwnedByYou, got %v", err)
+	}
+	for _, name := range []string{"dir/b", "dir/c", "a"} {
+		if _, err := api.PutObject(ctx, "bucket", name, strings.NewReader(name), -1, minio.PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	obj, err := api.GetObject(ctx, "bucket", "dir/b", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil || string(data) != "dir/b" {
+		t.Fatalf("expected dir/b, got %q, %v", data, err)
+	}
+
+	var keys []string
+	for object := range api.ListObjects(ctx, "bucket", minio.ListObjectsOptions{}) {
+		if object.Err != nil {
+			t.Fatal(object.Err)
+		}
+		keys = append(keys, object.Key)
+	}
+	if strings.Join(keys, ",") != "a,dir/" {
+		t.Fatalf("expected a,dir/, got %v", keys)
+	}
+	keys = nil
+	for object := range api.ListObjectsIter(ctx, "bucket", minio.ListObjectsOptions{Prefix: "dir/", Recursive: true}) {
+		keys = append(keys, object.Key)
+	}
+	if strings.Join(keys, ",") != "dir/b,dir/c" {
+		t.Fatalf("expected dir/b,dir/c, got %v", keys)
+	}
+
+	if err := api.RemoveBucket(ctx, "bucket"); !errors.Is(err, minio.ErrBucketNotEmpty) {
+		t.Fatalf("expected BucketNotEmpty, got %v", err)
+	}
+	if err := api.RemoveObject(ctx, "bucket", "a", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.StatObject(ctx, "bucket", "a", minio.StatObjectOptions{}); !errors.Is(err, minio.ErrNoSuchKey) {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
+
+func TestFakeVersions(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+	if err := f.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	v1, err := f.PutObject(ctx, "bucket", "object", strings.NewReader("v1"), 2, minio.PutObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.PutObject(ctx, "bucket", "object", strings.NewReader("v2"), 2, minio.PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.RemoveObject(ctx, "bucket", "object", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.StatObject(ctx, "bucket", "object", minio.StatObjectOptions{}); !errors.Is(err, minio.ErrNoSuchKey) {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+	info, err := f.StatObject(ctx, "bucket", "object", minio.StatObjectOptions{VersionID: v1.VersionID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.IsLatest || info.NumVersions != 3 {
+		t.Fatalf("unexpected info of the first version: %+v", info)
+	}
+
+	var markers, versions int
+	for object := range f.ListObjects(ctx, "bucket", minio.ListObjectsOptions{WithVersions: true}) {
+		if object.IsDeleteMarker {
+			if !object.IsLatest {
+				t.Fatal("expected the delete marker to be the latest version")
+			}
+			markers++
+		} else {
+			versions++
+		}
+	}
+	if markers != 1 || versions != 2 {
+		t.Fatalf("expected 1 delete marker and 2 versions, got %d and %d", markers, versions)
+	}
+	if err := f.RemoveObject(ctx, "bucket", "object", minio.RemoveObjectOptions{VersionID: "missing"}); !errors.Is(err, minio.ErrNoSuchVersion) {
+		t.Fatalf("expected NoSuchVersion, got %v", err)
+	}
+}
+
+func TestFakeInjectError(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+	if err := f.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	f.InjectError("PutObject", "bucket", "b", minio.ErrSlowDown)
+	if _, err := f.PutObject(ctx, "bucket", "a", strings.NewReader(""), 0, minio.PutObjectOptions{}); err != nil {
+		t.Fatalf("expected no error for another object, got %v", err)
+	}
+	if _, err := f.PutObject(ctx, "bucket", "b", strings.NewReader(""), 0, minio.PutObjectOptions{}); !errors.Is(err, minio.ErrSlowDown) {
+		t.Fatalf("expected SlowDown, got %v", err)
+	}
+	if _, err := f.PutObject(ctx, "bucket", "b", strings.NewReader(""), 0, minio.PutObjectOptions{}); err != nil {
+		t.Fatalf("expected the error to be injected once, got %v", err)
+	}
+
+	f.ErrorFunc = func(op, _, _ string) error {
+		if op == "StatObject" {
+			return minio.ErrAccessDenied
+		}
+		return nil
+	}
+	if _, err := f.StatObject(ctx, "bucket", "a", minio.StatObjectOptions{}); !errors.Is(err, minio.ErrAccessDenied) {
+		t.Fatalf("expected AccessDenied, got %v", err)
+	}
+}
@@ -0,0 +1,900 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"bufio"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// minPartSize is the minimum size of all but the last part of a
+	// multipart upload.
+	minPartSize = 5 * 1024 * 1024
+
+	maxPartNumber  = 10000
+	defaultMaxKeys = 1000
+
+	metadataPrefix = "X-Amz-Meta-"
+)
+
+// Server is an S3 server over httptest, it serves the buckets and objects
+// of a Fake. It implements the requests of buckets, objects, listings,
+// multipart uploads and bucket versioning. Requests are not authenticated
+// and must be path-style, i.e. use the Endpoint of the server.
+type Server struct {
+	*httptest.Server
+
+	// Fake holds the buckets and objects of the server, its injected
+	// errors fail requests. Operations are named like the methods of
+	// minio.Client, the operations of multipart uploads like the methods
+	// of minio.Core. Errors which are no minio.ErrorResponse close the
+	// connection, e.g. to test the retries of network errors.
+	Fake *Fake
+}
+
+// upload is a multipart upload.
+type upload struct {
+	objectName string
+	initiated  time.Time
+	info       minio.ObjectInfo
+	parts      map[int]part
+}
+
+type part struct {
+	etag         string
+	data         []byte
+	lastModified time.Time
+}
+
+// request is a request to the server.
+type request struct {
+	*http.Request
+	bucketName string
+	objectName string
+	query      url.Values
+	body       []byte
+}
+
+// response is the response to a request, it is written once f.mu is
+// released so that slow readers do not block other requests.
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// NewServer starts and returns a server without buckets, it must be
+// closed when done.
+func NewServer() *Server {
+	s := &Server{Fake: NewFake()}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Endpoint returns the endpoint of the server for minio.New.
+func (s *Server) Endpoint() string {
+	return s.Listener.Addr().String()
+}
+
+// supportedParams are the query parameters of the supported requests,
+// requests of other sub-resources fail with NotImplemented.
+var supportedParams = []string{
+	"continuation-token", "delete", "delimiter", "encoding-type",
+	"fetch-owner", "key-marker", "list-type", "location", "marker",
+	"max-keys", "max-parts", "max-uploads", "metadata", "part-number-marker",
+	"partNumber", "prefix", "start-after", "upload-id-marker", "uploadId",
+	"uploads", "versionId", "version-id-marker", "versioning", "versions",
+}
+
+// operation returns the name of the operation of a request, it is empty
+// if the request is not supported.
+func operation(r *request) string {
+	for k := range r.query {
+		if !slices.Contains(supportedParams, k) && !strings.HasPrefix(k, "X-Amz-") {
+			return ""
+		}
+	}
+	has := r.query.Has
+	switch {
+	case r.bucketName == "":
+		if r.Method == http.MethodGet {
+			return "ListBuckets"
+		}
+	case r.objectName == "":
+		switch r.Method {
+		case http.MethodPut:
+			if has("versioning") {
+				return "SetBucketVersioning"
+			}
+			return "MakeBucket"
+		case http.MethodGet:
+			switch {
+			case has("location"):
+				return "GetBucketLocation"
+			case has("versioning"):
+				return "GetBucketVersioning"
+			case has("uploads"):
+				return "ListMultipartUploads"
+			}
+			return "ListObjects"
+		case http.MethodHead:
+			return "BucketExists"
+		case http.MethodDelete:
+			return "RemoveBucket"
+		case http.MethodPost:
+			if has("delete") {
+				return "RemoveObjects"
+			}
+		}
+	default:
+		switch r.Method {
+		case http.MethodPut:
+			switch {
+			case has("uploadId"):
+				if r.Header.Get("X-Amz-Copy-Source") == "" {
+					return "PutObjectPart"
+				}
+			case r.Header.Get("X-Amz-Copy-Source") != "":
+				return "CopyObject"
+			default:
+				return "PutObject"
+			}
+		case http.MethodGet:
+			if has("uploadId") {
+				return "ListObjectParts"
+			}
+			return "GetObject"
+		case http.MethodHead:
+			return "StatObject"
+		case http.MethodDelete:
+			if has("uploadId") {
+				return "AbortMultipartUpload"
+			}
+			return "RemoveObject"
+		case http.MethodPost:
+			switch {
+			case has("uploads"):
+				return "NewMultipartUpload"
+			case has("uploadId"):
+				return "CompleteMultipartUpload"
+			}
+		}
+	}
+	return ""
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	req := &request{Request: r, query: r.URL.Query()}
+	req.bucketName, req.objectName, _ = strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	op := operation(req)
+	if op == "" {
+		writeError(w, r, errorResponse(http.StatusNotImplemented, minio.NotImplemented,
+			"A header you provided implies functionality that is not implemented.", req.bucketName, req.objectName))
+		return
+	}
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, r, errorResponse(http.StatusBadRequest, "IncompleteBody",
+			"You did not provide the number of bytes specified by the Content-Length HTTP header.", req.bucketName, req.objectName))
+		return
+	}
+	req.body = body
+
+	res, err := s.handle(op, req)
+	if err != nil {
+		var errResp minio.ErrorResponse
+		if !errors.As(err, &errResp) {
+			// Close the connection like a failed network.
+			panic(http.ErrAbortHandler)
+		}
+		writeError(w, r, errResp)
+		return
+	}
+	for k, v := range res.header {
+		w.Header()[k] = v
+	}
+	if res.body != nil {
+		w.Header().Set("Content-Length", strconv.Itoa(len(res.body)))
+	}
+	w.WriteHeader(res.status)
+	if r.Method != http.MethodHead {
+		w.Write(res.body)
+	}
+}
+
+// handle runs the operation op of a request.
+func (s *Server) handle(op string, r *request) (*response, error) {
+	f := s.Fake
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.fail(op, r.bucketName, r.objectName); err != nil {
+		return nil, err
+	}
+	if op == "ListBuckets" {
+		return s.listBuckets()
+	}
+	if op == "MakeBucket" {
+		objectLocking := r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled") == "true"
+		if err := f.makeBucket(r.bucketName, objectLocking); err != nil {
+			return nil, err
+		}
+		return &response{status: http.StatusOK}, nil
+	}
+	b, err := f.bucket(r.bucketName)
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "GetBucketLocation":
+		return xmlResponse(http.StatusOK, struct {
+			XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LocationConstraint"`
+		}{})
+	case "SetBucketVersioning":
+		var config minio.BucketVersioningConfiguration
+		if err := xml.Unmarshal(r.body, &config); err != nil {
+			return nil, errMalformedXML(r)
+		}
+		b.versioning = config
+		return &response{status: http.StatusOK}, nil
+	case "GetBucketVersioning":
+		return xmlResponse(http.StatusOK, b.versioning)
+	case "BucketExists":
+		return &response{status: http.StatusOK}, nil
+	case "RemoveBucket":
+		if err := f.removeBucket(r.bucketName); err != nil {
+			return nil, err
+		}
+		return &response{status: http.StatusNoContent}, nil
+	case "ListObjects":
+		return listObjects(b, r)
+	case "RemoveObjects":
+		return s.removeObjects(b, r)
+	case "PutObject":
+		info := b.put(r.objectName, r.body, objectInfo(r.Header))
+		return &response{status: http.StatusOK, header: uploadHeader(info)}, nil
+	case "CopyObject":
+		return s.copyObject(r)
+	case "GetObject", "StatObject":
+		return getObject(b, r)
+	case "RemoveObject":
+		res, err := b.remove(r.bucketName, r.objectName, r.query.Get("versionId"))
+		if err != nil {
+			return nil, err
+		}
+		header := make(http.Header)
+		if res.DeleteMarker {
+			header.Set("X-Amz-Delete-Marker", "true")
+			header.Set("X-Amz-Version-Id", res.DeleteMarkerVersionID)
+		}
+		return &response{status: http.StatusNoContent, header: header}, nil
+	case "NewMultipartUpload":
+		uploadID := uuid.NewString()
+		b.uploads[uploadID] = &upload{
+			objectName: r.objectName,
+			initiated:  time.Now().UTC(),
+			info:       objectInfo(r.Header),
+			parts:      make(map[int]part),
+		}
+		return xmlResponse(http.StatusOK, struct {
+			XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadID string `xml:"UploadId"`
+		}{Bucket: r.bucketName, Key: r.objectName, UploadID: uploadID})
+	case "ListMultipartUploads":
+		return listMultipartUploads(b, r)
+	}
+
+	up, ok := b.uploads[r.query.Get("uploadId")]
+	if !ok || up.objectName != r.objectName {
+		return nil, errorResponse(http.StatusNotFound, minio.NoSuchUpload,
+			"The specified multipart upload does not exist.", r.bucketName, r.objectName)
+	}
+	switch op {
+	case "PutObjectPart":
+		partNumber, err := strconv.Atoi(r.query.Get("partNumber"))
+		if err != nil || partNumber < 1 || partNumber > maxPartNumber {
+			return nil, errorResponse(http.StatusBadRequest, minio.InvalidArgument,
+				"Part number must be an integer between 1 and 10000, inclusive.", r.bucketName, r.objectName)
+		}
+		sum := md5.Sum(r.body)
+		p := part{etag: hex.EncodeToString(sum[:]), data: r.body, lastModified: time.Now().UTC()}
+		up.parts[partNumber] = p
+		header := make(http.Header)
+		header.Set("ETag", `"`+p.etag+`"`)
+		return &response{status: http.StatusOK, header: header}, nil
+	case "ListObjectParts":
+		return listObjectParts(up, r)
+	case "AbortMultipartUpload":
+		delete(b.uploads, r.query.Get("uploadId"))
+		return &response{status: http.StatusNoContent}, nil
+	case "CompleteMultipartUpload":
+		return completeMultipartUpload(b, up, r)
+	}
+	return nil, fmt.Errorf("unhandled operation %s", op)
+}
+
+// readBody reads the body of a request, aws-chunked bodies of streaming
+// signatures and trailers are decoded.
+func readBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+	br := bufio.NewReader(r.Body)
+	var data []byte
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			// Trailers, e.g. checksums, are ignored.
+			_, err = io.Copy(io.Discard, br)
+			return data, err
+		}
+		chunk := make([]byte, n+2)
+		if _, err = io.ReadFull(br, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:n]...)
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, errResp minio.ErrorResponse) {
+	if errResp.StatusCode == 0 {
+		errResp.StatusCode = http.StatusInternalServerError
+	}
+	if errResp.Message == "" {
+		errResp.Message = errResp.Code
+	}
+	errResp.Resource = r.URL.Path
+	body, _ := xml.Marshal(errResp)
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(errResp.StatusCode)
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
+
+func xmlResponse(status int, v any) (*response, error) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	header := make(http.Header)
+	header.Set("Content-Type", "application/xml")
+	return &response{status: status, header: header, body: append([]byte(xml.Header), body...)}, nil
+}
+
+func errMalformedXML(r *request) error {
+	return errorResponse(http.StatusBadRequest, "MalformedXML",
+		"The XML you provided was not well-formed or did not validate against our published schema.", r.bucketName, r.objectName)
+}
+
+// encodeName encodes an object name of a listing if requested.
+func encodeName(r *request, name string) string {
+	if r.query.Get("encoding-type") == "url" {
+		return url.QueryEscape(name)
+	}
+	return name
+}
+
+func (s *Server) listBuckets() (*response, error) {
+	type bucketInfo struct {
+		Name         string
+		CreationDate time.Time
+	}
+	var buckets []bucketInfo
+	for _, name := range slices.Sorted(maps.Keys(s.Fake.buckets)) {
+		buckets = append(buckets, bucketInfo{Name: name, CreationDate: s.Fake.buckets[name].created})
+	}
+	return xmlResponse(http.StatusOK, struct {
+		XMLName xml.Name     `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult"`
+		Buckets []bucketInfo `xml:"Buckets>Bucket"`
+	}{Buckets: buckets})
+}
+
+// objectInfo returns the content type, user metadata and tags of an
+// upload.
+func objectInfo(h http.Header) minio.ObjectInfo {
+	info := minio.ObjectInfo{ContentType: h.Get("Content-Type")}
+	for k, v := range h {
+		if strings.HasPrefix(k, metadataPrefix) {
+			if info.UserMetadata == nil {
+				info.UserMetadata = make(map[string]string)
+			}
+			info.UserMetadata[k[len(metadataPrefix):]] = v[0]
+		}
+	}
+	if tagging := h.Get("X-Amz-Tagging"); tagging != "" {
+		if values, err := url.ParseQuery(tagging); err == nil {
+			info.UserTags = make(map[string]string, len(values))
+			for k := range values {
+				info.UserTags[k] = values.Get(k)
+			}
+		}
+	}
+	return info
+}
+
+func uploadHeader(info minio.UploadInfo) http.Header {
+	header := make(http.Header)
+	header.Set("ETag", `"`+info.ETag+`"`)
+	if info.VersionID != "" {
+		header.Set("X-Amz-Version-Id", info.VersionID)
+	}
+	return header
+}
+
+func (s *Server) copyObject(r *request) (*response, error) {
+	source, err := url.PathUnescape(strings.TrimPrefix(r.Header.Get("X-Amz-Copy-Source"), "/"))
+	if err != nil {
+		return nil, errorResponse(http.StatusBadRequest, minio.InvalidArgument,
+			"Copy Source must mention the source bucket and key: sourcebucket/sourcekey.", r.bucketName, r.objectName)
+	}
+	source, versionID, _ := strings.Cut(source, "?versionId=")
+	srcBucket, srcObject, _ := strings.Cut(source, "/")
+	info := objectInfo(r.Header)
+	uploadInfo, err := s.Fake.copy(minio.CopyDestOptions{
+		Bucket:          r.bucketName,
+		Object:          r.objectName,
+		UserMetadata:    info.UserMetadata,
+		ReplaceMetadata: r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE",
+		UserTags:        info.UserTags,
+		ReplaceTags:     r.Header.Get("X-Amz-Tagging-Directive") == "REPLACE",
+	}, minio.CopySrcOptions{
+		Bucket:    srcBucket,
+		Object:    srcObject,
+		VersionID: versionID,
+		MatchETag: strings.Trim(r.Header.Get("X-Amz-Copy-Source-If-Match"), `"`),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := xmlResponse(http.StatusOK, struct {
+		XMLName      xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CopyObjectResult"`
+		ETag         string
+		LastModified time.Time
+	}{ETag: `"` + uploadInfo.ETag + `"`, LastModified: uploadInfo.LastModified})
+	if err != nil {
+		return nil, err
+	}
+	if uploadInfo.VersionID != "" {
+		res.header.Set("X-Amz-Version-Id", uploadInfo.VersionID)
+	}
+	return res, nil
+}
+
+func getObject(b *bucket, r *request) (*response, error) {
+	v, err := b.version(r.bucketName, r.objectName, r.query.Get("versionId"))
+	if err != nil {
+		return nil, err
+	}
+	info := b.objectInfo(r.objectName, v)
+	if match := r.Header.Get("If-Match"); match != "" && strings.Trim(match, `"`) != info.ETag {
+		return nil, errorResponse(http.StatusPreconditionFailed, minio.PreconditionFailed,
+			"At least one of the pre-conditions you specified did not hold", r.bucketName, r.objectName)
+	}
+	header := make(http.Header)
+	header.Set("ETag", `"`+info.ETag+`"`)
+	header.Set("Last-Modified", info.LastModified.Format(http.TimeFormat))
+	header.Set("Content-Type", info.ContentType)
+	header.Set("Accept-Ranges", "bytes")
+	if info.VersionID != "" {
+		header.Set("X-Amz-Version-Id", info.VersionID)
+	}
+	for k, v := range info.UserMetadata {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(k), metadataPrefix) {
+			k = metadataPrefix + k
+		}
+		header.Set(k, v)
+	}
+	if info.UserTagCount > 0 {
+		header.Set("X-Amz-Tagging-Count", strconv.Itoa(info.UserTagCount))
+	}
+	if none := r.Header.Get("If-None-Match"); none != "" && strings.Trim(none, `"`) == info.ETag {
+		return &response{status: http.StatusNotModified, header: header}, nil
+	}
+
+	status, data := http.StatusOK, v.data
+	if rng := r.Header.Get("Range"); rng != "" {
+		start, end, ok := parseRange(rng, info.Size)
+		if !ok {
+			return nil, errorResponse(http.StatusRequestedRangeNotSatisfiable, minio.InvalidRange,
+				"The requested range is not satisfiable", r.bucketName, r.objectName)
+		}
+		status, data = http.StatusPartialContent, data[start:end+1]
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, info.Size))
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	return &response{status: status, header: header, body: data}, nil
+}
+
+// parseRange parses a Range header of a single byte range, it returns
+// the offsets of the first and last byte.
+func parseRange(rng string, size int64) (start, end int64, ok bool) {
+	spec, found := strings.CutPrefix(rng, "bytes=")
+	if !found {
+		return 0, 0, false
+	}
+	first, last, found := strings.Cut(spec, "-")
+	if !found {
+		return 0, 0, false
+	}
+	var err error
+	switch {
+	case first == "":
+		var n int64
+		n, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || n <= 0 || size == 0 {
+			return 0, 0, false
+		}
+		return max(size-n, 0), size - 1, true
+	case last == "":
+		end = size - 1
+	default:
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+	if start, err = strconv.ParseInt(first, 10, 64); err != nil || start >= size || end < start {
+		return 0, 0, false
+	}
+	return start, min(end, size-1), true
+}
+
+type listEntry struct {
+	XMLName      xml.Name
+	Key          string
+	VersionID    string `xml:"VersionId,omitempty"`
+	IsLatest     bool   `xml:",omitempty"`
+	LastModified time.Time
+	ETag         string `xml:",omitempty"`
+	Size         int64
+	StorageClass string `xml:",omitempty"`
+}
+
+type commonPrefix struct {
+	Prefix string
+}
+
+func listObjects(b *bucket, r *request) (*response, error) {
+	delimiter := r.query.Get("delimiter")
+	if delimiter != "" && delimiter != "/" {
+		return nil, errorResponse(http.StatusNotImplemented, minio.NotImplemented,
+			"Only the delimiter / is implemented.", r.bucketName, "")
+	}
+	maxKeys := defaultMaxKeys
+	if n, err := strconv.Atoi(r.query.Get("max-keys")); err == nil && n > 0 {
+		maxKeys = min(n, defaultMaxKeys)
+	}
+	versions := r.query.Has("versions")
+	startAfter := max(r.query.Get("start-after"), r.query.Get("continuation-token"), r.query.Get("marker"))
+	objects := b.list(minio.ListObjectsOptions{
+		Prefix:       r.query.Get("prefix"),
+		Recursive:    delimiter == "",
+		StartAfter:   startAfter,
+		WithVersions: versions,
+	})
+
+	var (
+		entries   []listEntry
+		prefixes  []commonPrefix
+		truncated bool
+		next      string
+	)
+	for i, object := range objects {
+		// Versions are not paged, they are listed at once.
+		if i == maxKeys && !versions {
+			truncated, next = true, objects[i-1].Key
+			break
+		}
+		// Common prefixes are listed as objects without an ETag.
+		if object.ETag == "" && !object.IsDeleteMarker {
+			prefixes = append(prefixes, commonPrefix{Prefix: encodeName(r, object.Key)})
+			continue
+		}
+		entry := listEntry{
+			XMLName:      xml.Name{Local: "Contents"},
+			Key:          encodeName(r, object.Key),
+			LastModified: object.LastModified,
+			Size:         object.Size,
+		}
+		if object.ETag != "" {
+			entry.ETag = `"` + object.ETag + `"`
+			entry.StorageClass = "STANDARD"
+		}
+		if versions {
+			entry.XMLName.Local = "Version"
+			if object.IsDeleteMarker {
+				entry.XMLName.Local = "DeleteMarker"
+			}
+			entry.VersionID = object.VersionID
+			if entry.VersionID == "" {
+				entry.VersionID = nullVersionID
+			}
+			entry.IsLatest = object.IsLatest
+		}
+		entries = append(entries, entry)
+	}
+
+	result := struct {
+		XMLName               xml.Name
+		Name                  string
+		Prefix                string
+		Delimiter             string `xml:",omitempty"`
+		EncodingType          string `xml:",omitempty"`
+		MaxKeys               int
+		IsTruncated           bool
+		Marker                string `xml:",omitempty"`
+		NextMarker            string `xml:",omitempty"`
+		ContinuationToken     string `xml:",omitempty"`
+		NextContinuationToken string `xml:",omitempty"`
+		StartAfter            string `xml:",omitempty"`
+		KeyCount              int    `xml:",omitempty"`
+		Entries               []listEntry
+		CommonPrefixes        []commonPrefix
+	}{
+		XMLName:        xml.Name{Space: "http://s3.amazonaws.com/doc/2006-03-01/", Local: "ListBucketResult"},
+		Name:           r.bucketName,
+		Prefix:         encodeName(r, r.query.Get("prefix")),
+		Delimiter:      delimiter,
+		EncodingType:   r.query.Get("encoding-type"),
+		MaxKeys:        maxKeys,
+		IsTruncated:    truncated,
+		Entries:        entries,
+		CommonPrefixes: prefixes,
+	}
+	switch {
+	case versions:
+		result.XMLName.Local = "ListVersionsResult"
+	case r.query.Get("list-type") == "2":
+		result.KeyCount = len(entries) + len(prefixes)
+		result.StartAfter = encodeName(r, r.query.Get("start-after"))
+		result.ContinuationToken = r.query.Get("continuation-token")
+		if truncated {
+			result.NextContinuationToken = next
+		}
+	default:
+		result.Marker = encodeName(r, r.query.Get("marker"))
+		if truncated {
+			result.NextMarker = encodeName(r, next)
+		}
+	}
+	return xmlResponse(http.StatusOK, result)
+}
+
+func (s *Server) removeObjects(b *bucket, r *request) (*response, error) {
+	var del struct {
+		Quiet   bool
+		Objects []struct {
+			Key       string
+			VersionID string `xml:"VersionId"`
+		} `xml:"Object"`
+	}
+	if err := xml.Unmarshal(r.body, &del); err != nil {
+		return nil, errMalformedXML(r)
+	}
+	type deleted struct {
+		Key                   string
+		VersionID             string `xml:"VersionId,omitempty"`
+		DeleteMarker          bool   `xml:",omitempty"`
+		DeleteMarkerVersionID string `xml:"DeleteMarkerVersionId,omitempty"`
+	}
+	type failed struct {
+		Key       string
+		VersionID string `xml:"VersionId,omitempty"`
+		Code      string
+		Message   string
+	}
+	var result struct {
+		XMLName xml.Name  `xml:"http://s3.amazonaws.com/doc/2006-03-01/ DeleteResult"`
+		Deleted []deleted `xml:"Deleted"`
+		Errors  []failed  `xml:"Error"`
+	}
+	for _, object := range del.Objects {
+		err := s.Fake.fail("RemoveObject", r.bucketName, object.Key)
+		var res minio.RemoveObjectResult
+		if err == nil {
+			res, err = b.remove(r.bucketName, object.Key, object.VersionID)
+		}
+		if err != nil {
+			errResp := minio.ToErrorResponse(err)
+			result.Errors = append(result.Errors, failed{
+				Key:       object.Key,
+				VersionID: object.VersionID,
+				Code:      errResp.Code,
+				Message:   errResp.Message,
+			})
+			continue
+		}
+		if !del.Quiet {
+			result.Deleted = append(result.Deleted, deleted{
+				Key:                   object.Key,
+				VersionID:             object.VersionID,
+				DeleteMarker:          res.DeleteMarker,
+				DeleteMarkerVersionID: res.DeleteMarkerVersionID,
+			})
+		}
+	}
+	return xmlResponse(http.StatusOK, result)
+}
+
+func listMultipartUploads(b *bucket, r *request) (*response, error) {
+	type uploadInfo struct {
+		Key       string
+		UploadID  string `xml:"UploadId"`
+		Initiated time.Time
+	}
+	var uploads []uploadInfo
+	for id, up := range b.uploads {
+		if strings.HasPrefix(up.objectName, r.query.Get("prefix")) {
+			uploads = append(uploads, uploadInfo{Key: encodeName(r, up.objectName), UploadID: id, Initiated: up.initiated})
+		}
+	}
+	slices.SortFunc(uploads, func(a, b uploadInfo) int {
+		return strings.Compare(a.Key+"/"+a.UploadID, b.Key+"/"+b.UploadID)
+	})
+	return xmlResponse(http.StatusOK, struct {
+		XMLName      xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListMultipartUploadsResult"`
+		Bucket       string
+		Prefix       string
+		EncodingType string `xml:",omitempty"`
+		MaxUploads   int
+		IsTruncated  bool
+		Uploads      []uploadInfo `xml:"Upload"`
+	}{
+		Bucket:       r.bucketName,
+		Prefix:       encodeName(r, r.query.Get("prefix")),
+		EncodingType: r.query.Get("encoding-type"),
+		MaxUploads:   len(uploads),
+		Uploads:      uploads,
+	})
+}
+
+func listObjectParts(up *upload, r *request) (*response, error) {
+	type partInfo struct {
+		PartNumber   int
+		ETag         string
+		LastModified time.Time
+		Size         int64
+	}
+	marker, _ := strconv.Atoi(r.query.Get("part-number-marker"))
+	maxParts := defaultMaxKeys
+	if n, err := strconv.Atoi(r.query.Get("max-parts")); err == nil && n > 0 {
+		maxParts = min(n, defaultMaxKeys)
+	}
+	result := struct {
+		XMLName              xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListPartsResult"`
+		Bucket               string
+		Key                  string
+		UploadID             string `xml:"UploadId"`
+		PartNumberMarker     int
+		NextPartNumberMarker int
+		MaxParts             int
+		IsTruncated          bool
+		Parts                []partInfo `xml:"Part"`
+	}{
+		Bucket:           r.bucketName,
+		Key:              r.objectName,
+		UploadID:         r.query.Get("uploadId"),
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+	for _, number := range slices.Sorted(maps.Keys(up.parts)) {
+		if number <= marker {
+			continue
+		}
+		if len(result.Parts) == maxParts {
+			result.IsTruncated = true
+			break
+		}
+		p := up.parts[number]
+		result.Parts = append(result.Parts, partInfo{
+			PartNumber:   number,
+			ETag:         `"` + p.etag + `"`,
+			LastModified: p.lastModified,
+			Size:         int64(len(p.data)),
+		})
+		result.NextPartNumberMarker = number
+	}
+	return xmlResponse(http.StatusOK, result)
+}
+
+func completeMultipartUpload(b *bucket, up *upload, r *request) (*response, error) {
+	var complete struct {
+		Parts []struct {
+			PartNumber int
+			ETag       string
+		} `xml:"Part"`
+	}
+	if err := xml.Unmarshal(r.body, &complete); err != nil || len(complete.Parts) == 0 {
+		return nil, errMalformedXML(r)
+	}
+	var (
+		data []byte
+		sums []byte
+	)
+	for i, cp := range complete.Parts {
+		if i > 0 && cp.PartNumber <= complete.Parts[i-1].PartNumber {
+			return nil, errorResponse(http.StatusBadRequest, "InvalidPartOrder",
+				"The list of parts was not in ascending order.", r.bucketName, r.objectName)
+		}
+		p, ok := up.parts[cp.PartNumber]
+		if !ok || strings.Trim(cp.ETag, `"`) != p.etag {
+			return nil, errorResponse(http.StatusBadRequest, "InvalidPart",
+				"One or more of the specified parts could not be found.", r.bucketName, r.objectName)
+		}
+		if i < len(complete.Parts)-1 && len(p.data) < minPartSize {
+			return nil, errorResponse(http.StatusBadRequest, minio.EntityTooSmall,
+				"Your proposed upload is smaller than the minimum allowed object size.", r.bucketName, r.objectName)
+		}
+		data = append(data, p.data...)
+		sum, _ := hex.DecodeString(p.etag)
+		sums = append(sums, sum...)
+	}
+	sum := md5.Sum(sums)
+	info := up.info
+	info.ETag = fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), len(complete.Parts))
+	uploadInfo := b.put(r.objectName, data, info)
+	delete(b.uploads, r.query.Get("uploadId"))
+
+	res, err := xmlResponse(http.StatusOK, struct {
+		XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult"`
+		Location string
+		Bucket   string
+		Key      string
+		ETag     string
+	}{
+		Location: r.URL.Path,
+		Bucket:   r.bucketName,
+		Key:      r.objectName,
+		ETag:     `"` + uploadInfo.ETag + `"`,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if uploadInfo.VersionID != "" {
+		res.header.Set("X-Amz-Version-Id", uploadInfo.VersionID)
+	}
+	return res, nil
+}
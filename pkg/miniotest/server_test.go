@@ -0,0 +1,217 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miniotest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func newTestClient(t *testing.T) (*Server, *minio.Client) {
+	t.Helper()
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	clnt, err := minio.New(srv.Endpoint(), &minio.Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, clnt
+}
+
+func TestServerObjects(t *testing.T) {
+	ctx := context.Background()
+	_, clnt := newTestClient(t)
+
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clnt.PutObject(ctx, "bucket", "dir/a b", strings.NewReader("hello"), 5, minio.PutObjectOptions{
+		ContentType:  "text/plain",
+		UserMetadata: map[string]string{"Color": "blue"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := clnt.StatObject(ctx, "bucket", "dir/a b", minio.StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != 5 || info.ContentType != "text/plain" || info.UserMetadata["Color"] != "blue" {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+
+	obj, err := clnt.GetObject(ctx, "bucket", "dir/a b", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 3)
+	if _, err := obj.ReadAt(buf, 2); err != nil || string(buf) != "llo" {
+		t.Fatalf("unexpected ReadAt: %q, %v", buf, err)
+	}
+	obj.Close()
+
+	var keys []string
+	for object := range clnt.ListObjects(ctx, "bucket", minio.ListObjectsOptions{}) {
+		if object.Err != nil {
+			t.Fatal(object.Err)
+		}
+		keys = append(keys, object.Key)
+	}
+	if strings.Join(keys, ",") != "dir/" {
+		t.Fatalf("expected dir/, got %v", keys)
+	}
+	for object := range clnt.ListObjects(ctx, "bucket", minio.ListObjectsOptions{Recursive: true}) {
+		if object.Err != nil || object.Key != "dir/a b" || object.Size != 5 {
+			t.Fatalf("unexpected object: %+v", object)
+		}
+	}
+
+	if err := clnt.RemoveObject(ctx, "bucket", "dir/a b", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clnt.StatObject(ctx, "bucket", "dir/a b", minio.StatObjectOptions{}); !errors.Is(err, minio.ErrNoSuchKey) {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+	if err := clnt.RemoveBucket(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServerListPages(t *testing.T) {
+	ctx := context.Background()
+	_, clnt := newTestClient(t)
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", "b/1", "b/2", "c", "d"} {
+		if _, err := clnt.PutObject(ctx, "bucket", name, strings.NewReader(name), -1, minio.PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, v1 := range []bool{false, true} {
+		var keys []string
+		for object := range clnt.ListObjects(ctx, "bucket", minio.ListObjectsOptions{MaxKeys: 1, UseV1: v1}) {
+			if object.Err != nil {
+				t.Fatal(object.Err)
+			}
+			keys = append(keys, object.Key)
+		}
+		if strings.Join(keys, ",") != "a,b/,c,d" {
+			t.Fatalf("V1 %t: expected a,b/,c,d, got %v", v1, keys)
+		}
+	}
+}
+
+func TestServerMultipart(t *testing.T) {
+	ctx := context.Background()
+	srv, clnt := newTestClient(t)
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("0123456789abcdef"), 11*1024*1024/16)
+
+	// A part upload fails once, it is retried by the client.
+	srv.Fake.InjectError("PutObjectPart", "bucket", "object", minio.ErrSlowDown)
+	info, err := clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		PartSize: 5 * 1024 * 1024,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(info.ETag, "-3") {
+		t.Fatalf("expected the ETag of 3 parts, got %s", info.ETag)
+	}
+	obj, err := clnt.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	got, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("unexpected content of the multipart object")
+	}
+}
+
+func TestServerVersioningAndErrors(t *testing.T) {
+	ctx := context.Background()
+	srv, clnt := newTestClient(t)
+	if err := clnt.MakeBucket(ctx, "bucket", minio.MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := clnt.EnableVersioning(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := clnt.GetBucketVersioning(ctx, "bucket")
+	if err != nil || !cfg.Enabled() {
+		t.Fatalf("expected versioning to be enabled, got %+v, %v", cfg, err)
+	}
+	for range 2 {
+		if _, err := clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, minio.PutObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := clnt.RemoveObject(ctx, "bucket", "object", minio.RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	var markers, versions int
+	for object := range clnt.ListObjects(ctx, "bucket", minio.ListObjectsOptions{WithVersions: true}) {
+		switch {
+		case object.Err != nil:
+			t.Fatal(object.Err)
+		case object.IsDeleteMarker:
+			markers++
+		default:
+			versions++
+		}
+	}
+	if markers != 1 || versions != 2 {
+		t.Fatalf("expected 1 delete marker and 2 versions, got %d and %d", markers, versions)
+	}
+
+	// A network error is retried by the client.
+	srv.Fake.InjectError("BucketExists", "", "", errors.New("network error"))
+	if ok, err := clnt.BucketExists(ctx, "bucket"); err != nil || !ok {
+		t.Fatalf("expected the bucket to exist after a retry, got %t, %v", ok, err)
+	}
+	srv.Fake.ErrorFunc = func(op, _, _ string) error {
+		if op == "GetObject" {
+			return minio.ErrAccessDenied
+		}
+		return nil
+	}
+	obj, err := clnt.GetObject(ctx, "bucket", "object", minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if _, err := io.ReadAll(obj); !errors.Is(err, minio.ErrAccessDenied) {
+		t.Fatalf("expected AccessDenied, got %v", err)
+	}
+}
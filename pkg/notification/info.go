@@ -17,20 +17,25 @@
 
 package notification
 
-// Indentity represents the user id, this is a compliance field.
-type identity struct {
+import (
+	"net/url"
+	"time"
+)
+
+// Identity represents the user id, this is a compliance field.
+type Identity struct {
 	PrincipalID string `json:"principalId"`
 }
 
-// event bucket metadata.
-type bucketMeta struct {
+// BucketMeta - event bucket metadata.
+type BucketMeta struct {
 	Name          string   `json:"name"`
-	OwnerIdentity identity `json:"ownerIdentity"`
+	OwnerIdentity Identity `json:"ownerIdentity"`
 	ARN           string   `json:"arn"`
 }
 
-// event object metadata.
-type objectMeta struct {
+// ObjectMeta - event object metadata.
+type ObjectMeta struct {
 	Key          string            `json:"key"`
 	Size         int64             `json:"size,omitempty"`
 	ETag         string            `json:"eTag,omitempty"`
@@ -40,17 +45,23 @@ type objectMeta struct {
 	Sequencer    string            `json:"sequencer"`
 }
 
-// event server specific metadata.
-type eventMeta struct {
+// ObjectName returns the name of the object, the key of events is URL
+// encoded.
+func (o ObjectMeta) ObjectName() (string, error) {
+	return url.QueryUnescape(o.Key)
+}
+
+// EventMeta - event server specific metadata.
+type EventMeta struct {
 	SchemaVersion   string     `json:"s3SchemaVersion"`
 	ConfigurationID string     `json:"configurationId"`
-	Bucket          bucketMeta `json:"bucket"`
-	Object          objectMeta `json:"object"`
+	Bucket          BucketMeta `json:"bucket"`
+	Object          ObjectMeta `json:"object"`
 }
 
-// sourceInfo represents information on the client that
+// SourceInfo represents information on the client that
 // triggered the event notification.
-type sourceInfo struct {
+type SourceInfo struct {
 	Host      string `json:"host"`
 	Port      string `json:"port"`
 	UserAgent string `json:"userAgent"`
@@ -63,11 +74,21 @@ type Event struct {
 	AwsRegion         string            `json:"awsRegion"`
 	EventTime         string            `json:"eventTime"`
 	EventName         string            `json:"eventName"`
-	UserIdentity      identity          `json:"userIdentity"`
+	UserIdentity      Identity          `json:"userIdentity"`
 	RequestParameters map[string]string `json:"requestParameters"`
 	ResponseElements  map[string]string `json:"responseElements"`
-	S3                eventMeta         `json:"s3"`
-	Source            sourceInfo        `json:"source"`
+	S3                EventMeta         `json:"s3"`
+	Source            SourceInfo        `json:"source"`
+}
+
+// Type returns the type of the event, e.g. s3:ObjectCreated:Put.
+func (e Event) Type() EventType {
+	return EventType(e.EventName)
+}
+
+// Time returns the time of the event.
+func (e Event) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, e.EventTime)
 }
 
 // Info - represents the collection of notification events, additionally
@@ -0,0 +1,104 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ObjectExisting is the event type of the events of existing objects sent
+// by ListenBucketNotificationWithBackfill of the client before the live
+// events, it is not sent by servers.
+const ObjectExisting EventType = "s3:ObjectExisting"
+
+// Matches tells whether the event type name is selected by t, which may
+// be a wildcard such as ObjectCreatedAll.
+func (t EventType) Matches(name EventType) bool {
+	if prefix, ok := strings.CutSuffix(string(t), "*"); ok {
+		return strings.HasPrefix(string(name), prefix)
+	}
+	return t == name
+}
+
+// ListenFilter selects the events of a listen request by the prefix and
+// suffix of the object names and by the event types, all events are
+// selected by the zero value.
+//
+//	filter := notification.NewListenFilter(notification.ObjectCreatedAll).
+//		WithPrefix("photos/").
+//		WithSuffix(".jpg")
+type ListenFilter struct {
+	Prefix string
+	Suffix string
+	Events []EventType
+}
+
+// NewListenFilter creates a filter selecting the given event types.
+func NewListenFilter(events ...EventType) ListenFilter {
+	return ListenFilter{Events: events}
+}
+
+// WithPrefix returns a copy of f selecting the objects with the prefix.
+func (f ListenFilter) WithPrefix(prefix string) ListenFilter {
+	f.Prefix = prefix
+	return f
+}
+
+// WithSuffix returns a copy of f selecting the objects with the suffix.
+func (f ListenFilter) WithSuffix(suffix string) ListenFilter {
+	f.Suffix = suffix
+	return f
+}
+
+// WithEvents returns a copy of f additionally selecting the event types.
+func (f ListenFilter) WithEvents(events ...EventType) ListenFilter {
+	f.Events = append(f.Events[:len(f.Events):len(f.Events)], events...)
+	return f
+}
+
+// Matches tells whether the event is selected by f.
+func (f ListenFilter) Matches(e Event) bool {
+	name, err := e.S3.Object.ObjectName()
+	if err != nil {
+		name = e.S3.Object.Key
+	}
+	if !strings.HasPrefix(name, f.Prefix) || !strings.HasSuffix(name, f.Suffix) {
+		return false
+	}
+	if len(f.Events) == 0 {
+		return true
+	}
+	for _, t := range f.Events {
+		if t.Matches(e.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Query returns the query parameters of a listen request with the filter.
+func (f ListenFilter) Query() url.Values {
+	query := make(url.Values)
+	query.Set("prefix", f.Prefix)
+	query.Set("suffix", f.Suffix)
+	for _, t := range f.Events {
+		query.Add("events", string(t))
+	}
+	return query
+}
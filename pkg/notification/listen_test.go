@@ -0,0 +1,58 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+import (
+	"testing"
+)
+
+func TestListenFilterMatches(t *testing.T) {
+	event := func(name EventType, key string) Event {
+		return Event{EventName: string(name), S3: EventMeta{Object: ObjectMeta{Key: key}}}
+	}
+	testCases := []struct {
+		filter  ListenFilter
+		event   Event
+		matches bool
+	}{
+		{ListenFilter{}, event(ObjectRemovedDelete, "a"), true},
+		{NewListenFilter(ObjectCreatedAll), event(ObjectCreatedPut, "a"), true},
+		{NewListenFilter(ObjectCreatedAll), event(ObjectRemovedDelete, "a"), false},
+		{NewListenFilter(ObjectCreatedPut, ObjectRemovedDelete), event(ObjectRemovedDelete, "a"), true},
+		{NewListenFilter().WithPrefix("photos/"), event(ObjectCreatedPut, "photos%2Fa.jpg"), true},
+		{NewListenFilter().WithPrefix("photos/").WithSuffix(".png"), event(ObjectCreatedPut, "photos%2Fa.jpg"), false},
+	}
+	for i, testCase := range testCases {
+		if matches := testCase.filter.Matches(testCase.event); matches != testCase.matches {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.matches, matches)
+		}
+	}
+}
+
+func TestListenFilterWithEvents(t *testing.T) {
+	base := NewListenFilter(ObjectCreatedAll)
+	a := base.WithEvents(ObjectRemovedAll)
+	b := base.WithEvents(ObjectAccessedAll)
+	if len(base.Events) != 1 || a.Events[1] != ObjectRemovedAll || b.Events[1] != ObjectAccessedAll {
+		t.Fatalf("unexpected events %v %v %v", base.Events, a.Events, b.Events)
+	}
+	query := a.WithPrefix("p").Query()
+	if query.Get("prefix") != "p" || len(query["events"]) != 2 {
+		t.Fatalf("unexpected query %v", query)
+	}
+}
@@ -0,0 +1,226 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TargetType is the type of a MinIO notification target.
+type TargetType string
+
+// MinIO notification target types, see
+// https://min.io/docs/minio/linux/administration/monitoring/bucket-notifications.html
+const (
+	TargetAMQP          TargetType = "amqp"
+	TargetElasticsearch TargetType = "elasticsearch"
+	TargetKafka         TargetType = "kafka"
+	TargetMQTT          TargetType = "mqtt"
+	TargetMySQL         TargetType = "mysql"
+	TargetNATS          TargetType = "nats"
+	TargetNSQ           TargetType = "nsq"
+	TargetPostgreSQL    TargetType = "postgresql"
+	TargetRedis         TargetType = "redis"
+	TargetWebhook       TargetType = "webhook"
+)
+
+// NewSQSArn creates the ARN of an Amazon SQS queue.
+func NewSQSArn(region, accountID, queueName string) Arn {
+	return NewArn("aws", "sqs", region, accountID, queueName)
+}
+
+// NewSNSArn creates the ARN of an Amazon SNS topic.
+func NewSNSArn(region, accountID, topicName string) Arn {
+	return NewArn("aws", "sns", region, accountID, topicName)
+}
+
+// NewLambdaArn creates the ARN of an AWS Lambda function.
+func NewLambdaArn(region, accountID, functionName string) Arn {
+	return NewArn("aws", "lambda", region, accountID, "function:"+functionName)
+}
+
+// NewMinIOArn creates the ARN of a MinIO notification target, id is the
+// identifier of the target in the configuration of the server. MinIO
+// targets are configured as queues, see Configuration.AddQueue.
+func NewMinIOArn(region, id string, target TargetType) Arn {
+	return NewArn("minio", "sqs", region, id, string(target))
+}
+
+// knownEventTypes are the event types which may be configured.
+var knownEventTypes = []EventType{
+	ObjectCreatedAll, ObjectCreatedPut, ObjectCreatedPost, ObjectCreatedCopy,
+	ObjectCreatedDeleteTagging, ObjectCreatedCompleteMultipartUpload,
+	ObjectCreatedPutLegalHold, ObjectCreatedPutRetention, ObjectCreatedPutTagging,
+	ObjectAccessedGet, ObjectAccessedHead, ObjectAccessedGetRetention,
+	ObjectAccessedGetLegalHold, ObjectAccessedAll, ObjectRemovedAll,
+	ObjectRemovedDelete, ObjectRemovedDeleteMarkerCreated,
+	ILMDelMarkerExpirationDelete, ObjectReducedRedundancyLostObject,
+	ObjectTransitionAll, ObjectTransitionFailed, ObjectTransitionComplete,
+	ObjectTransitionPost, ObjectTransitionCompleted, ObjectReplicationAll,
+	ObjectReplicationOperationCompletedReplication,
+	ObjectReplicationOperationFailedReplication,
+	ObjectReplicationOperationMissedThreshold,
+	ObjectReplicationOperationNotTracked,
+	ObjectReplicationOperationReplicatedAfterThreshold,
+	ObjectScannerManyVersions, ObjectScannerBigPrefix, ObjectScannerAll,
+	BucketCreatedAll, BucketRemovedAll,
+	// Event types of AWS S3 without constants.
+	"s3:ObjectRestore:*", "s3:ObjectRestore:Delete", "s3:ObjectTagging:*",
+	"s3:ObjectTagging:Put", "s3:ObjectTagging:Delete", "s3:ObjectAcl:Put",
+	"s3:LifecycleExpiration:*", "s3:LifecycleExpiration:Delete",
+	"s3:LifecycleExpiration:DeleteMarkerCreated", "s3:LifecycleTransition",
+	"s3:IntelligentTiering",
+}
+
+// validate checks the ARN, events and filters of a notification config
+// of the given kind, which is the service of its ARN on AWS.
+func (t Config) validate(kind, arn string) error {
+	// The resource of Lambda ARNs is function:<name>.
+	parsed, err := NewArnFromString(strings.Replace(arn, ":function:", ":function/", 1))
+	if err != nil {
+		return fmt.Errorf("%s configuration %q: %w", kind, arn, err)
+	}
+	if parsed.Partition != "minio" && parsed.Service != kind {
+		return fmt.Errorf("%s configuration %q: ARN of service %s", kind, arn, parsed.Service)
+	}
+	if parsed.Partition == "minio" && kind != "sqs" {
+		return fmt.Errorf("%s configuration %q: MinIO targets must be configured as queues", kind, arn)
+	}
+	if len(t.Events) == 0 {
+		return fmt.Errorf("%s configuration %q: no events", kind, arn)
+	}
+	for _, event := range t.Events {
+		if !slices.Contains(knownEventTypes, event) {
+			return fmt.Errorf("%s configuration %q: unknown event %s", kind, arn, event)
+		}
+	}
+	if t.Filter != nil {
+		var prefix, suffix int
+		for _, rule := range t.Filter.S3Key.FilterRules {
+			switch rule.Name {
+			case "prefix":
+				prefix++
+			case "suffix":
+				suffix++
+			default:
+				return fmt.Errorf("%s configuration %q: unknown filter rule %s", kind, arn, rule.Name)
+			}
+		}
+		if prefix > 1 || suffix > 1 {
+			return fmt.Errorf("%s configuration %q: more than one prefix or suffix filter rule", kind, arn)
+		}
+	}
+	return nil
+}
+
+// Validate checks the ARNs, events and filters of the notification
+// configuration, and that the IDs of the configs are unique.
+func (b Configuration) Validate() error {
+	ids := make(map[string]struct{})
+	checkID := func(id string) error {
+		if id == "" {
+			return nil
+		}
+		if _, ok := ids[id]; ok {
+			return fmt.Errorf("duplicate notification configuration id %q", id)
+		}
+		ids[id] = struct{}{}
+		return nil
+	}
+	for _, c := range b.QueueConfigs {
+		if err := c.validate("sqs", c.Queue); err != nil {
+			return err
+		}
+		if err := checkID(c.ID); err != nil {
+			return err
+		}
+	}
+	for _, c := range b.TopicConfigs {
+		if err := c.validate("sns", c.Topic); err != nil {
+			return err
+		}
+		if err := checkID(c.ID); err != nil {
+			return err
+		}
+	}
+	for _, c := range b.LambdaConfigs {
+		if err := c.validate("lambda", c.Lambda); err != nil {
+			return err
+		}
+		if err := checkID(c.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matches tells whether the existing config t matches the desired config
+// want with the same ARN, IDs are only compared if want has one.
+func (t Config) matches(want Config) bool {
+	if want.ID != "" && want.ID != t.ID {
+		return false
+	}
+	var rules, wantRules []FilterRule
+	if t.Filter != nil {
+		rules = t.Filter.S3Key.FilterRules
+	}
+	if want.Filter != nil {
+		wantRules = want.Filter.S3Key.FilterRules
+	}
+	return EqualEventTypeList(t.Events, want.Events) && EqualFilterRuleList(rules, wantRules)
+}
+
+// Diff returns the configs of want which are missing in b and the
+// configs of b which are not in want. IDs assigned by the server are
+// ignored for the configs of want without an ID.
+func (b Configuration) Diff(want Configuration) (added, removed Configuration) {
+	added.QueueConfigs, removed.QueueConfigs = diffConfigs(b.QueueConfigs, want.QueueConfigs, func(c QueueConfig) (string, Config) { return c.Queue, c.Config })
+	added.TopicConfigs, removed.TopicConfigs = diffConfigs(b.TopicConfigs, want.TopicConfigs, func(c TopicConfig) (string, Config) { return c.Topic, c.Config })
+	added.LambdaConfigs, removed.LambdaConfigs = diffConfigs(b.LambdaConfigs, want.LambdaConfigs, func(c LambdaConfig) (string, Config) { return c.Lambda, c.Config })
+	return added, removed
+}
+
+// IsEmpty tells whether the configuration has no configs.
+func (b Configuration) IsEmpty() bool {
+	return len(b.QueueConfigs) == 0 && len(b.TopicConfigs) == 0 && len(b.LambdaConfigs) == 0
+}
+
+func diffConfigs[T any](have, want []T, config func(T) (string, Config)) (added, removed []T) {
+	matched := make([]bool, len(have))
+	for _, w := range want {
+		wantArn, wantConfig := config(w)
+		found := false
+		for i, h := range have {
+			if arn, c := config(h); !matched[i] && arn == wantArn && c.matches(wantConfig) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			added = append(added, w)
+		}
+	}
+	for i, h := range have {
+		if !matched[i] {
+			removed = append(removed, h)
+		}
+	}
+	return added, removed
+}
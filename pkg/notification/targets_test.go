@@ -0,0 +1,120 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notification
+
+import (
+	"testing"
+)
+
+func TestTargetArns(t *testing.T) {
+	testCases := []struct {
+		arn  Arn
+		want string
+	}{
+		{NewSQSArn("us-east-1", "123", "queue"), "arn:aws:sqs:us-east-1:123:queue"},
+		{NewSNSArn("us-east-1", "123", "topic"), "arn:aws:sns:us-east-1:123:topic"},
+		{NewLambdaArn("us-east-1", "123", "fn"), "arn:aws:lambda:us-east-1:123:function:fn"},
+		{NewMinIOArn("", "1", TargetWebhook), "arn:minio:sqs::1:webhook"},
+		{NewMinIOArn("us-east-1", "primary", TargetKafka), "arn:minio:sqs:us-east-1:primary:kafka"},
+	}
+	for i, testCase := range testCases {
+		if got := testCase.arn.String(); got != testCase.want {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.want, got)
+		}
+	}
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	config := func(arn Arn, events ...EventType) Config {
+		c := NewConfig(arn)
+		c.AddEvents(events...)
+		c.AddFilterPrefix("photos/")
+		return c
+	}
+	testCases := []struct {
+		configure  func(*Configuration)
+		shouldPass bool
+	}{
+		{func(b *Configuration) { b.AddQueue(config(NewMinIOArn("", "1", TargetWebhook), ObjectCreatedAll)) }, true},
+		{func(b *Configuration) { b.AddLambda(config(NewLambdaArn("us-east-1", "123", "fn"), ObjectRemovedAll)) }, true},
+		{func(b *Configuration) {
+			b.AddTopic(config(NewSNSArn("us-east-1", "123", "topic"), "s3:ObjectTagging:*"))
+		}, true},
+		{func(b *Configuration) { b.AddTopic(config(NewSQSArn("us-east-1", "123", "queue"), ObjectCreatedAll)) }, false},
+		{func(b *Configuration) { b.AddTopic(config(NewMinIOArn("", "1", TargetWebhook), ObjectCreatedAll)) }, false},
+		{func(b *Configuration) { b.AddQueue(config(NewSQSArn("us-east-1", "123", "queue"))) }, false},
+		{func(b *Configuration) {
+			b.AddQueue(config(NewSQSArn("us-east-1", "123", "queue"), "s3:ObjectCreated:Unknown"))
+		}, false},
+		{func(b *Configuration) {
+			c := config(NewSQSArn("us-east-1", "123", "queue"), ObjectCreatedAll)
+			c.Filter.S3Key.FilterRules = append(c.Filter.S3Key.FilterRules, FilterRule{Name: "prefix", Value: "a"})
+			b.AddQueue(c)
+		}, false},
+		{func(b *Configuration) {
+			c := config(NewSQSArn("us-east-1", "123", "queue"), ObjectCreatedAll)
+			c.ID = "id"
+			b.AddQueue(c)
+			c = config(NewSNSArn("us-east-1", "123", "topic"), ObjectCreatedAll)
+			c.ID = "id"
+			b.AddTopic(c)
+		}, false},
+	}
+	for i, testCase := range testCases {
+		var b Configuration
+		testCase.configure(&b)
+		err := b.Validate()
+		if err != nil && testCase.shouldPass {
+			t.Errorf("Test %d: Expected to pass, but failed with: %v", i+1, err)
+		}
+		if err == nil && !testCase.shouldPass {
+			t.Errorf("Test %d: Expected to fail, but passed instead", i+1)
+		}
+	}
+}
+
+func TestConfigurationDiff(t *testing.T) {
+	webhook := NewConfig(NewMinIOArn("", "1", TargetWebhook))
+	webhook.AddEvents(ObjectCreatedPut, ObjectRemovedDelete)
+	webhook.AddFilterSuffix(".jpg")
+	kafka := NewConfig(NewMinIOArn("", "1", TargetKafka))
+	kafka.AddEvents(ObjectCreatedAll)
+
+	var want Configuration
+	want.AddQueue(webhook)
+	want.AddQueue(kafka)
+
+	// The server assigns IDs and may reorder events.
+	var current Configuration
+	webhook.ID = "server-id"
+	webhook.Events = []EventType{ObjectRemovedDelete, ObjectCreatedPut}
+	current.AddQueue(webhook)
+	current.AddQueue(kafka)
+	if added, removed := current.Diff(want); !added.IsEmpty() || !removed.IsEmpty() {
+		t.Fatalf("expected no differences, got %+v %+v", added, removed)
+	}
+
+	want.QueueConfigs[1].Events = []EventType{ObjectRemovedAll}
+	added, removed := current.Diff(want)
+	if len(added.QueueConfigs) != 1 || added.QueueConfigs[0].Events[0] != ObjectRemovedAll {
+		t.Fatalf("unexpected added configs %+v", added)
+	}
+	if len(removed.QueueConfigs) != 1 || removed.QueueConfigs[0].Events[0] != ObjectCreatedAll {
+		t.Fatalf("unexpected removed configs %+v", removed)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/set"
+)
+
+// DefaultVersion is the version of the policy language.
+const DefaultVersion = "2012-10-17"
+
+// Effects of statements.
+const (
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+)
+
+// NewBucketAccessPolicy - returns a policy of the statements, e.g.
+//
+//	policy.NewBucketAccessPolicy(
+//		policy.Allow("s3:GetObject").On(policy.ObjectARN("bucket", "public/*")).For("*"),
+//		policy.Deny("s3:*").On(policy.BucketARN("bucket"), policy.ObjectARN("bucket", "*")).
+//			For("*").When("Bool", "aws:SecureTransport", "false"),
+//	)
+func NewBucketAccessPolicy(statements ...Statement) BucketAccessPolicy {
+	return BucketAccessPolicy{Version: DefaultVersion, Statements: statements}
+}
+
+// ParseBucketAccessPolicy - parses a policy document, e.g. the policy
+// returned by GetBucketPolicy.
+func ParseBucketAccessPolicy(policy string) (BucketAccessPolicy, error) {
+	var p BucketAccessPolicy
+	err := json.Unmarshal([]byte(policy), &p)
+	return p, err
+}
+
+// String - returns the policy document.
+func (p BucketAccessPolicy) String() string {
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+// BucketARN - returns the resource of a bucket.
+func BucketARN(bucketName string) string {
+	return awsResourcePrefix + bucketName
+}
+
+// ObjectARN - returns the resource of the objects of a bucket matching
+// pattern, e.g. "prefix/*".
+func ObjectARN(bucketName, pattern string) string {
+	return awsResourcePrefix + bucketName + "/" + pattern
+}
+
+// Allow - returns a statement allowing actions.
+func Allow(actions ...string) Statement {
+	return Statement{Effect: EffectAllow, Actions: set.CreateStringSet(actions...)}
+}
+
+// Deny - returns a statement denying actions.
+func Deny(actions ...string) Statement {
+	return Statement{Effect: EffectDeny, Actions: set.CreateStringSet(actions...)}
+}
+
+// On - returns the statement with the resources added.
+func (s Statement) On(resources ...string) Statement {
+	s.Resources = s.Resources.Union(set.CreateStringSet(resources...))
+	return s
+}
+
+// For - returns the statement with the AWS principals added, "*" is
+// anyone.
+func (s Statement) For(principals ...string) Statement {
+	s.Principal.AWS = s.Principal.AWS.Union(set.CreateStringSet(principals...))
+	return s
+}
+
+// When - returns the statement with a condition added, e.g.
+// When("StringLike", "s3:prefix", "home/*").
+func (s Statement) When(operator, key string, values ...string) Statement {
+	s.Conditions = mergeConditionMap(s.Conditions, ConditionMap{
+		operator: ConditionKeyMap{key: set.CreateStringSet(values...)},
+	})
+	return s
+}
+
+// WithSid - returns the statement with its ID set.
+func (s Statement) WithSid(sid string) Statement {
+	s.Sid = sid
+	return s
+}
+
+// Canonical - returns the policy in canonical form: the default version
+// is set, effects are capitalized, empty and duplicate statements are
+// removed and statements are sorted. Equivalent policies written
+// differently have the same canonical form.
+func (p BucketAccessPolicy) Canonical() BucketAccessPolicy {
+	out := BucketAccessPolicy{Version: p.Version}
+	if out.Version == "" {
+		out.Version = DefaultVersion
+	}
+	seen := make(map[string]bool)
+	for _, s := range p.Statements {
+		if s.Actions.IsEmpty() || s.Resources.IsEmpty() {
+			continue
+		}
+		switch {
+		case strings.EqualFold(s.Effect, EffectAllow):
+			s.Effect = EffectAllow
+		case strings.EqualFold(s.Effect, EffectDeny):
+			s.Effect = EffectDeny
+		}
+		if len(s.Conditions) == 0 {
+			s.Conditions = nil
+		}
+		if key := statementKey(s); !seen[key] {
+			seen[key] = true
+			out.Statements = append(out.Statements, s)
+		}
+	}
+	slices.SortStableFunc(out.Statements, func(a, b Statement) int {
+		return strings.Compare(statementKey(a), statementKey(b))
+	})
+	return out
+}
+
+// Equal - tells whether two policies grant and deny the same access,
+// i.e. have the same canonical form regardless of statement IDs.
+func (p BucketAccessPolicy) Equal(want BucketAccessPolicy) bool {
+	added, removed := p.Diff(want)
+	return len(added) == 0 && len(removed) == 0 && p.Canonical().Version == want.Canonical().Version
+}
+
+// Diff - returns the statements of want which are missing in p and the
+// statements of p which are not in want, in canonical form. Statement
+// IDs are ignored.
+func (p BucketAccessPolicy) Diff(want BucketAccessPolicy) (added, removed []Statement) {
+	have, wanted := p.Canonical().Statements, want.Canonical().Statements
+	haveKeys := make(map[string]bool, len(have))
+	for _, s := range have {
+		haveKeys[statementKey(s)] = true
+	}
+	wantKeys := make(map[string]bool, len(wanted))
+	for _, s := range wanted {
+		wantKeys[statementKey(s)] = true
+		if !haveKeys[statementKey(s)] {
+			added = append(added, s)
+		}
+	}
+	for _, s := range have {
+		if !wantKeys[statementKey(s)] {
+			removed = append(removed, s)
+		}
+	}
+	return added, removed
+}
+
+// statementKey - returns the JSON encoding of a statement without its
+// ID, sets and maps are encoded sorted.
+func statementKey(s Statement) string {
+	s.Sid = ""
+	b, _ := s.MarshalJSON()
+	return string(b)
+}
@@ -0,0 +1,66 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"testing"
+)
+
+func TestBucketAccessPolicyBuilder(t *testing.T) {
+	p := NewBucketAccessPolicy(
+		Allow("s3:GetObject").On(ObjectARN("bucket", "public/*")).For("*").WithSid("public"),
+		Deny("s3:*").On(BucketARN("bucket"), ObjectARN("bucket", "*")).For("*").
+			When("Bool", "aws:SecureTransport", "false"),
+	)
+	expected := `{"Version":"2012-10-17","Statement":[` +
+		`{"Action":["s3:GetObject"],"Effect":"Allow","Principal":{"AWS":["*"]},"Resource":["arn:aws:s3:::bucket/public/*"],"Sid":"public"},` +
+		`{"Action":["s3:*"],"Condition":{"Bool":{"aws:SecureTransport":["false"]}},"Effect":"Deny","Principal":{"AWS":["*"]},"Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"],"Sid":""}]}`
+	if s := p.String(); s != expected {
+		t.Fatalf("unexpected policy\n%s\nexpected\n%s", s, expected)
+	}
+
+	// The builder does not share the sets of the statements it extends.
+	base := Allow("s3:GetObject").On(ObjectARN("bucket", "*"))
+	if extended := base.On(ObjectARN("other", "*")); base.Resources.Equals(extended.Resources) {
+		t.Fatal("expected the resources of the base statement to be unchanged")
+	}
+
+	parsed, err := ParseBucketAccessPolicy(`{"Statement":[
+		{"Effect":"deny","Principal":"*","Action":"s3:*","Resource":["arn:aws:s3:::bucket/*","arn:aws:s3:::bucket"],"Condition":{"Bool":{"aws:SecureTransport":"false"}}},
+		{"Effect":"Allow","Principal":{"AWS":"*"},"Action":["s3:GetObject"],"Resource":"arn:aws:s3:::bucket/public/*"},
+		{"Effect":"Allow","Principal":{"AWS":"*"},"Action":["s3:GetObject"],"Resource":"arn:aws:s3:::bucket/public/*"}
+	]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Equal(p) {
+		t.Fatalf("expected the policies to be equal\n%s\n%s", parsed.Canonical(), p.Canonical())
+	}
+	if n := len(parsed.Canonical().Statements); n != 2 {
+		t.Fatalf("expected duplicate statements to be removed, got %d statements", n)
+	}
+
+	want := NewBucketAccessPolicy(p.Statements[0], Allow("s3:ListBucket").On(BucketARN("bucket")).For("*"))
+	added, removed := p.Diff(want)
+	if len(added) != 1 || !added[0].Actions.Contains("s3:ListBucket") {
+		t.Errorf("unexpected added statements %v", added)
+	}
+	if len(removed) != 1 || removed[0].Effect != EffectDeny {
+		t.Errorf("unexpected removed statements %v", removed)
+	}
+}
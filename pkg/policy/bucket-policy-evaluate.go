@@ -0,0 +1,236 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Decision - is the result of the evaluation of a request against a
+// policy.
+type Decision int
+
+// Decisions of the evaluation of a policy.
+const (
+	// ImplicitDeny - no statement allows the request.
+	ImplicitDeny Decision = iota
+	// Allowed - a statement allows the request and none denies it.
+	Allowed
+	// ExplicitDeny - a statement denies the request.
+	ExplicitDeny
+)
+
+func (d Decision) String() string {
+	switch d {
+	case Allowed:
+		return "Allowed"
+	case ExplicitDeny:
+		return "ExplicitDeny"
+	}
+	return "ImplicitDeny"
+}
+
+// Request - describes a request evaluated against a policy.
+type Request struct {
+	// Action, e.g. s3:GetObject.
+	Action string
+	// Resource, e.g. arn:aws:s3:::bucket/object, the arn:aws:s3:::
+	// prefix may be omitted.
+	Resource string
+	// Principal is the ARN or the canonical user ID of the caller, "*"
+	// for anonymous requests.
+	Principal string
+	// Context holds the values of the condition keys of the request,
+	// e.g. aws:SourceIp or s3:prefix. Keys are case insensitive.
+	Context map[string][]string
+}
+
+// Evaluate - simulates the evaluation of a request without condition
+// keys, statements with conditions on keys apply as if the keys were
+// absent.
+func (p BucketAccessPolicy) Evaluate(action, resource, principal string) Decision {
+	return p.EvaluateRequest(Request{Action: action, Resource: resource, Principal: principal})
+}
+
+// EvaluateRequest - simulates the evaluation of a request: the request is
+// denied if a statement denies it, otherwise allowed if a statement
+// allows it. Statements with unsupported condition operators do not
+// apply.
+func (p BucketAccessPolicy) EvaluateRequest(r Request) Decision {
+	if !strings.HasPrefix(r.Resource, "arn:") {
+		r.Resource = awsResourcePrefix + r.Resource
+	}
+	ctx := make(map[string][]string, len(r.Context))
+	for k, v := range r.Context {
+		ctx[strings.ToLower(k)] = v
+	}
+
+	decision := ImplicitDeny
+	for _, s := range p.Statements {
+		if !s.applies(r, ctx) {
+			continue
+		}
+		switch {
+		case strings.EqualFold(s.Effect, EffectDeny):
+			return ExplicitDeny
+		case strings.EqualFold(s.Effect, EffectAllow):
+			decision = Allowed
+		}
+	}
+	return decision
+}
+
+// applies - tells whether the statement applies to a request.
+func (s Statement) applies(r Request, ctx map[string][]string) bool {
+	// Actions are case insensitive.
+	if s.Actions.ApplyFunc(strings.ToLower).FuncMatch(resourceMatch, strings.ToLower(r.Action)).IsEmpty() {
+		return false
+	}
+	if s.Resources.FuncMatch(resourceMatch, r.Resource).IsEmpty() {
+		return false
+	}
+	if !s.Principal.AWS.Contains("*") && !s.Principal.AWS.Contains(r.Principal) &&
+		!s.Principal.CanonicalUser.Contains(r.Principal) {
+		return false
+	}
+	for operator, keys := range s.Conditions {
+		for key, values := range keys {
+			if !conditionMatch(operator, ctx[strings.ToLower(key)], values.ToSlice()) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// conditionMatch - evaluates a condition operator, the values of the
+// request are nil when its key is absent.
+func conditionMatch(operator string, have, want []string) bool {
+	if operator == "Null" {
+		return len(want) == 1 && strconv.FormatBool(have == nil) == strings.ToLower(want[0])
+	}
+	ifExists := strings.HasSuffix(operator, "IfExists")
+	operator = strings.TrimSuffix(operator, "IfExists")
+	if have == nil {
+		// Negated operators match absent keys.
+		return ifExists || strings.Contains(operator, "Not")
+	}
+
+	var match func(have, want string) bool
+	switch operator {
+	case "StringEquals", "StringNotEquals":
+		match = func(have, want string) bool { return have == want }
+	case "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase":
+		match = strings.EqualFold
+	case "StringLike", "StringNotLike":
+		match = func(have, want string) bool { return resourceMatch(want, have) }
+	case "IpAddress", "NotIpAddress":
+		match = func(have, want string) bool {
+			_, ipnet, err := net.ParseCIDR(want)
+			return err == nil && ipnet.Contains(net.ParseIP(have))
+		}
+	case "Bool":
+		match = func(have, want string) bool { return strings.EqualFold(have, want) }
+	default:
+		return false
+	}
+	negated := strings.Contains(operator, "Not")
+
+	// A request value must match a policy value, or none must match for
+	// negated operators.
+	for _, h := range have {
+		for _, w := range want {
+			if match(h, w) {
+				return !negated
+			}
+		}
+	}
+	return negated
+}
+
+// restrictingKeys are the condition keys that, pinned to fixed values,
+// restrict who can make a request, see IsPublic.
+var restrictingKeys = map[string]bool{
+	"aws:sourceip":         true,
+	"aws:sourcevpc":        true,
+	"aws:sourcevpce":       true,
+	"aws:sourcearn":        true,
+	"aws:sourceaccount":    true,
+	"aws:sourceowner":      true,
+	"aws:principalorgid":   true,
+	"aws:principalaccount": true,
+}
+
+// IsPublic - tells whether the policy allows anonymous requests, i.e.
+// has a statement allowing anyone whose conditions do not pin a fixed
+// value of a key restricting the caller, e.g. source IPs, VPC endpoints
+// or accounts. Other conditions, e.g. aws:SecureTransport or s3:prefix,
+// do not make a statement non-public, as for the policy status of AWS.
+func (p BucketAccessPolicy) IsPublic() bool {
+	for _, s := range p.Statements {
+		if !strings.EqualFold(s.Effect, EffectAllow) || !s.Principal.AWS.Contains("*") {
+			continue
+		}
+		restricted := false
+		for operator, keys := range s.Conditions {
+			for key, values := range keys {
+				if restrictingKeys[strings.ToLower(key)] && fixedValues(operator, values.ToSlice()) {
+					restricted = true
+				}
+			}
+		}
+		if !restricted {
+			return true
+		}
+	}
+	return false
+}
+
+// fixedValues - tells whether a condition operator requires a key to be
+// present and to have one of a set of fixed values.
+func fixedValues(operator string, values []string) bool {
+	if len(values) == 0 {
+		return false
+	}
+	var fixed func(v string) bool
+	switch operator {
+	case "StringEquals", "StringEqualsIgnoreCase", "ArnEquals":
+		fixed = func(v string) bool { return v != "" }
+	case "StringLike", "ArnLike":
+		fixed = func(v string) bool { return v != "" && !strings.ContainsAny(v, "*?") }
+	case "IpAddress":
+		fixed = func(v string) bool {
+			_, ipnet, err := net.ParseCIDR(v)
+			if err != nil {
+				return false
+			}
+			ones, _ := ipnet.Mask.Size()
+			return ones > 0
+		}
+	default:
+		return false
+	}
+	for _, v := range values {
+		if !fixed(v) {
+			return false
+		}
+	}
+	return true
+}
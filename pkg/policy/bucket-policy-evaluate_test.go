@@ -0,0 +1,110 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	const alice = "arn:aws:iam::123456789012:user/alice"
+	p := NewBucketAccessPolicy(
+		Allow("s3:GetObject").On(ObjectARN("bucket", "public/*")).For("*"),
+		Allow("s3:*").On(BucketARN("bucket"), ObjectARN("bucket", "*")).For(alice),
+		Allow("s3:ListBucket").On(BucketARN("bucket")).For("*").When("StringLike", "s3:prefix", "public/*"),
+		Deny("s3:DeleteObject").On(ObjectARN("bucket", "locked/*")).For("*"),
+		Deny("s3:PutObject").On(ObjectARN("bucket", "*")).For("*").When("NotIpAddress", "aws:SourceIp", "10.0.0.0/8"),
+	)
+
+	testCases := []struct {
+		request  Request
+		decision Decision
+	}{
+		{Request{Action: "s3:GetObject", Resource: "bucket/public/a", Principal: "*"}, Allowed},
+		{Request{Action: "S3:GETOBJECT", Resource: "arn:aws:s3:::bucket/public/a", Principal: "*"}, Allowed},
+		{Request{Action: "s3:GetObject", Resource: "bucket/private/a", Principal: "*"}, ImplicitDeny},
+		{Request{Action: "s3:GetObject", Resource: "bucket/private/a", Principal: alice}, Allowed},
+		{Request{Action: "s3:DeleteObject", Resource: "bucket/locked/a", Principal: alice}, ExplicitDeny},
+		{Request{Action: "s3:ListBucket", Resource: "bucket", Principal: "*", Context: map[string][]string{"s3:prefix": {"public/2025"}}}, Allowed},
+		{Request{Action: "s3:ListBucket", Resource: "bucket", Principal: "*", Context: map[string][]string{"s3:prefix": {"private/"}}}, ImplicitDeny},
+		{Request{Action: "s3:ListBucket", Resource: "bucket", Principal: "*"}, ImplicitDeny},
+		{Request{Action: "s3:PutObject", Resource: "bucket/a", Principal: alice, Context: map[string][]string{"aws:SourceIp": {"10.1.2.3"}}}, Allowed},
+		{Request{Action: "s3:PutObject", Resource: "bucket/a", Principal: alice, Context: map[string][]string{"AWS:SOURCEIP": {"192.168.1.1"}}}, ExplicitDeny},
+		{Request{Action: "s3:PutObject", Resource: "bucket/a", Principal: alice}, ExplicitDeny},
+	}
+	for i, testCase := range testCases {
+		if decision := p.EvaluateRequest(testCase.request); decision != testCase.decision {
+			t.Errorf("Test %d: expected %s, got %s for %+v", i+1, testCase.decision, decision, testCase.request)
+		}
+	}
+	if decision := p.Evaluate("s3:GetObject", "bucket/public/a", "*"); decision != Allowed {
+		t.Errorf("expected Allowed, got %s", decision)
+	}
+}
+
+func TestConditionMatch(t *testing.T) {
+	testCases := []struct {
+		operator   string
+		have, want []string
+		match      bool
+	}{
+		{"StringEquals", []string{"a"}, []string{"a", "b"}, true},
+		{"StringEquals", nil, []string{"a"}, false},
+		{"StringNotEquals", []string{"a"}, []string{"b"}, true},
+		{"StringNotEquals", nil, []string{"b"}, true},
+		{"StringEqualsIgnoreCase", []string{"A"}, []string{"a"}, true},
+		{"StringLike", []string{"home/alice/x"}, []string{"home/*/x"}, true},
+		{"StringNotLike", []string{"home/alice"}, []string{"home/*"}, false},
+		{"StringEqualsIfExists", nil, []string{"a"}, true},
+		{"IpAddress", []string{"192.168.1.1"}, []string{"192.168.0.0/16"}, true},
+		{"Bool", []string{"TRUE"}, []string{"true"}, true},
+		{"Null", nil, []string{"true"}, true},
+		{"Null", []string{"a"}, []string{"true"}, false},
+		{"DateGreaterThan", []string{"2025-01-01T00:00:00Z"}, []string{"2024-01-01T00:00:00Z"}, false},
+	}
+	for i, testCase := range testCases {
+		if match := conditionMatch(testCase.operator, testCase.have, testCase.want); match != testCase.match {
+			t.Errorf("Test %d: %s %v %v: expected %v, got %v", i+1, testCase.operator, testCase.have, testCase.want, testCase.match, match)
+		}
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	testCases := []struct {
+		policy BucketAccessPolicy
+		public bool
+	}{
+		{NewBucketAccessPolicy(), false},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*")), true},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("arn:aws:iam::123456789012:root")), false},
+		{NewBucketAccessPolicy(Deny("s3:GetObject").On(ObjectARN("bucket", "*")).For("*")), false},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*").When("IpAddress", "aws:SourceIp", "10.0.0.0/8")), false},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*").When("StringNotEquals", "aws:SourceVpce", "vpce-1")), true},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*").When("Bool", "aws:SecureTransport", "true")), true},
+		{NewBucketAccessPolicy(Allow("s3:ListBucket").On(BucketARN("bucket")).For("*").When("StringLike", "s3:prefix", "public/*")), true},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*").When("StringLike", "aws:SourceVpce", "vpce-*")), true},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*").When("IpAddress", "aws:SourceIp", "0.0.0.0/0")), true},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*").When("StringEquals", "aws:PrincipalOrgID", "o-123")), false},
+		{NewBucketAccessPolicy(Allow("s3:GetObject").On(ObjectARN("bucket", "*")).For("*").When("Bool", "aws:SecureTransport", "true").When("StringEquals", "aws:SourceVpc", "vpc-1")), false},
+	}
+	for i, testCase := range testCases {
+		if public := testCase.policy.IsPublic(); public != testCase.public {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.public, public)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"errors"
+	"time"
+)
+
+// ServiceType is the type of a remote target of MinIO.
+type ServiceType string
+
+// ReplicationService is the type of the remote targets of bucket
+// replication.
+const ReplicationService ServiceType = "replication"
+
+// TargetCredentials are the credentials of a remote target.
+type TargetCredentials struct {
+	AccessKey    string    `json:"accessKey,omitempty"`
+	SecretKey    string    `json:"secretKey,omitempty"`
+	SessionToken string    `json:"sessionToken,omitempty"`
+	Expiration   time.Time `json:"expiration,omitempty"`
+}
+
+// LatencyStat is the latency of the requests to a remote target.
+type LatencyStat struct {
+	Curr time.Duration `json:"curr"`
+	Avg  time.Duration `json:"avg"`
+	Max  time.Duration `json:"max"`
+}
+
+// BucketTarget is a remote target of MinIO, i.e. the bucket which
+// objects are replicated to. Its ARN is the destination of replication
+// rules, see Destination.
+type BucketTarget struct {
+	SourceBucket string             `json:"sourcebucket"`
+	Endpoint     string             `json:"endpoint"`
+	Credentials  *TargetCredentials `json:"credentials"`
+	TargetBucket string             `json:"targetbucket"`
+	Secure       bool               `json:"secure"`
+	Path         string             `json:"path,omitempty"`
+	API          string             `json:"api,omitempty"`
+	Arn          string             `json:"arn,omitempty"`
+	Type         ServiceType        `json:"type"`
+	Region       string             `json:"region,omitempty"`
+	// Bandwidth limit of the replication in bytes per second, 0 if
+	// unlimited.
+	BandwidthLimit int64 `json:"bandwidthlimit,omitempty"`
+	// Replicate synchronously instead of asynchronously.
+	ReplicationSync     bool          `json:"replicationSync"`
+	StorageClass        string        `json:"storageclass,omitempty"`
+	HealthCheckDuration time.Duration `json:"healthCheckDuration,omitempty"`
+	DisableProxy        bool          `json:"disableProxy"`
+	ResetBeforeDate     time.Time     `json:"resetBeforeDate,omitempty"`
+	ResetID             string        `json:"resetID,omitempty"`
+	DeploymentID        string        `json:"deploymentID,omitempty"`
+	Edge                bool          `json:"edge"`
+
+	// Health of the target, set by the server.
+	TotalDowntime time.Duration `json:"totalDowntime"`
+	LastOnline    time.Time     `json:"lastOnline"`
+	Online        bool          `json:"isOnline"`
+	Latency       LatencyStat   `json:"latency"`
+}
+
+// Validate checks that the remote target has an endpoint, a bucket and
+// credentials.
+func (t BucketTarget) Validate() error {
+	switch {
+	case t.Endpoint == "":
+		return errors.New("remote target has no endpoint")
+	case t.TargetBucket == "":
+		return errors.New("remote target has no bucket")
+	case t.Credentials == nil || t.Credentials.AccessKey == "" || t.Credentials.SecretKey == "":
+		return errors.New("remote target has no credentials")
+	}
+	return nil
+}
+
+// TargetUpdateType is a property of a remote target changed by an update.
+type TargetUpdateType int
+
+// The properties of remote targets which may be updated.
+const (
+	CredentialsUpdateType TargetUpdateType = 1 + iota
+	SyncUpdateType
+	ProxyUpdateType
+	BandwidthLimitUpdateType
+	HealthCheckDurationUpdateType
+	PathUpdateType
+	ResetUpdateType
+	EdgeUpdateType
+)
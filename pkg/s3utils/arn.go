@@ -0,0 +1,239 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3utils
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Services of the access point ARNs, which are also their signing names.
+const (
+	AccessPointServiceS3           = "s3"
+	AccessPointServiceObjectLambda = "s3-object-lambda"
+	AccessPointServiceOutposts     = "s3-outposts"
+)
+
+// AccessPointARN is the parsed ARN of an Amazon S3 access point, an S3
+// Object Lambda access point or an S3 on Outposts access point, e.g.
+//
+//	arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point
+//	arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/my-lambda-access-point
+//	arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/my-access-point
+//	arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap
+//
+// The last is a Multi-Region Access Point, which has no region and is
+// named by its alias. Access point ARNs are accepted as bucket names.
+type AccessPointARN struct {
+	Partition string
+	Service   string
+	Region    string
+	AccountID string
+	// OutpostID is only set for S3 on Outposts access points.
+	OutpostID string
+	Name      string
+}
+
+var (
+	validAccountID       = regexp.MustCompile(`^[0-9]{12}$`)
+	validAccessPointName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,48}[a-z0-9]$`)
+	validARNRegion       = regexp.MustCompile(`^[a-z0-9-]+$`)
+	validMRAPAlias       = regexp.MustCompile(`^[a-z0-9]{1,56}\.mrap$`)
+	validOutpostID       = regexp.MustCompile(`^op-[a-f0-9]{17}$`)
+)
+
+// IsAccessPointARN tells whether name is a valid access point ARN.
+func IsAccessPointARN(name string) bool {
+	_, err := ParseAccessPointARN(name)
+	return err == nil
+}
+
+// ParseAccessPointARN parses an access point ARN, the resource may be
+// separated by '/' or ':' as in accesspoint:my-access-point.
+func ParseAccessPointARN(arn string) (AccessPointARN, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return AccessPointARN{}, errors.New("Access point ARN must be arn:partition:service:region:account-id:resource")
+	}
+	a := AccessPointARN{
+		Partition: parts[1],
+		Service:   parts[2],
+		Region:    parts[3],
+		AccountID: parts[4],
+	}
+	if a.Partition == "" {
+		return AccessPointARN{}, errors.New("Access point ARN has no partition")
+	}
+	switch a.Service {
+	case AccessPointServiceS3, AccessPointServiceObjectLambda, AccessPointServiceOutposts:
+	default:
+		return AccessPointARN{}, errors.New("Access point ARN has unsupported service " + a.Service)
+	}
+	if !validARNRegion.MatchString(a.Region) && (a.Region != "" || a.Service != AccessPointServiceS3) {
+		return AccessPointARN{}, errors.New("Access point ARN has no valid region")
+	}
+	if !validAccountID.MatchString(a.AccountID) {
+		return AccessPointARN{}, errors.New("Access point ARN has no valid account id")
+	}
+	resource := strings.Split(strings.ReplaceAll(parts[5], ":", "/"), "/")
+	if a.Service == AccessPointServiceOutposts {
+		if len(resource) != 4 || resource[0] != "outpost" || resource[2] != "accesspoint" {
+			return AccessPointARN{}, errors.New("Outposts access point ARN resource must be outpost/outpost-id/accesspoint/name")
+		}
+		if !validOutpostID.MatchString(resource[1]) {
+			return AccessPointARN{}, errors.New("Outposts access point ARN has no valid outpost id")
+		}
+		a.OutpostID, resource = resource[1], resource[2:]
+	}
+	if len(resource) != 2 || resource[0] != "accesspoint" {
+		return AccessPointARN{}, errors.New("Access point ARN resource must be accesspoint/name")
+	}
+	a.Name = resource[1]
+	if a.Region == "" {
+		if !validMRAPAlias.MatchString(a.Name) {
+			return AccessPointARN{}, errors.New("Multi-Region Access Point ARN has no valid alias")
+		}
+		return a, nil
+	}
+	if !validAccessPointName.MatchString(a.Name) {
+		return AccessPointARN{}, errors.New("Access point ARN has no valid access point name")
+	}
+	return a, nil
+}
+
+// String returns the ARN.
+func (a AccessPointARN) String() string {
+	resource := "accesspoint/" + a.Name
+	if a.OutpostID != "" {
+		resource = "outpost/" + a.OutpostID + "/" + resource
+	}
+	return "arn:" + a.Partition + ":" + a.Service + ":" + a.Region + ":" + a.AccountID + ":" + resource
+}
+
+// IsMultiRegion tells whether the ARN is of a Multi-Region Access Point.
+func (a AccessPointARN) IsMultiRegion() bool {
+	return a.Region == ""
+}
+
+// IsMultiRegionAccessPointAlias tells whether name is the alias of a
+// Multi-Region Access Point, e.g. mfzwi23gnjvgw.mrap.
+func IsMultiRegionAccessPointAlias(name string) bool {
+	return validMRAPAlias.MatchString(name)
+}
+
+// SigningName returns the service name requests to the access point are
+// signed for.
+func (a AccessPointARN) SigningName() string {
+	return a.Service
+}
+
+// Endpoint returns the host of the endpoint of the access point, using
+// the dual-stack endpoint of S3 access points if dualstack is set.
+func (a AccessPointARN) Endpoint(dualstack bool) string {
+	domain := "amazonaws.com"
+	if strings.HasPrefix(a.Partition, "aws-cn") {
+		domain = "amazonaws.com.cn"
+	}
+	if a.IsMultiRegion() {
+		return a.Name + ".accesspoint.s3-global." + domain
+	}
+	prefix := a.Name + "-" + a.AccountID
+	switch a.Service {
+	case AccessPointServiceObjectLambda:
+		return prefix + ".s3-object-lambda." + a.Region + "." + domain
+	case AccessPointServiceOutposts:
+		return prefix + "." + a.OutpostID + ".s3-outposts." + a.Region + "." + domain
+	}
+	if dualstack {
+		return prefix + ".s3-accesspoint.dualstack." + a.Region + "." + domain
+	}
+	return prefix + ".s3-accesspoint." + a.Region + "." + domain
+}
+
+// OutpostsBucketARN is the parsed ARN of an S3 on Outposts bucket, e.g.
+//
+//	arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/my-bucket
+//
+// Outposts buckets are managed with their ARNs, their objects are
+// accessed through their access points.
+type OutpostsBucketARN struct {
+	Partition string
+	Region    string
+	AccountID string
+	OutpostID string
+	Bucket    string
+}
+
+// IsOutpostsBucketARN tells whether name is a valid Outposts bucket ARN.
+func IsOutpostsBucketARN(name string) bool {
+	_, err := ParseOutpostsBucketARN(name)
+	return err == nil
+}
+
+// ParseOutpostsBucketARN parses an Outposts bucket ARN.
+func ParseOutpostsBucketARN(arn string) (OutpostsBucketARN, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[1] == "" || parts[2] != AccessPointServiceOutposts {
+		return OutpostsBucketARN{}, errors.New("Outposts bucket ARN must be arn:partition:s3-outposts:region:account-id:outpost/outpost-id/bucket/name")
+	}
+	b := OutpostsBucketARN{
+		Partition: parts[1],
+		Region:    parts[3],
+		AccountID: parts[4],
+	}
+	if !validARNRegion.MatchString(b.Region) {
+		return OutpostsBucketARN{}, errors.New("Outposts bucket ARN has no valid region")
+	}
+	if !validAccountID.MatchString(b.AccountID) {
+		return OutpostsBucketARN{}, errors.New("Outposts bucket ARN has no valid account id")
+	}
+	resource := strings.Split(strings.ReplaceAll(parts[5], ":", "/"), "/")
+	if len(resource) != 4 || resource[0] != "outpost" || resource[2] != "bucket" {
+		return OutpostsBucketARN{}, errors.New("Outposts bucket ARN resource must be outpost/outpost-id/bucket/name")
+	}
+	if !validOutpostID.MatchString(resource[1]) {
+		return OutpostsBucketARN{}, errors.New("Outposts bucket ARN has no valid outpost id")
+	}
+	b.OutpostID, b.Bucket = resource[1], resource[3]
+	if err := CheckValidBucketNameStrict(b.Bucket); err != nil {
+		return OutpostsBucketARN{}, err
+	}
+	return b, nil
+}
+
+// String returns the ARN.
+func (b OutpostsBucketARN) String() string {
+	return "arn:" + b.Partition + ":" + AccessPointServiceOutposts + ":" + b.Region + ":" + b.AccountID +
+		":outpost/" + b.OutpostID + "/bucket/" + b.Bucket
+}
+
+// Endpoint returns the host of the S3 on Outposts control endpoint
+// managing the bucket.
+func (b OutpostsBucketARN) Endpoint() string {
+	return OutpostsControlEndpoint(b.Partition, b.Region)
+}
+
+// OutpostsControlEndpoint returns the host of the S3 on Outposts control
+// endpoint of region.
+func OutpostsControlEndpoint(partition, region string) string {
+	if strings.HasPrefix(partition, "aws-cn") {
+		return "s3-outposts." + region + ".amazonaws.com.cn"
+	}
+	return "s3-outposts." + region + ".amazonaws.com"
+}
@@ -0,0 +1,131 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3utils
+
+import "testing"
+
+func TestParseAccessPointARN(t *testing.T) {
+	testCases := []struct {
+		arn        string
+		shouldPass bool
+		endpoint   string
+		canonical  string
+	}{
+		{
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point", true,
+			"my-access-point-123456789012.s3-accesspoint.us-west-2.amazonaws.com",
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point",
+		},
+		{
+			"arn:aws:s3:us-west-2:123456789012:accesspoint:my-access-point", true,
+			"my-access-point-123456789012.s3-accesspoint.us-west-2.amazonaws.com",
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point",
+		},
+		{
+			"arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/my-access-point", true,
+			"my-access-point-123456789012.s3-accesspoint.cn-north-1.amazonaws.com.cn",
+			"arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/my-access-point",
+		},
+		{
+			"arn:aws:s3-object-lambda:us-east-1:123456789012:accesspoint/my-olap", true,
+			"my-olap-123456789012.s3-object-lambda.us-east-1.amazonaws.com",
+			"arn:aws:s3-object-lambda:us-east-1:123456789012:accesspoint/my-olap",
+		},
+		{
+			"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/my-ap", true,
+			"my-ap-123456789012.op-01ac5d28a6a232904.s3-outposts.us-west-2.amazonaws.com",
+			"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/my-ap",
+		},
+		{
+			"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap", true,
+			"mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com",
+			"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+		},
+		{"arn:aws:s3:::my-bucket", false, "", ""},
+		{"arn:aws:s3::123456789012:accesspoint/my-access-point", false, "", ""},
+		{"arn:aws:s3-object-lambda::123456789012:accesspoint/mfzwi23gnjvgw.mrap", false, "", ""},
+		{"arn:aws:ec2:us-west-2:123456789012:accesspoint/my-access-point", false, "", ""},
+		{"arn:aws:s3:us-west-2:1234:accesspoint/my-access-point", false, "", ""},
+		{"arn:aws:s3:us-west-2:123456789012:accesspoint/My_Access_Point", false, "", ""},
+		{"arn:aws:s3:us-west-2:123456789012:bucket/my-bucket", false, "", ""},
+		{"arn:aws:s3-outposts:us-west-2:123456789012:accesspoint/my-ap", false, "", ""},
+		{"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-1/accesspoint/my-ap", false, "", ""},
+		{"my-bucket", false, "", ""},
+	}
+	for i, testCase := range testCases {
+		accessPoint, err := ParseAccessPointARN(testCase.arn)
+		if testCase.shouldPass != (err == nil) {
+			t.Errorf("Test %d: %s: expected success %v, got %v", i+1, testCase.arn, testCase.shouldPass, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if endpoint := accessPoint.Endpoint(false); endpoint != testCase.endpoint {
+			t.Errorf("Test %d: expected endpoint %s, got %s", i+1, testCase.endpoint, endpoint)
+		}
+		if s := accessPoint.String(); s != testCase.canonical {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.canonical, s)
+		}
+		if err := CheckValidBucketName(testCase.arn); err != nil {
+			t.Errorf("Test %d: expected a valid bucket name, got %v", i+1, err)
+		}
+	}
+
+	accessPoint, _ := ParseAccessPointARN("arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point")
+	if endpoint := accessPoint.Endpoint(true); endpoint != "my-access-point-123456789012.s3-accesspoint.dualstack.us-west-2.amazonaws.com" {
+		t.Errorf("unexpected dual-stack endpoint %s", endpoint)
+	}
+	if !IsMultiRegionAccessPointAlias("mfzwi23gnjvgw.mrap") || IsMultiRegionAccessPointAlias("my-bucket") {
+		t.Error("unexpected Multi-Region Access Point alias match")
+	}
+	if err := CheckValidBucketName("arn:aws:s3:::my-bucket"); err == nil {
+		t.Error("expected an invalid bucket name")
+	}
+	if err := ValidateBucketName("arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point", NamingDefault); err == nil {
+		t.Error("expected access point ARNs to be invalid names of new buckets")
+	}
+}
+
+func TestParseOutpostsBucketARN(t *testing.T) {
+	const arn = "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/my-bucket"
+	bucket, err := ParseOutpostsBucketARN(arn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bucket.Bucket != "my-bucket" || bucket.OutpostID != "op-01ac5d28a6a232904" || bucket.Region != "us-west-2" ||
+		bucket.AccountID != "123456789012" || bucket.String() != arn {
+		t.Fatalf("unexpected bucket %+v", bucket)
+	}
+	if endpoint := bucket.Endpoint(); endpoint != "s3-outposts.us-west-2.amazonaws.com" {
+		t.Errorf("unexpected endpoint %s", endpoint)
+	}
+	if err := CheckValidBucketName(arn); err != nil {
+		t.Errorf("expected a valid bucket name, got %v", err)
+	}
+	for _, invalid := range []string{
+		"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/my-ap",
+		"arn:aws:s3:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/my-bucket",
+		"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-1/bucket/my-bucket",
+		"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/bucket/My_Bucket",
+	} {
+		if IsOutpostsBucketARN(invalid) {
+			t.Errorf("%s: expected an invalid Outposts bucket ARN", invalid)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3utils
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// NamingProfile is a set of rules for the names of buckets and objects.
+type NamingProfile int
+
+// Different naming profiles. Initialized to NamingDefault.
+const (
+	// NamingDefault - the rules of CheckValidBucketName and
+	// CheckValidObjectName, which accept the names of all providers.
+	NamingDefault NamingProfile = iota
+
+	// NamingAWS - the rules of AWS S3 for new buckets.
+	//  - https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html
+	NamingAWS
+
+	// NamingMinIO - the relaxed rules of MinIO, which also accepts upper
+	// case letters, underscores and colons in bucket names of legacy
+	// deployments.
+	NamingMinIO
+
+	// NamingGCS - the rules of the XML interoperability API of GCS.
+	//  - https://cloud.google.com/storage/docs/buckets#naming
+	//  - https://cloud.google.com/storage/docs/objects#naming
+	NamingGCS
+)
+
+// String returns the name of the naming profile.
+func (p NamingProfile) String() string {
+	switch p {
+	case NamingAWS:
+		return "AWS"
+	case NamingMinIO:
+		return "MinIO"
+	case NamingGCS:
+		return "GCS"
+	}
+	return "Default"
+}
+
+// NamingProfileForURL returns the naming profile of the server of
+// endpointURL, servers which are neither AWS S3 nor GCS use NamingDefault
+// since their rules are unknown, e.g. MinIO, Ceph RGW or Cloudflare R2.
+func NamingProfileForURL(endpointURL url.URL) NamingProfile {
+	switch {
+	case IsAmazonEndpoint(endpointURL):
+		return NamingAWS
+	case IsGoogleEndpoint(endpointURL):
+		return NamingGCS
+	}
+	return NamingDefault
+}
+
+var validBucketNameGCS = regexp.MustCompile(`^[a-z0-9][a-z0-9\.\-\_]*[a-z0-9]$`)
+
+// ValidateBucketName - checks if bucketName is a valid name for a new
+// bucket in the given naming profile.
+func ValidateBucketName(bucketName string, profile NamingProfile) error {
+	switch profile {
+	case NamingAWS:
+		if IsS3ExpressBucket(bucketName) {
+			return nil
+		}
+		if err := CheckValidBucketNameStrict(bucketName); err != nil {
+			return err
+		}
+		for _, prefix := range []string{"xn--", "sthree-", "amzn-s3-demo-"} {
+			if strings.HasPrefix(bucketName, prefix) {
+				return errors.New("Bucket name cannot start with " + prefix)
+			}
+		}
+		for _, suffix := range []string{"-s3alias", "--ol-s3", ".mrap", "--x-s3", "--table-s3"} {
+			if strings.HasSuffix(bucketName, suffix) {
+				return errors.New("Bucket name cannot end with " + suffix)
+			}
+		}
+		return nil
+	case NamingGCS:
+		return checkBucketNameGCS(bucketName)
+	}
+	return checkBucketNameCommon(bucketName, false)
+}
+
+func checkBucketNameGCS(bucketName string) error {
+	if strings.TrimSpace(bucketName) == "" {
+		return errors.New("Bucket name cannot be empty")
+	}
+	if len(bucketName) < 3 {
+		return errors.New("Bucket name cannot be shorter than 3 characters")
+	}
+	// Names with dots may have up to 222 characters, with components of
+	// up to 63 characters each.
+	maxLen := 63
+	if strings.Contains(bucketName, ".") {
+		maxLen = 222
+	}
+	if len(bucketName) > maxLen {
+		return errors.New("Bucket name is too long")
+	}
+	for _, component := range strings.Split(bucketName, ".") {
+		if len(component) > 63 {
+			return errors.New("Bucket name cannot have components longer than 63 characters")
+		}
+	}
+	if ipAddress.MatchString(bucketName) {
+		return errors.New("Bucket name cannot be an ip address")
+	}
+	if !validBucketNameGCS.MatchString(bucketName) {
+		return errors.New("Bucket name contains invalid characters")
+	}
+	if strings.HasPrefix(bucketName, "goog") || strings.Contains(bucketName, "google") {
+		return errors.New("Bucket name cannot start with goog or contain google")
+	}
+	return nil
+}
+
+// ValidateObjectKey - checks if objectName is a valid name for a new
+// object in the given naming profile.
+func ValidateObjectKey(objectName string, profile NamingProfile) error {
+	if err := CheckValidObjectName(objectName); err != nil {
+		return err
+	}
+	switch profile {
+	case NamingMinIO:
+		for _, component := range strings.Split(objectName, "/") {
+			if component == "." || component == ".." {
+				return errors.New("Object name cannot contain . or .. path components")
+			}
+		}
+	case NamingGCS:
+		if objectName == "." || objectName == ".." {
+			return errors.New("Object name cannot be . or ..")
+		}
+		if strings.ContainsAny(objectName, "\r\n") {
+			return errors.New("Object name cannot contain carriage returns or line feeds")
+		}
+		if strings.HasPrefix(objectName, ".well-known/acme-challenge/") {
+			return errors.New("Object name cannot start with .well-known/acme-challenge/")
+		}
+	}
+	return nil
+}
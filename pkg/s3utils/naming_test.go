@@ -0,0 +1,100 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3utils
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestValidateBucketName(t *testing.T) {
+	testCases := []struct {
+		bucketName string
+		profile    NamingProfile
+		shouldPass bool
+	}{
+		{"my-bucket", NamingAWS, true},
+		{"My_Bucket", NamingAWS, false},
+		{"xn--bucket", NamingAWS, false},
+		{"bucket-s3alias", NamingAWS, false},
+		{"bucket--usw2-az1--x-s3", NamingAWS, true},
+		{"My_Bucket", NamingMinIO, true},
+		{"my:bucket", NamingDefault, true},
+		{"my..bucket", NamingMinIO, false},
+		{"my_bucket", NamingGCS, true},
+		{"My_Bucket", NamingGCS, false},
+		{"googbucket", NamingGCS, false},
+		{"my-google-bucket", NamingGCS, false},
+		{strings.Repeat("a", 63) + "." + strings.Repeat("b", 63), NamingGCS, true},
+		{strings.Repeat("a", 64), NamingGCS, false},
+	}
+	for i, testCase := range testCases {
+		err := ValidateBucketName(testCase.bucketName, testCase.profile)
+		if err != nil && testCase.shouldPass {
+			t.Errorf("Test %d: Expected %s to pass for %s, but failed with: %v", i+1, testCase.bucketName, testCase.profile, err)
+		}
+		if err == nil && !testCase.shouldPass {
+			t.Errorf("Test %d: Expected %s to fail for %s, but passed instead", i+1, testCase.bucketName, testCase.profile)
+		}
+	}
+}
+
+func TestValidateObjectKey(t *testing.T) {
+	testCases := []struct {
+		objectName string
+		profile    NamingProfile
+		shouldPass bool
+	}{
+		{"a/b/c", NamingAWS, true},
+		{"", NamingAWS, false},
+		{"a/../c", NamingAWS, true},
+		{"a/../c", NamingMinIO, false},
+		{"a/./c", NamingMinIO, false},
+		{"a/..c", NamingMinIO, true},
+		{"a\nb", NamingGCS, false},
+		{".well-known/acme-challenge/token", NamingGCS, false},
+		{strings.Repeat("a", 1025), NamingDefault, false},
+	}
+	for i, testCase := range testCases {
+		err := ValidateObjectKey(testCase.objectName, testCase.profile)
+		if err != nil && testCase.shouldPass {
+			t.Errorf("Test %d: Expected %q to pass for %s, but failed with: %v", i+1, testCase.objectName, testCase.profile, err)
+		}
+		if err == nil && !testCase.shouldPass {
+			t.Errorf("Test %d: Expected %q to fail for %s, but passed instead", i+1, testCase.objectName, testCase.profile)
+		}
+	}
+}
+
+func TestNamingProfileForURL(t *testing.T) {
+	testCases := []struct {
+		host    string
+		profile NamingProfile
+	}{
+		{"s3.us-west-2.amazonaws.com", NamingAWS},
+		{"storage.googleapis.com", NamingGCS},
+		{"localhost:9000", NamingDefault},
+		{"account.r2.cloudflarestorage.com", NamingDefault},
+	}
+	for i, testCase := range testCases {
+		if profile := NamingProfileForURL(url.URL{Host: testCase.host}); profile != testCase.profile {
+			t.Errorf("Test %d: Expected %s for %s, got %s", i+1, testCase.profile, testCase.host, profile)
+		}
+	}
+}
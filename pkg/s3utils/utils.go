@@ -210,6 +210,15 @@ func IsAmazonExpressZonalEndpoint(endpointURL url.URL) bool {
 	return amazonS3HostExpress.MatchString(endpointURL.Hostname())
 }
 
+// IsAmazonMultiRegionAccessPointEndpoint - Match if it is an Amazon S3
+// Multi-Region Access Point endpoint, i.e. <alias>.accesspoint.s3-global.amazonaws.com.
+func IsAmazonMultiRegionAccessPointEndpoint(endpointURL url.URL) bool {
+	if endpointURL == sentinelURL {
+		return false
+	}
+	return strings.HasSuffix(endpointURL.Hostname(), ".accesspoint.s3-global.amazonaws.com")
+}
+
 // IsAmazonEndpoint - Match if it is exactly Amazon S3 endpoint.
 func IsAmazonEndpoint(endpointURL url.URL) bool {
 	if endpointURL.Hostname() == "s3-external-1.amazonaws.com" || endpointURL.Hostname() == "s3.amazonaws.com" {
@@ -375,6 +384,15 @@ func checkBucketNameCommon(bucketName string, strict bool) (err error) {
 
 // CheckValidBucketName - checks if we have a valid input bucket name.
 func CheckValidBucketName(bucketName string) (err error) {
+	// Access point and Outposts bucket ARNs are accepted wherever a
+	// bucket name is.
+	if strings.HasPrefix(bucketName, "arn:") {
+		if IsOutpostsBucketARN(bucketName) {
+			return nil
+		}
+		_, err = ParseAccessPointARN(bucketName)
+		return err
+	}
 	return checkBucketNameCommon(bucketName, false)
 }
 
@@ -383,6 +401,16 @@ func IsS3ExpressBucket(bucketName string) bool {
 	return CheckValidBucketNameS3Express(bucketName) == nil
 }
 
+// S3ExpressAvailabilityZoneID returns the ID of the availability zone of
+// an S3 Express directory bucket, e.g. usw2-az1 of
+// name--usw2-az1--x-s3, or an empty string for other buckets.
+func S3ExpressAvailabilityZoneID(bucketName string) string {
+	if !IsS3ExpressBucket(bucketName) {
+		return ""
+	}
+	return strings.Split(bucketName, "--")[1]
+}
+
 // CheckValidBucketNameS3Express - checks if we have a valid input bucket name for S3 Express.
 func CheckValidBucketNameS3Express(bucketName string) (err error) {
 	if strings.TrimSpace(bucketName) == "" {
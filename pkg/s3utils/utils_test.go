@@ -465,6 +465,33 @@ func TestIsAmazonPrivateLinkEndpoint(t *testing.T) {
 	}
 }
 
+func TestIsAmazonMultiRegionAccessPointEndpoint(t *testing.T) {
+	testCases := []struct {
+		url string
+		// Expected result.
+		result bool
+	}{
+		{"https://s3.amazonaws.com", false},
+		{"https://s3.us-west-1.amazonaws.com", false},
+		{"https://accesspoint.s3-global.amazonaws.com", false},
+		{"https://accesspoint.vpce-1a2b3c4d-5e6f.s3.us-east-1.vpce.amazonaws.com", false},
+		// valid inputs.
+		{"https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com", true},
+		{"https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com:443", true},
+	}
+
+	for i, testCase := range testCases {
+		u, err := url.Parse(testCase.url)
+		if err != nil {
+			t.Errorf("Test %d: Expected to pass, but failed with: <ERROR> %s", i+1, err)
+		}
+		result := IsAmazonMultiRegionAccessPointEndpoint(*u)
+		if testCase.result != result {
+			t.Errorf("Test %d: Expected IsAmazonMultiRegionAccessPointEndpoint to be '%v' for input \"%s\", but found it to be '%v' instead", i+1, testCase.result, testCase.url, result)
+		}
+	}
+}
+
 func TestS3ExpressBucket(t *testing.T) {
 	tests := []struct {
 		bucket  string
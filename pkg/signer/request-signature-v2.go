@@ -58,12 +58,18 @@ func encodeURL2Path(req *http.Request, virtualHost bool) (path string) {
 // PreSignV2 - presign the request in following style.
 // https://${S3_BUCKET}.s3.amazonaws.com/${S3_OBJECT}?AWSAccessKeyId=${S3_ACCESS_KEY}&Expires=${TIMESTAMP}&Signature=${SIGNATURE}.
 func PreSignV2(req http.Request, accessKeyID, secretAccessKey string, expires int64, virtualHost bool) *http.Request {
+	return PreSignV2WithTime(req, accessKeyID, secretAccessKey, expires, virtualHost, time.Now())
+}
+
+// PreSignV2WithTime is like PreSignV2 with the signing time d, the
+// URL expires expires seconds after d.
+func PreSignV2WithTime(req http.Request, accessKeyID, secretAccessKey string, expires int64, virtualHost bool, d time.Time) *http.Request {
 	// Presign is not needed for anonymous credentials.
 	if accessKeyID == "" || secretAccessKey == "" {
 		return &req
 	}
 
-	d := time.Now().UTC()
+	d = d.UTC()
 	// Find epoch expires when the request will expire.
 	epochExpires := d.Unix() + expires
 
@@ -128,13 +134,19 @@ func PostPresignSignatureV2(policyBase64, secretAccessKey string) string {
 
 // SignV2 sign the request before Do() (AWS Signature Version 2).
 func SignV2(req http.Request, accessKeyID, secretAccessKey string, virtualHost bool) *http.Request {
+	return SignV2WithTime(req, accessKeyID, secretAccessKey, virtualHost, time.Now())
+}
+
+// SignV2WithTime is SignV2 with the request time d, e.g. corrected for
+// the clock skew to the server.
+func SignV2WithTime(req http.Request, accessKeyID, secretAccessKey string, virtualHost bool, d time.Time) *http.Request {
 	// Signature calculation is not needed for anonymous credentials.
 	if accessKeyID == "" || secretAccessKey == "" {
 		return &req
 	}
 
 	// Initial time.
-	d := time.Now().UTC()
+	d = d.UTC()
 
 	// Add date if not present.
 	if date := req.Header.Get("Date"); date == "" {
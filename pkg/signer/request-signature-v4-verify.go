@@ -0,0 +1,119 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signer
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors returned by VerifyPreSignV4.
+var (
+	ErrMalformedPreSign         = errors.New("signer: malformed presigned request")
+	ErrPreSignExpired           = errors.New("signer: presigned request has expired")
+	ErrPreSignNotYetValid       = errors.New("signer: presigned request is not yet valid")
+	ErrInvalidAccessKeyID       = errors.New("signer: access key of presigned request does not match")
+	ErrPreSignSignatureMismatch = errors.New("signer: signature of presigned request does not match")
+)
+
+// maxPreSignExpires is the maximum validity of a presigned request, 7 days.
+const maxPreSignExpires = 7 * 24 * 60 * 60
+
+// VerifyPreSignV4 verifies the signature V4 query parameters of a presigned
+// request, as created by PreSignV4, for the credentials accessKeyID and
+// secretAccessKey and, if not empty, sessionToken. The request must be
+// valid at now, give or take clockSkew.
+func VerifyPreSignV4(req http.Request, accessKeyID, secretAccessKey, sessionToken string, clockSkew time.Duration, now time.Time) error {
+	query := req.URL.Query()
+	if query.Get("X-Amz-Algorithm") != signV4Algorithm {
+		return ErrMalformedPreSign
+	}
+
+	// Credential is <access-key>/<date>/<region>/<service>/aws4_request,
+	// the access key may contain '/'.
+	credential := strings.Split(query.Get("X-Amz-Credential"), "/")
+	if len(credential) < 5 || credential[len(credential)-1] != "aws4_request" {
+		return ErrMalformedPreSign
+	}
+	scope := credential[len(credential)-4:]
+	if strings.Join(credential[:len(credential)-4], "/") != accessKeyID {
+		return ErrInvalidAccessKeyID
+	}
+	if sessionToken != "" && query.Get("X-Amz-Security-Token") != sessionToken {
+		return ErrInvalidAccessKeyID
+	}
+
+	t, err := time.Parse(iso8601DateFormat, query.Get("X-Amz-Date"))
+	if err != nil || t.Format(yyyymmdd) != scope[0] {
+		return ErrMalformedPreSign
+	}
+	expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+	if err != nil || expires < 1 || expires > maxPreSignExpires {
+		return ErrMalformedPreSign
+	}
+	if now.Before(t.Add(-clockSkew)) {
+		return ErrPreSignNotYetValid
+	}
+	if now.After(t.Add(time.Duration(expires)*time.Second + clockSkew)) {
+		return ErrPreSignExpired
+	}
+
+	signature := query.Get("X-Amz-Signature")
+	if signature == "" {
+		return ErrMalformedPreSign
+	}
+	query.Del("X-Amz-Signature")
+
+	// Canonical request of the signed headers only.
+	signed := req
+	signed.URL = &url.URL{Path: req.URL.Path, RawQuery: query.Encode()}
+	if req.Host == "" {
+		signed.Host = req.URL.Host
+	}
+	signed.Header = make(http.Header)
+	for _, h := range strings.Split(query.Get("X-Amz-SignedHeaders"), ";") {
+		switch h {
+		case "":
+			return ErrMalformedPreSign
+		case "host":
+		default:
+			if v, ok := req.Header[http.CanonicalHeaderKey(h)]; ok {
+				signed.Header[http.CanonicalHeaderKey(h)] = v
+			} else {
+				return ErrPreSignSignatureMismatch
+			}
+		}
+	}
+	if getSignedHeaders(signed, v4IgnoredHeaders) != query.Get("X-Amz-SignedHeaders") {
+		return ErrPreSignSignatureMismatch
+	}
+
+	location, serviceType := scope[1], scope[2]
+	canonicalRequest := getCanonicalRequest(signed, v4IgnoredHeaders, getHashedPayload(signed))
+	stringToSign := getStringToSignV4(t, location, canonicalRequest, serviceType)
+	expected := getSignature(getSigningKey(secretAccessKey, location, t, serviceType), stringToSign)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) != 1 {
+		return ErrPreSignSignatureMismatch
+	}
+	return nil
+}
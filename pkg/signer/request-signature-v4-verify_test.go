@@ -0,0 +1,73 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signer
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestVerifyPreSignV4(t *testing.T) {
+	signingTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	req, err := http.NewRequest(http.MethodGet, "https://play.min.io/bucket/my%20object?response-content-type=text%2Fplain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Custom", "value")
+	presigned := PreSignV4WithTime(*req, "ACCESS/KEY", "SECRET", "TOKEN", "us-east-1", 3600, signingTime)
+
+	verify := func(method, url string, header http.Header, secret string, now time.Time) error {
+		r, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range header {
+			r.Header[k] = v
+		}
+		return VerifyPreSignV4(*r, "ACCESS/KEY", secret, "TOKEN", time.Minute, now)
+	}
+	header := http.Header{"X-Custom": {"value"}}
+	u := presigned.URL.String()
+	now := signingTime.Add(30 * time.Minute)
+
+	testCases := []struct {
+		name   string
+		method string
+		url    string
+		header http.Header
+		secret string
+		now    time.Time
+		err    error
+	}{
+		{"valid", http.MethodGet, u, header, "SECRET", now, nil},
+		{"clock skew", http.MethodGet, u, header, "SECRET", signingTime.Add(-30 * time.Second), nil},
+		{"not yet valid", http.MethodGet, u, header, "SECRET", signingTime.Add(-2 * time.Minute), ErrPreSignNotYetValid},
+		{"expired", http.MethodGet, u, header, "SECRET", signingTime.Add(2 * time.Hour), ErrPreSignExpired},
+		{"wrong secret", http.MethodGet, u, header, "OTHER", now, ErrPreSignSignatureMismatch},
+		{"wrong method", http.MethodPut, u, header, "SECRET", now, ErrPreSignSignatureMismatch},
+		{"missing header", http.MethodGet, u, nil, "SECRET", now, ErrPreSignSignatureMismatch},
+		{"tampered query", http.MethodGet, u + "&response-content-type=text%2Fhtml", header, "SECRET", now, ErrPreSignSignatureMismatch},
+		{"unsigned", http.MethodGet, "https://play.min.io/bucket/my%20object", header, "SECRET", now, ErrMalformedPreSign},
+	}
+	for _, tc := range testCases {
+		if err := verify(tc.method, tc.url, tc.header, tc.secret, tc.now); err != tc.err {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
@@ -41,6 +41,9 @@ const (
 	ServiceTypeS3        = "s3"
 	ServiceTypeSTS       = "sts"
 	ServiceTypeS3Express = "s3express"
+
+	ServiceTypeS3ObjectLambda = "s3-object-lambda"
+	ServiceTypeS3Outposts     = "s3-outposts"
 )
 
 // Excerpts from @lsegal -
@@ -207,16 +210,28 @@ func getStringToSignV4(t time.Time, location, canonicalRequest, serviceType stri
 // PreSignV4 presign the request, in accordance with
 // http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html.
 func PreSignV4(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string, expires int64) *http.Request {
+	return PreSignV4WithTime(req, accessKeyID, secretAccessKey, sessionToken, location, expires, time.Now())
+}
+
+// PreSignV4WithTime is like PreSignV4 with the signing time t, the
+// URL is valid from t for expires seconds.
+func PreSignV4WithTime(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string, expires int64, t time.Time) *http.Request {
+	return PreSignV4ServiceWithTime(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3, expires, t)
+}
+
+// PreSignV4ServiceWithTime is like PreSignV4WithTime for the signing name
+// serviceType, e.g. ServiceTypeS3ObjectLambda.
+func PreSignV4ServiceWithTime(req http.Request, accessKeyID, secretAccessKey, sessionToken, location, serviceType string, expires int64, t time.Time) *http.Request {
 	// Presign is not needed for anonymous credentials.
 	if accessKeyID == "" || secretAccessKey == "" {
 		return &req
 	}
 
 	// Initial time.
-	t := time.Now().UTC()
+	t = t.UTC()
 
 	// Get credential string.
-	credential := GetCredential(accessKeyID, location, t, ServiceTypeS3)
+	credential := GetCredential(accessKeyID, location, t, serviceType)
 
 	// Get all signed headers.
 	signedHeaders := getSignedHeaders(req, v4IgnoredHeaders)
@@ -242,10 +257,10 @@ func PreSignV4(req http.Request, accessKeyID, secretAccessKey, sessionToken, loc
 	canonicalRequest := getCanonicalRequest(req, v4IgnoredHeaders, getHashedPayload(req))
 
 	// Get string to sign from canonical request.
-	stringToSign := getStringToSignV4(t, location, canonicalRequest, ServiceTypeS3)
+	stringToSign := getStringToSignV4(t, location, canonicalRequest, serviceType)
 
 	// Gext hmac signing key.
-	signingKey := getSigningKey(secretAccessKey, location, t, ServiceTypeS3)
+	signingKey := getSigningKey(secretAccessKey, location, t, serviceType)
 
 	// Calculate signature.
 	signature := getSignature(signingKey, stringToSign)
@@ -268,18 +283,18 @@ func PostPresignSignatureV4(policyBase64 string, t time.Time, secretAccessKey, l
 
 // SignV4STS - signature v4 for STS request.
 func SignV4STS(req http.Request, accessKeyID, secretAccessKey, location string) *http.Request {
-	return signV4(req, accessKeyID, secretAccessKey, "", location, ServiceTypeSTS, nil)
+	return signV4(req, accessKeyID, secretAccessKey, "", location, ServiceTypeSTS, nil, time.Now())
 }
 
 // Internal function called for different service types.
-func signV4(req http.Request, accessKeyID, secretAccessKey, sessionToken, location, serviceType string, trailer http.Header) *http.Request {
+func signV4(req http.Request, accessKeyID, secretAccessKey, sessionToken, location, serviceType string, trailer http.Header, t time.Time) *http.Request {
 	// Signature calculation is not needed for anonymous credentials.
 	if accessKeyID == "" || secretAccessKey == "" {
 		return &req
 	}
 
 	// Initial time.
-	t := time.Now().UTC()
+	t = t.UTC()
 
 	// Set x-amz-date.
 	req.Header.Set("X-Amz-Date", t.Format(iso8601DateFormat))
@@ -373,23 +388,41 @@ func UnsignedTrailer(req http.Request, trailer http.Header) *http.Request {
 // SignV4 sign the request before Do(), in accordance with
 // http://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html.
 func SignV4(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string) *http.Request {
-	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3, nil)
+	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3, nil, time.Now())
 }
 
 // SignV4Express sign the request before Do(), in accordance with
 // http://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html.
 func SignV4Express(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string) *http.Request {
-	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3Express, nil)
+	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3Express, nil, time.Now())
 }
 
 // SignV4TrailerExpress sign the request before Do(), in accordance with
 // http://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
 func SignV4TrailerExpress(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string, trailer http.Header) *http.Request {
-	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3Express, trailer)
+	return SignV4TrailerExpressWithTime(req, accessKeyID, secretAccessKey, sessionToken, location, trailer, time.Now())
+}
+
+// SignV4TrailerExpressWithTime is SignV4TrailerExpress with the signing
+// time t, e.g. corrected for the clock skew to the server.
+func SignV4TrailerExpressWithTime(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string, trailer http.Header, t time.Time) *http.Request {
+	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3Express, trailer, t)
 }
 
 // SignV4Trailer sign the request before Do(), in accordance with
 // http://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
 func SignV4Trailer(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string, trailer http.Header) *http.Request {
-	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3, trailer)
+	return SignV4TrailerWithTime(req, accessKeyID, secretAccessKey, sessionToken, location, trailer, time.Now())
+}
+
+// SignV4TrailerWithTime is SignV4Trailer with the signing time t, e.g.
+// corrected for the clock skew to the server.
+func SignV4TrailerWithTime(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string, trailer http.Header, t time.Time) *http.Request {
+	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, ServiceTypeS3, trailer, t)
+}
+
+// SignV4TrailerServiceWithTime is SignV4TrailerWithTime for the signing
+// name serviceType, e.g. ServiceTypeS3Outposts.
+func SignV4TrailerServiceWithTime(req http.Request, accessKeyID, secretAccessKey, sessionToken, location, serviceType string, trailer http.Header, t time.Time) *http.Request {
+	return signV4(req, accessKeyID, secretAccessKey, sessionToken, location, serviceType, trailer, t)
 }
@@ -0,0 +1,266 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signer
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Signature V4A (asymmetric) signs requests with an ECDSA P-256 key derived
+// from the secret key, the signature is valid in a set of regions instead
+// of a single region. It is required by S3 Multi-Region Access Points.
+const (
+	signV4AAlgorithm = "AWS4-ECDSA-P256-SHA256"
+
+	// V4ARegionSetAll is the region set valid in all regions.
+	V4ARegionSetAll = "*"
+)
+
+// nMinusTwoP256 is the order of P-256 minus two, the upper bound
+// for derived private keys candidates.
+var nMinusTwoP256 = new(big.Int).Sub(elliptic.P256().Params().N, big.NewInt(2))
+
+var v4aKeyCache = struct {
+	sync.Mutex
+	keys map[[sha256.Size]byte]*ecdsa.PrivateKey
+}{keys: make(map[[sha256.Size]byte]*ecdsa.PrivateKey)}
+
+// DeriveV4AKey derives the ECDSA P-256 private key of an access key pair, as
+// specified by the AWS SigV4A algorithm (NIST SP 800-108 counter mode KDF).
+func DeriveV4AKey(accessKeyID, secretAccessKey string) (*ecdsa.PrivateKey, error) {
+	cacheKey := sha256.Sum256([]byte(accessKeyID + "\x00" + secretAccessKey))
+	v4aKeyCache.Lock()
+	key, ok := v4aKeyCache.keys[cacheKey]
+	v4aKeyCache.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	inputKey := []byte("AWS4A" + secretAccessKey)
+	nMinusTwo := nMinusTwoP256.FillBytes(make([]byte, 32))
+
+	var d []byte
+	for counter := 1; counter <= 0xff; counter++ {
+		kdfContext := append([]byte(accessKeyID), byte(counter))
+		candidate := hmacKeyDerivation(inputKey, []byte(signV4AAlgorithm), kdfContext, 256)
+		if bytesLess(candidate, nMinusTwo) {
+			d = candidate
+			break
+		}
+	}
+	if d == nil {
+		return nil, errors.New("signer: unable to derive SigV4A key")
+	}
+	// The private key is candidate + 1.
+	d = new(big.Int).Add(new(big.Int).SetBytes(d), big.NewInt(1)).FillBytes(make([]byte, 32))
+
+	ecdhKey, err := ecdh.P256().NewPrivateKey(d)
+	if err != nil {
+		return nil, err
+	}
+	pub := ecdhKey.PublicKey().Bytes() // 0x04 || X || Y
+	key = &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:33]),
+			Y:     new(big.Int).SetBytes(pub[33:]),
+		},
+		D: new(big.Int).SetBytes(d),
+	}
+
+	v4aKeyCache.Lock()
+	if len(v4aKeyCache.keys) > 100 {
+		clear(v4aKeyCache.keys)
+	}
+	v4aKeyCache.keys[cacheKey] = key
+	v4aKeyCache.Unlock()
+	return key, nil
+}
+
+// hmacKeyDerivation is the NIST SP 800-108 KDF in counter mode with
+// HMAC-SHA256 as pseudorandom function.
+func hmacKeyDerivation(key, label, context []byte, bitLen int) []byte {
+	var bitLenBuf [4]byte
+	binary.BigEndian.PutUint32(bitLenBuf[:], uint32(bitLen))
+
+	var output []byte
+	for i := uint32(1); len(output) < bitLen/8; i++ {
+		var counter [4]byte
+		binary.BigEndian.PutUint32(counter[:], i)
+		h := hmac.New(sha256.New, key)
+		h.Write(counter[:])
+		h.Write(label)
+		h.Write([]byte{0x00})
+		h.Write(context)
+		h.Write(bitLenBuf[:])
+		output = h.Sum(output)
+	}
+	return output[:bitLen/8]
+}
+
+// bytesLess compares two big-endian numbers of equal length in
+// constant time.
+func bytesLess(a, b []byte) bool {
+	var lt, gt int
+	for i := range a {
+		x, y := int(a[i]), int(b[i])
+		// Only the first differing byte decides.
+		undecided := 1 ^ (lt | gt)
+		lt |= undecided & subtle.ConstantTimeLessOrEq(x+1, y)
+		gt |= undecided & subtle.ConstantTimeLessOrEq(y+1, x)
+	}
+	return lt == 1
+}
+
+// getScopeV4A returns the credential scope, without region.
+func getScopeV4A(t time.Time, serviceType string) string {
+	return strings.Join([]string{
+		t.Format(yyyymmdd),
+		serviceType,
+		"aws4_request",
+	}, "/")
+}
+
+func getStringToSignV4A(t time.Time, canonicalRequest, serviceType string) string {
+	return signV4AAlgorithm + "\n" + t.Format(iso8601DateFormat) + "\n" +
+		getScopeV4A(t, serviceType) + "\n" +
+		hex.EncodeToString(sum256([]byte(canonicalRequest)))
+}
+
+func getSignatureV4A(key *ecdsa.PrivateKey, stringToSign string) (string, error) {
+	sig, err := ecdsa.SignASN1(rand.Reader, key, sum256([]byte(stringToSign)))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sig), nil
+}
+
+// SignV4A sign the request before Do() with signature V4A, valid in the
+// regions of regionSet, e.g. V4ARegionSetAll.
+//
+// The request is returned unsigned if the key cannot be derived or the
+// signature fails, which is only possible with invalid keys.
+func SignV4A(req http.Request, accessKeyID, secretAccessKey, sessionToken, regionSet string) *http.Request {
+	return SignV4ATrailer(req, accessKeyID, secretAccessKey, sessionToken, regionSet, nil)
+}
+
+// SignV4ATrailer sign the request before Do() with signature V4A, with an
+// unsigned trailer.
+func SignV4ATrailer(req http.Request, accessKeyID, secretAccessKey, sessionToken, regionSet string, trailer http.Header) *http.Request {
+	return SignV4ATrailerWithTime(req, accessKeyID, secretAccessKey, sessionToken, regionSet, trailer, time.Now())
+}
+
+// SignV4ATrailerWithTime is SignV4ATrailer with the signing time t, e.g.
+// corrected for the clock skew to the server.
+func SignV4ATrailerWithTime(req http.Request, accessKeyID, secretAccessKey, sessionToken, regionSet string, trailer http.Header, t time.Time) *http.Request {
+	// Signature calculation is not needed for anonymous credentials.
+	if accessKeyID == "" || secretAccessKey == "" {
+		return &req
+	}
+	key, err := DeriveV4AKey(accessKeyID, secretAccessKey)
+	if err != nil {
+		return &req
+	}
+
+	t = t.UTC()
+	req.Header.Set("X-Amz-Date", t.Format(iso8601DateFormat))
+	req.Header.Set("X-Amz-Region-Set", regionSet)
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+	if len(trailer) > 0 {
+		for k := range trailer {
+			req.Header.Add("X-Amz-Trailer", strings.ToLower(k))
+		}
+		req.Header.Set("Content-Encoding", "aws-chunked")
+		req.Header.Set("x-amz-decoded-content-length", strconv.FormatInt(req.ContentLength, 10))
+	}
+
+	canonicalRequest := getCanonicalRequest(req, v4IgnoredHeaders, getHashedPayload(req))
+	signature, err := getSignatureV4A(key, getStringToSignV4A(t, canonicalRequest, ServiceTypeS3))
+	if err != nil {
+		return &req
+	}
+
+	req.Header.Set("Authorization", strings.Join([]string{
+		signV4AAlgorithm + " Credential=" + accessKeyID + "/" + getScopeV4A(t, ServiceTypeS3),
+		"SignedHeaders=" + getSignedHeaders(req, v4IgnoredHeaders),
+		"Signature=" + signature,
+	}, ", "))
+
+	if len(trailer) > 0 {
+		req.Trailer = trailer
+		return StreamingUnsignedV4(&req, sessionToken, req.ContentLength, t)
+	}
+	return &req
+}
+
+// PreSignV4A presign the request with signature V4A, valid in the regions
+// of regionSet for expires seconds.
+func PreSignV4A(req http.Request, accessKeyID, secretAccessKey, sessionToken, regionSet string, expires int64) *http.Request {
+	return PreSignV4AWithTime(req, accessKeyID, secretAccessKey, sessionToken, regionSet, expires, time.Now())
+}
+
+// PreSignV4AWithTime is like PreSignV4A with the signing time t, the
+// URL is valid from t for expires seconds.
+func PreSignV4AWithTime(req http.Request, accessKeyID, secretAccessKey, sessionToken, regionSet string, expires int64, t time.Time) *http.Request {
+	// Presign is not needed for anonymous credentials.
+	if accessKeyID == "" || secretAccessKey == "" {
+		return &req
+	}
+	key, err := DeriveV4AKey(accessKeyID, secretAccessKey)
+	if err != nil {
+		return &req
+	}
+
+	t = t.UTC()
+	query := req.URL.Query()
+	query.Set("X-Amz-Algorithm", signV4AAlgorithm)
+	query.Set("X-Amz-Date", t.Format(iso8601DateFormat))
+	query.Set("X-Amz-Expires", strconv.FormatInt(expires, 10))
+	query.Set("X-Amz-SignedHeaders", getSignedHeaders(req, v4IgnoredHeaders))
+	query.Set("X-Amz-Credential", accessKeyID+"/"+getScopeV4A(t, ServiceTypeS3))
+	query.Set("X-Amz-Region-Set", regionSet)
+	if sessionToken != "" {
+		query.Set("X-Amz-Security-Token", sessionToken)
+	}
+	req.URL.RawQuery = query.Encode()
+
+	canonicalRequest := getCanonicalRequest(req, v4IgnoredHeaders, getHashedPayload(req))
+	signature, err := getSignatureV4A(key, getStringToSignV4A(t, canonicalRequest, ServiceTypeS3))
+	if err != nil {
+		return &req
+	}
+	req.URL.RawQuery += "&X-Amz-Signature=" + signature
+	return &req
+}
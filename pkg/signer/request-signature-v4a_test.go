@@ -0,0 +1,78 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeriveV4AKey(t *testing.T) {
+	key, err := DeriveV4AKey("AKISORANDOMAASORANDOM", "q+jcrXGc+0zWN6uzclKVhvMmUsIfRPa4rlRandom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := fmt.Sprintf("%064X", key.X); x != "15D242CEEBF8D8169FD6A8B5A746C41140414C3B07579038DA06AF89190FFFCB" {
+		t.Errorf("Unexpected public key X %s", x)
+	}
+	if y := fmt.Sprintf("%064X", key.Y); y != "0515242CEDD82E94799482E4C0514B505AFCCF2C0C98D6A553BF539F424C5EC0" {
+		t.Errorf("Unexpected public key Y %s", y)
+	}
+}
+
+func TestSignV4A(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com/object", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+	req = SignV4A(*req, "AKID", "SECRET", "", V4ARegionSetAll)
+
+	if v := req.Header.Get("X-Amz-Region-Set"); v != "*" {
+		t.Fatalf("Unexpected region set %q", v)
+	}
+	auth := req.Header.Get("Authorization")
+	date := req.Header.Get("X-Amz-Date")
+	tm, err := time.Parse(iso8601DateFormat, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := signV4AAlgorithm + " Credential=AKID/" + tm.Format(yyyymmdd) + "/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date;x-amz-region-set, Signature="
+	if !strings.HasPrefix(auth, prefix) {
+		t.Fatalf("Unexpected authorization header %q", auth)
+	}
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(auth, prefix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := DeriveV4AKey("AKID", "SECRET")
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonicalRequest := getCanonicalRequest(*req, v4IgnoredHeaders, getHashedPayload(*req))
+	digest := sum256([]byte(getStringToSignV4A(tm, canonicalRequest, ServiceTypeS3)))
+	if !ecdsa.VerifyASN1(&key.PublicKey, digest, sig) {
+		t.Fatal("Signature does not verify")
+	}
+}
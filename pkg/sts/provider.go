@@ -0,0 +1,86 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sts
+
+import (
+	"context"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// provider is a credentials.Provider refreshing its credentials
+// with a STS request of the client.
+type provider struct {
+	credentials.Expiry
+
+	client   *Client
+	retrieve func(ctx context.Context, c *Client) (Credentials, error)
+}
+
+func (p *provider) RetrieveWithCredContext(cc *credentials.CredContext) (credentials.Value, error) {
+	c := p.client
+	if cc != nil && cc.Client != nil && !c.ownTransport {
+		clnt := *c
+		clnt.httpClient = cc.Client
+		c = &clnt
+	}
+	creds, err := p.retrieve(context.Background(), c)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	p.SetExpiration(creds.Expiration, credentials.DefaultExpiryWindow)
+	return creds.Value(), nil
+}
+
+func (p *provider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithCredContext(nil)
+}
+
+// AssumeRoleCredentials returns credentials of the role assumed with in,
+// refreshed with AssumeRole before they expire.
+func (c *Client) AssumeRoleCredentials(in AssumeRoleInput) *credentials.Credentials {
+	return credentials.New(&provider{client: c, retrieve: func(ctx context.Context, c *Client) (Credentials, error) {
+		out, err := c.AssumeRole(ctx, in)
+		return out.Credentials, err
+	}})
+}
+
+// SessionTokenCredentials returns credentials refreshed with
+// GetSessionToken before they expire.
+func (c *Client) SessionTokenCredentials(in GetSessionTokenInput) *credentials.Credentials {
+	return credentials.New(&provider{client: c, retrieve: func(ctx context.Context, c *Client) (Credentials, error) {
+		out, err := c.GetSessionToken(ctx, in)
+		return out.Credentials, err
+	}})
+}
+
+// WebIdentityCredentials returns credentials refreshed with
+// AssumeRoleWithWebIdentity before they expire, the token is
+// returned by getToken on every refresh.
+func (c *Client) WebIdentityCredentials(in AssumeRoleWithWebIdentityInput, getToken func() (string, error)) *credentials.Credentials {
+	return credentials.New(&provider{client: c, retrieve: func(ctx context.Context, c *Client) (Credentials, error) {
+		token, err := getToken()
+		if err != nil {
+			return Credentials{}, err
+		}
+		req := in
+		req.WebIdentityToken = token
+		out, err := c.AssumeRoleWithWebIdentity(ctx, req)
+		return out.Credentials, err
+	}})
+}
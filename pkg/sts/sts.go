@@ -0,0 +1,361 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sts implements a client of the AWS Security Token Service API,
+// which is also implemented by MinIO. The client mints temporary, optionally
+// scoped down, credentials to be used by this process or handed to other
+// services, and provides credentials providers for the Client.
+package sts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// Client of a STS endpoint.
+type Client struct {
+	endpoint   string
+	region     string
+	creds      *credentials.Credentials
+	httpClient *http.Client
+
+	// ownTransport is set if the transport is given by the options,
+	// else credentials providers use the http client of their
+	// CredContext.
+	ownTransport bool
+}
+
+// Options for New.
+type Options struct {
+	// Creds of the caller, used to sign AssumeRole and
+	// GetSessionToken requests. Not needed for requests
+	// authenticated by a token, e.g. AssumeRoleWithWebIdentity.
+	Creds *credentials.Credentials
+
+	// Region of the endpoint, defaults to us-east-1.
+	Region string
+
+	// Transport of the http client, defaults to http.DefaultTransport.
+	// Credentials providers of the client use the http client of
+	// their CredContext instead, unless Transport is set.
+	Transport http.RoundTripper
+}
+
+// New returns a STS client of endpoint, e.g. https://sts.amazonaws.com
+// or the URL of a MinIO server.
+func New(endpoint string, opts *Options) (*Client, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("sts: endpoint must be an http or https URL")
+	}
+	region := opts.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	transport := opts.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Client{
+		endpoint:     u.Scheme + "://" + u.Host + "/",
+		region:       region,
+		creds:        opts.Creds,
+		httpClient:   &http.Client{Transport: transport},
+		ownTransport: opts.Transport != nil,
+	}, nil
+}
+
+// Credentials are temporary security credentials.
+type Credentials struct {
+	AccessKeyID     string    `xml:"AccessKeyId"`
+	SecretAccessKey string    `xml:"SecretAccessKey"`
+	SessionToken    string    `xml:"SessionToken"`
+	Expiration      time.Time `xml:"Expiration"`
+}
+
+// Value returns the credentials as credentials.Value.
+func (c Credentials) Value() credentials.Value {
+	return credentials.Value{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		Expiration:      c.Expiration,
+		SignerType:      credentials.SignatureV4,
+	}
+}
+
+// Tag is a session tag.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// AssumedRoleUser identifies the temporary credentials of an assumed role.
+type AssumedRoleUser struct {
+	Arn           string `xml:"Arn"`
+	AssumedRoleID string `xml:"AssumedRoleId"`
+}
+
+// AssumeRoleInput are the parameters of AssumeRole.
+type AssumeRoleInput struct {
+	// RoleARN of the role to assume, required by AWS STS.
+	RoleARN string
+
+	// RoleSessionName identifies the session, defaults to a
+	// generated name if RoleARN is set.
+	RoleSessionName string
+
+	// DurationSeconds of the credentials, the server default if zero.
+	DurationSeconds int
+
+	// Policy is an inline session policy, the credentials are limited
+	// to the intersection of the role policies and the session policies.
+	Policy string
+
+	// PolicyARNs of managed session policies.
+	PolicyARNs []string
+
+	// Tags of the session, TransitiveTagKeys are the keys of the tags
+	// that persist through role chaining.
+	Tags              []Tag
+	TransitiveTagKeys []string
+
+	// ExternalID required by the trust policy of the role, if any.
+	ExternalID string
+
+	// SourceIdentity of the caller, persisted through role chaining.
+	SourceIdentity string
+
+	// SerialNumber and TokenCode of the MFA device, if required.
+	SerialNumber string
+	TokenCode    string
+}
+
+// AssumeRoleOutput is the result of AssumeRole.
+type AssumeRoleOutput struct {
+	Credentials      Credentials     `xml:"Credentials"`
+	AssumedRoleUser  AssumedRoleUser `xml:"AssumedRoleUser"`
+	PackedPolicySize int             `xml:"PackedPolicySize"`
+	SourceIdentity   string          `xml:"SourceIdentity"`
+}
+
+// AssumeRole returns temporary credentials of a role, signed with the
+// credentials of the client.
+func (c *Client) AssumeRole(ctx context.Context, in AssumeRoleInput) (AssumeRoleOutput, error) {
+	v := url.Values{}
+	v.Set("Action", "AssumeRole")
+	if in.RoleARN != "" {
+		v.Set("RoleArn", in.RoleARN)
+		if in.RoleSessionName == "" {
+			in.RoleSessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+		}
+	}
+	if in.RoleSessionName != "" {
+		v.Set("RoleSessionName", in.RoleSessionName)
+	}
+	setSessionParams(v, in.DurationSeconds, in.Policy, in.PolicyARNs)
+	for i, tag := range in.Tags {
+		n := strconv.Itoa(i + 1)
+		v.Set("Tags.member."+n+".Key", tag.Key)
+		v.Set("Tags.member."+n+".Value", tag.Value)
+	}
+	for i, key := range in.TransitiveTagKeys {
+		v.Set("TransitiveTagKeys.member."+strconv.Itoa(i+1), key)
+	}
+	if in.ExternalID != "" {
+		v.Set("ExternalId", in.ExternalID)
+	}
+	if in.SourceIdentity != "" {
+		v.Set("SourceIdentity", in.SourceIdentity)
+	}
+	if in.SerialNumber != "" {
+		v.Set("SerialNumber", in.SerialNumber)
+		v.Set("TokenCode", in.TokenCode)
+	}
+
+	var out struct {
+		Result AssumeRoleOutput `xml:"AssumeRoleResult"`
+	}
+	if err := c.do(ctx, v, true, &out); err != nil {
+		return AssumeRoleOutput{}, err
+	}
+	return out.Result, nil
+}
+
+// GetSessionTokenInput are the parameters of GetSessionToken.
+type GetSessionTokenInput struct {
+	// DurationSeconds of the credentials, the server default if zero.
+	DurationSeconds int
+
+	// SerialNumber and TokenCode of the MFA device, if required.
+	SerialNumber string
+	TokenCode    string
+}
+
+// GetSessionTokenOutput is the result of GetSessionToken.
+type GetSessionTokenOutput struct {
+	Credentials Credentials `xml:"Credentials"`
+}
+
+// GetSessionToken returns temporary credentials of the caller, signed
+// with the credentials of the client.
+func (c *Client) GetSessionToken(ctx context.Context, in GetSessionTokenInput) (GetSessionTokenOutput, error) {
+	v := url.Values{}
+	v.Set("Action", "GetSessionToken")
+	setSessionParams(v, in.DurationSeconds, "", nil)
+	if in.SerialNumber != "" {
+		v.Set("SerialNumber", in.SerialNumber)
+		v.Set("TokenCode", in.TokenCode)
+	}
+
+	var out struct {
+		Result GetSessionTokenOutput `xml:"GetSessionTokenResult"`
+	}
+	if err := c.do(ctx, v, true, &out); err != nil {
+		return GetSessionTokenOutput{}, err
+	}
+	return out.Result, nil
+}
+
+// AssumeRoleWithWebIdentityInput are the parameters of
+// AssumeRoleWithWebIdentity.
+type AssumeRoleWithWebIdentityInput struct {
+	// WebIdentityToken is the OAuth 2.0 access token or OpenID
+	// Connect ID token of the identity provider.
+	WebIdentityToken string
+
+	// RoleARN of the role to assume, required by AWS STS.
+	RoleARN string
+
+	// RoleSessionName identifies the session, defaults to a
+	// generated name if RoleARN is set.
+	RoleSessionName string
+
+	// ProviderID of OAuth 2.0 identity providers.
+	ProviderID string
+
+	// DurationSeconds of the credentials, the server default if zero.
+	DurationSeconds int
+
+	// Policy and PolicyARNs are session policies.
+	Policy     string
+	PolicyARNs []string
+}
+
+// AssumeRoleWithWebIdentityOutput is the result of AssumeRoleWithWebIdentity.
+type AssumeRoleWithWebIdentityOutput struct {
+	Credentials                 Credentials     `xml:"Credentials"`
+	AssumedRoleUser             AssumedRoleUser `xml:"AssumedRoleUser"`
+	SubjectFromWebIdentityToken string          `xml:"SubjectFromWebIdentityToken"`
+	Audience                    string          `xml:"Audience"`
+	Provider                    string          `xml:"Provider"`
+	PackedPolicySize            int             `xml:"PackedPolicySize"`
+	SourceIdentity              string          `xml:"SourceIdentity"`
+}
+
+// AssumeRoleWithWebIdentity returns temporary credentials for the identity
+// of a web identity token, the request is not signed.
+func (c *Client) AssumeRoleWithWebIdentity(ctx context.Context, in AssumeRoleWithWebIdentityInput) (AssumeRoleWithWebIdentityOutput, error) {
+	if in.WebIdentityToken == "" {
+		return AssumeRoleWithWebIdentityOutput{}, errors.New("sts: web identity token is required")
+	}
+	v := url.Values{}
+	v.Set("Action", "AssumeRoleWithWebIdentity")
+	v.Set("WebIdentityToken", in.WebIdentityToken)
+	if in.RoleARN != "" {
+		v.Set("RoleArn", in.RoleARN)
+		if in.RoleSessionName == "" {
+			in.RoleSessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+		}
+	}
+	if in.RoleSessionName != "" {
+		v.Set("RoleSessionName", in.RoleSessionName)
+	}
+	if in.ProviderID != "" {
+		v.Set("ProviderId", in.ProviderID)
+	}
+	setSessionParams(v, in.DurationSeconds, in.Policy, in.PolicyARNs)
+
+	var out struct {
+		Result AssumeRoleWithWebIdentityOutput `xml:"AssumeRoleWithWebIdentityResult"`
+	}
+	if err := c.do(ctx, v, false, &out); err != nil {
+		return AssumeRoleWithWebIdentityOutput{}, err
+	}
+	return out.Result, nil
+}
+
+func setSessionParams(v url.Values, durationSeconds int, policy string, policyARNs []string) {
+	if durationSeconds > 0 {
+		v.Set("DurationSeconds", strconv.Itoa(durationSeconds))
+	}
+	if policy != "" {
+		v.Set("Policy", policy)
+	}
+	for i, arn := range policyARNs {
+		v.Set("PolicyArns.member."+strconv.Itoa(i+1)+".arn", arn)
+	}
+}
+
+// do sends the STS request of params and decodes the response into result,
+// failed requests return a credentials.ErrorResponse.
+func (c *Client) do(ctx context.Context, params url.Values, sign bool, result interface{}) error {
+	params.Set("Version", credentials.STSVersion)
+	body := params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if sign {
+		if c.creds == nil {
+			return errors.New("sts: credentials are required to sign the request")
+		}
+		value, err := c.creds.GetWithContext(&credentials.CredContext{Client: c.httpClient, Endpoint: c.endpoint})
+		if err != nil {
+			return err
+		}
+		if value.AccessKeyID == "" || value.SecretAccessKey == "" {
+			return errors.New("sts: credentials are required to sign the request")
+		}
+		sum := sha256.Sum256([]byte(body))
+		req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+		if value.SessionToken != "" {
+			req.Header.Set("X-Amz-Security-Token", value.SessionToken)
+		}
+		req = signer.SignV4STS(*req, value.AccessKeyID, value.SecretAccessKey, c.region)
+	}
+	return credentials.STSRequest(c.httpClient, req, result)
+}
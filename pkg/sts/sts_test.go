@@ -0,0 +1,164 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sts
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const assumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<AssumeRoleResult>
+<AssumedRoleUser><Arn>arn:aws:sts::123456789012:assumed-role/demo/session</Arn><AssumedRoleId>ARO123EXAMPLE123:session</AssumedRoleId></AssumedRoleUser>
+<Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>2030-01-01T00:00:00Z</Expiration></Credentials>
+<PackedPolicySize>6</PackedPolicySize>
+<SourceIdentity>alice</SourceIdentity>
+</AssumeRoleResult>
+</AssumeRoleResponse>`
+
+const errorResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<Error><Type>Sender</Type><Code>AccessDenied</Code><Message>not authorized</Message></Error>
+<RequestId>1234</RequestId>
+</ErrorResponse>`
+
+func TestAssumeRole(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			t.Errorf("Unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get("X-Amz-Security-Token") != "caller-token" {
+			t.Errorf("Missing session token of the caller")
+		}
+		if r.Form.Get("RoleArn") == "arn:aws:iam::123456789012:role/denied" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(errorResponse))
+			return
+		}
+		expected := map[string]string{
+			"Action":                     "AssumeRole",
+			"Version":                    credentials.STSVersion,
+			"RoleArn":                    "arn:aws:iam::123456789012:role/demo",
+			"RoleSessionName":            "session",
+			"DurationSeconds":            "900",
+			"Policy":                     `{"Version":"2012-10-17"}`,
+			"PolicyArns.member.1.arn":    "arn:aws:iam::aws:policy/ReadOnlyAccess",
+			"Tags.member.1.Key":          "team",
+			"Tags.member.1.Value":        "storage",
+			"TransitiveTagKeys.member.1": "team",
+			"ExternalId":                 "external",
+			"SourceIdentity":             "alice",
+		}
+		for k, v := range expected {
+			if got := r.Form.Get(k); got != v {
+				t.Errorf("Expected %s=%q, got %q", k, v, got)
+			}
+		}
+		w.Write([]byte(assumeRoleResponse))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, &Options{Creds: credentials.NewStaticV4("AKID", "SECRET", "caller-token")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := AssumeRoleInput{
+		RoleARN:           "arn:aws:iam::123456789012:role/demo",
+		RoleSessionName:   "session",
+		DurationSeconds:   900,
+		Policy:            `{"Version":"2012-10-17"}`,
+		PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		Tags:              []Tag{{Key: "team", Value: "storage"}},
+		TransitiveTagKeys: []string{"team"},
+		ExternalID:        "external",
+		SourceIdentity:    "alice",
+	}
+	out, err := c.AssumeRole(context.Background(), in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Credentials.AccessKeyID != "ASIAEXAMPLE" || out.Credentials.SessionToken != "token" {
+		t.Fatalf("Unexpected credentials %+v", out.Credentials)
+	}
+	if out.AssumedRoleUser.AssumedRoleID != "ARO123EXAMPLE123:session" || out.PackedPolicySize != 6 || out.SourceIdentity != "alice" {
+		t.Fatalf("Unexpected output %+v", out)
+	}
+
+	value, err := c.AssumeRoleCredentials(in).GetWithContext(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.AccessKeyID != "ASIAEXAMPLE" || value.SignerType != credentials.SignatureV4 {
+		t.Fatalf("Unexpected credentials value %+v", value)
+	}
+
+	in.RoleARN = "arn:aws:iam::123456789012:role/denied"
+	_, err = c.AssumeRole(context.Background(), in)
+	var errResp credentials.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.STSError.Code != "AccessDenied" || errResp.RequestID != "1234" {
+		t.Fatalf("Unexpected error %v", err)
+	}
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestProviderCredContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(assumeRoleResponse))
+	}))
+	defer srv.Close()
+
+	for _, ownTransport := range []bool{false, true} {
+		own, caller := &countingTransport{}, &countingTransport{}
+		opts := &Options{Creds: credentials.NewStaticV4("AKID", "SECRET", "")}
+		if ownTransport {
+			opts.Transport = own
+		}
+		c, err := New(srv.URL, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		creds := c.AssumeRoleCredentials(AssumeRoleInput{RoleARN: "arn:aws:iam::123456789012:role/demo"})
+		if _, err = creds.GetWithContext(&credentials.CredContext{Client: &http.Client{Transport: caller}}); err != nil {
+			t.Fatal(err)
+		}
+		// The transport of the options takes precedence over the
+		// http client of the CredContext.
+		if ownTransport && (own.requests != 1 || caller.requests != 0) {
+			t.Errorf("Expected the transport of the options, got %d and %d requests", own.requests, caller.requests)
+		}
+		if !ownTransport && caller.requests != 1 {
+			t.Errorf("Expected the http client of the CredContext, got %d requests", caller.requests)
+		}
+	}
+}
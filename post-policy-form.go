@@ -0,0 +1,89 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/minio/minio-go/v7/internal/json"
+)
+
+// PresignedPostForm is a presigned POST policy ready to be served to
+// browsers, either rendered as an HTML form or as JSON for scripts
+// building a multipart/form-data request.
+type PresignedPostForm struct {
+	// URL the form is posted to.
+	URL string `json:"url"`
+
+	// Fields of the form, the file field must be
+	// the last field of the form.
+	Fields map[string]string `json:"fields"`
+}
+
+// PresignedPostForm presigns p, like PresignedPostPolicy, and returns
+// the resulting form.
+func (c *Client) PresignedPostForm(ctx context.Context, p *PostPolicy) (*PresignedPostForm, error) {
+	u, formData, err := c.PresignedPostPolicy(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return &PresignedPostForm{URL: u.String(), Fields: formData}, nil
+}
+
+// JSON returns the form as a JSON object with url and fields.
+func (f *PresignedPostForm) JSON() ([]byte, error) {
+	return json.Marshal(f)
+}
+
+var postFormTemplate = template.Must(template.New("form").Parse(
+	`<form action="{{.URL}}" method="post" enctype="multipart/form-data">
+{{- range .Fields}}
+  <input type="hidden" name="{{.Name}}" value="{{.Value}}">
+{{- end}}
+  <input type="file" name="file">
+  <input type="submit" value="Upload">
+</form>
+`))
+
+// HTML returns an HTML form posting a file with the fields of the form,
+// all values are escaped.
+func (f *PresignedPostForm) HTML() (string, error) {
+	type field struct{ Name, Value string }
+	fields := make([]field, 0, len(f.Fields))
+	for name, value := range f.Fields {
+		fields = append(fields, field{Name: name, Value: value})
+	}
+	// Stable output, the key first since S3 expands ${filename}
+	// in it with the name of the uploaded file.
+	sort.Slice(fields, func(i, j int) bool {
+		if (fields[i].Name == "key") != (fields[j].Name == "key") {
+			return fields[i].Name == "key"
+		}
+		return fields[i].Name < fields[j].Name
+	})
+
+	var sb strings.Builder
+	err := postFormTemplate.Execute(&sb, struct {
+		URL    string
+		Fields []field
+	}{f.URL, fields})
+	return sb.String(), err
+}
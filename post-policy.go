@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -363,7 +364,8 @@ func (p *PostPolicy) SetChecksum(c Checksum) error {
 	return nil
 }
 
-// SetEncryption - sets encryption headers for POST API
+// SetEncryption - sets encryption headers for POST API, along with
+// the policy conditions matching them.
 func (p *PostPolicy) SetEncryption(sse encrypt.ServerSide) {
 	if sse == nil {
 		return
@@ -372,7 +374,64 @@ func (p *PostPolicy) SetEncryption(sse encrypt.ServerSide) {
 	sse.Marshal(h)
 	for k, v := range h {
 		p.formData[k] = v[0]
+		p.addNewPolicy(policyCondition{
+			matchType: "eq",
+			condition: "$" + strings.ToLower(k),
+			value:     v[0],
+		})
+	}
+}
+
+// SetStartsWith - Sets a condition that the value of a form field must
+// start with prefix, e.g. SetStartsWith("Content-Type", "image/").
+// Can use an empty prefix ("") to allow any value.
+func (p *PostPolicy) SetStartsWith(field, prefix string) error {
+	field = strings.TrimPrefix(strings.TrimSpace(field), "$")
+	if field == "" {
+		return errInvalidArgument("Field is empty.")
+	}
+	policyCond := policyCondition{
+		matchType: "starts-with",
+		condition: "$" + field,
+		value:     prefix,
+	}
+	if err := p.addNewPolicy(policyCond); err != nil {
+		return err
+	}
+	if _, ok := p.formData[field]; !ok {
+		p.formData[field] = prefix
+	}
+	return nil
+}
+
+// SetEquals - Sets a condition that the value of a form field must
+// be exactly value, the field is added to the form data.
+func (p *PostPolicy) SetEquals(field, value string) error {
+	field = strings.TrimPrefix(strings.TrimSpace(field), "$")
+	if field == "" {
+		return errInvalidArgument("Field is empty.")
+	}
+	policyCond := policyCondition{
+		matchType: "eq",
+		condition: "$" + field,
+		value:     value,
+	}
+	if err := p.addNewPolicy(policyCond); err != nil {
+		return err
+	}
+	p.formData[field] = value
+	return nil
+}
+
+// SetSuccessActionStatusCode - Sets the status code returned on success,
+// one of 200, 201 or 204, when no success_action_redirect is set.
+func (p *PostPolicy) SetSuccessActionStatusCode(status int) error {
+	switch status {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	default:
+		return errInvalidArgument(fmt.Sprintf("Invalid success action status %d, must be 200, 201 or 204", status))
 	}
+	return p.SetSuccessStatusAction(strconv.Itoa(status))
 }
 
 // SetUserData - Set user data as a key/value couple.
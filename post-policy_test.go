@@ -458,3 +458,75 @@ func TestPostPolicySetEncryption(t *testing.T) {
 		})
 	}
 }
+
+func TestPostPolicyConditions(t *testing.T) {
+	pp := NewPostPolicy()
+	if err := pp.SetStartsWith("Content-Type", "image/"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pp.SetEquals("x-amz-meta-owner", "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pp.SetSuccessActionStatusCode(201); err != nil {
+		t.Fatal(err)
+	}
+	if err := pp.SetSuccessActionStatusCode(302); err == nil {
+		t.Fatal("Expected invalid success action status to fail")
+	}
+	if err := pp.SetStartsWith("", "x"); err == nil {
+		t.Fatal("Expected empty field to fail")
+	}
+	sse, err := encrypt.NewSSEKMS("my-key-id", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pp.SetEncryption(sse)
+
+	policy := pp.String()
+	for _, cond := range []string{
+		`["starts-with","$Content-Type","image/"]`,
+		`["eq","$x-amz-meta-owner","alice"]`,
+		`["eq","$success_action_status","201"]`,
+		`["eq","$x-amz-server-side-encryption","aws:kms"]`,
+		`["eq","$x-amz-server-side-encryption-aws-kms-key-id","my-key-id"]`,
+	} {
+		if !strings.Contains(policy, cond) {
+			t.Errorf("Expected condition %s in policy %s", cond, policy)
+		}
+	}
+	if pp.formData["x-amz-meta-owner"] != "alice" || pp.formData["success_action_status"] != "201" {
+		t.Errorf("Unexpected form data %v", pp.formData)
+	}
+}
+
+func TestPresignedPostForm(t *testing.T) {
+	f := &PresignedPostForm{
+		URL: "https://play.min.io/bucket",
+		Fields: map[string]string{
+			"policy":          "eyJ9",
+			"key":             `uploads/${filename}`,
+			"x-amz-meta-note": `"><script>`,
+		},
+	}
+	html, err := f.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(html, "<script>") {
+		t.Fatalf("Form values are not escaped: %s", html)
+	}
+	if strings.Index(html, `name="key"`) > strings.Index(html, `name="policy"`) {
+		t.Fatalf("Expected key to be the first field: %s", html)
+	}
+	if !strings.Contains(html, `action="https://play.min.io/bucket"`) || !strings.Contains(html, `type="file" name="file"`) {
+		t.Fatalf("Unexpected form %s", html)
+	}
+
+	data, err := f.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"url":"https://play.min.io/bucket"`) || !strings.Contains(string(data), `"fields":{`) {
+		t.Fatalf("Unexpected JSON %s", data)
+	}
+}
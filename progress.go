@@ -0,0 +1,158 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// TransferProgress describes the progress of an upload or download.
+type TransferProgress struct {
+	// Transferred is the number of bytes transferred. It decreases when
+	// a part is retried, since its bytes are sent again.
+	Transferred int64
+
+	// Total is the size of the transfer, -1 if unknown.
+	Total int64
+
+	// PartNumber is the part of a multipart upload which transferred
+	// the bytes, zero for single part transfers. Parts are uploaded
+	// concurrently, so reports of different parts interleave.
+	PartNumber int
+
+	// Retries is the number of parts which were retried.
+	Retries int
+
+	// Elapsed is the duration since the start of the transfer.
+	Elapsed time.Duration
+
+	// Rate is the average transfer rate in bytes per second.
+	Rate float64
+
+	// Remaining is the estimated duration until the transfer is done,
+	// zero if the total is unknown.
+	Remaining time.Duration
+
+	// Deadline of the context of the transfer, zero if there is none.
+	// The transfer is not expected to finish in time if the time of
+	// the report plus Remaining is after Deadline.
+	Deadline time.Time
+}
+
+// ProgressFunc receives the progress of a transfer, it is called
+// serially while bytes are transferred and must not block.
+type ProgressFunc func(p TransferProgress)
+
+// transferProgress tracks the progress of a transfer.
+type transferProgress struct {
+	fn       ProgressFunc
+	start    time.Time
+	deadline time.Time
+
+	mu          sync.Mutex
+	total       int64
+	transferred int64
+	retries     int
+}
+
+func newTransferProgress(ctx context.Context, total int64, fn ProgressFunc) *transferProgress {
+	if fn == nil {
+		return nil
+	}
+	deadline, _ := ctx.Deadline()
+	return &transferProgress{
+		fn:       fn,
+		start:    time.Now(),
+		deadline: deadline,
+		total:    total,
+	}
+}
+
+// report adds n transferred bytes of a part and calls the ProgressFunc.
+func (t *transferProgress) report(partNumber int, n int64, retry bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.transferred += n
+	if retry {
+		t.retries++
+	}
+	p := TransferProgress{
+		Transferred: t.transferred,
+		Total:       t.total,
+		PartNumber:  partNumber,
+		Retries:     t.retries,
+		Elapsed:     time.Since(t.start),
+		Deadline:    t.deadline,
+	}
+	if secs := p.Elapsed.Seconds(); secs > 0 {
+		p.Rate = float64(p.Transferred) / secs
+	}
+	if p.Total >= 0 && p.Rate > 0 {
+		p.Remaining = time.Duration(float64(max(p.Total-p.Transferred, 0)) / p.Rate * float64(time.Second))
+	}
+	t.fn(p)
+}
+
+// setTotal sets the size of the transfer once it is known.
+func (t *transferProgress) setTotal(total int64) {
+	t.mu.Lock()
+	t.total = total
+	t.mu.Unlock()
+}
+
+// part returns r reporting the bytes read from it as transferred bytes
+// of a part, r is returned as is if t is nil.
+func (t *transferProgress) part(r io.Reader, partNumber int) io.Reader {
+	if t == nil {
+		return r
+	}
+	return newHook(r, &partProgress{progress: t, partNumber: partNumber})
+}
+
+// partProgress is the hook of a part, it is rewound by the retries of the
+// request which uploads the part.
+type partProgress struct {
+	progress   *transferProgress
+	partNumber int
+	read       int64
+}
+
+func (p *partProgress) Read(b []byte) (int, error) {
+	p.read += int64(len(b))
+	p.progress.report(p.partNumber, int64(len(b)), false)
+	return len(b), nil
+}
+
+func (p *partProgress) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += p.read
+	default:
+		return 0, errors.New("partProgress.Seek: unsupported whence")
+	}
+	if offset != p.read {
+		p.progress.report(p.partNumber, offset-p.read, offset < p.read)
+		p.read = offset
+	}
+	return offset, nil
+}
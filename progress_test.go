@@ -0,0 +1,111 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestTransferProgress(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 100<<10)
+	var puts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			io.Copy(io.Discard, r.Body)
+			if puts.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("ETag", `"etag"`)
+		case http.MethodGet:
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Write(data)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:       credentials.NewStaticV4("access", "secret", ""),
+		Region:      "us-east-1",
+		RetryPolicy: StandardRetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var reports []TransferProgress
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = c.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), PutObjectOptions{
+		ProgressFunc: func(p TransferProgress) { reports = append(reports, p) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) == 0 {
+		t.Fatal("Expected progress reports")
+	}
+	last := reports[len(reports)-1]
+	if last.Transferred != int64(len(data)) || last.Total != int64(len(data)) || last.Retries != 1 {
+		t.Errorf("Unexpected last report: %+v", last)
+	}
+	if last.Deadline.IsZero() || last.Remaining != 0 {
+		t.Errorf("Unexpected deadline or remaining duration: %+v", last)
+	}
+	var rewound bool
+	for i := 1; i < len(reports); i++ {
+		if reports[i].Transferred < reports[i-1].Transferred {
+			rewound = true
+		}
+	}
+	if !rewound {
+		t.Error("Expected the retry to rewind the progress")
+	}
+
+	reports = nil
+	obj, err := c.GetObject(context.Background(), "bucket", "object", GetObjectOptions{
+		ProgressFunc: func(p TransferProgress) { reports = append(reports, p) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if _, err = io.Copy(io.Discard, obj); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) == 0 {
+		t.Fatal("Expected progress reports")
+	}
+	last = reports[len(reports)-1]
+	if last.Transferred != int64(len(data)) || last.Total != int64(len(data)) || last.PartNumber != 0 {
+		t.Errorf("Unexpected last report: %+v", last)
+	}
+}
@@ -0,0 +1,245 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/limits"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// Profile is the S3 compatible provider of an endpoint, the client
+// adjusts its requests to the known quirks of the provider.
+type Profile int
+
+// Different provider profiles. Initialized to ProfileAuto.
+const (
+	// ProfileAuto - the provider is detected from the endpoint, see
+	// ProfileForURL, endpoints of unknown providers use ProfileS3.
+	ProfileAuto Profile = iota
+
+	// ProfileS3 - AWS S3, MinIO and other servers without known quirks.
+	ProfileS3
+
+	// ProfileR2 - Cloudflare R2.
+	ProfileR2
+
+	// ProfileB2 - the S3 compatible API of Backblaze B2.
+	ProfileB2
+
+	// ProfileGCS - the XML interoperability API of Google Cloud Storage.
+	ProfileGCS
+
+	// ProfileRGW - Ceph RadosGW, whose extensions are used by the client,
+	// e.g. appending to objects and unordered listings.
+	ProfileRGW
+)
+
+// String returns the name of the profile.
+func (p Profile) String() string {
+	switch p {
+	case ProfileS3:
+		return "S3"
+	case ProfileR2:
+		return "R2"
+	case ProfileB2:
+		return "B2"
+	case ProfileGCS:
+		return "GCS"
+	case ProfileRGW:
+		return "RGW"
+	}
+	return "Auto"
+}
+
+// ProfileForURL returns the profile of the provider of endpointURL, it
+// returns ProfileAuto for endpoints of unknown providers.
+func ProfileForURL(endpointURL url.URL) Profile {
+	host := endpointURL.Hostname()
+	switch {
+	case s3utils.IsAmazonEndpoint(endpointURL):
+		return ProfileS3
+	case s3utils.IsGoogleEndpoint(endpointURL):
+		return ProfileGCS
+	case strings.HasSuffix(host, ".r2.cloudflarestorage.com"):
+		return ProfileR2
+	case strings.HasSuffix(host, ".backblazeb2.com"):
+		return ProfileB2
+	}
+	return ProfileAuto
+}
+
+// providerQuirks are the differences of a provider to AWS S3.
+type providerQuirks struct {
+	// Checksums cannot be sent in trailers of aws-chunked payloads.
+	noTrailingChecksums bool
+	// Payloads cannot be signed in chunks.
+	noStreamingSignature bool
+	// The largest number of entries of a page of a listing, larger
+	// pages are rejected.
+	maxKeys int
+	// Prefixes of the canonical names of the headers rejected by the
+	// provider, requests setting them fail.
+	disallowedHeaders []string
+	// Prefixes of the canonical names of the headers set by the client
+	// and ignored by the provider, they are removed from requests.
+	droppedHeaders []string
+	// ETags of objects uploaded in a single part are the MD5 sum of the
+	// content regardless of their encryption.
+	md5ETags bool
+	// Objects may be listed out of order, which is faster for buckets
+	// with sharded indexes.
+	unorderedListing bool
+	// Service limits which differ from the limits of AWS S3.
+	limits func() limits.Limits
+	// Metadata values must be US-ASCII, as on AWS S3.
+	asciiMetadata bool
+}
+
+var profileQuirks = map[Profile]providerQuirks{
+	ProfileR2: {
+		noTrailingChecksums: true,
+		maxKeys:             1000,
+		disallowedHeaders: []string{
+			"X-Amz-Acl", "X-Amz-Grant-", "X-Amz-Object-Lock-", "X-Amz-Tagging",
+			"X-Amz-Website-Redirect-Location",
+		},
+		md5ETags: true,
+		limits:   limits.R2,
+	},
+	ProfileB2: {
+		noTrailingChecksums: true,
+		maxKeys:             1000,
+		disallowedHeaders: []string{
+			"X-Amz-Grant-", "X-Amz-Storage-Class", "X-Amz-Tagging",
+			"X-Amz-Website-Redirect-Location",
+		},
+		md5ETags:      true,
+		limits:        limits.B2,
+		asciiMetadata: true,
+	},
+	ProfileGCS: {
+		noTrailingChecksums:  true,
+		noStreamingSignature: true,
+		maxKeys:              1000,
+		disallowedHeaders:    []string{"X-Amz-Object-Lock-", "X-Amz-Tagging"},
+		droppedHeaders:       []string{"X-Amz-Checksum-", "X-Amz-Sdk-Checksum-Algorithm"},
+		md5ETags:             true,
+		asciiMetadata:        true,
+	},
+	ProfileRGW: {
+		unorderedListing: true,
+	},
+}
+
+// Profile returns the profile of the provider of the endpoint, see
+// Options.Profile.
+func (c *Client) Profile() Profile {
+	if c.profile != ProfileAuto {
+		return c.profile
+	}
+	return ProfileS3
+}
+
+// quirks returns the quirks of the provider of the endpoint.
+func (c *Client) quirks() providerQuirks {
+	return profileQuirks[c.Profile()]
+}
+
+// Limits returns the service limits of the endpoint, see Options.Limits.
+func (c *Client) Limits() limits.Limits {
+	if c.limits != nil {
+		return *c.limits
+	}
+	if l := c.quirks().limits; l != nil {
+		return l()
+	}
+	return limits.S3()
+}
+
+// trailingChecksums returns true if checksums are sent in trailers.
+func (c *Client) trailingChecksums() bool {
+	return c.trailingHeaderSupport && !c.quirks().noTrailingChecksums
+}
+
+// putTrailingChecksums returns true if the checksums of an upload are
+// sent in trailers, see PutObjectOptions.UnsignedPayloadTrailer.
+func (c *Client) putTrailingChecksums(opts PutObjectOptions) bool {
+	if opts.UnsignedPayloadTrailer && c.secure && c.overrideSignerType.IsV4() {
+		return !c.quirks().noTrailingChecksums
+	}
+	return c.trailingChecksums()
+}
+
+// providerListOptions returns the options of a listing adjusted to the
+// provider, the number of entries requested per page is limited to the
+// largest page of the provider and unordered listings are only
+// requested from providers supporting them.
+func (c *Client) providerListOptions(opts ListObjectsOptions) ListObjectsOptions {
+	quirks := c.quirks()
+	if quirks.maxKeys > 0 && opts.MaxKeys > quirks.maxKeys {
+		opts.MaxKeys = quirks.maxKeys
+	}
+	if opts.AllowUnordered && !opts.WithVersions && quirks.unorderedListing {
+		unordered := requestExtensions{extraQuery: url.Values{"allow-unordered": {"true"}}}
+		opts.requestExtensions = unordered.merge(opts.requestExtensions)
+	}
+	return opts
+}
+
+// checkDisallowedHeaders returns an error if h sets a header rejected by
+// the provider, the headers ignored by the provider are removed.
+func (c *Client) checkDisallowedHeaders(h http.Header) error {
+	quirks := c.quirks()
+	for k := range h {
+		for _, prefix := range quirks.disallowedHeaders {
+			if strings.HasPrefix(k, prefix) {
+				return errInvalidArgument(k + " is not supported by " + c.Profile().String() + " endpoints")
+			}
+		}
+		for _, prefix := range quirks.droppedHeaders {
+			if strings.HasPrefix(k, prefix) {
+				delete(h, k)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// ETagIsMD5 returns true if the ETag of the object is the MD5 sum of
+// its content. ETags of objects uploaded in parts never are, nor are
+// the ETags of objects encrypted with SSE-C or SSE-KMS unless the
+// provider keeps them.
+func (c *Client) ETagIsMD5(info ObjectInfo) bool {
+	etag := trimEtag(info.ETag)
+	if _, err := hex.DecodeString(etag); err != nil || len(etag) != 32 {
+		return false
+	}
+	if c.quirks().md5ETags {
+		return true
+	}
+	if info.Metadata.Get("X-Amz-Server-Side-Encryption-Customer-Algorithm") != "" {
+		return false
+	}
+	return !strings.HasPrefix(info.Metadata.Get(sseHeaderPrefix), "aws:kms")
+}
@@ -0,0 +1,221 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/limits"
+)
+
+func TestProfileForURL(t *testing.T) {
+	testCases := []struct {
+		endpoint string
+		profile  Profile
+	}{
+		{"https://s3.amazonaws.com", ProfileS3},
+		{"https://storage.googleapis.com", ProfileGCS},
+		{"https://0123456789abcdef.r2.cloudflarestorage.com", ProfileR2},
+		{"https://s3.us-west-004.backblazeb2.com", ProfileB2},
+		{"http://localhost:9000", ProfileAuto},
+	}
+	for _, testCase := range testCases {
+		u, err := url.Parse(testCase.endpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if profile := ProfileForURL(*u); profile != testCase.profile {
+			t.Errorf("%s: expected profile %s, got %s", testCase.endpoint, testCase.profile, profile)
+		}
+	}
+}
+
+func TestProfileQuirks(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		headers = make(map[string]http.Header)
+		query   url.Values
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers[r.Method] = r.Header.Clone()
+		if r.Method == http.MethodGet {
+			query = r.URL.Query()
+		}
+		mu.Unlock()
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`<ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated></ListBucketResult>`))
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:           credentials.NewStaticV4("access", "secret", ""),
+		Region:          "us-east-1",
+		Profile:         ProfileR2,
+		TrailingHeaders: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	// Headers rejected by the provider fail before any request.
+	for _, opts := range []PutObjectOptions{
+		{UserTags: map[string]string{"key": "value"}},
+		{Mode: Governance, RetainUntilDate: time.Now().Add(time.Hour)},
+		{LegalHold: LegalHoldEnabled},
+	} {
+		_, err = clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, opts)
+		if code := ToErrorResponse(err).Code; code != InvalidArgument {
+			t.Fatalf("expected InvalidArgument error, got %v", err)
+		}
+	}
+	if len(headers) != 0 {
+		t.Fatalf("unexpected requests %v", headers)
+	}
+
+	if _, err = clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if put := headers[http.MethodPut]; put.Get("X-Amz-Trailer") != "" {
+		t.Fatalf("unexpected headers of the upload: %v", put)
+	}
+
+	for obj := range clnt.ListObjects(ctx, "bucket", ListObjectsOptions{MaxKeys: 5000}) {
+		if obj.Err != nil {
+			t.Fatal(obj.Err)
+		}
+	}
+	if maxKeys := query.Get("max-keys"); maxKeys != "1000" {
+		t.Fatalf("expected max-keys 1000, got %q", maxKeys)
+	}
+
+	_, err = clnt.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{
+		Checksum: ChecksumCRC32C,
+	})
+	if err == nil {
+		t.Fatal("expected checksums to be rejected")
+	}
+}
+
+func TestProfileNotDetected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "cloudflare")
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clnt.BucketExists(context.Background(), "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	// The profile is not guessed from the responses of the endpoint.
+	if profile := clnt.Profile(); profile != ProfileS3 {
+		t.Fatalf("expected profile S3, got %s", profile)
+	}
+	var opts PutObjectOptions
+	opts.LegalHold = LegalHoldEnabled
+	if _, err = clnt.PutObject(context.Background(), "bucket", "object", strings.NewReader("data"), 4, opts); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestETagIsMD5(t *testing.T) {
+	encrypted := http.Header{"X-Amz-Server-Side-Encryption": {"aws:kms"}}
+	testCases := []struct {
+		profile Profile
+		info    ObjectInfo
+		md5     bool
+	}{
+		{ProfileS3, ObjectInfo{ETag: `"9e107d9d372bb6826bd81d3542a419d6"`}, true},
+		{ProfileS3, ObjectInfo{ETag: `"9e107d9d372bb6826bd81d3542a419d6-3"`}, false},
+		{ProfileS3, ObjectInfo{ETag: "etag"}, false},
+		{ProfileS3, ObjectInfo{ETag: "9e107d9d372bb6826bd81d3542a419d6", Metadata: encrypted}, false},
+		{ProfileGCS, ObjectInfo{ETag: "9e107d9d372bb6826bd81d3542a419d6", Metadata: encrypted}, true},
+		{ProfileR2, ObjectInfo{ETag: "9e107d9d372bb6826bd81d3542a419d6-2"}, false},
+	}
+	for i, testCase := range testCases {
+		c := &Client{profile: testCase.profile}
+		if md5 := c.ETagIsMD5(testCase.info); md5 != testCase.md5 {
+			t.Errorf("test %d: expected %t, got %t", i+1, testCase.md5, md5)
+		}
+	}
+}
+
+func TestClientLimits(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requests++
+	}))
+	defer srv.Close()
+
+	newClient := func(opts Options) *Client {
+		opts.Creds = credentials.NewStaticV4("access", "secret", "")
+		opts.Region = "us-east-1"
+		clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clnt
+	}
+	ctx := context.Background()
+
+	b2 := newClient(Options{Profile: ProfileB2})
+	if l := b2.Limits(); l != limits.B2() {
+		t.Fatalf("expected the limits of B2, got %+v", l)
+	}
+	if l := newClient(Options{Profile: ProfileR2}).Limits(); l != limits.R2() {
+		t.Fatalf("expected the limits of R2, got %+v", l)
+	}
+	_, err := b2.PutObject(ctx, "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{PartSize: 5 << 30})
+	var lerr *limits.Error
+	if !errors.As(err, &lerr) || lerr.Limit != "MaxPartSize" {
+		t.Fatalf("expected the part size to exceed MaxPartSize, got %v", err)
+	}
+
+	clnt := newClient(Options{Limits: &limits.Limits{MaxPartsCount: 100}})
+	if l := clnt.Limits(); l.MaxPartsCount != 100 || l.MaxPartSize != limits.S3().MaxPartSize {
+		t.Fatalf("unexpected limits %+v", l)
+	}
+	core := Core{clnt}
+	for _, partNumber := range []int{0, 101} {
+		_, err = core.PutObjectPart(ctx, "bucket", "object", "upload", partNumber, strings.NewReader("data"), 4, PutObjectPartOptions{})
+		if errResp := ToErrorResponse(err); errResp.Code != InvalidArgument || !strings.Contains(errResp.Message, "100") {
+			t.Errorf("part %d: expected the part number to exceed MaxPartsCount, got %v", partNumber, err)
+		}
+	}
+	if requests != 0 {
+		t.Fatalf("expected no requests, got %d", requests)
+	}
+}
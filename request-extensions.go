@@ -0,0 +1,120 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// requestExtensions are the vendor specific headers and query parameters
+// of the options of a call, they are sent with every request the call
+// makes, e.g. with every part of a multipart upload. This allows using
+// the extensions of other S3 compatible servers without forking.
+//
+// The headers and query parameters are not validated, the headers replace
+// the headers set by the call and the query parameters are added to the
+// query parameters of the call. They are not used by presigned URLs.
+type requestExtensions struct {
+	extraHeaders http.Header
+	extraQuery   url.Values
+}
+
+// Set adds a key value pair to the options. The
+// key-value pair will be part of the HTTP request
+// headers.
+func (e *requestExtensions) Set(key, value string) {
+	if e.extraHeaders == nil {
+		e.extraHeaders = make(http.Header)
+	}
+	e.extraHeaders.Set(key, value)
+}
+
+// AddQuery adds a query parameter to the requests of the call.
+func (e *requestExtensions) AddQuery(key, value string) {
+	if e.extraQuery == nil {
+		e.extraQuery = make(url.Values)
+	}
+	e.extraQuery.Add(key, value)
+}
+
+// isEmpty returns true if no headers or query parameters are set.
+func (e requestExtensions) isEmpty() bool {
+	return len(e.extraHeaders) == 0 && len(e.extraQuery) == 0
+}
+
+// merge returns the headers and query parameters of defaults and e, the
+// values of e win.
+func (e requestExtensions) merge(defaults requestExtensions) requestExtensions {
+	if len(defaults.extraHeaders) > 0 {
+		headers := defaults.extraHeaders.Clone()
+		maps.Copy(headers, e.extraHeaders)
+		e.extraHeaders = headers
+	}
+	if len(defaults.extraQuery) > 0 {
+		query := maps.Clone(defaults.extraQuery)
+		maps.Copy(query, e.extraQuery)
+		e.extraQuery = query
+	}
+	return e
+}
+
+type requestExtensionsContextKey struct{}
+
+// withRequestExtensions returns a copy of ctx carrying the extensions of
+// a call, merged with those of an outer call.
+func withRequestExtensions(ctx context.Context, e requestExtensions) context.Context {
+	if e.isEmpty() {
+		return ctx
+	}
+	e = e.merge(requestExtensionsFrom(ctx))
+	return context.WithValue(ctx, requestExtensionsContextKey{}, e)
+}
+
+// requestExtensionsFrom returns the extensions of the requests made with
+// ctx.
+func requestExtensionsFrom(ctx context.Context) requestExtensions {
+	e, _ := ctx.Value(requestExtensionsContextKey{}).(requestExtensions)
+	return e
+}
+
+// query returns the query parameters of a request with the extra query
+// parameters added.
+func (e requestExtensions) query(query url.Values) url.Values {
+	if len(e.extraQuery) == 0 {
+		return query
+	}
+	merged := make(url.Values, len(query)+len(e.extraQuery))
+	for k, v := range query {
+		merged[k] = v
+	}
+	for k, v := range e.extraQuery {
+		merged[k] = slices.Concat(merged[k], v)
+	}
+	return merged
+}
+
+// setHeaders sets the extra headers of a request.
+func (e requestExtensions) setHeaders(h http.Header) {
+	for k, v := range e.extraHeaders {
+		h[k] = slices.Clone(v)
+	}
+}
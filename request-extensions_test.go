@@ -0,0 +1,101 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestRequestExtensions(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []*http.Request
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r)
+		mu.Unlock()
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	statOpts := StatObjectOptions{VersionID: "v1"}
+	statOpts.AddQuery("x-vendor-mode", "fast")
+	if _, err = clnt.StatObject(ctx, "bucket", "object", statOpts); err != nil {
+		t.Fatal(err)
+	}
+	removeOpts := RemoveObjectOptions{}
+	removeOpts.Set("X-Vendor-Hint", "hint")
+	removeOpts.AddQuery("x-vendor-mode", "fast")
+	if err = clnt.RemoveObject(ctx, "bucket", "object", removeOpts); err != nil {
+		t.Fatal(err)
+	}
+	if err = clnt.RemoveObject(ctx, "bucket", "object", RemoveObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+	if q := requests[0].URL.Query(); q.Get("x-vendor-mode") != "fast" || q.Get("versionId") != "v1" {
+		t.Errorf("unexpected query of the stat: %v", q)
+	}
+	if r := requests[1]; r.URL.Query().Get("x-vendor-mode") != "fast" || r.Header.Get("X-Vendor-Hint") != "hint" {
+		t.Errorf("unexpected extensions of the remove: %v %v", r.URL.Query(), r.Header)
+	}
+	if r := requests[2]; r.URL.RawQuery != "" || r.Header.Get("X-Vendor-Hint") != "" {
+		t.Errorf("unexpected extensions without options: %v %v", r.URL.Query(), r.Header)
+	}
+}
+
+func TestRequestExtensionsMerge(t *testing.T) {
+	var outer, inner requestExtensions
+	outer.Set("X-Tenant", "a")
+	outer.AddQuery("x-mode", "slow")
+	inner.AddQuery("x-mode", "fast")
+
+	ctx := withRequestExtensions(context.Background(), outer)
+	ctx = withRequestExtensions(ctx, inner)
+	e := requestExtensionsFrom(ctx)
+	if e.extraHeaders.Get("X-Tenant") != "a" || len(e.extraQuery["x-mode"]) != 1 || e.extraQuery.Get("x-mode") != "fast" {
+		t.Fatalf("unexpected extensions: %+v", e)
+	}
+	if len(outer.extraQuery["x-mode"]) != 1 || outer.extraQuery.Get("x-mode") != "slow" {
+		t.Fatalf("outer extensions modified: %+v", outer)
+	}
+}
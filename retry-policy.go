@@ -0,0 +1,252 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"iter"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryAttempt describes a failed attempt of a request which can be retried.
+type RetryAttempt struct {
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt int
+
+	// Response of the failed attempt, nil when the request failed
+	// without a response. Only the status and headers can be used.
+	Response *http.Response
+
+	// Err of the failed attempt.
+	Err error
+
+	// PrevDelay is the delay before the failed attempt, zero for the
+	// first attempt.
+	PrevDelay time.Duration
+}
+
+// RetryPolicy decides whether and when failed requests are retried. It is
+// only consulted for errors which can be retried, e.g. network errors,
+// throttling and 5xx responses, and must be safe for concurrent use.
+type RetryPolicy interface {
+	// Retry returns the delay before the next attempt, or false to
+	// give up and return the error of the failed attempt.
+	Retry(a RetryAttempt) (delay time.Duration, retry bool)
+}
+
+// RetryJitter is the randomization of retry delays.
+type RetryJitter int
+
+const (
+	// RetryJitterFull waits a random delay between zero and the
+	// exponential backoff.
+	RetryJitterFull RetryJitter = iota
+
+	// RetryJitterNone waits the exponential backoff.
+	RetryJitterNone
+
+	// RetryJitterDecorrelated waits a random delay between the base
+	// delay and three times the previous delay.
+	RetryJitterDecorrelated
+)
+
+// StandardRetryPolicy is a RetryPolicy with exponential backoff.
+type StandardRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, defaults to
+	// MaxRetry. Set to 1 to disable retries.
+	MaxAttempts int
+
+	// BaseDelay and MaxDelay of the backoff, default to
+	// DefaultRetryUnit and DefaultRetryCap.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Jitter of the delays, defaults to RetryJitterFull.
+	Jitter RetryJitter
+
+	// MaxRetryAfter limits the delay requested by the Retry-After
+	// header of a response, which is honored when longer than the
+	// backoff. Defaults to 30 seconds, negative values ignore
+	// Retry-After.
+	MaxRetryAfter time.Duration
+
+	// Budget limits the retries of all requests using the policy,
+	// nil for no limit.
+	Budget *RetryBudget
+}
+
+const defaultMaxRetryAfter = 30 * time.Second
+
+// Retry implements RetryPolicy.
+func (p StandardRetryPolicy) Retry(a RetryAttempt) (time.Duration, bool) {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = MaxRetry
+	}
+	if a.Attempt >= maxAttempts {
+		return 0, false
+	}
+	if p.Budget != nil && !p.Budget.take() {
+		return 0, false
+	}
+	base, maxDelay := p.BaseDelay, p.MaxDelay
+	if base <= 0 {
+		base = DefaultRetryUnit
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultRetryCap
+	}
+
+	var delay time.Duration
+	switch p.Jitter {
+	case RetryJitterDecorrelated:
+		prev := max(a.PrevDelay, base)
+		delay = min(maxDelay, base+rand.N(3*prev-base+1))
+	default:
+		// 1<<uint(attempt) below could overflow, so limit the value of attempt
+		delay = min(maxDelay, base*time.Duration(1<<uint(min(a.Attempt-1, 30))))
+		if p.Jitter == RetryJitterFull {
+			delay = rand.N(delay + 1)
+		}
+	}
+
+	maxRetryAfter := p.MaxRetryAfter
+	if maxRetryAfter == 0 {
+		maxRetryAfter = defaultMaxRetryAfter
+	}
+	if maxRetryAfter > 0 && a.Response != nil {
+		if d, ok := parseRetryAfter(a.Response.Header.Get("Retry-After")); ok {
+			delay = max(delay, min(d, maxRetryAfter))
+		}
+	}
+	return delay, true
+}
+
+// parseRetryAfter parses the seconds or HTTP date of a Retry-After header.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(max(secs, 0)) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// RetryBudget is a token bucket limiting the rate of retries, e.g. shared
+// by all requests of a client so that an unavailable server is not hit
+// with the retries of every request.
+type RetryBudget struct {
+	mu       sync.Mutex
+	tokens   float64
+	capacity float64
+	rate     float64
+	last     time.Time
+}
+
+// NewRetryBudget returns a RetryBudget allowing bursts of capacity retries
+// and refilled with refillPerSecond retries every second.
+func NewRetryBudget(capacity int, refillPerSecond float64) *RetryBudget {
+	return &RetryBudget{
+		tokens:   float64(capacity),
+		capacity: float64(capacity),
+		rate:     refillPerSecond,
+		last:     time.Now(),
+	}
+}
+
+// take takes a token for a retry, it returns false if the budget is
+// exhausted.
+func (b *RetryBudget) take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+type retryPolicyContextKey struct{}
+
+// WithRetryPolicy returns a copy of ctx carrying policy, requests made
+// with the returned context are retried with policy instead of the retry
+// policy of the Client.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	if policy == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// getRetryPolicy returns the retry policy of a request made with ctx,
+// nil for the default retries.
+func (c *Client) getRetryPolicy(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy); ok {
+		return policy
+	}
+	return c.retryPolicy
+}
+
+// newRetryPolicyTimer is like newRetryTimer with delays of policy, the
+// failed attempts are described by last after each yield.
+func newRetryPolicyTimer(ctx context.Context, policy RetryPolicy, maxRetry int, last *RetryAttempt) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		// if context is already canceled, skip yield
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		var delay time.Duration
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+			if i+1 >= maxRetry {
+				return
+			}
+			a := *last
+			a.Attempt = i + 1
+			a.PrevDelay = delay
+			var retry bool
+			if delay, retry = policy.Retry(a); !retry {
+				return
+			}
+
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestStandardRetryPolicy(t *testing.T) {
+	retryAfter := &http.Response{Header: http.Header{"Retry-After": {"2"}}}
+	testCases := []struct {
+		policy   StandardRetryPolicy
+		attempt  RetryAttempt
+		retry    bool
+		minDelay time.Duration
+		maxDelay time.Duration
+	}{
+		{StandardRetryPolicy{MaxAttempts: 3}, RetryAttempt{Attempt: 3}, false, 0, 0},
+		{StandardRetryPolicy{MaxAttempts: 3, Jitter: RetryJitterNone, BaseDelay: time.Second, MaxDelay: time.Minute}, RetryAttempt{Attempt: 2}, true, 2 * time.Second, 2 * time.Second},
+		{StandardRetryPolicy{Jitter: RetryJitterNone, BaseDelay: time.Second, MaxDelay: 3 * time.Second}, RetryAttempt{Attempt: 5}, true, 3 * time.Second, 3 * time.Second},
+		{StandardRetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute}, RetryAttempt{Attempt: 3}, true, 0, 4 * time.Second},
+		{StandardRetryPolicy{Jitter: RetryJitterDecorrelated, BaseDelay: time.Second, MaxDelay: time.Minute}, RetryAttempt{Attempt: 2, PrevDelay: 2 * time.Second}, true, time.Second, 6 * time.Second},
+		{StandardRetryPolicy{Jitter: RetryJitterNone, BaseDelay: time.Millisecond}, RetryAttempt{Attempt: 1, Response: retryAfter}, true, 2 * time.Second, 2 * time.Second},
+		{StandardRetryPolicy{Jitter: RetryJitterNone, BaseDelay: time.Millisecond, MaxRetryAfter: time.Second}, RetryAttempt{Attempt: 1, Response: retryAfter}, true, time.Second, time.Second},
+		{StandardRetryPolicy{Jitter: RetryJitterNone, BaseDelay: time.Millisecond, MaxRetryAfter: -1}, RetryAttempt{Attempt: 1, Response: retryAfter}, true, time.Millisecond, time.Millisecond},
+	}
+	for i, testCase := range testCases {
+		delay, retry := testCase.policy.Retry(testCase.attempt)
+		if retry != testCase.retry {
+			t.Fatalf("Test %d: expected retry %v, got %v", i+1, testCase.retry, retry)
+		}
+		if delay < testCase.minDelay || delay > testCase.maxDelay {
+			t.Errorf("Test %d: expected delay in [%s, %s], got %s", i+1, testCase.minDelay, testCase.maxDelay, delay)
+		}
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	policy := StandardRetryPolicy{Budget: NewRetryBudget(2, 0)}
+	for i := range 3 {
+		if _, retry := policy.Retry(RetryAttempt{Attempt: 1}); retry != (i < 2) {
+			t.Errorf("Retry %d: expected retry %v", i+1, i < 2)
+		}
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1)%3 != 0 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:       credentials.NewStaticV4("access", "secret", ""),
+		Region:      "us-east-1",
+		RetryPolicy: StandardRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 3 {
+		t.Errorf("Expected 3 attempts, got %d", n)
+	}
+
+	requests.Store(0)
+	opts := StatObjectOptions{}
+	opts.RetryPolicy = StandardRetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	if _, err = c.StatObject(context.Background(), "bucket", "object", opts); err == nil {
+		t.Fatal("Expected error after 2 attempts")
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("Expected 2 attempts, got %d", n)
+	}
+}
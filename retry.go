@@ -19,6 +19,7 @@ package minio
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"iter"
@@ -136,18 +137,25 @@ func isHTTPStatusRetryable(httpStatusCode int) (ok bool) {
 
 // For now, all http Do() requests are retriable except some well defined errors
 func isRequestErrorRetryable(ctx context.Context, err error) bool {
+	if errors.Is(err, ErrRequestQueueTimeout) {
+		return false
+	}
 	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 		// Retry if internal timeout in the HTTP call.
 		return ctx.Err() == nil
 	}
+	// The certificates of the server are rejected.
+	var verifyErr *tls.CertificateVerificationError
+	if errors.As(err, &verifyErr) || errors.Is(err, ErrCertificateNotPinned) {
+		return false
+	}
+	// x509: certificate signed by unknown authority
+	var authorityErr x509.UnknownAuthorityError
+	if errors.As(err, &authorityErr) {
+		return false
+	}
 	if ue, ok := err.(*url.Error); ok {
-		e := ue.Unwrap()
-		switch e.(type) {
-		// x509: certificate signed by unknown authority
-		case x509.UnknownAuthorityError:
-			return false
-		}
-		switch e.Error() {
+		switch ue.Unwrap().Error() {
 		case "http: server gave HTTP response to HTTPS client":
 			return false
 		}
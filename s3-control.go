@@ -0,0 +1,97 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/minio/minio-go/v7/internal/json"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// controlRequest is a request of a control plane API, i.e. of the Amazon
+// S3 Control API, which is served by its own endpoints instead of the
+// endpoint of the client, or of the MinIO admin API.
+type controlRequest struct {
+	method      string
+	scheme      string // https if empty
+	host        string
+	path        string
+	query       url.Values
+	region      string
+	serviceType string
+	header      http.Header
+	body        []byte
+}
+
+// executeControl sends a control plane request, responses other than 200
+// and 204 are returned as errors.
+func (c *Client) executeControl(ctx context.Context, r controlRequest) (*http.Response, error) {
+	targetURL := url.URL{
+		Scheme:   r.scheme,
+		Host:     r.host,
+		Path:     r.path,
+		RawQuery: s3utils.QueryEncode(r.query),
+	}
+	if targetURL.Scheme == "" {
+		targetURL.Scheme = "https"
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, targetURL.String(), bytes.NewReader(r.body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(r.body))
+	c.setUserAgent(req)
+	for k, v := range r.header {
+		req.Header[k] = v
+	}
+	req.Header.Set("X-Amz-Content-Sha256", sum256Hex(r.body))
+
+	value, err := c.getCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if value.SignerType.IsAnonymous() || c.overrideSignerType.IsAnonymous() {
+		return nil, errors.New("S3 Control requests require credentials")
+	}
+	req = signer.SignV4TrailerServiceWithTime(*req, value.AccessKeyID, value.SecretAccessKey, value.SessionToken,
+		r.region, r.serviceType, nil, c.now())
+
+	resp, err := c.do(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		defer closeResponse(resp)
+		// The MinIO admin API responds with JSON errors.
+		if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+			errResp := ErrorResponse{StatusCode: resp.StatusCode, Server: resp.Header.Get("Server")}
+			if err = json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Code != "" {
+				return nil, errResp
+			}
+		}
+		return nil, httpRespToErrorResponse(resp, "", "")
+	}
+	return resp, nil
+}
@@ -66,6 +66,9 @@ const (
 	BucketAlreadyExists               = "BucketAlreadyExists"
 	NoSuchVersion                     = "NoSuchVersion"
 	NoSuchTagSet                      = "NoSuchTagSet"
+	ObjectLocked                      = "ObjectLocked"
+	SlowDown                          = "SlowDown"
+	ExpiredToken                      = "ExpiredToken"
 	Testing                           = "Testing"
 	Success                           = "Success"
 )
@@ -112,5 +115,6 @@ var s3ErrorResponseMap = map[string]string{
 	XAmzContentSHA256Mismatch:         "The provided 'x-amz-content-sha256' header does not match what was computed.",
 	NoSuchCORSConfiguration:           "The specified bucket does not have a CORS configuration.",
 	Conflict:                          "Bucket not empty.",
+	SlowDown:                          "Please reduce your request rate.",
 	// Add new API errors here.
 }
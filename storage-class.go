@@ -0,0 +1,101 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"net/url"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// StorageClass - the class of storage of an object.
+//
+// MinIO servers also return the names of the configured remote tiers as
+// storage classes of transitioned objects, hence any value is accepted by
+// servers other than AWS S3 and GCS. The StorageClass fields of options
+// and object infos are strings, e.g. StorageClass(info.StorageClass).
+type StorageClass string
+
+// Storage classes of AWS S3 and MinIO.
+const (
+	StorageClassStandard           StorageClass = "STANDARD"
+	StorageClassReducedRedundancy  StorageClass = "REDUCED_REDUNDANCY"
+	StorageClassStandardIA         StorageClass = "STANDARD_IA"
+	StorageClassOneZoneIA          StorageClass = "ONEZONE_IA"
+	StorageClassIntelligentTiering StorageClass = "INTELLIGENT_TIERING"
+	StorageClassGlacier            StorageClass = "GLACIER"
+	StorageClassGlacierIR          StorageClass = "GLACIER_IR"
+	StorageClassDeepArchive        StorageClass = "DEEP_ARCHIVE"
+	StorageClassOutposts           StorageClass = "OUTPOSTS"
+	StorageClassSnow               StorageClass = "SNOW"
+	StorageClassExpressOneZone     StorageClass = "EXPRESS_ONEZONE"
+)
+
+// Storage classes of GCS.
+const (
+	StorageClassNearline StorageClass = "NEARLINE"
+	StorageClassColdline StorageClass = "COLDLINE"
+	StorageClassArchive  StorageClass = "ARCHIVE"
+)
+
+func (s StorageClass) String() string {
+	return string(s)
+}
+
+// IsValid - check whether this is a known storage class of AWS S3, MinIO
+// or GCS.
+func (s StorageClass) IsValid() bool {
+	return s.isAmazon() || s.isGoogle()
+}
+
+// IsArchival - check whether objects of this storage class must be
+// restored before they can be read.
+func (s StorageClass) IsArchival() bool {
+	return s == StorageClassGlacier || s == StorageClassDeepArchive
+}
+
+// IsSupportedBy - check whether the server of endpointURL accepts this
+// storage class for uploads.
+func (s StorageClass) IsSupportedBy(endpointURL url.URL) bool {
+	switch {
+	case s3utils.IsAmazonEndpoint(endpointURL):
+		return s.isAmazon()
+	case s3utils.IsGoogleEndpoint(endpointURL):
+		return s.isGoogle()
+	}
+	return s != ""
+}
+
+func (s StorageClass) isAmazon() bool {
+	switch s {
+	case StorageClassStandard, StorageClassReducedRedundancy, StorageClassStandardIA,
+		StorageClassOneZoneIA, StorageClassIntelligentTiering, StorageClassGlacier,
+		StorageClassGlacierIR, StorageClassDeepArchive, StorageClassOutposts,
+		StorageClassSnow, StorageClassExpressOneZone:
+		return true
+	}
+	return false
+}
+
+func (s StorageClass) isGoogle() bool {
+	switch s {
+	case StorageClassStandard, StorageClassNearline, StorageClassColdline, StorageClassArchive:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestStorageClassIsSupportedBy(t *testing.T) {
+	amazon := url.URL{Scheme: "https", Host: "s3.amazonaws.com"}
+	google := url.URL{Scheme: "https", Host: "storage.googleapis.com"}
+	minio := url.URL{Scheme: "http", Host: "localhost:9000"}
+
+	testCases := []struct {
+		storageClass StorageClass
+		endpointURL  url.URL
+		supported    bool
+	}{
+		{StorageClassStandard, amazon, true},
+		{StorageClassExpressOneZone, amazon, true},
+		{StorageClassNearline, amazon, false},
+		{"standard", amazon, false},
+		{StorageClassColdline, google, true},
+		{StorageClassGlacier, google, false},
+		{StorageClassReducedRedundancy, minio, true},
+		{"WARM-TIER", minio, true},
+		{"", minio, false},
+	}
+	for i, testCase := range testCases {
+		if supported := testCase.storageClass.IsSupportedBy(testCase.endpointURL); supported != testCase.supported {
+			t.Errorf("Test %d: expected %v for %s at %s, got %v", i+1, testCase.supported, testCase.storageClass, testCase.endpointURL.Host, supported)
+		}
+	}
+}
+
+func TestStorageClassIsArchival(t *testing.T) {
+	for _, sc := range []StorageClass{StorageClassGlacier, StorageClassDeepArchive} {
+		if !sc.IsArchival() {
+			t.Errorf("expected %s to be archival", sc)
+		}
+	}
+	for _, sc := range []StorageClass{StorageClassStandard, StorageClassGlacierIR, StorageClassArchive} {
+		if sc.IsArchival() {
+			t.Errorf("expected %s not to be archival", sc)
+		}
+	}
+}
+
+func TestPutObjectInvalidStorageClass(t *testing.T) {
+	clnt, err := New("s3.amazonaws.com", &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = clnt.PutObject(context.Background(), "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{StorageClass: "NEARLINE"})
+	if err == nil || ToErrorResponse(err).Code != InvalidArgument {
+		t.Fatalf("expected an InvalidArgument error, got %v", err)
+	}
+}
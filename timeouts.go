@@ -0,0 +1,199 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Timeouts of the individual requests of an operation, every retry
+// attempt is timed separately. Zero values disable a timeout.
+type Timeouts struct {
+	// TTFB limits the time from sending the request until the
+	// response headers are received.
+	TTFB time.Duration
+
+	// PerRequest limits the time until the response headers of requests
+	// which are not downloads are received, e.g. HEAD, DELETE and
+	// metadata requests. Uploads of a known size are given additional
+	// time to send their body at MinThroughput. Response bodies, e.g. the
+	// streams of SelectObjectContent, are only limited by Idle.
+	PerRequest time.Duration
+
+	// Idle limits the time without receiving data of a response body.
+	Idle time.Duration
+
+	// MinThroughput in bytes per second by which PerRequest is extended
+	// for uploads, defaults to 1 MiB/s.
+	MinThroughput int64
+}
+
+const defaultMinThroughput = 1 << 20
+
+// requestTimeout returns the PerRequest timeout of a request.
+func (t Timeouts) requestTimeout(method string, contentLength int64) time.Duration {
+	if t.PerRequest <= 0 || method == http.MethodGet {
+		return 0
+	}
+	if contentLength < 0 {
+		// Uploads of unknown size cannot be bounded.
+		return 0
+	}
+	if contentLength == 0 {
+		return t.PerRequest
+	}
+	rate := t.MinThroughput
+	if rate <= 0 {
+		rate = defaultMinThroughput
+	}
+	return t.PerRequest + time.Duration(contentLength/rate+1)*time.Second
+}
+
+// attemptTimeouts enforces the timeouts of a request attempt.
+type attemptTimeouts struct {
+	cancel context.CancelFunc
+	idle   time.Duration
+
+	mu      sync.Mutex
+	ttfb    *time.Timer
+	ttfbDur time.Duration
+	done    bool
+	ttfbHit atomic.Bool
+
+	request    *time.Timer
+	requestDur time.Duration
+	requestHit atomic.Bool
+}
+
+// withTimeouts returns req with the client timeouts applied, the
+// returned attemptTimeouts must be finished with the response.
+func (c *Client) withTimeouts(req *http.Request, contentLength int64) (*http.Request, *attemptTimeouts) {
+	tm := c.timeouts
+	if tm == (Timeouts{}) {
+		return req, nil
+	}
+	ctx := req.Context()
+	t := &attemptTimeouts{idle: tm.Idle}
+	ctx, t.cancel = context.WithCancel(ctx)
+	if d := tm.requestTimeout(req.Method, contentLength); d > 0 {
+		t.requestDur = d
+		t.request = time.AfterFunc(d, func() {
+			t.requestHit.Store(true)
+			t.cancel()
+		})
+	}
+	if tm.TTFB > 0 {
+		t.ttfbDur = tm.TTFB
+		t.ttfb = time.AfterFunc(tm.TTFB, func() {
+			t.ttfbHit.Store(true)
+			t.cancel()
+		})
+		t.ttfb.Stop()
+		ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			WroteRequest: func(httptrace.WroteRequestInfo) {
+				t.mu.Lock()
+				if !t.done {
+					t.ttfb.Reset(tm.TTFB)
+				}
+				t.mu.Unlock()
+			},
+		})
+	}
+	return req.WithContext(ctx), t
+}
+
+// finish stops the TTFB and PerRequest timeouts and applies the idle
+// timeout to the response body, the request context is canceled when it
+// is closed.
+func (t *attemptTimeouts) finish(req *http.Request, res *http.Response, err error) (*http.Response, error) {
+	if t == nil {
+		return res, err
+	}
+	if t.ttfb != nil {
+		t.mu.Lock()
+		t.done = true
+		t.ttfb.Stop()
+		t.mu.Unlock()
+	}
+	if t.request != nil {
+		t.request.Stop()
+	}
+	if err != nil {
+		t.cancel()
+		timeout := t.ttfbDur
+		if t.requestHit.Load() {
+			timeout = t.requestDur
+		}
+		if t.ttfbHit.Load() || t.requestHit.Load() {
+			err = &url.Error{
+				Op:  req.Method,
+				URL: req.URL.Redacted(),
+				Err: fmt.Errorf("no response within %s: %w", timeout, context.DeadlineExceeded),
+			}
+		}
+		return nil, err
+	}
+	body := &timeoutBody{ReadCloser: res.Body, cancel: t.cancel, idle: t.idle}
+	if t.idle > 0 {
+		body.timer = time.AfterFunc(t.idle, func() {
+			body.idleHit.Store(true)
+			t.cancel()
+		})
+	}
+	res.Body = body
+	return res, nil
+}
+
+// timeoutBody is a response body with an idle timeout.
+type timeoutBody struct {
+	io.ReadCloser
+	cancel  context.CancelFunc
+	idle    time.Duration
+	timer   *time.Timer
+	idleHit atomic.Bool
+}
+
+func (b *timeoutBody) Read(p []byte) (n int, err error) {
+	n, err = b.ReadCloser.Read(p)
+	if b.timer != nil {
+		if b.idleHit.Load() {
+			if err != nil && err != io.EOF {
+				err = fmt.Errorf("no data received within %s: %w", b.idle, context.DeadlineExceeded)
+			}
+			return n, err
+		}
+		b.timer.Reset(b.idle)
+	}
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+	b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,128 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	tm := Timeouts{PerRequest: time.Minute, MinThroughput: 1 << 20}
+	testCases := []struct {
+		method        string
+		contentLength int64
+		expected      time.Duration
+	}{
+		{http.MethodHead, 0, time.Minute},
+		{http.MethodGet, 0, 0},
+		{http.MethodPut, -1, 0},
+		{http.MethodPut, 10 << 20, time.Minute + 11*time.Second},
+		{http.MethodDelete, 0, time.Minute},
+	}
+	for i, testCase := range testCases {
+		if got := tm.requestTimeout(testCase.method, testCase.contentLength); got != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestTimeouts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		switch {
+		case r.Method == http.MethodHead:
+			time.Sleep(200 * time.Millisecond)
+		case r.Method == http.MethodPost:
+			// Streaming responses outlive PerRequest.
+			w.WriteHeader(http.StatusOK)
+			for range 10 {
+				w.Write([]byte("x"))
+				w.(http.Flusher).Flush()
+				time.Sleep(20 * time.Millisecond)
+			}
+		case strings.HasSuffix(r.URL.Path, "/stalled"):
+			w.Header().Set("Content-Length", "10")
+			w.Write([]byte("hello"))
+			w.(http.Flusher).Flush()
+			time.Sleep(200 * time.Millisecond)
+			w.Write([]byte("world"))
+		default:
+			// Slow but steady downloads are not limited.
+			w.Header().Set("Content-Length", "10")
+			for range 10 {
+				w.Write([]byte("x"))
+				w.(http.Flusher).Flush()
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+		Creds:      credentials.NewStaticV4("access", "secret", ""),
+		Region:     "us-east-1",
+		MaxRetries: 1,
+		Timeouts:   Timeouts{TTFB: 50 * time.Millisecond, PerRequest: 50 * time.Millisecond, Idle: 50 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err = c.StatObject(ctx, "bucket", "object", StatObjectOptions{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected StatObject to time out, got %v", err)
+	}
+
+	obj, err := c.GetObject(ctx, "bucket", "steady", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(obj)
+	if err != nil || len(data) != 10 {
+		t.Errorf("Expected 10 bytes, got %d, %v", len(data), err)
+	}
+	obj.Close()
+
+	resp, err := c.executeMethod(ctx, http.MethodPost, requestMetadata{bucketName: "bucket", objectName: "select", contentSHA256Hex: emptySHA256Hex})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = io.ReadAll(resp.Body)
+	closeResponse(resp)
+	if err != nil || len(data) != 10 {
+		t.Errorf("Expected 10 bytes of the stream, got %d, %v", len(data), err)
+	}
+
+	resp, err = c.executeMethod(ctx, http.MethodGet, requestMetadata{bucketName: "bucket", objectName: "stalled", contentSHA256Hex: emptySHA256Hex})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeResponse(resp)
+	if _, err = io.ReadAll(resp.Body); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected idle timeout, got %v", err)
+	}
+}
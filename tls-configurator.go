@@ -0,0 +1,152 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCertificateNotPinned is returned when no certificate of a server
+// matches the pins of PinSPKI.
+var ErrCertificateNotPinned = errors.New("tls: no certificate of the server matches the pinned public keys")
+
+// TLSConfigurator configures the TLS settings of the transport created
+// by the client, see Options.TLSConfigurator. The checks of the helpers
+// run at every handshake, e.g. PinSPKI and VerifyPeer.
+type TLSConfigurator func(config *tls.Config) error
+
+// TLSConfigurators combines configurators, which are applied in order.
+func TLSConfigurators(configurators ...TLSConfigurator) TLSConfigurator {
+	return func(config *tls.Config) error {
+		for _, configure := range configurators {
+			if err := configure(config); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// VerifyPeer calls verify at every handshake after the certificates of
+// the server are verified, the handshake fails if it returns an error.
+func VerifyPeer(verify func(cs tls.ConnectionState) error) TLSConfigurator {
+	return func(config *tls.Config) error {
+		next := config.VerifyConnection
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			if next != nil {
+				if err := next(cs); err != nil {
+					return err
+				}
+			}
+			return verify(cs)
+		}
+		return nil
+	}
+}
+
+// PinSPKI pins the public keys of servers, a server must present a
+// certificate whose public key matches one of the hashes. Hashes are the
+// base64 encoded SHA-256 of the SubjectPublicKeyInfo of certificates,
+// optionally prefixed by "sha256/".
+func PinSPKI(hashes ...string) TLSConfigurator {
+	return func(config *tls.Config) error {
+		if len(hashes) == 0 {
+			return errInvalidArgument("No public key hashes to pin.")
+		}
+		pins := make(map[[sha256.Size]byte]struct{}, len(hashes))
+		for _, hash := range hashes {
+			b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(hash, "sha256/"))
+			if err != nil || len(b) != sha256.Size {
+				return errInvalidArgument("Invalid public key hash " + hash + ".")
+			}
+			pins[[sha256.Size]byte(b)] = struct{}{}
+		}
+		return VerifyPeer(func(cs tls.ConnectionState) error {
+			for _, cert := range cs.PeerCertificates {
+				if _, ok := pins[sha256.Sum256(cert.RawSubjectPublicKeyInfo)]; ok {
+					return nil
+				}
+			}
+			return ErrCertificateNotPinned
+		})(config)
+	}
+}
+
+// RootCAs verifies servers with the certificate authorities of pool
+// instead of the system roots, e.g. with the authorities of an internal
+// PKI. Every client has its own transport, so clients of different
+// endpoints can trust different authorities.
+func RootCAs(pool *x509.CertPool) TLSConfigurator {
+	return func(config *tls.Config) error {
+		if pool == nil {
+			return errInvalidArgument("No certificate authorities.")
+		}
+		config.RootCAs = pool
+		return nil
+	}
+}
+
+// ClientCertificate authenticates the client with the certificate and
+// the key of the PEM files for mutual TLS. The files are loaded at the
+// first handshake and reloaded when they change, e.g. when the
+// certificate is renewed.
+func ClientCertificate(certFile, keyFile string) TLSConfigurator {
+	return func(config *tls.Config) error {
+		if certFile == "" || keyFile == "" {
+			return errInvalidArgument("Client certificate and key files must be set.")
+		}
+		var (
+			mu      sync.Mutex
+			cert    *tls.Certificate
+			modTime time.Time
+		)
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			certInfo, err := os.Stat(certFile)
+			if err != nil {
+				return nil, err
+			}
+			keyInfo, err := os.Stat(keyFile)
+			if err != nil {
+				return nil, err
+			}
+			latest := certInfo.ModTime()
+			if keyInfo.ModTime().After(latest) {
+				latest = keyInfo.ModTime()
+			}
+			if cert == nil || !latest.Equal(modTime) {
+				c, err := tls.LoadX509KeyPair(certFile, keyFile)
+				if err != nil {
+					return nil, err
+				}
+				cert, modTime = &c, latest
+			}
+			return cert, nil
+		}
+		return nil
+	}
+}
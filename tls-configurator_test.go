@@ -0,0 +1,126 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestTLSConfigurator(t *testing.T) {
+	var clientCerts atomic.Int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) > 0 {
+			clientCerts.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	pin := base64.StdEncoding.EncodeToString(func() []byte {
+		sum := sha256.Sum256(srv.Certificate().RawSubjectPublicKeyInfo)
+		return sum[:]
+	}())
+
+	// The certificate of the server authenticates the client too.
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "public.crt"), filepath.Join(dir, "private.key")
+	key, err := x509.MarshalPKCS8PrivateKey(srv.TLS.Certificates[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var verified atomic.Int32
+	testCases := []struct {
+		configurator TLSConfigurator
+		clientCert   bool
+		err          error
+	}{
+		{err: &tls.CertificateVerificationError{}},
+		{configurator: RootCAs(pool)},
+		{configurator: TLSConfigurators(RootCAs(pool), PinSPKI("sha256/"+pin))},
+		{configurator: TLSConfigurators(RootCAs(pool), PinSPKI(base64.StdEncoding.EncodeToString(make([]byte, 32)))), err: ErrCertificateNotPinned},
+		{configurator: TLSConfigurators(RootCAs(pool), ClientCertificate(certFile, keyFile)), clientCert: true},
+		{configurator: TLSConfigurators(RootCAs(pool), VerifyPeer(func(tls.ConnectionState) error {
+			verified.Add(1)
+			return nil
+		}))},
+	}
+	for i, testCase := range testCases {
+		clientCerts.Store(0)
+		c, err := New(strings.TrimPrefix(srv.URL, "https://"), &Options{
+			Creds:           credentials.NewStaticV4("access", "secret", ""),
+			Region:          "us-east-1",
+			Secure:          true,
+			TLSConfigurator: testCase.configurator,
+		})
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		_, err = c.BucketExists(context.Background(), "bucket")
+		switch testCase.err.(type) {
+		case nil:
+			if err != nil {
+				t.Errorf("Test %d: unexpected error %v", i+1, err)
+			}
+		case *tls.CertificateVerificationError:
+			var verifyErr *tls.CertificateVerificationError
+			if !errors.As(err, &verifyErr) {
+				t.Errorf("Test %d: expected a verification error, got %v", i+1, err)
+			}
+		default:
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("Test %d: expected %v, got %v", i+1, testCase.err, err)
+			}
+		}
+		if got := clientCerts.Load() > 0; got != testCase.clientCert {
+			t.Errorf("Test %d: expected client certificate %t, got %t", i+1, testCase.clientCert, got)
+		}
+	}
+	if verified.Load() == 0 {
+		t.Error("Expected VerifyPeer to be called")
+	}
+
+	if _, err = New("localhost:9000", &Options{Secure: true, TLSConfigurator: PinSPKI("invalid")}); err == nil {
+		t.Error("Expected an invalid pin to fail")
+	}
+}
@@ -25,8 +25,11 @@ import (
 	"crypto/x509"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
+
+	"golang.org/x/net/http/httpproxy"
 )
 
 // mustGetSystemCertPool - return system CAs or empty pool in case of error (or windows)
@@ -81,3 +84,84 @@ var DefaultTransport = func(secure bool) (*http.Transport, error) {
 	}
 	return tr, nil
 }
+
+// defaultTLSSessionCacheSize is the TLS session cache size of transports
+// created by the client.
+const defaultTLSSessionCacheSize = 64
+
+// defaultDNSCacheTTL is the DNS cache TTL when only a resolver is set.
+const defaultDNSCacheTTL = time.Minute
+
+// newTransport returns DefaultTransport tuned with the transport options.
+func newTransport(opts *Options) (*http.Transport, error) {
+	tr, err := DefaultTransport(opts.Secure)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ProxyURL != nil {
+		if tr.Proxy, err = proxyFunc(opts.ProxyURL, opts.NoProxy); err != nil {
+			return nil, err
+		}
+	}
+	if opts.MaxIdleConnsPerHost > 0 {
+		tr.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+		tr.MaxIdleConns = max(tr.MaxIdleConns, opts.MaxIdleConnsPerHost)
+	}
+	if opts.MaxConnsPerHost > 0 {
+		tr.MaxConnsPerHost = opts.MaxConnsPerHost
+	}
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if opts.DialTimeout > 0 {
+		dialer.Timeout = opts.DialTimeout
+		tr.DialContext = dialer.DialContext
+	}
+	if opts.DNSCacheTTL > 0 || opts.DNSResolver != nil {
+		ttl := opts.DNSCacheTTL
+		if ttl <= 0 {
+			ttl = defaultDNSCacheTTL
+		}
+		tr.DialContext = newDNSCache(dialer, opts.DNSResolver, ttl).DialContext
+	}
+	if opts.TLSHandshakeTimeout > 0 {
+		tr.TLSHandshakeTimeout = opts.TLSHandshakeTimeout
+	}
+	tr.ForceAttemptHTTP2 = opts.ForceAttemptHTTP2
+	if tr.TLSClientConfig != nil && opts.TLSSessionCacheSize >= 0 {
+		size := opts.TLSSessionCacheSize
+		if size == 0 {
+			size = defaultTLSSessionCacheSize
+		}
+		tr.TLSClientConfig.ClientSessionCache = tls.NewLRUClientSessionCache(size)
+	}
+	if tr.TLSClientConfig != nil && opts.TLSConfigurator != nil {
+		if err = opts.TLSConfigurator(tr.TLSClientConfig); err != nil {
+			return nil, err
+		}
+	}
+	return tr, nil
+}
+
+// proxyFunc returns the proxy function of transports using proxyURL for
+// the hosts not matched by noProxy.
+func proxyFunc(proxyURL *url.URL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, errInvalidArgument("Unsupported proxy scheme " + proxyURL.Scheme + ".")
+	}
+	if proxyURL.Host == "" {
+		return nil, errInvalidArgument("Proxy URL has no host.")
+	}
+	cfg := httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    noProxy,
+	}
+	proxy := cfg.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
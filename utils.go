@@ -41,6 +41,7 @@ import (
 	"time"
 
 	md5simd "github.com/minio/md5-simd"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/tags"
 )
@@ -105,6 +106,15 @@ func sumMD5Base64(data []byte) string {
 	return base64.StdEncoding.EncodeToString(hash.Sum(nil))
 }
 
+// contentMD5Base64 returns the base64 encoded md5sum of the body of a
+// request, empty if MD5 is disabled, see Options.DisableMD5.
+func (c *Client) contentMD5Base64(data []byte) string {
+	if c.disableMD5 {
+		return ""
+	}
+	return sumMD5Base64(data)
+}
+
 // getEndpointURL - construct a new endpoint.
 func getEndpointURL(endpoint string, secure bool) (*url.URL, error) {
 	// If secure is false, use 'http' scheme.
@@ -374,6 +384,19 @@ func ToObjectInfo(bucketName, objectName string, h http.Header) (ObjectInfo, err
 
 	deleteMarker := h.Get(amzDeleteMarker) == "true"
 
+	// Malformed optional headers are ignored.
+	var retainUntil time.Time
+	if retainUntilStr := h.Get(amzLockRetainUntil); retainUntilStr != "" {
+		if t, err := time.Parse(time.RFC3339, retainUntilStr); err == nil {
+			retainUntil = t
+		}
+	}
+
+	partsCount := etagPartsCount(etag)
+	if count, err := strconv.Atoi(h.Get(amzMpPartsCount)); err == nil {
+		partsCount = count
+	}
+
 	// Save object metadata info.
 	return ObjectInfo{
 		ETag:              etag,
@@ -396,6 +419,22 @@ func ToObjectInfo(bucketName, objectName string, h http.Header) (ObjectInfo, err
 		UserTagCount: tagCount,
 		Restore:      restore,
 
+		StorageClass: h.Get(amzStorageClass),
+
+		ServerSideEncryption: h.Get(encrypt.SseGenericHeader),
+		SSEKMSKeyID:          h.Get(encrypt.SseKmsKeyID),
+		SSEBucketKeyEnabled:  h.Get(amzBucketKeyEnabled) == "true",
+		SSECustomerAlgorithm: h.Get(encrypt.SseCustomerAlgorithm),
+
+		ObjectLockMode:            RetentionMode(h.Get(amzLockMode)),
+		ObjectLockRetainUntilDate: retainUntil,
+		ObjectLockLegalHold:       LegalHoldStatus(h.Get(amzLegalHoldHeader)),
+
+		PartsCount: partsCount,
+		RawHeaders: h.Clone(),
+
+		RGWAttributes: rgwAttributes(h),
+
 		// Checksum values
 		ChecksumCRC32:     h.Get(ChecksumCRC32.Key()),
 		ChecksumCRC32C:    h.Get(ChecksumCRC32C.Key()),
@@ -406,6 +445,20 @@ func ToObjectInfo(bucketName, objectName string, h http.Header) (ObjectInfo, err
 	}, nil
 }
 
+// etagPartsCount returns the number of parts of a multipart ETag, i.e.
+// "<md5>-<parts>", zero for other ETags.
+func etagPartsCount(etag string) int {
+	_, count, ok := strings.Cut(etag, "-")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil || n < 1 {
+		return 0
+	}
+	return n
+}
+
 var readFull = func(r io.Reader, buf []byte) (n int, err error) {
 	// ReadFull reads exactly len(buf) bytes from r into buf.
 	// It returns the number of bytes copied and an error if
@@ -442,7 +495,7 @@ var readFull = func(r io.Reader, buf []byte) (n int, err error) {
 }
 
 // regCred matches credential string in HTTP header
-var regCred = regexp.MustCompile("Credential=([A-Z0-9]+)/")
+var regCred = regexp.MustCompile("Credential=([^/]+)/")
 
 // regCred matches signature string in HTTP header
 var regSign = regexp.MustCompile("Signature=([[0-9a-f]+)")
@@ -642,12 +695,15 @@ func IsNetworkOrHostDown(err error, expectTimeouts bool) bool {
 
 	// We need to figure if the error either a timeout
 	// or a non-temporary error.
-	urlErr := &url.Error{}
-	if errors.As(err, &urlErr) {
-		switch urlErr.Err.(type) {
-		case *net.DNSError, *net.OpError, net.UnknownNetworkError, *tls.CertificateVerificationError:
-			return true
-		}
+	// The error of *url.Error values may be wrapped in a RequestError.
+	var (
+		dnsErr     *net.DNSError
+		opErr      *net.OpError
+		networkErr net.UnknownNetworkError
+		verifyErr  *tls.CertificateVerificationError
+	)
+	if errors.As(err, &dnsErr) || errors.As(err, &opErr) || errors.As(err, &networkErr) || errors.As(err, &verifyErr) {
+		return true
 	}
 	var e net.Error
 	if errors.As(err, &e) {
@@ -719,146 +775,3 @@ func (h *hashReaderWrapper) Read(p []byte) (n int, err error) {
 	}
 	return n, err
 }
-
-// Following is ported from C to Go in 2016 by Justin Ruggles, with minimal alteration.
-// Used uint for unsigned long. Used uint32 for input arguments in order to match
-// the Go hash/crc32 package. zlib CRC32 combine (https://github.com/madler/zlib)
-// Modified for hash/crc64 by Klaus Post, 2024.
-func gf2MatrixTimes(mat []uint64, vec uint64) uint64 {
-	var sum uint64
-
-	for vec != 0 {
-		if vec&1 != 0 {
-			sum ^= mat[0]
-		}
-		vec >>= 1
-		mat = mat[1:]
-	}
-	return sum
-}
-
-func gf2MatrixSquare(square, mat []uint64) {
-	if len(square) != len(mat) {
-		panic("square matrix size mismatch")
-	}
-	for n := range mat {
-		square[n] = gf2MatrixTimes(mat, mat[n])
-	}
-}
-
-// crc32Combine returns the combined CRC-32 hash value of the two passed CRC-32
-// hash values crc1 and crc2. poly represents the generator polynomial
-// and len2 specifies the byte length that the crc2 hash covers.
-func crc32Combine(poly uint32, crc1, crc2 uint32, len2 int64) uint32 {
-	// degenerate case (also disallow negative lengths)
-	if len2 <= 0 {
-		return crc1
-	}
-
-	even := make([]uint64, 32) // even-power-of-two zeros operator
-	odd := make([]uint64, 32)  // odd-power-of-two zeros operator
-
-	// put operator for one zero bit in odd
-	odd[0] = uint64(poly) // CRC-32 polynomial
-	row := uint64(1)
-	for n := 1; n < 32; n++ {
-		odd[n] = row
-		row <<= 1
-	}
-
-	// put operator for two zero bits in even
-	gf2MatrixSquare(even, odd)
-
-	// put operator for four zero bits in odd
-	gf2MatrixSquare(odd, even)
-
-	// apply len2 zeros to crc1 (first square will put the operator for one
-	// zero byte, eight zero bits, in even)
-	crc1n := uint64(crc1)
-	for {
-		// apply zeros operator for this bit of len2
-		gf2MatrixSquare(even, odd)
-		if len2&1 != 0 {
-			crc1n = gf2MatrixTimes(even, crc1n)
-		}
-		len2 >>= 1
-
-		// if no more bits set, then done
-		if len2 == 0 {
-			break
-		}
-
-		// another iteration of the loop with odd and even swapped
-		gf2MatrixSquare(odd, even)
-		if len2&1 != 0 {
-			crc1n = gf2MatrixTimes(odd, crc1n)
-		}
-		len2 >>= 1
-
-		// if no more bits set, then done
-		if len2 == 0 {
-			break
-		}
-	}
-
-	// return combined crc
-	crc1n ^= uint64(crc2)
-	return uint32(crc1n)
-}
-
-func crc64Combine(poly uint64, crc1, crc2 uint64, len2 int64) uint64 {
-	// degenerate case (also disallow negative lengths)
-	if len2 <= 0 {
-		return crc1
-	}
-
-	even := make([]uint64, 64) // even-power-of-two zeros operator
-	odd := make([]uint64, 64)  // odd-power-of-two zeros operator
-
-	// put operator for one zero bit in odd
-	odd[0] = poly // CRC-64 polynomial
-	row := uint64(1)
-	for n := 1; n < 64; n++ {
-		odd[n] = row
-		row <<= 1
-	}
-
-	// put operator for two zero bits in even
-	gf2MatrixSquare(even, odd)
-
-	// put operator for four zero bits in odd
-	gf2MatrixSquare(odd, even)
-
-	// apply len2 zeros to crc1 (first square will put the operator for one
-	// zero byte, eight zero bits, in even)
-	crc1n := crc1
-	for {
-		// apply zeros operator for this bit of len2
-		gf2MatrixSquare(even, odd)
-		if len2&1 != 0 {
-			crc1n = gf2MatrixTimes(even, crc1n)
-		}
-		len2 >>= 1
-
-		// if no more bits set, then done
-		if len2 == 0 {
-			break
-		}
-
-		// another iteration of the loop with odd and even swapped
-		gf2MatrixSquare(odd, even)
-		if len2&1 != 0 {
-			crc1n = gf2MatrixTimes(odd, crc1n)
-		}
-		len2 >>= 1
-
-		// if no more bits set, then done
-		if len2 == 0 {
-			break
-		}
-	}
-
-	// return combined crc
-	crc1n ^= crc2
-	return crc1n
-}
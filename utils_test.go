@@ -542,3 +542,113 @@ func TestExtractObjMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRestoreInfo(t *testing.T) {
+	testCases := []struct {
+		header          string
+		expectedOngoing bool
+		expectedExpiry  time.Time
+		expectedSuccess bool
+	}{
+		{`ongoing-request="true"`, true, time.Time{}, true},
+		{`ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`, false, time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC), true},
+		{`ongoing-request="maybe"`, false, time.Time{}, false},
+		{`expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`, false, time.Time{}, false},
+	}
+	for i, testCase := range testCases {
+		info, err := ParseRestoreInfo(testCase.header)
+		if err != nil && testCase.expectedSuccess {
+			t.Errorf("Test %d: Expected success but got error: %v", i+1, err)
+			continue
+		}
+		if err == nil && !testCase.expectedSuccess {
+			t.Errorf("Test %d: Expected failure but got success", i+1)
+			continue
+		}
+		if info.OngoingRestore != testCase.expectedOngoing {
+			t.Errorf("Test %d: Expected ongoing %v, got %v", i+1, testCase.expectedOngoing, info.OngoingRestore)
+		}
+		if !info.ExpiryTime.Equal(testCase.expectedExpiry) {
+			t.Errorf("Test %d: Expected expiry %v, got %v", i+1, testCase.expectedExpiry, info.ExpiryTime)
+		}
+	}
+}
+
+func TestToObjectInfoHeaders(t *testing.T) {
+	retainUntil := time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		header   http.Header
+		expected ObjectInfo
+	}{
+		{
+			header: http.Header{
+				"Etag":                         {`"5d41402abc4b2a76b9719d911017c592-3"`},
+				"X-Amz-Storage-Class":          {"GLACIER"},
+				"X-Amz-Server-Side-Encryption": {"aws:kms"},
+				"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id":     {"key"},
+				"X-Amz-Server-Side-Encryption-Bucket-Key-Enabled": {"true"},
+				"X-Amz-Object-Lock-Mode":                          {"COMPLIANCE"},
+				"X-Amz-Object-Lock-Retain-Until-Date":             {retainUntil.Format(time.RFC3339)},
+				"X-Amz-Object-Lock-Legal-Hold":                    {"ON"},
+			},
+			expected: ObjectInfo{
+				StorageClass:              string(StorageClassGlacier),
+				ServerSideEncryption:      "aws:kms",
+				SSEKMSKeyID:               "key",
+				SSEBucketKeyEnabled:       true,
+				ObjectLockMode:            Compliance,
+				ObjectLockRetainUntilDate: retainUntil,
+				ObjectLockLegalHold:       LegalHoldEnabled,
+				PartsCount:                3,
+			},
+		},
+		{
+			header: http.Header{
+				"Etag":                 {`"5d41402abc4b2a76b9719d911017c592-3"`},
+				"X-Amz-Mp-Parts-Count": {"5"},
+				"X-Amz-Server-Side-Encryption-Customer-Algorithm": {"AES256"},
+			},
+			expected: ObjectInfo{SSECustomerAlgorithm: "AES256", PartsCount: 5},
+		},
+		{
+			header:   http.Header{"Etag": {`"5d41402abc4b2a76b9719d911017c592"`}},
+			expected: ObjectInfo{},
+		},
+		// Malformed optional headers are ignored.
+		{
+			header:   http.Header{"X-Amz-Object-Lock-Retain-Until-Date": {"tomorrow"}},
+			expected: ObjectInfo{},
+		},
+		{
+			header: http.Header{
+				"Etag":                 {`"5d41402abc4b2a76b9719d911017c592-3"`},
+				"X-Amz-Mp-Parts-Count": {"many"},
+			},
+			expected: ObjectInfo{PartsCount: 3},
+		},
+	}
+	for i, testCase := range testCases {
+		testCase.header.Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		info, err := ToObjectInfo("bucket", "object", testCase.header)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		got := ObjectInfo{
+			StorageClass:              info.StorageClass,
+			ServerSideEncryption:      info.ServerSideEncryption,
+			SSEKMSKeyID:               info.SSEKMSKeyID,
+			SSEBucketKeyEnabled:       info.SSEBucketKeyEnabled,
+			SSECustomerAlgorithm:      info.SSECustomerAlgorithm,
+			ObjectLockMode:            info.ObjectLockMode,
+			ObjectLockRetainUntilDate: info.ObjectLockRetainUntilDate,
+			ObjectLockLegalHold:       info.ObjectLockLegalHold,
+			PartsCount:                info.PartsCount,
+		}
+		if !reflect.DeepEqual(got, testCase.expected) {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.expected, got)
+		}
+		if !reflect.DeepEqual(info.RawHeaders, testCase.header) {
+			t.Errorf("Test %d: expected the raw headers %v, got %v", i+1, testCase.header, info.RawHeaders)
+		}
+	}
+}
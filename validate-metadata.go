@@ -0,0 +1,134 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/limits"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"golang.org/x/net/http/httpguts"
+)
+
+// ErrInvalidMetadata is returned when the user metadata or the tags of an
+// object would be rejected by the endpoint, or would fail the signature
+// of the request.
+type ErrInvalidMetadata struct {
+	// Key is the offending metadata name or tag key.
+	Key string
+	// Tag is set when Key is the key of a tag.
+	Tag bool
+	// Reason describes why the key or its value is invalid.
+	Reason string
+}
+
+func (e ErrInvalidMetadata) Error() string {
+	if e.Tag {
+		return fmt.Sprintf("invalid tag %q: %s", e.Key, e.Reason)
+	}
+	return fmt.Sprintf("invalid metadata %q: %s", e.Key, e.Reason)
+}
+
+// validateMetadata checks the user metadata and the tags of an object
+// against the limits and the rules of the endpoint.
+func (c *Client) validateMetadata(metadata, userTags map[string]string) error {
+	l, asciiOnly := limits.S3(), false
+	if c != nil {
+		l = c.Limits()
+		asciiOnly = c.quirks().asciiMetadata || s3utils.IsAmazonEndpoint(*c.endpointURL)
+	}
+
+	var size int
+	for _, k := range slices.Sorted(maps.Keys(metadata)) {
+		v := metadata[k]
+		if !httpguts.ValidHeaderFieldName(k) {
+			return ErrInvalidMetadata{Key: k, Reason: "name is not a valid header name"}
+		}
+		if reason := invalidMetadataValue(v, asciiOnly); reason != "" {
+			return ErrInvalidMetadata{Key: k, Reason: reason}
+		}
+		name, user := userMetadataName(k)
+		if !user {
+			continue
+		}
+		if name == "" {
+			return ErrInvalidMetadata{Key: k, Reason: "name is empty"}
+		}
+		size += len(name) + len(v)
+		if l.CheckUserMetadataSize(size) != nil {
+			return ErrInvalidMetadata{Key: k, Reason: fmt.Sprintf("user metadata exceeds %d bytes", l.MaxUserMetadataSize)}
+		}
+	}
+
+	for i, k := range slices.Sorted(maps.Keys(userTags)) {
+		if l.CheckObjectTags(i+1) != nil {
+			return ErrInvalidMetadata{Key: k, Tag: true, Reason: fmt.Sprintf("objects cannot have more than %d tags", l.MaxObjectTags)}
+		}
+		if err := (tags.Tag{Key: k, Value: userTags[k]}).Validate(); err != nil {
+			return ErrInvalidMetadata{Key: k, Tag: true, Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+// userMetadataName returns the name of user metadata without its
+// x-amz-meta- prefix, and whether k is user metadata.
+func userMetadataName(k string) (string, bool) {
+	if len(k) >= len("x-amz-meta-") && strings.EqualFold(k[:len("x-amz-meta-")], "x-amz-meta-") {
+		return k[len("x-amz-meta-"):], true
+	}
+	if isAmzHeader(k) || isStandardHeader(k) || isStorageClassHeader(k) || isMinioHeader(k) {
+		return "", false
+	}
+	return k, true
+}
+
+// invalidMetadataValue returns why a metadata value is invalid, or an
+// empty string.
+func invalidMetadataValue(v string, asciiOnly bool) string {
+	if strings.TrimSpace(v) != v {
+		// Trimmed by the server, which breaks the signature.
+		return "value has leading or trailing whitespace"
+	}
+	for i := 0; i < len(v); i++ {
+		switch b := v[i]; {
+		case b == 0x7f || (b < ' ' && b != '\t'):
+			return "value contains control characters"
+		case b >= 0x80 && asciiOnly:
+			return "value contains non US-ASCII characters"
+		}
+	}
+	return ""
+}
+
+// validateCopyDestMetadata checks the metadata and the tags replaced by a
+// copy.
+func (c *Client) validateCopyDestMetadata(dst CopyDestOptions) error {
+	var metadata, userTags map[string]string
+	if dst.ReplaceMetadata {
+		metadata = dst.UserMetadata
+	}
+	if dst.ReplaceTags {
+		userTags = dst.UserTags
+	}
+	return c.validateMetadata(metadata, userTags)
+}
@@ -0,0 +1,99 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2025 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestValidateMetadata(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requests++
+	}))
+	defer srv.Close()
+
+	newClient := func(profile Profile) *Client {
+		clnt, err := New(strings.TrimPrefix(srv.URL, "http://"), &Options{
+			Creds:   credentials.NewStaticV4("access", "secret", ""),
+			Region:  "us-east-1",
+			Profile: profile,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return clnt
+	}
+	s3, b2 := newClient(ProfileS3), newClient(ProfileB2)
+
+	manyTags := make(map[string]string)
+	for i := range 11 {
+		manyTags["key"+strconv.Itoa(i)] = "value"
+	}
+	testCases := []struct {
+		name     string
+		clnt     *Client
+		metadata map[string]string
+		tags     map[string]string
+		key      string
+		tag      bool
+	}{
+		{"valid", s3, map[string]string{"name": "value", "X-Amz-Meta-Other": "é", "Content-Type": "text/plain"}, map[string]string{"key": "a value"}, "", false},
+		{"invalid name", s3, map[string]string{"bad name": "value"}, nil, "bad name", false},
+		{"empty name", s3, map[string]string{"x-amz-meta-": "value"}, nil, "x-amz-meta-", false},
+		{"control character", s3, map[string]string{"name": "line\nbreak"}, nil, "name", false},
+		{"trailing space", s3, map[string]string{"name": "value "}, nil, "name", false},
+		{"non ascii", b2, map[string]string{"name": "é"}, nil, "name", false},
+		{"too large", s3, map[string]string{"a": strings.Repeat("v", 1024), "b": strings.Repeat("v", 1024)}, nil, "b", false},
+		{"standard headers are not counted", s3, map[string]string{"Cache-Control": strings.Repeat("v", 4096)}, nil, "", false},
+		{"invalid tag", s3, nil, map[string]string{"key": "value!"}, "key", true},
+		{"too many tags", s3, nil, manyTags, "key9", true},
+	}
+	for _, testCase := range testCases {
+		err := testCase.clnt.validateMetadata(testCase.metadata, testCase.tags)
+		var merr ErrInvalidMetadata
+		switch {
+		case testCase.key == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", testCase.name, err)
+		case testCase.key == "":
+		case !errors.As(err, &merr):
+			t.Errorf("%s: expected ErrInvalidMetadata, got %v", testCase.name, err)
+		case merr.Key != testCase.key || merr.Tag != testCase.tag:
+			t.Errorf("%s: expected key %q (tag %v), got %v", testCase.name, testCase.key, testCase.tag, merr)
+		}
+	}
+
+	_, err := s3.PutObject(context.Background(), "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{
+		UserMetadata: map[string]string{"name": "line\rbreak"},
+	})
+	var merr ErrInvalidMetadata
+	if !errors.As(err, &merr) || merr.Key != "name" {
+		t.Fatalf("expected ErrInvalidMetadata, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("expected no requests, got %d", requests)
+	}
+}